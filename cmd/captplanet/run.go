@@ -12,12 +12,14 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 
+	"czarcoin.org/czarcoin/pkg/accounting/tally"
 	"czarcoin.org/czarcoin/pkg/audit"
 	"czarcoin.org/czarcoin/pkg/auth/grpcauth"
 	"czarcoin.org/czarcoin/pkg/bwagreement"
 	"czarcoin.org/czarcoin/pkg/cfgstruct"
 	"czarcoin.org/czarcoin/pkg/datarepair/checker"
 	"czarcoin.org/czarcoin/pkg/datarepair/repairer"
+	"czarcoin.org/czarcoin/pkg/gc"
 	"czarcoin.org/czarcoin/pkg/inspector"
 	"czarcoin.org/czarcoin/pkg/kademlia"
 	"czarcoin.org/czarcoin/pkg/miniogw"
@@ -45,9 +47,11 @@ type Satellite struct {
 	Inspector   inspector.Config
 	Checker     checker.Config
 	Repairer    repairer.Config
+	GC          gc.Config
 	Audit       audit.Config
 	StatDB      statdb.Config
 	BwAgreement bwagreement.Config
+	Tally       tally.Config
 	Web         satelliteweb.Config
 	Database    string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
 }
@@ -131,7 +135,9 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 			runCfg.Satellite.PointerDB,
 			runCfg.Satellite.Checker,
 			runCfg.Satellite.Repairer,
+			runCfg.Satellite.GC,
 			runCfg.Satellite.BwAgreement,
+			runCfg.Satellite.Tally,
 			runCfg.Satellite.Web,
 
 			// NB(dylan): Inspector is only used for local development and testing.
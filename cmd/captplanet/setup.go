@@ -145,8 +145,10 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 			setupCfg.BasePath, "satellite", "pointerdb.db"),
 		"satellite.overlay.database-url": "bolt://" + filepath.Join(
 			setupCfg.BasePath, "satellite", "overlay.db"),
-		"satellite.repairer.queue-address": "redis://127.0.0.1:6378?db=1&password=abc123",
-		"satellite.repairer.overlay-addr":  overlayAddr,
+		// all storage nodes run on the same host
+		"satellite.overlay.node.distinct-ip": false,
+		"satellite.repairer.queue-address":   "redis://127.0.0.1:6378?db=1&password=abc123",
+		"satellite.repairer.overlay-addr":    overlayAddr,
 		"satellite.repairer.pointer-db-addr": joinHostPort(
 			setupCfg.ListenHost, startingPort+1),
 		"satellite.repairer.api-key": setupCfg.APIKey,
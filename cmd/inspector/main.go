@@ -12,6 +12,7 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/spf13/cobra"
@@ -23,6 +24,7 @@ import (
 	"czarcoin.org/czarcoin/pkg/provider"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/pkg/transport"
+	"czarcoin.org/czarcoin/pkg/utils"
 )
 
 var (
@@ -54,11 +56,28 @@ var (
 		Use:   "statdb",
 		Short: "commands for statdb",
 	}
+	repairsCmd = &cobra.Command{
+		Use:   "repairs",
+		Short: "commands for the repair history",
+	}
+	irreparableCmd = &cobra.Command{
+		Use:   "irreparable",
+		Short: "commands for segments which lost too many pieces to be repaired",
+	}
+	bandwidthCmd = &cobra.Command{
+		Use:   "bandwidth",
+		Short: "commands for the settled bandwidth",
+	}
 	countNodeCmd = &cobra.Command{
 		Use:   "count",
 		Short: "count nodes in kademlia and overlay",
 		RunE:  CountNodes,
 	}
+	versionsCmd = &cobra.Command{
+		Use:   "versions",
+		Short: "show how many nodes in the overlay run each version",
+		RunE:  VersionDistribution,
+	}
 	getBucketsCmd = &cobra.Command{
 		Use:   "list-buckets",
 		Short: "get all buckets in overlay",
@@ -113,12 +132,43 @@ var (
 		Args:  cobra.MinimumNArgs(1),
 		RunE:  CreateCSVStats,
 	}
+	listRepairsCmd = &cobra.Command{
+		Use:   "list [path]",
+		Short: "list the latest repairs of all segments or of the segment at path",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  ListRepairs,
+	}
+	listRollupsCmd = &cobra.Command{
+		Use:   "rollups <start> <end>",
+		Short: "list the hourly bandwidth rollups between start and end, as RFC3339 or YYYY-MM-DD",
+		Args:  cobra.ExactArgs(2),
+		RunE:  ListBandwidthRollups,
+	}
+	listIrreparableCmd = &cobra.Command{
+		Use:   "list [limit] [offset]",
+		Short: "list a page of irreparable segments",
+		Args:  cobra.MaximumNArgs(2),
+		RunE:  ListIrreparable,
+	}
+	showIrreparableCmd = &cobra.Command{
+		Use:   "show <path>",
+		Short: "show the details of an irreparable segment",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  ShowIrreparable,
+	}
+	retryIrreparableCmd = &cobra.Command{
+		Use:   "retry <path>",
+		Short: "check the pieces of an irreparable segment again and queue it for repair",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  RetryIrreparable,
+	}
 )
 
 // Inspector gives access to kademlia and overlay cache
 type Inspector struct {
-	identity *provider.FullIdentity
-	client   pb.InspectorClient
+	identity    *provider.FullIdentity
+	client      pb.InspectorClient
+	irreparable pb.IrreparableClient
 }
 
 // NewInspector creates a new gRPC inspector server for access to kad
@@ -139,8 +189,9 @@ func NewInspector(address string) (*Inspector, error) {
 	c := pb.NewInspectorClient(conn)
 
 	return &Inspector{
-		identity: identity,
-		client:   c,
+		identity:    identity,
+		client:      c,
+		irreparable: pb.NewIrreparableClient(conn),
 	}, nil
 }
 
@@ -160,6 +211,32 @@ func CountNodes(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// VersionDistribution prints how many nodes in the overlay run each version
+func VersionDistribution(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.client.VersionDistribution(context.Background(), &pb.VersionDistributionRequest{})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("%-8s %-12s %8s  %s\n", "TYPE", "VERSION", "NODES", "STATUS")
+	for _, v := range res.Versions {
+		version, status := v.Version, "ok"
+		if version == "" {
+			version = "unknown"
+		}
+		if !v.Allowed {
+			status = "below minimum"
+		}
+		fmt.Printf("%-8s %-12s %8d  %s\n", v.Type, version, v.Count, status)
+	}
+	return nil
+}
+
 // GetBuckets returns all buckets in the overlay cache's routing table
 func GetBuckets(cmd *cobra.Command, args []string) (err error) {
 	i, err := NewInspector(*Addr)
@@ -465,11 +542,146 @@ func CreateCSVStats(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// ListRepairs prints the latest repairs from the repair history
+func ListRepairs(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	req := &pb.ListRepairsRequest{Limit: 100}
+	if len(args) > 0 {
+		req.Path = args[0]
+	}
+
+	res, err := i.client.ListRepairs(context.Background(), req)
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	for _, repair := range res.Repairs {
+		outcome := "ok"
+		if repair.Failure != "" {
+			outcome = "failed: " + repair.Failure
+		}
+		fmt.Printf("%s %s healthy: %d, rebuilt: %d, %s\n",
+			time.Unix(repair.RepairedUnixSec, 0).Format(time.RFC3339), repair.Path,
+			repair.HealthyPieces, repair.RebuiltPieces, outcome)
+		for _, nodeID := range repair.NewNodes {
+			fmt.Printf("\tnew node %s\n", nodeID)
+		}
+	}
+	return nil
+}
+
+// ListBandwidthRollups prints the hourly bandwidth rollups of a period
+func ListBandwidthRollups(cmd *cobra.Command, args []string) (err error) {
+	start, err := utils.ParseTime(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	end, err := utils.ParseTime(args[1])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	i, err := NewInspector(*Addr)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.client.ListBandwidthRollups(context.Background(), &pb.ListBandwidthRollupsRequest{
+		StartUnixSec: start.Unix(),
+		EndUnixSec:   end.Unix(),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	for _, rollup := range res.Rollups {
+		fmt.Printf("%s storage node: %s, uplink: %s, %s: %d\n",
+			time.Unix(rollup.IntervalStartUnixSec, 0).UTC().Format(time.RFC3339),
+			rollup.StorageNodeId, rollup.UplinkId, rollup.Action, rollup.Total)
+	}
+	return nil
+}
+
+// ListIrreparable prints a page of irreparable segments
+func ListIrreparable(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	req := &pb.ListIrreparableRequest{Limit: 50}
+	if len(args) > 0 {
+		limit, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			return ErrArgs.Wrap(err)
+		}
+		req.Limit = int32(limit)
+	}
+	if len(args) > 1 {
+		req.Offset, err = strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return ErrArgs.Wrap(err)
+		}
+	}
+
+	res, err := i.irreparable.ListIrreparable(context.Background(), req)
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	for _, segment := range res.Segments {
+		fmt.Printf("%s lost: %d, attempts: %d, last attempt: %s\n",
+			segment.Path, segment.LostPieces, segment.RepairAttemptCount,
+			time.Unix(segment.LastRepairAttemptUnixSec, 0).Format(time.RFC3339))
+	}
+	return nil
+}
+
+// ShowIrreparable prints the details of an irreparable segment
+func ShowIrreparable(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.irreparable.GetIrreparable(context.Background(), &pb.GetIrreparableRequest{Path: args[0]})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	m := jsonpb.Marshaler{Indent: "  ", EmitDefaults: true}
+	return m.Marshal(os.Stdout, res.Segment)
+}
+
+// RetryIrreparable checks the pieces of an irreparable segment again
+func RetryIrreparable(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.irreparable.RetryIrreparable(context.Background(), &pb.RetryIrreparableRequest{Path: args[0]})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("%s %s, healthy: %d\n", args[0], res.Status, res.HealthyPieces)
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(kadCmd)
 	rootCmd.AddCommand(statsCmd)
+	rootCmd.AddCommand(repairsCmd)
+	rootCmd.AddCommand(irreparableCmd)
+	rootCmd.AddCommand(bandwidthCmd)
 
 	kadCmd.AddCommand(countNodeCmd)
+	kadCmd.AddCommand(versionsCmd)
 	kadCmd.AddCommand(getBucketsCmd)
 	kadCmd.AddCommand(getBucketCmd)
 	kadCmd.AddCommand(pingNodeCmd)
@@ -481,6 +693,12 @@ func init() {
 	statsCmd.AddCommand(createStatsCmd)
 	statsCmd.AddCommand(createCSVStatsCmd)
 
+	repairsCmd.AddCommand(listRepairsCmd)
+
+	irreparableCmd.AddCommand(listIrreparableCmd)
+	irreparableCmd.AddCommand(showIrreparableCmd)
+	irreparableCmd.AddCommand(retryIrreparableCmd)
+
 	flag.Parse()
 }
 
@@ -54,5 +54,5 @@ func (c cacheConfig) open() (*overlay.Cache, error) {
 		return nil, Error.New("statdb error: %s", err)
 	}
 
-	return overlay.NewOverlayCache(db, nil, sdb), nil
+	return overlay.NewOverlayCache(overlay.NewKeyValueDB(db), nil, sdb), nil
 }
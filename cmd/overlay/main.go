@@ -53,25 +53,16 @@ func cmdList(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	keys, err := c.DB.List(nil, 0)
+	nodes, err := c.DB.List(process.Ctx(cmd), czarcoin.NodeID{}, 0)
 	if err != nil {
 		return err
 	}
 
-	nodeIDs, err := czarcoin.NodeIDsFromBytes(keys.ByteSlices())
-	if err != nil {
-		return err
-	}
-	for _, id := range nodeIDs {
-		n, err := c.Get(process.Ctx(cmd), id)
-		if err != nil {
-			zap.S().Infof("ID: %s; error getting value\n", id.String())
-		}
-		if n != nil {
-			zap.S().Infof("ID: %s; Address: %s\n", id.String(), n.Address.Address)
+	for _, n := range nodes {
+		if n == nil {
 			continue
 		}
-		zap.S().Infof("ID: %s: nil\n", id.String())
+		zap.S().Infof("ID: %s; Address: %s\n", n.Id.String(), n.GetAddress().GetAddress())
 	}
 
 	return nil
@@ -16,6 +16,9 @@ import (
 	"github.com/zeebo/errs"
 
 	"czarcoin.org/czarcoin/internal/fpath"
+	"czarcoin.org/czarcoin/pkg/accounting"
+	"czarcoin.org/czarcoin/pkg/accounting/payout"
+	"czarcoin.org/czarcoin/pkg/accounting/tally"
 	"czarcoin.org/czarcoin/pkg/audit"
 	"czarcoin.org/czarcoin/pkg/auth/grpcauth"
 	"czarcoin.org/czarcoin/pkg/bwagreement"
@@ -23,6 +26,7 @@ import (
 	"czarcoin.org/czarcoin/pkg/datarepair/checker"
 	"czarcoin.org/czarcoin/pkg/datarepair/queue"
 	"czarcoin.org/czarcoin/pkg/datarepair/repairer"
+	"czarcoin.org/czarcoin/pkg/gc"
 	"czarcoin.org/czarcoin/pkg/kademlia"
 	"czarcoin.org/czarcoin/pkg/overlay"
 	"czarcoin.org/czarcoin/pkg/pb"
@@ -30,6 +34,7 @@ import (
 	"czarcoin.org/czarcoin/pkg/process"
 	"czarcoin.org/czarcoin/pkg/provider"
 	"czarcoin.org/czarcoin/pkg/statdb"
+	"czarcoin.org/czarcoin/pkg/utils"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/satellite/satellitedb"
 	"czarcoin.org/czarcoin/storage/redis"
@@ -60,6 +65,15 @@ var (
 		Short: "Repair Queue Diagnostic Tool support",
 		RunE:  cmdQDiag,
 	}
+	reportsCmd = &cobra.Command{
+		Use:   "reports",
+		Short: "Generate reports from the accounting data",
+	}
+	payoutCmd = &cobra.Command{
+		Use:   "payout",
+		Short: "Generate the storage node payouts of a closed period",
+		RunE:  cmdPayout,
+	}
 
 	runCfg struct {
 		Identity    provider.IdentityConfig
@@ -69,8 +83,10 @@ var (
 		StatDB      statdb.Config
 		Checker     checker.Config
 		Repairer    repairer.Config
+		GC          gc.Config
 		Audit       audit.Config
 		BwAgreement bwagreement.Config
+		Tally       tally.Config
 		Database    string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
 	}
 	setupCfg struct {
@@ -86,6 +102,15 @@ var (
 		DatabaseURL string `help:"the database connection string to use" default:"redis://127.0.0.1:6378?db=1&password=abc123"`
 		QListLimit  int    `help:"maximum segments that can be requested" default:"1000"`
 	}
+	payoutCfg struct {
+		Database              string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+		AccountingDatabaseURL string `help:"the accounting database connection string" default:"sqlite3://$CONFDIR/stats.db"`
+		Start                 string `help:"start of the period, on the hour, as RFC3339 or YYYY-MM-DD in UTC" default:""`
+		End                   string `help:"end of the period, exclusive and on the hour, as RFC3339 or YYYY-MM-DD in UTC" default:""`
+		Format                string `help:"report format, csv or json" default:"csv"`
+		Output                string `help:"file to write the report to, stdout when empty" default:""`
+		Rates                 payout.Rates
+	}
 
 	defaultConfDir string
 )
@@ -96,10 +121,13 @@ func init() {
 	rootCmd.AddCommand(setupCmd)
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(qdiagCmd)
+	rootCmd.AddCommand(reportsCmd)
+	reportsCmd.AddCommand(payoutCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir))
 	cfgstruct.Bind(setupCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir))
 	cfgstruct.Bind(diagCmd.Flags(), &diagCfg, cfgstruct.ConfDir(defaultConfDir))
 	cfgstruct.Bind(qdiagCmd.Flags(), &qdiagCfg, cfgstruct.ConfDir(defaultConfDir))
+	cfgstruct.Bind(payoutCmd.Flags(), &payoutCfg, cfgstruct.ConfDir(defaultConfDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -127,8 +155,10 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		runCfg.Overlay,
 		runCfg.Checker,
 		runCfg.Repairer,
+		runCfg.GC,
 		runCfg.Audit,
 		runCfg.BwAgreement,
+		runCfg.Tally,
 	)
 }
 
@@ -285,6 +315,53 @@ func cmdQDiag(cmd *cobra.Command, args []string) (err error) {
 	return w.Flush()
 }
 
+func cmdPayout(cmd *cobra.Command, args []string) (err error) {
+	start, err := utils.ParseTime(payoutCfg.Start)
+	if err != nil {
+		return errs.New("invalid start: %+v", err)
+	}
+	end, err := utils.ParseTime(payoutCfg.End)
+	if err != nil {
+		return errs.New("invalid end: %+v", err)
+	}
+
+	write := payout.WriteCSV
+	switch payoutCfg.Format {
+	case "csv":
+	case "json":
+		write = payout.WriteJSON
+	default:
+		return errs.New("unknown report format %q", payoutCfg.Format)
+	}
+
+	database, err := satellitedb.NewDB(payoutCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() { err = utils.CombineErrors(err, database.Close()) }()
+
+	accountingDB, err := accounting.NewDb(payoutCfg.AccountingDatabaseURL)
+	if err != nil {
+		return errs.New("error connecting to accounting database on satellite: %+v", err)
+	}
+	defer func() { err = utils.CombineErrors(err, accountingDB.Close()) }()
+
+	payouts, err := payout.Generate(process.Ctx(cmd), accountingDB, database.NodeWallets(), start, end, payoutCfg.Rates)
+	if err != nil {
+		return err
+	}
+
+	if payoutCfg.Output == "" {
+		return write(os.Stdout, payouts)
+	}
+	file, err := os.Create(payoutCfg.Output)
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, file.Close()) }()
+	return write(file, payouts)
+}
+
 func main() {
 	runCmd.Flags().String("config",
 		filepath.Join(defaultConfDir, "config.yaml"), "path to configuration")
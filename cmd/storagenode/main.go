@@ -124,7 +124,7 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 
 	// open the sql db
 	dbpath := filepath.Join(diagCfg.BasePath, "piecestore.db")
-	db, err := psdb.Open(context.Background(), "", dbpath)
+	db, err := psdb.Open(context.Background(), nil, dbpath)
 	if err != nil {
 		fmt.Println("Storagenode database couldnt open:", dbpath)
 		return err
@@ -111,12 +111,13 @@ func main() {
 				Data: serializedAllocation,
 			}
 
-			if err := psClient.Put(context.Background(), id, dataSection, ttl, pba, nil); err != nil {
+			hash, err := psClient.Put(context.Background(), id, dataSection, ttl, pba, nil)
+			if err != nil {
 				fmt.Printf("Failed to Store data of id: %s\n", id)
 				return err
 			}
 
-			fmt.Printf("Successfully stored file of id: %s\n", id)
+			fmt.Printf("Successfully stored file of id: %s with hash: %x\n", id, hash)
 
 			return nil
 		},
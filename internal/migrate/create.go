@@ -21,8 +21,22 @@ type DB interface {
 // Error is the default migrate errs class
 var Error = errs.Class("migrate")
 
+// Migration upgrades tables created with an older schema to the current one
+type Migration struct {
+	// From is the schema the tables were created with
+	From string
+	// Steps are executed in order to upgrade the tables and their rows
+	Steps []string
+}
+
 // Create with a previous schema check
 func Create(identifier string, db DB) error {
+	return CreateWithMigrations(identifier, db)
+}
+
+// CreateWithMigrations works like Create, but when the previous schema is the
+// From of a migration, its steps are executed and the schema is replaced
+func CreateWithMigrations(identifier string, db DB, migrations ...Migration) error {
 	tx, err := db.Begin()
 	if err != nil {
 		return Error.Wrap(err)
@@ -59,6 +73,22 @@ func Create(identifier string, db DB) error {
 	}
 
 	if schema != previousSchema {
+		for _, migration := range migrations {
+			if migration.From != previousSchema {
+				continue
+			}
+			for _, step := range migration.Steps {
+				if _, err := tx.Exec(step); err != nil {
+					return Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+				}
+			}
+			_, err = tx.Exec(db.Rebind(`UPDATE table_schemas SET schemaText = ? WHERE id = ?;`), schema, identifier)
+			if err != nil {
+				return Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+			}
+			return Error.Wrap(tx.Commit())
+		}
+
 		err := Error.New("schema mismatch:\nold %v\nnew %v", previousSchema, schema)
 		return Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
 	}
@@ -144,7 +144,7 @@ func (node *Node) DialOverlay(destination *Node) (overlay.Client, error) {
 
 // initOverlay creates overlay for a given planet
 func (node *Node) initOverlay(planet *Planet) error {
-	routing, err := kademlia.NewRoutingTable(node.Info, teststore.New(), teststore.New())
+	routing, err := kademlia.NewRoutingTable(node.Info, teststore.New())
 	if err != nil {
 		return err
 	}
@@ -162,7 +162,7 @@ func (node *Node) initOverlay(planet *Planet) error {
 	}
 	node.StatDB = sdb
 
-	node.Overlay = overlay.NewOverlayCache(teststore.New(), node.Kademlia, node.StatDB)
+	node.Overlay = overlay.NewOverlayCache(overlay.NewKeyValueDB(teststore.New()), node.Kademlia, node.StatDB)
 
 	return nil
 }
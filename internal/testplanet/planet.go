@@ -27,6 +27,7 @@ import (
 	"czarcoin.org/czarcoin/pkg/pointerdb"
 	"czarcoin.org/czarcoin/pkg/provider"
 	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/storage/filestore"
 	"czarcoin.org/czarcoin/storage/teststore"
 )
 
@@ -90,7 +91,7 @@ func New(t zaptest.TestingT, satelliteCount, storageNodeCount, uplinkCount int)
 	}
 
 	for _, n := range planet.nodes {
-		server := node.NewServer(n.Kademlia)
+		server := node.NewServer(n.Kademlia, n.Identity)
 		pb.RegisterNodesServer(n.Provider.GRPC(), server)
 		// TODO: shutdown
 	}
@@ -104,6 +105,8 @@ func New(t zaptest.TestingT, satelliteCount, storageNodeCount, uplinkCount int)
 				MinRemoteSegmentSize: 1240,
 				MaxInlineSegmentSize: 8000,
 				Overlay:              true,
+				AllocationExpiration: 24 * time.Hour,
+				AllocationMaxSize:    1 << 30,
 			},
 			node.Identity)
 		pb.RegisterPointerDBServer(node.Provider.GRPC(), pointerServer)
@@ -114,8 +117,9 @@ func New(t zaptest.TestingT, satelliteCount, storageNodeCount, uplinkCount int)
 			}
 		}(node)
 
-		overlayServer := overlay.NewServer(node.Log.Named("overlay"), node.Overlay, node.Kademlia)
+		overlayServer := overlay.NewServer(node.Log.Named("overlay"), node.Overlay, node.Kademlia, overlay.NodeSelectionConfig{}, overlay.CheckInConfig{})
 		pb.RegisterOverlayServer(node.Provider.GRPC(), overlayServer)
+		pb.RegisterCheckInServer(node.Provider.GRPC(), overlayServer)
 
 		node.Dependencies = append(node.Dependencies,
 			closerFunc(func() error {
@@ -139,12 +143,17 @@ func New(t zaptest.TestingT, satelliteCount, storageNodeCount, uplinkCount int)
 	for _, node := range planet.StorageNodes {
 		storageDir := filepath.Join(planet.directory, node.ID().String())
 
-		serverdb, err := psdb.OpenInMemory(context.Background(), storageDir)
+		blobs, err := filestore.NewAt(storageDir)
 		if err != nil {
 			return nil, utils.CombineErrors(err, planet.Shutdown())
 		}
 
-		server := pieceserver.New(storageDir, serverdb, pieceserver.Config{
+		serverdb, err := psdb.OpenInMemory(context.Background(), blobs)
+		if err != nil {
+			return nil, utils.CombineErrors(err, planet.Shutdown())
+		}
+
+		server := pieceserver.New(blobs, serverdb, pieceserver.Config{
 			Path:               storageDir,
 			AllocatedDiskSpace: memory.GB.Int64(),
 			AllocatedBandwidth: 100 * memory.GB.Int64(),
@@ -4,6 +4,8 @@
 package accounting
 
 import (
+	"time"
+
 	"github.com/zeebo/errs"
 
 	"czarcoin.org/czarcoin/internal/migrate"
@@ -17,8 +19,14 @@ var (
 
 	// LastBandwidthTally is a name in the accounting timestamps database
 	LastBandwidthTally = dbx.Timestamps_Name("LastBandwidthTally")
+	// LastAtRestTally is a name in the accounting timestamps database
+	LastAtRestTally = dbx.Timestamps_Name("LastAtRestTally")
 )
 
+// SettlementWindow is how long after its creation a bandwidth agreement may still be
+// committed, the bandwidth rollups are complete up to LastBandwidthTally minus it
+const SettlementWindow = 10 * time.Minute
+
 // NewDb - constructor for DB
 func NewDb(databaseURL string) (*dbx.DB, error) {
 	dbURL, err := utils.ParseURL(databaseURL)
@@ -30,7 +38,7 @@ func NewDb(databaseURL string) (*dbx.DB, error) {
 		return nil, Error.New("failed opening database %q, %q: %v",
 			dbURL.Scheme, dbURL.Path, err)
 	}
-	err = migrate.Create("accounting", db)
+	err = migrate.CreateWithMigrations("accounting", db, migrations...)
 	if err != nil {
 		_ = db.Close()
 		return nil, err
@@ -293,6 +293,27 @@ CREATE TABLE timestamps (
 	name text NOT NULL,
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
+);
+CREATE TABLE bandwidth_rollups (
+	storage_node_id text NOT NULL,
+	uplink_id text NOT NULL,
+	action integer NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( storage_node_id, uplink_id, action, interval_start )
+);
+CREATE TABLE storage_rollups (
+	storage_node_id text NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	at_rest_total double precision NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start )
+);
+CREATE TABLE payout_wallets (
+	storage_node_id text NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	wallet text NOT NULL,
+	PRIMARY KEY ( storage_node_id, period_start, period_end )
 );`
 }
 
@@ -378,6 +399,27 @@ CREATE TABLE timestamps (
 	name TEXT NOT NULL,
 	value TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
+);
+CREATE TABLE bandwidth_rollups (
+	storage_node_id TEXT NOT NULL,
+	uplink_id TEXT NOT NULL,
+	action INTEGER NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( storage_node_id, uplink_id, action, interval_start )
+);
+CREATE TABLE storage_rollups (
+	storage_node_id TEXT NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	at_rest_total REAL NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start )
+);
+CREATE TABLE payout_wallets (
+	storage_node_id TEXT NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	wallet TEXT NOT NULL,
+	PRIMARY KEY ( storage_node_id, period_start, period_end )
 );`
 }
 
@@ -718,6 +760,260 @@ func (f Timestamps_Value_Field) value() interface{} {
 
 func (Timestamps_Value_Field) _Column() string { return "value" }
 
+type BandwidthRollup struct {
+	StorageNodeId string
+	UplinkId      string
+	Action        int
+	IntervalStart time.Time
+	Total         int64
+}
+
+func (BandwidthRollup) _Table() string { return "bandwidth_rollups" }
+
+type BandwidthRollup_Update_Fields struct {
+	Total BandwidthRollup_Total_Field
+}
+
+type BandwidthRollup_StorageNodeId_Field struct {
+	_set   bool
+	_value string
+}
+
+func BandwidthRollup_StorageNodeId(v string) BandwidthRollup_StorageNodeId_Field {
+	return BandwidthRollup_StorageNodeId_Field{_set: true, _value: v}
+}
+
+func (f BandwidthRollup_StorageNodeId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BandwidthRollup_StorageNodeId_Field) _Column() string { return "storage_node_id" }
+
+type BandwidthRollup_UplinkId_Field struct {
+	_set   bool
+	_value string
+}
+
+func BandwidthRollup_UplinkId(v string) BandwidthRollup_UplinkId_Field {
+	return BandwidthRollup_UplinkId_Field{_set: true, _value: v}
+}
+
+func (f BandwidthRollup_UplinkId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BandwidthRollup_UplinkId_Field) _Column() string { return "uplink_id" }
+
+type BandwidthRollup_Action_Field struct {
+	_set   bool
+	_value int
+}
+
+func BandwidthRollup_Action(v int) BandwidthRollup_Action_Field {
+	return BandwidthRollup_Action_Field{_set: true, _value: v}
+}
+
+func (f BandwidthRollup_Action_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BandwidthRollup_Action_Field) _Column() string { return "action" }
+
+type BandwidthRollup_IntervalStart_Field struct {
+	_set   bool
+	_value time.Time
+}
+
+func BandwidthRollup_IntervalStart(v time.Time) BandwidthRollup_IntervalStart_Field {
+	return BandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f BandwidthRollup_IntervalStart_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type BandwidthRollup_Total_Field struct {
+	_set   bool
+	_value int64
+}
+
+func BandwidthRollup_Total(v int64) BandwidthRollup_Total_Field {
+	return BandwidthRollup_Total_Field{_set: true, _value: v}
+}
+
+func (f BandwidthRollup_Total_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BandwidthRollup_Total_Field) _Column() string { return "total" }
+
+type StorageRollup struct {
+	StorageNodeId string
+	IntervalStart time.Time
+	AtRestTotal   float64
+}
+
+func (StorageRollup) _Table() string { return "storage_rollups" }
+
+type StorageRollup_Update_Fields struct {
+	AtRestTotal StorageRollup_AtRestTotal_Field
+}
+
+type StorageRollup_StorageNodeId_Field struct {
+	_set   bool
+	_value string
+}
+
+func StorageRollup_StorageNodeId(v string) StorageRollup_StorageNodeId_Field {
+	return StorageRollup_StorageNodeId_Field{_set: true, _value: v}
+}
+
+func (f StorageRollup_StorageNodeId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (StorageRollup_StorageNodeId_Field) _Column() string { return "storage_node_id" }
+
+type StorageRollup_IntervalStart_Field struct {
+	_set   bool
+	_value time.Time
+}
+
+func StorageRollup_IntervalStart(v time.Time) StorageRollup_IntervalStart_Field {
+	return StorageRollup_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f StorageRollup_IntervalStart_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (StorageRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type StorageRollup_AtRestTotal_Field struct {
+	_set   bool
+	_value float64
+}
+
+func StorageRollup_AtRestTotal(v float64) StorageRollup_AtRestTotal_Field {
+	return StorageRollup_AtRestTotal_Field{_set: true, _value: v}
+}
+
+func (f StorageRollup_AtRestTotal_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (StorageRollup_AtRestTotal_Field) _Column() string { return "at_rest_total" }
+
+type PayoutWallet struct {
+	StorageNodeId string
+	PeriodStart   time.Time
+	PeriodEnd     time.Time
+	Wallet        string
+}
+
+func (PayoutWallet) _Table() string { return "payout_wallets" }
+
+type PayoutWallet_Update_Fields struct {
+}
+
+type PayoutWallet_StorageNodeId_Field struct {
+	_set   bool
+	_value string
+}
+
+func PayoutWallet_StorageNodeId(v string) PayoutWallet_StorageNodeId_Field {
+	return PayoutWallet_StorageNodeId_Field{_set: true, _value: v}
+}
+
+func (f PayoutWallet_StorageNodeId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutWallet_StorageNodeId_Field) _Column() string { return "storage_node_id" }
+
+type PayoutWallet_PeriodStart_Field struct {
+	_set   bool
+	_value time.Time
+}
+
+func PayoutWallet_PeriodStart(v time.Time) PayoutWallet_PeriodStart_Field {
+	return PayoutWallet_PeriodStart_Field{_set: true, _value: v}
+}
+
+func (f PayoutWallet_PeriodStart_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutWallet_PeriodStart_Field) _Column() string { return "period_start" }
+
+type PayoutWallet_PeriodEnd_Field struct {
+	_set   bool
+	_value time.Time
+}
+
+func PayoutWallet_PeriodEnd(v time.Time) PayoutWallet_PeriodEnd_Field {
+	return PayoutWallet_PeriodEnd_Field{_set: true, _value: v}
+}
+
+func (f PayoutWallet_PeriodEnd_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutWallet_PeriodEnd_Field) _Column() string { return "period_end" }
+
+type PayoutWallet_Wallet_Field struct {
+	_set   bool
+	_value string
+}
+
+func PayoutWallet_Wallet(v string) PayoutWallet_Wallet_Field {
+	return PayoutWallet_Wallet_Field{_set: true, _value: v}
+}
+
+func (f PayoutWallet_Wallet_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (PayoutWallet_Wallet_Field) _Column() string { return "wallet" }
+
 func toUTC(t time.Time) time.Time {
 	return t.UTC()
 }
@@ -1222,178 +1518,861 @@ func (obj *postgresImpl) Delete_Granular_By_NodeId(ctx context.Context,
 
 }
 
-func (impl postgresImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(*pq.Error); ok {
-		if e.Code.Class() == "23" {
-			return e.Constraint, true
-		}
-	}
-	return "", false
-}
+func (obj *postgresImpl) Create_BandwidthRollup(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field,
+	bandwidth_rollup_total BandwidthRollup_Total_Field) (
+	bandwidth_rollup *BandwidthRollup, err error) {
 
-func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error) {
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.Exec("DELETE FROM timestamps;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+	__storage_node_id_val := bandwidth_rollup_storage_node_id.value()
+	__uplink_id_val := bandwidth_rollup_uplink_id.value()
+	__action_val := bandwidth_rollup_action.value()
+	__interval_start_val := bandwidth_rollup_interval_start.value()
+	__total_val := bandwidth_rollup_total.value()
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM granulars;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bandwidth_rollups ( storage_node_id, uplink_id, action, interval_start, total ) VALUES ( ?, ?, ?, ?, ? ) RETURNING bandwidth_rollups.storage_node_id, bandwidth_rollups.uplink_id, bandwidth_rollups.action, bandwidth_rollups.interval_start, bandwidth_rollups.total")
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM aggregates;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __storage_node_id_val, __uplink_id_val, __action_val, __interval_start_val, __total_val)
 
-	__count, err = __res.RowsAffected()
+	bandwidth_rollup = &BandwidthRollup{}
+	err = obj.driver.QueryRow(__stmt, __storage_node_id_val, __uplink_id_val, __action_val, __interval_start_val, __total_val).Scan(&bandwidth_rollup.StorageNodeId, &bandwidth_rollup.UplinkId, &bandwidth_rollup.Action, &bandwidth_rollup.IntervalStart, &bandwidth_rollup.Total)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	count += __count
-
-	return count, nil
+	return bandwidth_rollup, nil
 
 }
 
-func (obj *sqlite3Impl) Create_Timestamps(ctx context.Context,
-	timestamps_name Timestamps_Name_Field,
-	timestamps_value Timestamps_Value_Field) (
-	timestamps *Timestamps, err error) {
-	__name_val := timestamps_name.value()
-	__value_val := timestamps_value.value()
+func (obj *postgresImpl) Get_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field) (
+	bandwidth_rollup *BandwidthRollup, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO timestamps ( name, value ) VALUES ( ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bandwidth_rollups.storage_node_id, bandwidth_rollups.uplink_id, bandwidth_rollups.action, bandwidth_rollups.interval_start, bandwidth_rollups.total FROM bandwidth_rollups WHERE bandwidth_rollups.storage_node_id = ? AND bandwidth_rollups.uplink_id = ? AND bandwidth_rollups.action = ? AND bandwidth_rollups.interval_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, bandwidth_rollup_storage_node_id.value())
+	__values = append(__values, bandwidth_rollup_uplink_id.value())
+	__values = append(__values, bandwidth_rollup_action.value())
+	__values = append(__values, bandwidth_rollup_interval_start.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __name_val, __value_val)
+	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.Exec(__stmt, __name_val, __value_val)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	__pk, err := __res.LastInsertId()
+	bandwidth_rollup = &BandwidthRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bandwidth_rollup.StorageNodeId, &bandwidth_rollup.UplinkId, &bandwidth_rollup.Action, &bandwidth_rollup.IntervalStart, &bandwidth_rollup.Total)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastTimestamps(ctx, __pk)
+	return bandwidth_rollup, nil
 
 }
 
-func (obj *sqlite3Impl) Create_Aggregate(ctx context.Context,
-	aggregate_node_id Aggregate_NodeId_Field,
-	aggregate_start_time Aggregate_StartTime_Field,
-	aggregate_interval Aggregate_Interval_Field) (
-	aggregate *Aggregate, err error) {
+func (obj *postgresImpl) All_BandwidthRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+	bandwidth_rollup_interval_start_greater_or_equal BandwidthRollup_IntervalStart_Field) (
+	rows []*BandwidthRollup, err error) {
 
-	__now := obj.db.Hooks.Now().UTC()
-	__node_id_val := aggregate_node_id.value()
-	__start_time_val := aggregate_start_time.value()
-	__interval_val := aggregate_interval.value()
-	__created_at_val := __now
-	__updated_at_val := __now
+	var __embed_stmt = __sqlbundle_Literal("SELECT bandwidth_rollups.storage_node_id, bandwidth_rollups.uplink_id, bandwidth_rollups.action, bandwidth_rollups.interval_start, bandwidth_rollups.total FROM bandwidth_rollups WHERE bandwidth_rollups.interval_start >= ? ORDER BY bandwidth_rollups.interval_start")
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO aggregates ( node_id, start_time, interval, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ? )")
+	var __values []interface{}
+	__values = append(__values, bandwidth_rollup_interval_start_greater_or_equal.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __start_time_val, __interval_val, __created_at_val, __updated_at_val)
+	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __start_time_val, __interval_val, __created_at_val, __updated_at_val)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bandwidth_rollup := &BandwidthRollup{}
+		err = __rows.Scan(&bandwidth_rollup.StorageNodeId, &bandwidth_rollup.UplinkId, &bandwidth_rollup.Action, &bandwidth_rollup.IntervalStart, &bandwidth_rollup.Total)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bandwidth_rollup)
+	}
+	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastAggregate(ctx, __pk)
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Update_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field,
+	update BandwidthRollup_Update_Fields) (
+	bandwidth_rollup *BandwidthRollup, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bandwidth_rollups SET "), __sets, __sqlbundle_Literal(" WHERE bandwidth_rollups.storage_node_id = ? AND bandwidth_rollups.uplink_id = ? AND bandwidth_rollups.action = ? AND bandwidth_rollups.interval_start = ? RETURNING bandwidth_rollups.storage_node_id, bandwidth_rollups.uplink_id, bandwidth_rollups.action, bandwidth_rollups.interval_start, bandwidth_rollups.total")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Total._set {
+		__values = append(__values, update.Total.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bandwidth_rollup_storage_node_id.value())
+	__args = append(__args, bandwidth_rollup_uplink_id.value())
+	__args = append(__args, bandwidth_rollup_action.value())
+	__args = append(__args, bandwidth_rollup_interval_start.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bandwidth_rollup = &BandwidthRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bandwidth_rollup.StorageNodeId, &bandwidth_rollup.UplinkId, &bandwidth_rollup.Action, &bandwidth_rollup.IntervalStart, &bandwidth_rollup.Total)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bandwidth_rollup, nil
+}
+
+func (obj *postgresImpl) Delete_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bandwidth_rollups WHERE bandwidth_rollups.storage_node_id = ? AND bandwidth_rollups.uplink_id = ? AND bandwidth_rollups.action = ? AND bandwidth_rollups.interval_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, bandwidth_rollup_storage_node_id.value())
+	__values = append(__values, bandwidth_rollup_uplink_id.value())
+	__values = append(__values, bandwidth_rollup_action.value())
+	__values = append(__values, bandwidth_rollup_interval_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_StorageRollup(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field,
+	storage_rollup_at_rest_total StorageRollup_AtRestTotal_Field) (
+	storage_rollup *StorageRollup, err error) {
+
+	__storage_node_id_val := storage_rollup_storage_node_id.value()
+	__interval_start_val := storage_rollup_interval_start.value()
+	__at_rest_total_val := storage_rollup_at_rest_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO storage_rollups ( storage_node_id, interval_start, at_rest_total ) VALUES ( ?, ?, ? ) RETURNING storage_rollups.storage_node_id, storage_rollups.interval_start, storage_rollups.at_rest_total")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __storage_node_id_val, __interval_start_val, __at_rest_total_val)
+
+	storage_rollup = &StorageRollup{}
+	err = obj.driver.QueryRow(__stmt, __storage_node_id_val, __interval_start_val, __at_rest_total_val).Scan(&storage_rollup.StorageNodeId, &storage_rollup.IntervalStart, &storage_rollup.AtRestTotal)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return storage_rollup, nil
+
+}
+
+func (obj *postgresImpl) Get_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field) (
+	storage_rollup *StorageRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT storage_rollups.storage_node_id, storage_rollups.interval_start, storage_rollups.at_rest_total FROM storage_rollups WHERE storage_rollups.storage_node_id = ? AND storage_rollups.interval_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, storage_rollup_storage_node_id.value())
+	__values = append(__values, storage_rollup_interval_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	storage_rollup = &StorageRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&storage_rollup.StorageNodeId, &storage_rollup.IntervalStart, &storage_rollup.AtRestTotal)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return storage_rollup, nil
+
+}
+
+func (obj *postgresImpl) All_StorageRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+	storage_rollup_interval_start_greater_or_equal StorageRollup_IntervalStart_Field) (
+	rows []*StorageRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT storage_rollups.storage_node_id, storage_rollups.interval_start, storage_rollups.at_rest_total FROM storage_rollups WHERE storage_rollups.interval_start >= ? ORDER BY storage_rollups.interval_start")
+
+	var __values []interface{}
+	__values = append(__values, storage_rollup_interval_start_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		storage_rollup := &StorageRollup{}
+		err = __rows.Scan(&storage_rollup.StorageNodeId, &storage_rollup.IntervalStart, &storage_rollup.AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, storage_rollup)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Update_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field,
+	update StorageRollup_Update_Fields) (
+	storage_rollup *StorageRollup, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE storage_rollups SET "), __sets, __sqlbundle_Literal(" WHERE storage_rollups.storage_node_id = ? AND storage_rollups.interval_start = ? RETURNING storage_rollups.storage_node_id, storage_rollups.interval_start, storage_rollups.at_rest_total")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.AtRestTotal._set {
+		__values = append(__values, update.AtRestTotal.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("at_rest_total = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, storage_rollup_storage_node_id.value())
+	__args = append(__args, storage_rollup_interval_start.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	storage_rollup = &StorageRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&storage_rollup.StorageNodeId, &storage_rollup.IntervalStart, &storage_rollup.AtRestTotal)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return storage_rollup, nil
+}
+
+func (obj *postgresImpl) Delete_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM storage_rollups WHERE storage_rollups.storage_node_id = ? AND storage_rollups.interval_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, storage_rollup_storage_node_id.value())
+	__values = append(__values, storage_rollup_interval_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_PayoutWallet(ctx context.Context,
+	payout_wallet_storage_node_id PayoutWallet_StorageNodeId_Field,
+	payout_wallet_period_start PayoutWallet_PeriodStart_Field,
+	payout_wallet_period_end PayoutWallet_PeriodEnd_Field,
+	payout_wallet_wallet PayoutWallet_Wallet_Field) (
+	payout_wallet *PayoutWallet, err error) {
+
+	__storage_node_id_val := payout_wallet_storage_node_id.value()
+	__period_start_val := payout_wallet_period_start.value()
+	__period_end_val := payout_wallet_period_end.value()
+	__wallet_val := payout_wallet_wallet.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO payout_wallets ( storage_node_id, period_start, period_end, wallet ) VALUES ( ?, ?, ?, ? ) RETURNING payout_wallets.storage_node_id, payout_wallets.period_start, payout_wallets.period_end, payout_wallets.wallet")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __storage_node_id_val, __period_start_val, __period_end_val, __wallet_val)
+
+	payout_wallet = &PayoutWallet{}
+	err = obj.driver.QueryRow(__stmt, __storage_node_id_val, __period_start_val, __period_end_val, __wallet_val).Scan(&payout_wallet.StorageNodeId, &payout_wallet.PeriodStart, &payout_wallet.PeriodEnd, &payout_wallet.Wallet)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return payout_wallet, nil
+
+}
+
+func (obj *postgresImpl) All_PayoutWallet_By_PeriodStart_PeriodEnd(ctx context.Context,
+	payout_wallet_period_start PayoutWallet_PeriodStart_Field,
+	payout_wallet_period_end PayoutWallet_PeriodEnd_Field) (
+	rows []*PayoutWallet, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_wallets.storage_node_id, payout_wallets.period_start, payout_wallets.period_end, payout_wallets.wallet FROM payout_wallets WHERE payout_wallets.period_start = ? AND payout_wallets.period_end = ?")
+
+	var __values []interface{}
+	__values = append(__values, payout_wallet_period_start.value())
+	__values = append(__values, payout_wallet_period_end.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		payout_wallet := &PayoutWallet{}
+		err = __rows.Scan(&payout_wallet.StorageNodeId, &payout_wallet.PeriodStart, &payout_wallet.PeriodEnd, &payout_wallet.Wallet)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, payout_wallet)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (impl postgresImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(*pq.Error); ok {
+		if e.Code.Class() == "23" {
+			return e.Constraint, true
+		}
+	}
+	return "", false
+}
+
+func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error) {
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM payout_wallets;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM storage_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bandwidth_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM timestamps;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM granulars;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM aggregates;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+
+	return count, nil
+
+}
+
+func (obj *sqlite3Impl) Create_Timestamps(ctx context.Context,
+	timestamps_name Timestamps_Name_Field,
+	timestamps_value Timestamps_Value_Field) (
+	timestamps *Timestamps, err error) {
+	__name_val := timestamps_name.value()
+	__value_val := timestamps_value.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO timestamps ( name, value ) VALUES ( ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __name_val, __value_val)
+
+	__res, err := obj.driver.Exec(__stmt, __name_val, __value_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastTimestamps(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_Aggregate(ctx context.Context,
+	aggregate_node_id Aggregate_NodeId_Field,
+	aggregate_start_time Aggregate_StartTime_Field,
+	aggregate_interval Aggregate_Interval_Field) (
+	aggregate *Aggregate, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := aggregate_node_id.value()
+	__start_time_val := aggregate_start_time.value()
+	__interval_val := aggregate_interval.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO aggregates ( node_id, start_time, interval, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __start_time_val, __interval_val, __created_at_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __start_time_val, __interval_val, __created_at_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAggregate(ctx, __pk)
 
 }
 
 func (obj *sqlite3Impl) Create_Granular(ctx context.Context,
 	granular_node_id Granular_NodeId_Field,
-	granular_start_time Granular_StartTime_Field,
-	granular_end_time Granular_EndTime_Field,
-	granular_data_total Granular_DataTotal_Field) (
+	granular_start_time Granular_StartTime_Field,
+	granular_end_time Granular_EndTime_Field,
+	granular_data_total Granular_DataTotal_Field) (
+	granular *Granular, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := granular_node_id.value()
+	__start_time_val := granular_start_time.value()
+	__end_time_val := granular_end_time.value()
+	__data_total_val := granular_data_total.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO granulars ( node_id, start_time, end_time, data_total, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __start_time_val, __end_time_val, __data_total_val, __created_at_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __start_time_val, __end_time_val, __data_total_val, __created_at_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastGranular(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Find_Timestamps_Value_By_Name(ctx context.Context,
+	timestamps_name Timestamps_Name_Field) (
+	row *Value_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT timestamps.value FROM timestamps WHERE timestamps.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, timestamps_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Value_Row{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.Value)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *sqlite3Impl) Get_Aggregate_By_NodeId(ctx context.Context,
+	aggregate_node_id Aggregate_NodeId_Field) (
+	aggregate *Aggregate, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT aggregates.node_id, aggregates.start_time, aggregates.interval, aggregates.created_at, aggregates.updated_at FROM aggregates WHERE aggregates.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, aggregate_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	aggregate = &Aggregate{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&aggregate.NodeId, &aggregate.StartTime, &aggregate.Interval, &aggregate.CreatedAt, &aggregate.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return aggregate, nil
+
+}
+
+func (obj *sqlite3Impl) Get_Granular_By_NodeId(ctx context.Context,
+	granular_node_id Granular_NodeId_Field) (
+	granular *Granular, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT granulars.node_id, granulars.start_time, granulars.end_time, granulars.data_total, granulars.created_at, granulars.updated_at FROM granulars WHERE granulars.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, granular_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	granular = &Granular{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&granular.NodeId, &granular.StartTime, &granular.EndTime, &granular.DataTotal, &granular.CreatedAt, &granular.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return granular, nil
+
+}
+
+func (obj *sqlite3Impl) Update_Timestamps_By_Name(ctx context.Context,
+	timestamps_name Timestamps_Name_Field,
+	update Timestamps_Update_Fields) (
+	timestamps *Timestamps, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE timestamps SET "), __sets, __sqlbundle_Literal(" WHERE timestamps.name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Value._set {
+		__values = append(__values, update.Value.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, timestamps_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	timestamps = &Timestamps{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT timestamps.name, timestamps.value FROM timestamps WHERE timestamps.name = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&timestamps.Name, &timestamps.Value)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return timestamps, nil
+}
+
+func (obj *sqlite3Impl) Update_Aggregate_By_NodeId(ctx context.Context,
+	aggregate_node_id Aggregate_NodeId_Field,
+	update Aggregate_Update_Fields) (
+	aggregate *Aggregate, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE aggregates SET "), __sets, __sqlbundle_Literal(" WHERE aggregates.node_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.StartTime._set {
+		__values = append(__values, update.StartTime.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("start_time = ?"))
+	}
+
+	if update.Interval._set {
+		__values = append(__values, update.Interval.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("interval = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, aggregate_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	aggregate = &Aggregate{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT aggregates.node_id, aggregates.start_time, aggregates.interval, aggregates.created_at, aggregates.updated_at FROM aggregates WHERE aggregates.node_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&aggregate.NodeId, &aggregate.StartTime, &aggregate.Interval, &aggregate.CreatedAt, &aggregate.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return aggregate, nil
+}
+
+func (obj *sqlite3Impl) Update_Granular_By_NodeId(ctx context.Context,
+	granular_node_id Granular_NodeId_Field,
+	update Granular_Update_Fields) (
 	granular *Granular, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE granulars SET "), __sets, __sqlbundle_Literal(" WHERE granulars.node_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.StartTime._set {
+		__values = append(__values, update.StartTime.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("start_time = ?"))
+	}
+
+	if update.EndTime._set {
+		__values = append(__values, update.EndTime.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("end_time = ?"))
+	}
+
+	if update.DataTotal._set {
+		__values = append(__values, update.DataTotal.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("data_total = ?"))
+	}
 
 	__now := obj.db.Hooks.Now().UTC()
-	__node_id_val := granular_node_id.value()
-	__start_time_val := granular_start_time.value()
-	__end_time_val := granular_end_time.value()
-	__data_total_val := granular_data_total.value()
-	__created_at_val := __now
-	__updated_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO granulars ( node_id, start_time, end_time, data_total, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, granular_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __start_time_val, __end_time_val, __data_total_val, __created_at_val, __updated_at_val)
+	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __start_time_val, __end_time_val, __data_total_val, __created_at_val, __updated_at_val)
+	granular = &Granular{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	__pk, err := __res.LastInsertId()
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT granulars.node_id, granulars.start_time, granulars.end_time, granulars.data_total, granulars.created_at, granulars.updated_at FROM granulars WHERE granulars.node_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&granular.NodeId, &granular.StartTime, &granular.EndTime, &granular.DataTotal, &granular.CreatedAt, &granular.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastGranular(ctx, __pk)
-
+	return granular, nil
 }
 
-func (obj *sqlite3Impl) Find_Timestamps_Value_By_Name(ctx context.Context,
-	timestamps_name Timestamps_Name_Field) (
-	row *Value_Row, err error) {
+func (obj *sqlite3Impl) Delete_Aggregate_By_NodeId(ctx context.Context,
+	aggregate_node_id Aggregate_NodeId_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT timestamps.value FROM timestamps WHERE timestamps.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM aggregates WHERE aggregates.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, timestamps_name.value())
+	__values = append(__values, aggregate_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	row = &Value_Row{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.Value)
-	if err == sql.ErrNoRows {
-		return nil, nil
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
+
+	__count, err := __res.RowsAffected()
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	return row, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) Get_Aggregate_By_NodeId(ctx context.Context,
-	aggregate_node_id Aggregate_NodeId_Field) (
-	aggregate *Aggregate, err error) {
+func (obj *sqlite3Impl) Delete_Granular_By_NodeId(ctx context.Context,
+	granular_node_id Granular_NodeId_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT aggregates.node_id, aggregates.start_time, aggregates.interval, aggregates.created_at, aggregates.updated_at FROM aggregates WHERE aggregates.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM granulars WHERE granulars.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, aggregate_node_id.value())
+	__values = append(__values, granular_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastTimestamps(ctx context.Context,
+	pk int64) (
+	timestamps *Timestamps, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT timestamps.name, timestamps.value FROM timestamps WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	timestamps = &Timestamps{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&timestamps.Name, &timestamps.Value)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return timestamps, nil
+
+}
+
+func (obj *sqlite3Impl) getLastAggregate(ctx context.Context,
+	pk int64) (
+	aggregate *Aggregate, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT aggregates.node_id, aggregates.start_time, aggregates.interval, aggregates.created_at, aggregates.updated_at FROM aggregates WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
 	aggregate = &Aggregate{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&aggregate.NodeId, &aggregate.StartTime, &aggregate.Interval, &aggregate.CreatedAt, &aggregate.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&aggregate.NodeId, &aggregate.StartTime, &aggregate.Interval, &aggregate.CreatedAt, &aggregate.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -1401,166 +2380,328 @@ func (obj *sqlite3Impl) Get_Aggregate_By_NodeId(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Get_Granular_By_NodeId(ctx context.Context,
-	granular_node_id Granular_NodeId_Field) (
+func (obj *sqlite3Impl) getLastGranular(ctx context.Context,
+	pk int64) (
 	granular *Granular, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT granulars.node_id, granulars.start_time, granulars.end_time, granulars.data_total, granulars.created_at, granulars.updated_at FROM granulars WHERE granulars.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT granulars.node_id, granulars.start_time, granulars.end_time, granulars.data_total, granulars.created_at, granulars.updated_at FROM granulars WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	granular = &Granular{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&granular.NodeId, &granular.StartTime, &granular.EndTime, &granular.DataTotal, &granular.CreatedAt, &granular.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return granular, nil
+
+}
+
+func (obj *sqlite3Impl) Create_BandwidthRollup(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field,
+	bandwidth_rollup_total BandwidthRollup_Total_Field) (
+	bandwidth_rollup *BandwidthRollup, err error) {
+
+	__storage_node_id_val := bandwidth_rollup_storage_node_id.value()
+	__uplink_id_val := bandwidth_rollup_uplink_id.value()
+	__action_val := bandwidth_rollup_action.value()
+	__interval_start_val := bandwidth_rollup_interval_start.value()
+	__total_val := bandwidth_rollup_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bandwidth_rollups ( storage_node_id, uplink_id, action, interval_start, total ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __storage_node_id_val, __uplink_id_val, __action_val, __interval_start_val, __total_val)
+
+	__res, err := obj.driver.Exec(__stmt, __storage_node_id_val, __uplink_id_val, __action_val, __interval_start_val, __total_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBandwidthRollup(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field) (
+	bandwidth_rollup *BandwidthRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bandwidth_rollups.storage_node_id, bandwidth_rollups.uplink_id, bandwidth_rollups.action, bandwidth_rollups.interval_start, bandwidth_rollups.total FROM bandwidth_rollups WHERE bandwidth_rollups.storage_node_id = ? AND bandwidth_rollups.uplink_id = ? AND bandwidth_rollups.action = ? AND bandwidth_rollups.interval_start = ?")
 
 	var __values []interface{}
-	__values = append(__values, granular_node_id.value())
+	__values = append(__values, bandwidth_rollup_storage_node_id.value())
+	__values = append(__values, bandwidth_rollup_uplink_id.value())
+	__values = append(__values, bandwidth_rollup_action.value())
+	__values = append(__values, bandwidth_rollup_interval_start.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	granular = &Granular{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&granular.NodeId, &granular.StartTime, &granular.EndTime, &granular.DataTotal, &granular.CreatedAt, &granular.UpdatedAt)
+	bandwidth_rollup = &BandwidthRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bandwidth_rollup.StorageNodeId, &bandwidth_rollup.UplinkId, &bandwidth_rollup.Action, &bandwidth_rollup.IntervalStart, &bandwidth_rollup.Total)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return granular, nil
+	return bandwidth_rollup, nil
 
 }
 
-func (obj *sqlite3Impl) Update_Timestamps_By_Name(ctx context.Context,
-	timestamps_name Timestamps_Name_Field,
-	update Timestamps_Update_Fields) (
-	timestamps *Timestamps, err error) {
+func (obj *sqlite3Impl) All_BandwidthRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+	bandwidth_rollup_interval_start_greater_or_equal BandwidthRollup_IntervalStart_Field) (
+	rows []*BandwidthRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bandwidth_rollups.storage_node_id, bandwidth_rollups.uplink_id, bandwidth_rollups.action, bandwidth_rollups.interval_start, bandwidth_rollups.total FROM bandwidth_rollups WHERE bandwidth_rollups.interval_start >= ? ORDER BY bandwidth_rollups.interval_start")
+
+	var __values []interface{}
+	__values = append(__values, bandwidth_rollup_interval_start_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bandwidth_rollup := &BandwidthRollup{}
+		err = __rows.Scan(&bandwidth_rollup.StorageNodeId, &bandwidth_rollup.UplinkId, &bandwidth_rollup.Action, &bandwidth_rollup.IntervalStart, &bandwidth_rollup.Total)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bandwidth_rollup)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Update_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field,
+	update BandwidthRollup_Update_Fields) (
+	bandwidth_rollup *BandwidthRollup, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE timestamps SET "), __sets, __sqlbundle_Literal(" WHERE timestamps.name = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bandwidth_rollups SET "), __sets, __sqlbundle_Literal(" WHERE bandwidth_rollups.storage_node_id = ? AND bandwidth_rollups.uplink_id = ? AND bandwidth_rollups.action = ? AND bandwidth_rollups.interval_start = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
 	var __args []interface{}
 
-	if update.Value._set {
-		__values = append(__values, update.Value.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
+	if update.Total._set {
+		__values = append(__values, update.Total.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, timestamps_name.value())
+	__args = append(__args, bandwidth_rollup_storage_node_id.value())
+	__args = append(__args, bandwidth_rollup_uplink_id.value())
+	__args = append(__args, bandwidth_rollup_action.value())
+	__args = append(__args, bandwidth_rollup_interval_start.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, __values...)
+
+	bandwidth_rollup = &BandwidthRollup{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT bandwidth_rollups.storage_node_id, bandwidth_rollups.uplink_id, bandwidth_rollups.action, bandwidth_rollups.interval_start, bandwidth_rollups.total FROM bandwidth_rollups WHERE bandwidth_rollups.storage_node_id = ? AND bandwidth_rollups.uplink_id = ? AND bandwidth_rollups.action = ? AND bandwidth_rollups.interval_start = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&bandwidth_rollup.StorageNodeId, &bandwidth_rollup.UplinkId, &bandwidth_rollup.Action, &bandwidth_rollup.IntervalStart, &bandwidth_rollup.Total)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bandwidth_rollup, nil
+}
+
+func (obj *sqlite3Impl) Delete_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bandwidth_rollups WHERE bandwidth_rollups.storage_node_id = ? AND bandwidth_rollups.uplink_id = ? AND bandwidth_rollups.action = ? AND bandwidth_rollups.interval_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, bandwidth_rollup_storage_node_id.value())
+	__values = append(__values, bandwidth_rollup_uplink_id.value())
+	__values = append(__values, bandwidth_rollup_action.value())
+	__values = append(__values, bandwidth_rollup_interval_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastBandwidthRollup(ctx context.Context,
+	pk int64) (
+	bandwidth_rollup *BandwidthRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bandwidth_rollups.storage_node_id, bandwidth_rollups.uplink_id, bandwidth_rollups.action, bandwidth_rollups.interval_start, bandwidth_rollups.total FROM bandwidth_rollups WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	timestamps = &Timestamps{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	bandwidth_rollup = &BandwidthRollup{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bandwidth_rollup.StorageNodeId, &bandwidth_rollup.UplinkId, &bandwidth_rollup.Action, &bandwidth_rollup.IntervalStart, &bandwidth_rollup.Total)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	return bandwidth_rollup, nil
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT timestamps.name, timestamps.value FROM timestamps WHERE timestamps.name = ?")
+}
 
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+func (obj *sqlite3Impl) Create_StorageRollup(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field,
+	storage_rollup_at_rest_total StorageRollup_AtRestTotal_Field) (
+	storage_rollup *StorageRollup, err error) {
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&timestamps.Name, &timestamps.Value)
-	if err == sql.ErrNoRows {
-		return nil, nil
+	__storage_node_id_val := storage_rollup_storage_node_id.value()
+	__interval_start_val := storage_rollup_interval_start.value()
+	__at_rest_total_val := storage_rollup_at_rest_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO storage_rollups ( storage_node_id, interval_start, at_rest_total ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __storage_node_id_val, __interval_start_val, __at_rest_total_val)
+
+	__res, err := obj.driver.Exec(__stmt, __storage_node_id_val, __interval_start_val, __at_rest_total_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	__pk, err := __res.LastInsertId()
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return timestamps, nil
+	return obj.getLastStorageRollup(ctx, __pk)
+
 }
 
-func (obj *sqlite3Impl) Update_Aggregate_By_NodeId(ctx context.Context,
-	aggregate_node_id Aggregate_NodeId_Field,
-	update Aggregate_Update_Fields) (
-	aggregate *Aggregate, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (obj *sqlite3Impl) Get_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field) (
+	storage_rollup *StorageRollup, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE aggregates SET "), __sets, __sqlbundle_Literal(" WHERE aggregates.node_id = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT storage_rollups.storage_node_id, storage_rollups.interval_start, storage_rollups.at_rest_total FROM storage_rollups WHERE storage_rollups.storage_node_id = ? AND storage_rollups.interval_start = ?")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
-	var __args []interface{}
+	__values = append(__values, storage_rollup_storage_node_id.value())
+	__values = append(__values, storage_rollup_interval_start.value())
 
-	if update.StartTime._set {
-		__values = append(__values, update.StartTime.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("start_time = ?"))
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
 
-	if update.Interval._set {
-		__values = append(__values, update.Interval.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("interval = ?"))
+	storage_rollup = &StorageRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&storage_rollup.StorageNodeId, &storage_rollup.IntervalStart, &storage_rollup.AtRestTotal)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return storage_rollup, nil
 
-	__now := obj.db.Hooks.Now().UTC()
+}
 
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+func (obj *sqlite3Impl) All_StorageRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+	storage_rollup_interval_start_greater_or_equal StorageRollup_IntervalStart_Field) (
+	rows []*StorageRollup, err error) {
 
-	__args = append(__args, aggregate_node_id.value())
+	var __embed_stmt = __sqlbundle_Literal("SELECT storage_rollups.storage_node_id, storage_rollups.interval_start, storage_rollups.at_rest_total FROM storage_rollups WHERE storage_rollups.interval_start >= ? ORDER BY storage_rollups.interval_start")
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	var __values []interface{}
+	__values = append(__values, storage_rollup_interval_start_greater_or_equal.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	aggregate = &Aggregate{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT aggregates.node_id, aggregates.start_time, aggregates.interval, aggregates.created_at, aggregates.updated_at FROM aggregates WHERE aggregates.node_id = ?")
-
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
-
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&aggregate.NodeId, &aggregate.StartTime, &aggregate.Interval, &aggregate.CreatedAt, &aggregate.UpdatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
+	for __rows.Next() {
+		storage_rollup := &StorageRollup{}
+		err = __rows.Scan(&storage_rollup.StorageNodeId, &storage_rollup.IntervalStart, &storage_rollup.AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, storage_rollup)
 	}
-	if err != nil {
+	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return aggregate, nil
+	return rows, nil
+
 }
 
-func (obj *sqlite3Impl) Update_Granular_By_NodeId(ctx context.Context,
-	granular_node_id Granular_NodeId_Field,
-	update Granular_Update_Fields) (
-	granular *Granular, err error) {
+func (obj *sqlite3Impl) Update_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field,
+	update StorageRollup_Update_Fields) (
+	storage_rollup *StorageRollup, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE granulars SET "), __sets, __sqlbundle_Literal(" WHERE granulars.node_id = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE storage_rollups SET "), __sets, __sqlbundle_Literal(" WHERE storage_rollups.storage_node_id = ? AND storage_rollups.interval_start = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
 	var __args []interface{}
 
-	if update.StartTime._set {
-		__values = append(__values, update.StartTime.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("start_time = ?"))
-	}
-
-	if update.EndTime._set {
-		__values = append(__values, update.EndTime.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("end_time = ?"))
+	if update.AtRestTotal._set {
+		__values = append(__values, update.AtRestTotal.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("at_rest_total = ?"))
 	}
 
-	if update.DataTotal._set {
-		__values = append(__values, update.DataTotal.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("data_total = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
-
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
-
-	__args = append(__args, granular_node_id.value())
+	__args = append(__args, storage_rollup_storage_node_id.value())
+	__args = append(__args, storage_rollup_interval_start.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -1568,35 +2709,37 @@ func (obj *sqlite3Impl) Update_Granular_By_NodeId(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	granular = &Granular{}
+	storage_rollup = &StorageRollup{}
 	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT granulars.node_id, granulars.start_time, granulars.end_time, granulars.data_total, granulars.created_at, granulars.updated_at FROM granulars WHERE granulars.node_id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT storage_rollups.storage_node_id, storage_rollups.interval_start, storage_rollups.at_rest_total FROM storage_rollups WHERE storage_rollups.storage_node_id = ? AND storage_rollups.interval_start = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&granular.NodeId, &granular.StartTime, &granular.EndTime, &granular.DataTotal, &granular.CreatedAt, &granular.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&storage_rollup.StorageNodeId, &storage_rollup.IntervalStart, &storage_rollup.AtRestTotal)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return granular, nil
+	return storage_rollup, nil
 }
 
-func (obj *sqlite3Impl) Delete_Aggregate_By_NodeId(ctx context.Context,
-	aggregate_node_id Aggregate_NodeId_Field) (
+func (obj *sqlite3Impl) Delete_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM aggregates WHERE aggregates.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM storage_rollups WHERE storage_rollups.storage_node_id = ? AND storage_rollups.interval_start = ?")
 
 	var __values []interface{}
-	__values = append(__values, aggregate_node_id.value())
+	__values = append(__values, storage_rollup_storage_node_id.value())
+	__values = append(__values, storage_rollup_interval_start.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -1615,83 +2758,103 @@ func (obj *sqlite3Impl) Delete_Aggregate_By_NodeId(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Delete_Granular_By_NodeId(ctx context.Context,
-	granular_node_id Granular_NodeId_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM granulars WHERE granulars.node_id = ?")
+func (obj *sqlite3Impl) getLastStorageRollup(ctx context.Context,
+	pk int64) (
+	storage_rollup *StorageRollup, err error) {
 
-	var __values []interface{}
-	__values = append(__values, granular_node_id.value())
+	var __embed_stmt = __sqlbundle_Literal("SELECT storage_rollups.storage_node_id, storage_rollups.interval_start, storage_rollups.at_rest_total FROM storage_rollups WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+	obj.logStmt(__stmt, pk)
 
-	__count, err := __res.RowsAffected()
+	storage_rollup = &StorageRollup{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&storage_rollup.StorageNodeId, &storage_rollup.IntervalStart, &storage_rollup.AtRestTotal)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-
-	return __count > 0, nil
+	return storage_rollup, nil
 
 }
 
-func (obj *sqlite3Impl) getLastTimestamps(ctx context.Context,
-	pk int64) (
-	timestamps *Timestamps, err error) {
+func (obj *sqlite3Impl) Create_PayoutWallet(ctx context.Context,
+	payout_wallet_storage_node_id PayoutWallet_StorageNodeId_Field,
+	payout_wallet_period_start PayoutWallet_PeriodStart_Field,
+	payout_wallet_period_end PayoutWallet_PeriodEnd_Field,
+	payout_wallet_wallet PayoutWallet_Wallet_Field) (
+	payout_wallet *PayoutWallet, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT timestamps.name, timestamps.value FROM timestamps WHERE _rowid_ = ?")
+	__storage_node_id_val := payout_wallet_storage_node_id.value()
+	__period_start_val := payout_wallet_period_start.value()
+	__period_end_val := payout_wallet_period_end.value()
+	__wallet_val := payout_wallet_wallet.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO payout_wallets ( storage_node_id, period_start, period_end, wallet ) VALUES ( ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+	obj.logStmt(__stmt, __storage_node_id_val, __period_start_val, __period_end_val, __wallet_val)
 
-	timestamps = &Timestamps{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&timestamps.Name, &timestamps.Value)
+	__res, err := obj.driver.Exec(__stmt, __storage_node_id_val, __period_start_val, __period_end_val, __wallet_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return timestamps, nil
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastPayoutWallet(ctx, __pk)
 
 }
 
-func (obj *sqlite3Impl) getLastAggregate(ctx context.Context,
-	pk int64) (
-	aggregate *Aggregate, err error) {
+func (obj *sqlite3Impl) All_PayoutWallet_By_PeriodStart_PeriodEnd(ctx context.Context,
+	payout_wallet_period_start PayoutWallet_PeriodStart_Field,
+	payout_wallet_period_end PayoutWallet_PeriodEnd_Field) (
+	rows []*PayoutWallet, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT aggregates.node_id, aggregates.start_time, aggregates.interval, aggregates.created_at, aggregates.updated_at FROM aggregates WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_wallets.storage_node_id, payout_wallets.period_start, payout_wallets.period_end, payout_wallets.wallet FROM payout_wallets WHERE payout_wallets.period_start = ? AND payout_wallets.period_end = ?")
+
+	var __values []interface{}
+	__values = append(__values, payout_wallet_period_start.value())
+	__values = append(__values, payout_wallet_period_end.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+	obj.logStmt(__stmt, __values...)
 
-	aggregate = &Aggregate{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&aggregate.NodeId, &aggregate.StartTime, &aggregate.Interval, &aggregate.CreatedAt, &aggregate.UpdatedAt)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return aggregate, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		payout_wallet := &PayoutWallet{}
+		err = __rows.Scan(&payout_wallet.StorageNodeId, &payout_wallet.PeriodStart, &payout_wallet.PeriodEnd, &payout_wallet.Wallet)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, payout_wallet)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *sqlite3Impl) getLastGranular(ctx context.Context,
+func (obj *sqlite3Impl) getLastPayoutWallet(ctx context.Context,
 	pk int64) (
-	granular *Granular, err error) {
+	payout_wallet *PayoutWallet, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT granulars.node_id, granulars.start_time, granulars.end_time, granulars.data_total, granulars.created_at, granulars.updated_at FROM granulars WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT payout_wallets.storage_node_id, payout_wallets.period_start, payout_wallets.period_end, payout_wallets.wallet FROM payout_wallets WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
-	granular = &Granular{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&granular.NodeId, &granular.StartTime, &granular.EndTime, &granular.DataTotal, &granular.CreatedAt, &granular.UpdatedAt)
+	payout_wallet = &PayoutWallet{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&payout_wallet.StorageNodeId, &payout_wallet.PeriodStart, &payout_wallet.PeriodEnd, &payout_wallet.Wallet)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return granular, nil
+	return payout_wallet, nil
 
 }
 
@@ -1713,6 +2876,36 @@ func (impl sqlite3Impl) isConstraintError(err error) (
 func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM payout_wallets;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM storage_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bandwidth_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.Exec("DELETE FROM timestamps;")
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1912,6 +3105,150 @@ func (rx *Rx) Update_Timestamps_By_Name(ctx context.Context,
 	return tx.Update_Timestamps_By_Name(ctx, timestamps_name, update)
 }
 
+func (rx *Rx) Create_BandwidthRollup(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field,
+	bandwidth_rollup_total BandwidthRollup_Total_Field) (
+	bandwidth_rollup *BandwidthRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BandwidthRollup(ctx, bandwidth_rollup_storage_node_id, bandwidth_rollup_uplink_id, bandwidth_rollup_action, bandwidth_rollup_interval_start, bandwidth_rollup_total)
+}
+
+func (rx *Rx) Get_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field) (
+	bandwidth_rollup *BandwidthRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx, bandwidth_rollup_storage_node_id, bandwidth_rollup_uplink_id, bandwidth_rollup_action, bandwidth_rollup_interval_start)
+}
+
+func (rx *Rx) Update_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field,
+	update BandwidthRollup_Update_Fields) (
+	bandwidth_rollup *BandwidthRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx, bandwidth_rollup_storage_node_id, bandwidth_rollup_uplink_id, bandwidth_rollup_action, bandwidth_rollup_interval_start, update)
+}
+
+func (rx *Rx) Delete_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+	bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+	bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+	bandwidth_rollup_action BandwidthRollup_Action_Field,
+	bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx, bandwidth_rollup_storage_node_id, bandwidth_rollup_uplink_id, bandwidth_rollup_action, bandwidth_rollup_interval_start)
+}
+
+func (rx *Rx) All_BandwidthRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+	bandwidth_rollup_interval_start_greater_or_equal BandwidthRollup_IntervalStart_Field) (
+	rows []*BandwidthRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_BandwidthRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx, bandwidth_rollup_interval_start_greater_or_equal)
+}
+
+func (rx *Rx) Create_StorageRollup(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field,
+	storage_rollup_at_rest_total StorageRollup_AtRestTotal_Field) (
+	storage_rollup *StorageRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_StorageRollup(ctx, storage_rollup_storage_node_id, storage_rollup_interval_start, storage_rollup_at_rest_total)
+}
+
+func (rx *Rx) Get_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field) (
+	storage_rollup *StorageRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_StorageRollup_By_StorageNodeId_IntervalStart(ctx, storage_rollup_storage_node_id, storage_rollup_interval_start)
+}
+
+func (rx *Rx) Update_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field,
+	update StorageRollup_Update_Fields) (
+	storage_rollup *StorageRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_StorageRollup_By_StorageNodeId_IntervalStart(ctx, storage_rollup_storage_node_id, storage_rollup_interval_start, update)
+}
+
+func (rx *Rx) Delete_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+	storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+	storage_rollup_interval_start StorageRollup_IntervalStart_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_StorageRollup_By_StorageNodeId_IntervalStart(ctx, storage_rollup_storage_node_id, storage_rollup_interval_start)
+}
+
+func (rx *Rx) All_StorageRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+	storage_rollup_interval_start_greater_or_equal StorageRollup_IntervalStart_Field) (
+	rows []*StorageRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_StorageRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx, storage_rollup_interval_start_greater_or_equal)
+}
+
+func (rx *Rx) Create_PayoutWallet(ctx context.Context,
+	payout_wallet_storage_node_id PayoutWallet_StorageNodeId_Field,
+	payout_wallet_period_start PayoutWallet_PeriodStart_Field,
+	payout_wallet_period_end PayoutWallet_PeriodEnd_Field,
+	payout_wallet_wallet PayoutWallet_Wallet_Field) (
+	payout_wallet *PayoutWallet, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_PayoutWallet(ctx, payout_wallet_storage_node_id, payout_wallet_period_start, payout_wallet_period_end, payout_wallet_wallet)
+}
+
+func (rx *Rx) All_PayoutWallet_By_PeriodStart_PeriodEnd(ctx context.Context,
+	payout_wallet_period_start PayoutWallet_PeriodStart_Field,
+	payout_wallet_period_end PayoutWallet_PeriodEnd_Field) (
+	rows []*PayoutWallet, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_PayoutWallet_By_PeriodStart_PeriodEnd(ctx, payout_wallet_period_start, payout_wallet_period_end)
+}
+
 type Methods interface {
 	Create_Aggregate(ctx context.Context,
 		aggregate_node_id Aggregate_NodeId_Field,
@@ -1965,6 +3302,78 @@ type Methods interface {
 		timestamps_name Timestamps_Name_Field,
 		update Timestamps_Update_Fields) (
 		timestamps *Timestamps, err error)
+
+	Create_BandwidthRollup(ctx context.Context,
+		bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+		bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+		bandwidth_rollup_action BandwidthRollup_Action_Field,
+		bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field,
+		bandwidth_rollup_total BandwidthRollup_Total_Field) (
+		bandwidth_rollup *BandwidthRollup, err error)
+
+	Get_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+		bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+		bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+		bandwidth_rollup_action BandwidthRollup_Action_Field,
+		bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field) (
+		bandwidth_rollup *BandwidthRollup, err error)
+
+	Update_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+		bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+		bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+		bandwidth_rollup_action BandwidthRollup_Action_Field,
+		bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field,
+		update BandwidthRollup_Update_Fields) (
+		bandwidth_rollup *BandwidthRollup, err error)
+
+	Delete_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx context.Context,
+		bandwidth_rollup_storage_node_id BandwidthRollup_StorageNodeId_Field,
+		bandwidth_rollup_uplink_id BandwidthRollup_UplinkId_Field,
+		bandwidth_rollup_action BandwidthRollup_Action_Field,
+		bandwidth_rollup_interval_start BandwidthRollup_IntervalStart_Field) (
+		deleted bool, err error)
+
+	All_BandwidthRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+		bandwidth_rollup_interval_start_greater_or_equal BandwidthRollup_IntervalStart_Field) (
+		rows []*BandwidthRollup, err error)
+
+	Create_StorageRollup(ctx context.Context,
+		storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+		storage_rollup_interval_start StorageRollup_IntervalStart_Field,
+		storage_rollup_at_rest_total StorageRollup_AtRestTotal_Field) (
+		storage_rollup *StorageRollup, err error)
+
+	Get_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+		storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+		storage_rollup_interval_start StorageRollup_IntervalStart_Field) (
+		storage_rollup *StorageRollup, err error)
+
+	Update_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+		storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+		storage_rollup_interval_start StorageRollup_IntervalStart_Field,
+		update StorageRollup_Update_Fields) (
+		storage_rollup *StorageRollup, err error)
+
+	Delete_StorageRollup_By_StorageNodeId_IntervalStart(ctx context.Context,
+		storage_rollup_storage_node_id StorageRollup_StorageNodeId_Field,
+		storage_rollup_interval_start StorageRollup_IntervalStart_Field) (
+		deleted bool, err error)
+
+	All_StorageRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx context.Context,
+		storage_rollup_interval_start_greater_or_equal StorageRollup_IntervalStart_Field) (
+		rows []*StorageRollup, err error)
+
+	Create_PayoutWallet(ctx context.Context,
+		payout_wallet_storage_node_id PayoutWallet_StorageNodeId_Field,
+		payout_wallet_period_start PayoutWallet_PeriodStart_Field,
+		payout_wallet_period_end PayoutWallet_PeriodEnd_Field,
+		payout_wallet_wallet PayoutWallet_Wallet_Field) (
+		payout_wallet *PayoutWallet, err error)
+
+	All_PayoutWallet_By_PeriodStart_PeriodEnd(ctx context.Context,
+		payout_wallet_period_start PayoutWallet_PeriodStart_Field,
+		payout_wallet_period_end PayoutWallet_PeriodEnd_Field) (
+		rows []*PayoutWallet, err error)
 }
 
 type TxMethods interface {
@@ -22,3 +22,24 @@ CREATE TABLE timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE bandwidth_rollups (
+	storage_node_id text NOT NULL,
+	uplink_id text NOT NULL,
+	action integer NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( storage_node_id, uplink_id, action, interval_start )
+);
+CREATE TABLE storage_rollups (
+	storage_node_id text NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	at_rest_total double precision NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start )
+);
+CREATE TABLE payout_wallets (
+	storage_node_id text NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	wallet text NOT NULL,
+	PRIMARY KEY ( storage_node_id, period_start, period_end )
+);
@@ -22,3 +22,24 @@ CREATE TABLE timestamps (
 	value TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE bandwidth_rollups (
+	storage_node_id TEXT NOT NULL,
+	uplink_id TEXT NOT NULL,
+	action INTEGER NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( storage_node_id, uplink_id, action, interval_start )
+);
+CREATE TABLE storage_rollups (
+	storage_node_id TEXT NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	at_rest_total REAL NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start )
+);
+CREATE TABLE payout_wallets (
+	storage_node_id TEXT NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	wallet TEXT NOT NULL,
+	PRIMARY KEY ( storage_node_id, period_start, period_end )
+);
@@ -0,0 +1,111 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"czarcoin.org/czarcoin/internal/migrate"
+)
+
+// tables of the previous accounting schemas
+const (
+	postgresTables = `CREATE TABLE aggregates (
+	node_id text NOT NULL,
+	start_time timestamp with time zone NOT NULL,
+	interval bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE granulars (
+	node_id text NOT NULL,
+	start_time timestamp with time zone NOT NULL,
+	end_time timestamp with time zone NOT NULL,
+	data_total bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE timestamps (
+	name text NOT NULL,
+	value timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);`
+	postgresBandwidthRollups = `CREATE TABLE bandwidth_rollups (
+	storage_node_id text NOT NULL,
+	uplink_id text NOT NULL,
+	action integer NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( storage_node_id, uplink_id, action, interval_start )
+);`
+	postgresStorageRollups = `CREATE TABLE storage_rollups (
+	storage_node_id text NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	at_rest_total double precision NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start )
+);`
+	postgresPayoutWallets = `CREATE TABLE payout_wallets (
+	storage_node_id text NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	wallet text NOT NULL,
+	PRIMARY KEY ( storage_node_id, period_start, period_end )
+);`
+
+	sqliteTables = `CREATE TABLE aggregates (
+	node_id TEXT NOT NULL,
+	start_time TIMESTAMP NOT NULL,
+	interval INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE granulars (
+	node_id TEXT NOT NULL,
+	start_time TIMESTAMP NOT NULL,
+	end_time TIMESTAMP NOT NULL,
+	data_total INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE timestamps (
+	name TEXT NOT NULL,
+	value TIMESTAMP NOT NULL,
+	PRIMARY KEY ( name )
+);`
+	sqliteBandwidthRollups = `CREATE TABLE bandwidth_rollups (
+	storage_node_id TEXT NOT NULL,
+	uplink_id TEXT NOT NULL,
+	action INTEGER NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( storage_node_id, uplink_id, action, interval_start )
+);`
+	sqliteStorageRollups = `CREATE TABLE storage_rollups (
+	storage_node_id TEXT NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	at_rest_total REAL NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start )
+);`
+	sqlitePayoutWallets = `CREATE TABLE payout_wallets (
+	storage_node_id TEXT NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	wallet TEXT NOT NULL,
+	PRIMARY KEY ( storage_node_id, period_start, period_end )
+);`
+)
+
+// migrations upgrade accounting databases created before the rollups and payouts
+var migrations = []migrate.Migration{
+	{
+		From:  postgresTables,
+		Steps: []string{postgresBandwidthRollups, postgresStorageRollups, postgresPayoutWallets},
+	},
+	{
+		From:  sqliteTables,
+		Steps: []string{sqliteBandwidthRollups, sqliteStorageRollups, sqlitePayoutWallets},
+	},
+}
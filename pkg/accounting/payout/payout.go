@@ -0,0 +1,227 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payout
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"czarcoin.org/czarcoin/pkg/accounting"
+	dbx "czarcoin.org/czarcoin/pkg/accounting/dbx"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/utils"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is the default payout errs class
+	Error = errs.Class("payout error")
+)
+
+const (
+	bytesPerTB    = 1e12
+	hoursPerMonth = 720
+)
+
+// Rates are the payouts per TB
+type Rates struct {
+	AtRest float64 `help:"payout per TB-month of data at rest, a month being 720 hours" default:"1.5"`
+	Egress float64 `help:"payout per TB of egress" default:"20"`
+}
+
+// Payout is what a storage node earned within a period
+type Payout struct {
+	NodeID          czarcoin.NodeID `json:"node_id"`
+	Wallet          string          `json:"wallet"`
+	AtRestByteHours float64         `json:"at_rest_byte_hours"`
+	EgressBytes     int64           `json:"egress_bytes"`
+	AtRestPayout    float64         `json:"at_rest_payout"`
+	EgressPayout    float64         `json:"egress_payout"`
+	Total           float64         `json:"total"`
+}
+
+// Generate joins the storage and egress rollups of the hours starting within [start, end)
+// into the payouts of each storage node, ordered by node ID. Only periods the tally has
+// completed are accepted and the wallets are kept from the first report of a period, so
+// reports of the same period are always the same.
+func Generate(ctx context.Context, db *dbx.DB, wallets overlay.WalletDB, start, end time.Time, rates Rates) (payouts []*Payout, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !start.Before(end) {
+		return nil, Error.New("start %s must be before end %s", start, end)
+	}
+	// rollups are hourly, a period ending within an hour would include a
+	// rollup which still grows after the period closed
+	if !start.Equal(start.Truncate(time.Hour)) || !end.Equal(end.Truncate(time.Hour)) {
+		return nil, Error.New("start %s and end %s must be on the hour", start, end)
+	}
+	lastTally, err := db.Find_Timestamps_Value_By_Name(ctx, accounting.LastAtRestTally)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if lastTally == nil || lastTally.Value.Before(end) {
+		return nil, Error.New("period ending %s isn't closed by the tally yet", end)
+	}
+	// agreements of the period may be settled until the end of the settlement window
+	lastBwTally, err := db.Find_Timestamps_Value_By_Name(ctx, accounting.LastBandwidthTally)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if lastBwTally == nil || lastBwTally.Value.Add(-accounting.SettlementWindow).Before(end) {
+		return nil, Error.New("period ending %s isn't closed by the bandwidth tally yet", end)
+	}
+
+	byNode := make(map[czarcoin.NodeID]*Payout)
+	payout := func(nodeID czarcoin.NodeID) *Payout {
+		p, ok := byNode[nodeID]
+		if !ok {
+			p = &Payout{NodeID: nodeID}
+			byNode[nodeID] = p
+			payouts = append(payouts, p)
+		}
+		return p
+	}
+
+	storage, err := accounting.GetStorageRollups(ctx, db, start, end)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, rollup := range storage {
+		payout(rollup.StorageNodeID).AtRestByteHours += rollup.AtRestTotal
+	}
+
+	bandwidth, err := accounting.GetBandwidthRollups(ctx, db, start, end)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, rollup := range bandwidth {
+		// storage nodes are paid for the data downloaded from them
+		if rollup.Action == pb.PayerBandwidthAllocation_GET {
+			payout(rollup.StorageNodeID).EgressBytes += rollup.Total
+		}
+	}
+
+	sort.Slice(payouts, func(i, k int) bool { return payouts[i].NodeID.Less(payouts[k].NodeID) })
+
+	if err := setWallets(ctx, db, wallets, start, end, payouts); err != nil {
+		return nil, err
+	}
+
+	for _, p := range payouts {
+		p.AtRestPayout = p.AtRestByteHours / bytesPerTB / hoursPerMonth * rates.AtRest
+		p.EgressPayout = float64(p.EgressBytes) / bytesPerTB * rates.Egress
+		p.Total = p.AtRestPayout + p.EgressPayout
+	}
+	return payouts, nil
+}
+
+// setWallets sets the wallets of the payouts from the snapshot of the period. Wallets of
+// nodes without one are taken from their check-ins and added to the snapshot, so changing
+// a wallet later doesn't change the reports of closed periods. Periods with nodes which
+// never reported a wallet aren't closed, as their payouts would be lost.
+func setWallets(ctx context.Context, db *dbx.DB, wallets overlay.WalletDB, start, end time.Time, payouts []*Payout) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	periodStart, periodEnd := dbx.PayoutWallet_PeriodStart(start.UTC()), dbx.PayoutWallet_PeriodEnd(end.UTC())
+	saved, err := db.All_PayoutWallet_By_PeriodStart_PeriodEnd(ctx, periodStart, periodEnd)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	snapshot := make(map[string]string, len(saved))
+	for _, w := range saved {
+		snapshot[w.StorageNodeId] = w.Wallet
+	}
+
+	var missing []*Payout
+	var missingIDs czarcoin.NodeIDList
+	for _, p := range payouts {
+		wallet, ok := snapshot[p.NodeID.String()]
+		if !ok {
+			missing = append(missing, p)
+			missingIDs = append(missingIDs, p.NodeID)
+			continue
+		}
+		p.Wallet = wallet
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	reported, err := wallets.GetWallets(ctx, missingIDs)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	var unknown czarcoin.NodeIDList
+	for _, p := range missing {
+		p.Wallet = reported[p.NodeID]
+		if p.Wallet == "" {
+			unknown = append(unknown, p.NodeID)
+		}
+	}
+	if len(unknown) > 0 {
+		return Error.New("%d nodes of the period didn't report a wallet yet, including %s", len(unknown), unknown[0])
+	}
+
+	tx, err := db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, p := range missing {
+		_, err = tx.Create_PayoutWallet(ctx, dbx.PayoutWallet_StorageNodeId(p.NodeID.String()),
+			periodStart, periodEnd, dbx.PayoutWallet_Wallet(p.Wallet))
+		if err != nil {
+			return Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+		}
+	}
+	return Error.Wrap(tx.Commit())
+}
+
+// WriteCSV writes the payouts as csv with a header row
+func WriteCSV(w io.Writer, payouts []*Payout) error {
+	out := csv.NewWriter(w)
+	err := out.Write([]string{"node_id", "wallet", "at_rest_byte_hours", "egress_bytes", "at_rest_payout", "egress_payout", "total"})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, p := range payouts {
+		err := out.Write([]string{
+			p.NodeID.String(),
+			p.Wallet,
+			formatFloat(p.AtRestByteHours),
+			strconv.FormatInt(p.EgressBytes, 10),
+			formatFloat(p.AtRestPayout),
+			formatFloat(p.EgressPayout),
+			formatFloat(p.Total),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	out.Flush()
+	return Error.Wrap(out.Error())
+}
+
+// WriteJSON writes the payouts as an indented json array
+func WriteJSON(w io.Writer, payouts []*Payout) error {
+	if payouts == nil {
+		payouts = []*Payout{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return Error.Wrap(enc.Encode(payouts))
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
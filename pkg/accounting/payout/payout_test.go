@@ -0,0 +1,153 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/accounting"
+	dbx "czarcoin.org/czarcoin/pkg/accounting/dbx"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+// fakeWallets looks wallets up from a map
+type fakeWallets map[czarcoin.NodeID]string
+
+func (wallets fakeWallets) SetWallet(ctx context.Context, nodeID czarcoin.NodeID, wallet string) error {
+	wallets[nodeID] = wallet
+	return nil
+}
+
+func (wallets fakeWallets) GetWallets(ctx context.Context, nodeIDs czarcoin.NodeIDList) (map[czarcoin.NodeID]string, error) {
+	found := make(map[czarcoin.NodeID]string)
+	for _, id := range nodeIDs {
+		if wallet, ok := wallets[id]; ok {
+			found[id] = wallet
+		}
+	}
+	return found, nil
+}
+
+func TestGenerate(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := accounting.NewDb("sqlite3://file::memory:?mode=memory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(db.Close)
+
+	node1 := testczarcoin.NodeIDFromString("node1")
+	node2 := testczarcoin.NodeIDFromString("node2")
+	uplink := testczarcoin.NodeIDFromString("uplink")
+	wallets := fakeWallets{node1: "0x1"}
+
+	start := time.Date(2018, 11, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC)
+	rates := Rates{AtRest: 2, Egress: 10}
+
+	for _, rollup := range []accounting.StorageRollup{
+		{StorageNodeID: node1, IntervalStart: start, AtRestTotal: 360e12},
+		{StorageNodeID: node1, IntervalStart: start.Add(time.Hour), AtRestTotal: 360e12},
+		{StorageNodeID: node2, IntervalStart: start.Add(time.Hour), AtRestTotal: 72e12},
+		{StorageNodeID: node2, IntervalStart: end, AtRestTotal: 1e15}, // after the period
+	} {
+		_, err := db.Create_StorageRollup(ctx,
+			dbx.StorageRollup_StorageNodeId(rollup.StorageNodeID.String()),
+			dbx.StorageRollup_IntervalStart(rollup.IntervalStart),
+			dbx.StorageRollup_AtRestTotal(rollup.AtRestTotal))
+		assert.NoError(t, err)
+	}
+	for _, rollup := range []accounting.BandwidthRollup{
+		{StorageNodeID: node2, UplinkID: uplink, Action: pb.PayerBandwidthAllocation_GET, IntervalStart: start, Total: 3e11},
+		{StorageNodeID: node2, UplinkID: uplink, Action: pb.PayerBandwidthAllocation_PUT, IntervalStart: start, Total: 5e12},                 // ingress isn't paid
+		{StorageNodeID: node2, UplinkID: uplink, Action: pb.PayerBandwidthAllocation_GET, IntervalStart: start.Add(-time.Hour), Total: 1e15}, // before the period
+	} {
+		_, err := db.Create_BandwidthRollup(ctx,
+			dbx.BandwidthRollup_StorageNodeId(rollup.StorageNodeID.String()),
+			dbx.BandwidthRollup_UplinkId(rollup.UplinkID.String()),
+			dbx.BandwidthRollup_Action(int(rollup.Action)),
+			dbx.BandwidthRollup_IntervalStart(rollup.IntervalStart),
+			dbx.BandwidthRollup_Total(rollup.Total))
+		assert.NoError(t, err)
+	}
+
+	// periods must be on the hour, as the rollups are hourly
+	_, err = Generate(ctx, db, wallets, start, end.Add(30*time.Minute), rates)
+	assert.Error(t, err)
+	_, err = Generate(ctx, db, wallets, start.Add(time.Minute), end, rates)
+	assert.Error(t, err)
+
+	// the tally didn't complete the period yet
+	_, err = Generate(ctx, db, wallets, start, end, rates)
+	assert.Error(t, err)
+	_, err = db.Create_Timestamps(ctx, accounting.LastAtRestTally, dbx.Timestamps_Value(end))
+	assert.NoError(t, err)
+
+	// nor did agreements of the period settle yet
+	_, err = Generate(ctx, db, wallets, start, end, rates)
+	assert.Error(t, err)
+	_, err = db.Create_Timestamps(ctx, accounting.LastBandwidthTally, dbx.Timestamps_Value(end))
+	assert.NoError(t, err)
+	_, err = Generate(ctx, db, wallets, start, end, rates)
+	assert.Error(t, err)
+	update := dbx.Timestamps_Update_Fields{Value: dbx.Timestamps_Value(end.Add(accounting.SettlementWindow))}
+	_, err = db.Update_Timestamps_By_Name(ctx, accounting.LastBandwidthTally, update)
+	assert.NoError(t, err)
+
+	// nor did all nodes of the period report their wallet yet
+	_, err = Generate(ctx, db, wallets, start, end, rates)
+	assert.Error(t, err)
+	wallets[node2] = "0x2"
+
+	payouts, err := Generate(ctx, db, wallets, start, end, rates)
+	assert.NoError(t, err)
+
+	expected := []*Payout{
+		{NodeID: node1, Wallet: "0x1", AtRestByteHours: 720e12, AtRestPayout: 2, Total: 2},
+		{NodeID: node2, Wallet: "0x2", AtRestByteHours: 72e12, EgressBytes: 3e11, AtRestPayout: 0.2, EgressPayout: 3, Total: 3.2},
+	}
+	if node2.Less(node1) {
+		expected[0], expected[1] = expected[1], expected[0]
+	}
+	if assert.Len(t, payouts, 2) {
+		for i, payout := range payouts {
+			assert.Equal(t, expected[i].NodeID, payout.NodeID)
+			assert.Equal(t, expected[i].Wallet, payout.Wallet)
+			assert.Equal(t, expected[i].EgressBytes, payout.EgressBytes)
+			assert.InDelta(t, expected[i].AtRestByteHours, payout.AtRestByteHours, 1)
+			assert.InDelta(t, expected[i].AtRestPayout, payout.AtRestPayout, 1e-9)
+			assert.InDelta(t, expected[i].EgressPayout, payout.EgressPayout, 1e-9)
+			assert.InDelta(t, expected[i].Total, payout.Total, 1e-9)
+		}
+	}
+
+	// reports of a closed period don't change, also when wallets do
+	wallets[node1], wallets[node2] = "0x3", "0x4"
+	again, err := Generate(ctx, db, wallets, start, end, rates)
+	assert.NoError(t, err)
+	assert.Equal(t, payouts, again)
+
+	var csvOut, jsonOut bytes.Buffer
+	assert.NoError(t, WriteCSV(&csvOut, payouts))
+	assert.Contains(t, csvOut.String(), "node_id,wallet,at_rest_byte_hours,egress_bytes,at_rest_payout,egress_payout,total\n")
+	assert.Contains(t, csvOut.String(), node1.String()+",0x1,720000000000000,0,2,0,2\n")
+
+	assert.NoError(t, WriteJSON(&jsonOut, payouts))
+	var decoded []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonOut.Bytes(), &decoded))
+	if assert.Len(t, decoded, 2) {
+		assert.Equal(t, payouts[0].NodeID.String(), decoded[0]["node_id"])
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectusage
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"czarcoin.org/czarcoin/pkg/satellite"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is the default project usage errs class
+	Error = errs.Class("project usage error")
+)
+
+// DB tracks the storage and egress of projects
+type DB interface {
+	// AddStorage adds delta bytes to the data the project stores
+	AddStorage(ctx context.Context, projectID uuid.UUID, delta int64) error
+	// GetStorage returns the number of bytes the project stores
+	GetStorage(ctx context.Context, projectID uuid.UUID) (int64, error)
+	// AddEgress adds bytes to the egress of the project within the month
+	AddEgress(ctx context.Context, projectID uuid.UUID, month time.Time, bytes int64) error
+	// GetEgress returns the egress of the project within the month
+	GetEgress(ctx context.Context, projectID uuid.UUID, month time.Time) (int64, error)
+}
+
+// Month returns the start of the UTC month of t
+func Month(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// ParseProjectID converts the project id of a pointer or bandwidth allocation to a UUID
+func ParseProjectID(data []byte) (uuid.UUID, error) {
+	var id uuid.UUID
+	if len(data) != len(id) {
+		return uuid.UUID{}, Error.New("invalid project id %x", data)
+	}
+	copy(id[:], data)
+	return id, nil
+}
+
+// IsQuotaExceeded returns whether err is the status the satellite returns for requests over a project's limits
+func IsQuotaExceeded(err error) bool {
+	return status.Code(errs.Unwrap(err)) == codes.FailedPrecondition
+}
+
+// Service attributes requests to projects and enforces their monthly limits
+type Service struct {
+	usage   DB
+	apiKeys satellite.APIKeys
+	limits  satellite.ProjectLimits
+}
+
+// NewService creates a service which looks the projects and their limits up in the console database
+func NewService(usage DB, console satellite.DB) *Service {
+	return &Service{
+		usage:   usage,
+		apiKeys: console.APIKeys(),
+		limits:  console.ProjectLimits(),
+	}
+}
+
+// Project returns the project of the API key, or nil when the key doesn't belong to a project
+func (s *Service) Project(ctx context.Context, apiKey []byte) (projectID *uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := s.apiKeys.GetByKey(ctx, apiKey)
+	if err != nil || info == nil {
+		return nil, Error.Wrap(err)
+	}
+	return &info.ProjectID, nil
+}
+
+// CheckStorage returns a quota exceeded status when storing size more bytes exceeds the storage limit of the project
+func (s *Service) CheckStorage(ctx context.Context, projectID uuid.UUID, size int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit, err := s.limits.Get(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if limit.StorageLimit <= 0 {
+		return nil
+	}
+
+	stored, err := s.usage.GetStorage(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if stored+size > limit.StorageLimit || (size <= 0 && stored >= limit.StorageLimit) {
+		return status.Errorf(codes.FailedPrecondition, "quota exceeded: project stores %d of its %d bytes", stored, limit.StorageLimit)
+	}
+	return nil
+}
+
+// CheckEgress returns a quota exceeded status when the project used up the egress limit of the month of now
+func (s *Service) CheckEgress(ctx context.Context, projectID uuid.UUID, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit, err := s.limits.Get(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if limit.EgressLimit <= 0 {
+		return nil
+	}
+
+	egress, err := s.usage.GetEgress(ctx, projectID, Month(now))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if egress >= limit.EgressLimit {
+		return status.Errorf(codes.FailedPrecondition, "quota exceeded: project downloaded %d of its %d bytes this month", egress, limit.EgressLimit)
+	}
+	return nil
+}
+
+// AddStorage adds delta bytes to the data the project stores
+func (s *Service) AddStorage(ctx context.Context, projectID uuid.UUID, delta int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(s.usage.AddStorage(ctx, projectID, delta))
+}
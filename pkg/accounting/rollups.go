@@ -0,0 +1,87 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"time"
+
+	dbx "czarcoin.org/czarcoin/pkg/accounting/dbx"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+// BandwidthRollup is the bandwidth a storage node served an uplink for an action within an hour
+type BandwidthRollup struct {
+	StorageNodeID czarcoin.NodeID
+	UplinkID      czarcoin.NodeID
+	Action        pb.PayerBandwidthAllocation_Action
+	IntervalStart time.Time
+	Total         int64
+}
+
+// GetBandwidthRollups returns the rollups of the hours starting within [start, end), oldest first
+func GetBandwidthRollups(ctx context.Context, db *dbx.DB, start, end time.Time) ([]BandwidthRollup, error) {
+	rows, err := db.All_BandwidthRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx,
+		dbx.BandwidthRollup_IntervalStart(start))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var rollups []BandwidthRollup
+	for _, row := range rows {
+		if !row.IntervalStart.Before(end) {
+			break
+		}
+		storageNodeID, err := czarcoin.NodeIDFromString(row.StorageNodeId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		uplinkID, err := czarcoin.NodeIDFromString(row.UplinkId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rollups = append(rollups, BandwidthRollup{
+			StorageNodeID: storageNodeID,
+			UplinkID:      uplinkID,
+			Action:        pb.PayerBandwidthAllocation_Action(row.Action),
+			IntervalStart: row.IntervalStart,
+			Total:         row.Total,
+		})
+	}
+	return rollups, nil
+}
+
+// StorageRollup is the data a storage node stored within an hour
+type StorageRollup struct {
+	StorageNodeID czarcoin.NodeID
+	IntervalStart time.Time
+	AtRestTotal   float64 // byte-hours
+}
+
+// GetStorageRollups returns the rollups of the hours starting within [start, end), oldest first
+func GetStorageRollups(ctx context.Context, db *dbx.DB, start, end time.Time) ([]StorageRollup, error) {
+	rows, err := db.All_StorageRollup_By_IntervalStart_GreaterOrEqual_OrderBy_Asc_IntervalStart(ctx,
+		dbx.StorageRollup_IntervalStart(start))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var rollups []StorageRollup
+	for _, row := range rows {
+		if !row.IntervalStart.Before(end) {
+			break
+		}
+		storageNodeID, err := czarcoin.NodeIDFromString(row.StorageNodeId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rollups = append(rollups, StorageRollup{
+			StorageNodeID: storageNodeID,
+			IntervalStart: row.IntervalStart,
+			AtRestTotal:   row.AtRestTotal,
+		})
+	}
+	return rollups, nil
+}
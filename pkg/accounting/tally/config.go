@@ -12,7 +12,6 @@ import (
 
 	"czarcoin.org/czarcoin/pkg/accounting"
 	"czarcoin.org/czarcoin/pkg/bwagreement"
-	"czarcoin.org/czarcoin/pkg/kademlia"
 	"czarcoin.org/czarcoin/pkg/overlay"
 	"czarcoin.org/czarcoin/pkg/pointerdb"
 	"czarcoin.org/czarcoin/pkg/provider"
@@ -27,8 +26,13 @@ type Config struct {
 // Initialize a tally struct
 func (c Config) initialize(ctx context.Context) (Tally, error) {
 	pointerdb := pointerdb.LoadFromContext(ctx)
+	if pointerdb == nil {
+		return nil, Error.New("programmer error: pointerdb responsibility unstarted")
+	}
 	overlay := overlay.LoadServerFromContext(ctx)
-	kademlia := kademlia.LoadFromContext(ctx)
+	if overlay == nil {
+		return nil, Error.New("programmer error: overlay responsibility unstarted")
+	}
 	db, err := accounting.NewDb(c.DatabaseURL)
 	if err != nil {
 		return nil, err
@@ -38,7 +42,7 @@ func (c Config) initialize(ctx context.Context) (Tally, error) {
 	if !ok {
 		return nil, errs.New("unable to get master db instance")
 	}
-	return newTally(zap.L(), db, masterDB.BandwidthAgreement(), pointerdb, overlay, kademlia, 0, c.Interval), nil
+	return newTally(zap.L(), db, masterDB.BandwidthAgreement(), pointerdb, overlay, c.Interval), nil
 }
 
 // Run runs the tally with configured values
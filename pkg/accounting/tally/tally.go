@@ -8,17 +8,16 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"czarcoin.org/czarcoin/pkg/accounting"
 	dbx "czarcoin.org/czarcoin/pkg/accounting/dbx"
 	"czarcoin.org/czarcoin/pkg/bwagreement"
-	"czarcoin.org/czarcoin/pkg/kademlia"
-	"czarcoin.org/czarcoin/pkg/node"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/pointerdb"
-	"czarcoin.org/czarcoin/pkg/provider"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/utils"
 	"czarcoin.org/czarcoin/storage"
 )
 
@@ -30,20 +29,16 @@ type Tally interface {
 type tally struct {
 	pointerdb   *pointerdb.Server
 	overlay     pb.OverlayServer
-	kademlia    *kademlia.Kademlia
-	limit       int
 	logger      *zap.Logger
 	ticker      *time.Ticker
 	db          *dbx.DB        // accounting db
 	bwAgreement bwagreement.DB // bwagreements database
 }
 
-func newTally(logger *zap.Logger, db *dbx.DB, bwAgreement bwagreement.DB, pointerdb *pointerdb.Server, overlay pb.OverlayServer, kademlia *kademlia.Kademlia, limit int, interval time.Duration) *tally {
+func newTally(logger *zap.Logger, db *dbx.DB, bwAgreement bwagreement.DB, pointerdb *pointerdb.Server, overlay pb.OverlayServer, interval time.Duration) *tally {
 	return &tally{
 		pointerdb:   pointerdb,
 		overlay:     overlay,
-		kademlia:    kademlia,
-		limit:       limit,
 		logger:      logger,
 		ticker:      time.NewTicker(interval),
 		bwAgreement: bwAgreement,
@@ -56,10 +51,14 @@ func (t *tally) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	for {
-		err = t.identifyActiveNodes(ctx)
+		err = t.tallyAtRestStorage(ctx)
 		if err != nil {
 			zap.L().Error("Tally failed", zap.Error(err))
 		}
+		err = t.Query(ctx)
+		if err != nil {
+			zap.L().Error("Bandwidth tally failed", zap.Error(err))
+		}
 
 		select {
 		case <-t.ticker.C: // wait for the next interval to happen
@@ -70,52 +69,77 @@ func (t *tally) Run(ctx context.Context) (err error) {
 	}
 }
 
-// identifyActiveNodes iterates through pointerdb and identifies nodes that have storage on them
-func (t *tally) identifyActiveNodes(ctx context.Context) (err error) {
+// tallyAtRestStorage adds the data stored on each online node since the last run to the storage rollups
+func (t *tally) tallyAtRestStorage(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	rt, err := t.kademlia.GetRoutingTable(ctx)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-	self := rt.Local()
-	identity := &provider.FullIdentity{} //do i need anything in here?
-	client, err := node.NewNodeClient(identity, self, t.kademlia)
+	nodeData, err := t.calculateAtRestData(ctx)
 	if err != nil {
-		return Error.Wrap(err)
+		return err
 	}
+	return t.saveAtRestData(ctx, nodeData, time.Now())
+}
 
+// calculateAtRestData iterates through pointerdb and sums the bytes stored on each online node
+func (t *tally) calculateAtRestData(ctx context.Context) (nodeData map[czarcoin.NodeID]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeData = make(map[czarcoin.NodeID]int64)
 	err = t.pointerdb.Iterate(ctx, &pb.IterateRequest{Recurse: true},
 		func(it storage.Iterator) error {
 			var item storage.ListItem
-			lim := t.limit
-			if lim <= 0 || lim > storage.LookupLimit {
-				lim = storage.LookupLimit
-			}
-			for ; lim > 0 && it.Next(&item); lim-- {
+			for it.Next(&item) {
 				pointer := &pb.Pointer{}
-				err = proto.Unmarshal(item.Value, pointer)
+				err := proto.Unmarshal(item.Value, pointer)
 				if err != nil {
 					return Error.Wrap(err)
 				}
-				pieces := pointer.Remote.RemotePieces
-				var nodeIDs czarcoin.NodeIDList
-				for _, p := range pieces {
-					nodeIDs = append(nodeIDs, p.NodeId)
+				// inline segments aren't stored on storage nodes
+				remote := pointer.GetRemote()
+				if remote == nil {
+					continue
 				}
-				online, err := t.onlineNodes(ctx, nodeIDs)
-				if err != nil {
-					return Error.Wrap(err)
+				minReq := remote.GetRedundancy().GetMinReq()
+				if minReq <= 0 {
+					t.logger.Error("minReq must be an int greater than 0", zap.String("path", item.Key.String()))
+					continue
+				}
+				pieceSize := pointer.GetSegmentSize() / int64(minReq)
+				for _, piece := range remote.GetRemotePieces() {
+					nodeData[piece.NodeId] += pieceSize
 				}
-				go t.tallyAtRestStorage(ctx, pointer, online, client)
 			}
 			return nil
 		},
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+
+	var nodeIDs czarcoin.NodeIDList
+	for id := range nodeData {
+		nodeIDs = append(nodeIDs, id)
+	}
+	online, err := t.onlineNodes(ctx, nodeIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	isOnline := make(map[czarcoin.NodeID]bool, len(online))
+	for _, n := range online {
+		isOnline[n.Id] = true
+	}
+	for id := range nodeData {
+		if !isOnline[id] {
+			delete(nodeData, id)
+		}
+	}
+	return nodeData, nil
 }
 
 func (t *tally) onlineNodes(ctx context.Context, nodeIDs czarcoin.NodeIDList) (online []*pb.Node, err error) {
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
 	responses, err := t.overlay.BulkLookup(ctx, pb.NodeIDsToLookupRequests(nodeIDs))
 	if err != nil {
 		return []*pb.Node{}, err
@@ -129,119 +153,194 @@ func (t *tally) onlineNodes(ctx context.Context, nodeIDs czarcoin.NodeIDList) (o
 	return online, nil
 }
 
-func (t *tally) tallyAtRestStorage(ctx context.Context, pointer *pb.Pointer, nodes []*pb.Node, client node.Client) {
-	segmentSize := pointer.GetSegmentSize()
-	minReq := pointer.Remote.Redundancy.GetMinReq()
-	if minReq <= 0 {
-		zap.L().Error("minReq must be an int greater than 0")
-		return
+// saveAtRestData adds the byte-hours stored since the last run to the rollups of the hours
+// they were stored in, the first run only marks the time
+func (t *tally) saveAtRestData(ctx context.Context, nodeData map[czarcoin.NodeID]int64, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lastAtRestTally, err := t.db.Find_Timestamps_Value_By_Name(ctx, accounting.LastAtRestTally)
+	if err != nil {
+		return Error.Wrap(err)
 	}
-	pieceSize := segmentSize / int64(minReq)
-	for _, n := range nodes {
-		nodeAvail := true
-		var err error
-		ok := t.needToContact(n.Id)
-		if ok {
-			nodeAvail, err = client.Ping(ctx, *n)
-			if err != nil {
-				zap.L().Error("ping failed")
-				continue
-			}
+
+	tx, err := t.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			t.logger.Warn("DB txn was rolled back in tally at rest")
+			err = utils.CombineErrors(err, tx.Rollback())
+		}
+	}()
+
+	now = now.UTC()
+	if lastAtRestTally == nil {
+		_, err = tx.Create_Timestamps(ctx, accounting.LastAtRestTally, dbx.Timestamps_Value(now))
+		return Error.Wrap(err)
+	}
+	if !lastAtRestTally.Value.Before(now) {
+		return nil
+	}
+
+	// split the time since the last run at the hours
+	for from := lastAtRestTally.Value.UTC(); from.Before(now); {
+		intervalStart := from.Truncate(time.Hour)
+		to := intervalStart.Add(time.Hour)
+		if to.After(now) {
+			to = now
 		}
-		if nodeAvail {
-			err := t.updateGranularTable(n.Id, pieceSize)
-			if err != nil {
-				zap.L().Error("update failed")
+		hours := to.Sub(from).Hours()
+		for id, bytes := range nodeData {
+			if err = addStorageRollup(ctx, tx, id.String(), intervalStart, float64(bytes)*hours); err != nil {
+				return Error.Wrap(err)
 			}
 		}
+		from = to
 	}
-}
 
-func (t *tally) needToContact(id czarcoin.NodeID) bool {
-	//TODO
-	//check db if node was updated within the last time period
-	return true
+	update := dbx.Timestamps_Update_Fields{Value: dbx.Timestamps_Value(now)}
+	_, err = tx.Update_Timestamps_By_Name(ctx, accounting.LastAtRestTally, update)
+	return Error.Wrap(err)
 }
 
-func (t *tally) updateGranularTable(id czarcoin.NodeID, pieceSize int64) error {
-	//TODO
-	return nil
+// addStorageRollup adds byte-hours to the rollup, creating it when it doesn't exist
+func addStorageRollup(ctx context.Context, tx *dbx.Tx, nodeID string, intervalStart time.Time, atRest float64) error {
+	storageNodeID := dbx.StorageRollup_StorageNodeId(nodeID)
+	start := dbx.StorageRollup_IntervalStart(intervalStart)
+
+	rollup, err := tx.Get_StorageRollup_By_StorageNodeId_IntervalStart(ctx, storageNodeID, start)
+	if isNoRows(err) {
+		_, err = tx.Create_StorageRollup(ctx, storageNodeID, start, dbx.StorageRollup_AtRestTotal(atRest))
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	_, err = tx.Update_StorageRollup_By_StorageNodeId_IntervalStart(ctx, storageNodeID, start,
+		dbx.StorageRollup_Update_Fields{AtRestTotal: dbx.StorageRollup_AtRestTotal(rollup.AtRestTotal + atRest)})
+	return err
 }
 
-// Query bandwidth allocation database, selecting all new contracts since the last collection run time.
-// Grouping by storage node ID and adding total of bandwidth to granular data table.
-func (t *tally) Query(ctx context.Context) error {
+// Query bandwidth allocation database, selecting all contracts of the hours since the
+// settlement window before the last collection run time. The hourly rollups of each
+// storage node, uplink and action are recomputed from all of their agreements, so
+// agreements committed late are added and agreements read twice are counted once.
+func (t *tally) Query(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// agreements committed after the read are created within the settlement
+	// window before now, so the next run reads them
+	now := time.Now().UTC()
+
 	lastBwTally, err := t.db.Find_Timestamps_Value_By_Name(ctx, accounting.LastBandwidthTally)
 	if err != nil {
-		return err
+		return Error.Wrap(err)
 	}
 	var bwAgreements []bwagreement.Agreement
 	if lastBwTally == nil {
 		t.logger.Info("Tally found no existing bandwith tracking data")
 		bwAgreements, err = t.bwAgreement.GetAgreements(ctx)
 	} else {
-		bwAgreements, err = t.bwAgreement.GetAgreementsSince(ctx, lastBwTally.Value)
+		since := lastBwTally.Value.Add(-accounting.SettlementWindow).Truncate(time.Hour)
+		bwAgreements, err = t.bwAgreement.GetAgreementsSince(ctx, since)
+	}
+	if err != nil {
+		return Error.Wrap(err)
 	}
 	if len(bwAgreements) == 0 {
 		t.logger.Info("Tally found no new bandwidth allocations")
-		return nil
 	}
 
-	// sum totals by node id ... todo: add nodeid as SQL column so DB can do this?
-	bwTotals := make(map[string]int64)
-	var latestBwa time.Time
+	// sum totals by the hour the agreements were created, the hours are read
+	// from their start so the sums are complete
+	rollups := make(map[rollupKey]int64)
 	for _, baRow := range bwAgreements {
 		rbad := &pb.RenterBandwidthAllocation_Data{}
 		if err := proto.Unmarshal(baRow.Agreement, rbad); err != nil {
 			t.logger.DPanic("Could not deserialize renter bwa in tally query")
 			continue
 		}
-		if baRow.CreatedAt.After(latestBwa) {
-			latestBwa = baRow.CreatedAt
+		pbad := &pb.PayerBandwidthAllocation_Data{}
+		if err := proto.Unmarshal(rbad.GetPayerAllocation().GetData(), pbad); err != nil {
+			t.logger.DPanic("Could not deserialize payer bwa in tally query")
+			continue
 		}
-		bwTotals[rbad.StorageNodeId.String()] += rbad.GetTotal() // todo: check for overflow?
-	}
-
-	//todo:  consider if we actually need EndTime in granular
-	if lastBwTally == nil {
-		t.logger.Info("No previous bandwidth timestamp found in tally query")
-		lastBwTally = &dbx.Value_Row{Value: latestBwa} //todo: something better here?
+		key := rollupKey{
+			storageNodeID: rbad.StorageNodeId.String(),
+			uplinkID:      pbad.UplinkId.String(),
+			action:        int(pbad.GetAction()),
+			intervalStart: baRow.CreatedAt.UTC().Truncate(time.Hour),
+		}
+		rollups[key] += rbad.GetTotal() // todo: check for overflow?
 	}
 
 	//insert all records in a transaction so if we fail, we don't have partial info stored
-	//todo:  replace with a WithTx() method per DBX docs?
 	tx, err := t.db.Open(ctx)
 	if err != nil {
-		t.logger.DPanic("Failed to create DB txn in tally query")
-		return err
+		return Error.Wrap(err)
 	}
 	defer func() {
 		if err == nil {
 			err = tx.Commit()
 		} else {
 			t.logger.Warn("DB txn was rolled back in tally query")
-			err = tx.Rollback()
+			err = utils.CombineErrors(err, tx.Rollback())
 		}
 	}()
 
-	//todo:  switch to bulk update SQL?
-	for k, v := range bwTotals {
-		nID := dbx.Granular_NodeId(k)
-		start := dbx.Granular_StartTime(lastBwTally.Value)
-		end := dbx.Granular_EndTime(latestBwa)
-		total := dbx.Granular_DataTotal(v)
-		_, err = tx.Create_Granular(ctx, nID, start, end, total)
-		if err != nil {
-			t.logger.DPanic("Create granular SQL failed in tally query")
-			return err //todo: retry strategy?
+	for key, total := range rollups {
+		if err = setBandwidthRollup(ctx, tx, key, total); err != nil {
+			return Error.Wrap(err)
 		}
 	}
 
-	//todo:  move this into txn when we have masterdb?
-	update := dbx.Timestamps_Update_Fields{Value: dbx.Timestamps_Value(latestBwa)}
-	_, err = tx.Update_Timestamps_By_Name(ctx, accounting.LastBandwidthTally, update)
+	// mark the time of the read, the rollups of the hours before it and its
+	// settlement window are complete
+	if lastBwTally == nil {
+		_, err = tx.Create_Timestamps(ctx, accounting.LastBandwidthTally, dbx.Timestamps_Value(now))
+	} else {
+		update := dbx.Timestamps_Update_Fields{Value: dbx.Timestamps_Value(now)}
+		_, err = tx.Update_Timestamps_By_Name(ctx, accounting.LastBandwidthTally, update)
+	}
+	return Error.Wrap(err)
+}
+
+// rollupKey identifies a bandwidth rollup
+type rollupKey struct {
+	storageNodeID string
+	uplinkID      string
+	action        int
+	intervalStart time.Time
+}
+
+// setBandwidthRollup sets the total of the rollup, creating it when it doesn't exist
+func setBandwidthRollup(ctx context.Context, tx *dbx.Tx, key rollupKey, total int64) error {
+	storageNodeID := dbx.BandwidthRollup_StorageNodeId(key.storageNodeID)
+	uplinkID := dbx.BandwidthRollup_UplinkId(key.uplinkID)
+	action := dbx.BandwidthRollup_Action(key.action)
+	intervalStart := dbx.BandwidthRollup_IntervalStart(key.intervalStart)
+
+	_, err := tx.Get_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx,
+		storageNodeID, uplinkID, action, intervalStart)
+	if isNoRows(err) {
+		_, err = tx.Create_BandwidthRollup(ctx, storageNodeID, uplinkID, action, intervalStart,
+			dbx.BandwidthRollup_Total(total))
+		return err
+	}
 	if err != nil {
-		t.logger.DPanic("Failed to update bandwith timestamp in tally query")
+		return err
 	}
+	_, err = tx.Update_BandwidthRollup_By_StorageNodeId_UplinkId_Action_IntervalStart(ctx,
+		storageNodeID, uplinkID, action, intervalStart,
+		dbx.BandwidthRollup_Update_Fields{Total: dbx.BandwidthRollup_Total(total)})
 	return err
 }
+
+// isNoRows returns whether err is a dbx error for a missing row
+func isNoRows(err error) bool {
+	dbxErr, ok := errs.Unwrap(err).(*dbx.Error)
+	return ok && dbxErr.Code == dbx.ErrorCode_NoRows
+}
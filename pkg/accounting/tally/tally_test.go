@@ -4,12 +4,13 @@
 package tally
 
 import (
-	"crypto/ecdsa"
+	"context"
 	"math/rand"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
@@ -19,7 +20,6 @@ import (
 	"czarcoin.org/czarcoin/pkg/accounting"
 	"czarcoin.org/czarcoin/pkg/bwagreement"
 	"czarcoin.org/czarcoin/pkg/bwagreement/test"
-	"czarcoin.org/czarcoin/pkg/kademlia"
 	"czarcoin.org/czarcoin/pkg/overlay"
 	"czarcoin.org/czarcoin/pkg/overlay/mocks"
 	"czarcoin.org/czarcoin/pkg/pb"
@@ -29,9 +29,6 @@ import (
 	"czarcoin.org/czarcoin/storage/teststore"
 )
 
-func TestIdentifyActiveNodes(t *testing.T) {
-
-}
 func TestOnlineNodes(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -56,8 +53,6 @@ func TestOnlineNodes(t *testing.T) {
 		}
 	}
 	overlayServer := mocks.NewOverlay(nodes)
-	kad := &kademlia.Kademlia{}
-	limit := 0
 	interval := time.Second
 
 	accountingDb, err := accounting.NewDb("sqlite3://file::memory:?mode=memory&cache=shared")
@@ -68,7 +63,7 @@ func TestOnlineNodes(t *testing.T) {
 	assert.NoError(t, err)
 	defer ctx.Check(masterDB.Close)
 
-	tally := newTally(logger, accountingDb, masterDB.BandwidthAgreement(), pointerdb, overlayServer, kad, limit, interval)
+	tally := newTally(logger, accountingDb, masterDB.BandwidthAgreement(), pointerdb, overlayServer, interval)
 
 	online, err := tally.onlineNodes(ctx, nodeIDs)
 	assert.NoError(t, err)
@@ -76,15 +71,75 @@ func TestOnlineNodes(t *testing.T) {
 }
 
 func TestTallyAtRestStorage(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
 
-}
+	online1 := testczarcoin.NodeIDFromString("online1")
+	online2 := testczarcoin.NodeIDFromString("online2")
+	offline := testczarcoin.NodeIDFromString("offline")
 
-func TestNeedToContact(t *testing.T) {
+	store := teststore.New()
+	for path, pointer := range map[string]*pb.Pointer{
+		"a": remotePointer(1000, online1, online2, offline),
+		"b": remotePointer(400, online1),
+		"c": {Type: pb.Pointer_INLINE, InlineSegment: []byte("inline"), SegmentSize: 6},
+	} {
+		value, err := proto.Marshal(pointer)
+		assert.NoError(t, err)
+		assert.NoError(t, store.Put([]byte(path), value))
+	}
+	pointerdb := pointerdb.NewServer(store, &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
+	overlayServer := mocks.NewOverlay([]*pb.Node{{Id: online1}, {Id: online2}})
 
-}
+	accountingDb, err := accounting.NewDb("sqlite3://file::memory:?mode=memory")
+	assert.NoError(t, err)
+	defer ctx.Check(accountingDb.Close)
 
-func TestUpdateGranularTable(t *testing.T) {
+	tally := newTally(zap.NewNop(), accountingDb, nil, pointerdb, overlayServer, time.Second)
 
+	nodeData, err := tally.calculateAtRestData(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[czarcoin.NodeID]int64{online1: 700, online2: 500}, nodeData)
+
+	//the first run only marks the time
+	start := time.Date(2018, 12, 1, 10, 30, 0, 0, time.UTC)
+	assert.NoError(t, tally.saveAtRestData(ctx, nodeData, start))
+	rollups, err := accounting.GetStorageRollups(ctx, accountingDb, start.Add(-time.Hour), start.Add(3*time.Hour))
+	assert.NoError(t, err)
+	assert.Empty(t, rollups)
+
+	//the data stored since is split across the hours it was stored in
+	assert.NoError(t, tally.saveAtRestData(ctx, nodeData, start.Add(2*time.Hour)))
+	rollups, err = accounting.GetStorageRollups(ctx, accountingDb, start.Add(-time.Hour), start.Add(3*time.Hour))
+	assert.NoError(t, err)
+	atRest := make(map[time.Time]map[czarcoin.NodeID]float64)
+	for _, rollup := range rollups {
+		hour := rollup.IntervalStart.UTC()
+		if atRest[hour] == nil {
+			atRest[hour] = make(map[czarcoin.NodeID]float64)
+		}
+		atRest[hour][rollup.StorageNodeID] += rollup.AtRestTotal
+	}
+	assert.Equal(t, map[time.Time]map[czarcoin.NodeID]float64{
+		time.Date(2018, 12, 1, 10, 0, 0, 0, time.UTC): {online1: 350, online2: 250},
+		time.Date(2018, 12, 1, 11, 0, 0, 0, time.UTC): {online1: 700, online2: 500},
+		time.Date(2018, 12, 1, 12, 0, 0, 0, time.UTC): {online1: 350, online2: 250},
+	}, atRest)
+}
+
+// remotePointer creates a pointer with a piece of the segment on each of the nodes
+func remotePointer(segmentSize int64, nodeIDs ...czarcoin.NodeID) *pb.Pointer {
+	pointer := &pb.Pointer{
+		Type:        pb.Pointer_REMOTE,
+		SegmentSize: segmentSize,
+		Remote: &pb.RemoteSegment{
+			Redundancy: &pb.RedundancyScheme{MinReq: 2},
+		},
+	}
+	for i, nodeID := range nodeIDs {
+		pointer.Remote.RemotePieces = append(pointer.Remote.RemotePieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+	}
+	return pointer
 }
 
 func TestQueryNoAgreements(t *testing.T) {
@@ -94,16 +149,17 @@ func TestQueryNoAgreements(t *testing.T) {
 	//get stuff we need
 	pointerdb := pointerdb.NewServer(teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
 	overlayServer := mocks.NewOverlay([]*pb.Node{})
-	kad := &kademlia.Kademlia{}
 	accountingDb, err := accounting.NewDb("sqlite3://file::memory:?mode=memory&cache=shared")
 	assert.NoError(t, err)
 	defer ctx.Check(accountingDb.Close)
 
 	masterDB, err := satellitedb.NewDB("sqlite3://file::memory:?mode=memory&cache=shared")
 	assert.NoError(t, err)
+	err = masterDB.CreateTables()
+	assert.NoError(t, err)
 	defer ctx.Check(masterDB.Close)
 
-	tally := newTally(zap.NewNop(), accountingDb, masterDB.BandwidthAgreement(), pointerdb, overlayServer, kad, 0, time.Second)
+	tally := newTally(zap.NewNop(), accountingDb, masterDB.BandwidthAgreement(), pointerdb, overlayServer, time.Second)
 
 	//check the db
 	err = tally.Query(ctx)
@@ -117,7 +173,6 @@ func TestQueryWithBw(t *testing.T) {
 	//get stuff we need
 	pointerdb := pointerdb.NewServer(teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
 	overlayServer := mocks.NewOverlay([]*pb.Node{})
-	kad := &kademlia.Kademlia{}
 	accountingDb, err := accounting.NewDb("sqlite3://file::memory:?mode=memory&cache=shared")
 	assert.NoError(t, err)
 	defer ctx.Check(accountingDb.Close)
@@ -129,23 +184,135 @@ func TestQueryWithBw(t *testing.T) {
 	defer ctx.Check(masterDB.Close)
 
 	bwDb := masterDB.BandwidthAgreement()
-	tally := newTally(zap.NewNop(), accountingDb, bwDb, pointerdb, overlayServer, kad, 0, time.Second)
+	tally := newTally(zap.NewNop(), accountingDb, bwDb, pointerdb, overlayServer, time.Second)
 
-	//get a private key
+	//get an identity
 	fiC, err := testidentity.NewTestIdentity()
 	assert.NoError(t, err)
-	k, ok := fiC.Key.(*ecdsa.PrivateKey)
-	assert.True(t, ok)
-	//generate an agreement with the key
-	pba, err := test.GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_GET, k)
+	//generate an agreement with its key
+	pba, err := test.GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_GET, fiC.Key, testczarcoin.NodeIDFromString("UplinkID"))
 	assert.NoError(t, err)
-	rba, err := test.GenerateRenterBandwidthAllocation(pba, k)
+	rba, err := test.GenerateRenterBandwidthAllocation(pba, fiC)
 	assert.NoError(t, err)
 	//save to db
-	err = bwDb.CreateAgreement(ctx, bwagreement.Agreement{Signature: rba.GetSignature(), Agreement: rba.GetData()})
+	err = bwDb.CreateAgreement(ctx, bwagreement.Serial{SerialNumber: "serial", Total: 666, MaxSize: 1024}, bwagreement.Agreement{Signature: rba.GetSignature(), Agreement: rba.GetData()})
 	assert.NoError(t, err)
 
 	//check the db
 	err = tally.Query(ctx)
 	assert.NoError(t, err)
+
+	storageNode := testczarcoin.NodeIDFromString("StorageNodeID")
+	otherNode := testczarcoin.NodeIDFromString("OtherNodeID")
+	assert.Equal(t, map[string]int64{"StorageNodeID GET": 666}, sumRollups(t, tally, storageNode, otherNode))
+
+	//add agreements for another action and storage node
+	putPba, err := test.GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_PUT, fiC.Key, testczarcoin.NodeIDFromString("UplinkID"))
+	assert.NoError(t, err)
+	for i, agreement := range []struct {
+		pba         *pb.PayerBandwidthAllocation
+		storageNode czarcoin.NodeID
+		total       int64
+	}{
+		{pba, storageNode, 100},
+		{putPba, storageNode, 200},
+		{pba, otherNode, 300},
+	} {
+		rba, err := test.GenerateRenterBandwidthAllocationFor(agreement.pba, agreement.storageNode, agreement.total, fiC)
+		assert.NoError(t, err)
+		serial := bwagreement.Serial{SerialNumber: "serial" + strconv.Itoa(i), StorageNodeID: agreement.storageNode, Total: agreement.total, MaxSize: 1024}
+		err = bwDb.CreateAgreement(ctx, serial, bwagreement.Agreement{Signature: rba.GetSignature(), Agreement: rba.GetData()})
+		assert.NoError(t, err)
+	}
+
+	//only the new agreements are added to the rollups
+	for i := 0; i < 2; i++ {
+		err = tally.Query(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{
+			"StorageNodeID GET": 766,
+			"StorageNodeID PUT": 200,
+			"OtherNodeID GET":   300,
+		}, sumRollups(t, tally, storageNode, otherNode))
+	}
+}
+
+// sumRollups sums the bandwidth rollups by storage node and action
+func sumRollups(t *testing.T, tally *tally, storageNode, otherNode czarcoin.NodeID) map[string]int64 {
+	names := map[czarcoin.NodeID]string{storageNode: "StorageNodeID", otherNode: "OtherNodeID"}
+	uplink := testczarcoin.NodeIDFromString("UplinkID")
+
+	rollups, err := accounting.GetBandwidthRollups(context.Background(), tally.db, time.Time{}, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	sums := make(map[string]int64)
+	for _, rollup := range rollups {
+		assert.Equal(t, uplink, rollup.UplinkID)
+		sums[names[rollup.StorageNodeID]+" "+rollup.Action.String()] += rollup.Total
+	}
+	return sums
+}
+
+// lateAgreements is a bandwidth agreement database whose agreements are
+// committed with the time they were created at
+type lateAgreements struct {
+	agreements []bwagreement.Agreement
+}
+
+func (db *lateAgreements) CreateAgreement(ctx context.Context, serial bwagreement.Serial, agreement bwagreement.Agreement) error {
+	db.agreements = append(db.agreements, agreement)
+	return nil
+}
+
+func (db *lateAgreements) GetAgreements(ctx context.Context) ([]bwagreement.Agreement, error) {
+	return db.agreements, nil
+}
+
+func (db *lateAgreements) GetAgreementsSince(ctx context.Context, since time.Time) (agreements []bwagreement.Agreement, err error) {
+	for _, agreement := range db.agreements {
+		if !agreement.CreatedAt.Before(since) {
+			agreements = append(agreements, agreement)
+		}
+	}
+	return agreements, nil
+}
+
+func TestQueryLateAgreements(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	pointerdb := pointerdb.NewServer(teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
+	overlayServer := mocks.NewOverlay([]*pb.Node{})
+	accountingDb, err := accounting.NewDb("sqlite3://file::memory:?mode=memory&cache=shared")
+	assert.NoError(t, err)
+	defer ctx.Check(accountingDb.Close)
+
+	bwDb := &lateAgreements{}
+	tally := newTally(zap.NewNop(), accountingDb, bwDb, pointerdb, overlayServer, time.Second)
+
+	fiC, err := testidentity.NewTestIdentity()
+	assert.NoError(t, err)
+	pba, err := test.GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_GET, fiC.Key, testczarcoin.NodeIDFromString("UplinkID"))
+	assert.NoError(t, err)
+	storageNode := testczarcoin.NodeIDFromString("StorageNodeID")
+	settle := func(total int64, createdAt time.Time) {
+		rba, err := test.GenerateRenterBandwidthAllocationFor(pba, storageNode, total, fiC)
+		assert.NoError(t, err)
+		err = bwDb.CreateAgreement(ctx, bwagreement.Serial{}, bwagreement.Agreement{
+			Signature: rba.GetSignature(), Agreement: rba.GetData(), CreatedAt: createdAt,
+		})
+		assert.NoError(t, err)
+	}
+
+	settle(100, time.Now().Add(-time.Second))
+	err = tally.Query(ctx)
+	assert.NoError(t, err)
+
+	// an agreement created before the last run but committed after it
+	settle(200, time.Now().Add(-2*time.Second))
+	for i := 0; i < 2; i++ {
+		err = tally.Query(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{"StorageNodeID GET": 300}, sumRollups(t, tally, storageNode, testczarcoin.NodeIDFromString("OtherNodeID")))
+	}
 }
@@ -0,0 +1,140 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package challenge
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"hash"
+	"math/big"
+
+	"github.com/zeebo/errs"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+// Error is the default error class for audit challenges
+var Error = errs.Class("audit challenge error")
+
+// NonceSize is the size of the secret nonce of a challenge
+const NonceSize = 16
+
+// Hash returns the keyed hash over an erasure share which answers a challenge
+func Hash(nonce, share []byte) []byte {
+	mac := hmac.New(sha256.New, nonce)
+	_, _ = mac.Write(share)
+	return mac.Sum(nil)
+}
+
+// Verify checks the answer of a node to challenge
+func Verify(challenge *pb.AuditChallenge, hash []byte) bool {
+	return hmac.Equal(challenge.GetHash(), hash)
+}
+
+// Sampler creates challenges for randomly sampled erasure shares of a piece
+// as it is written, so that challenges can be created while uploading
+type Sampler struct {
+	count     int
+	shareSize int
+
+	index      int64 // index of the current share
+	written    int   // bytes of the current share written so far
+	slot       int   // slot of the current share, -1 if it isn't sampled
+	nonce      []byte
+	mac        hash.Hash
+	challenges []*pb.AuditChallenge
+}
+
+// NewSampler creates a Sampler which keeps count challenges for shares of
+// shareSize bytes
+func NewSampler(count, shareSize int) *Sampler {
+	return &Sampler{count: count, shareSize: shareSize, slot: -1}
+}
+
+// Write implements io.Writer
+func (sampler *Sampler) Write(p []byte) (n int, err error) {
+	if sampler.count <= 0 || sampler.shareSize <= 0 {
+		return len(p), nil
+	}
+
+	for len(p) > 0 {
+		if sampler.written == 0 {
+			if err := sampler.begin(); err != nil {
+				return n, err
+			}
+		}
+
+		chunk := p
+		if remaining := sampler.shareSize - sampler.written; len(chunk) > remaining {
+			chunk = chunk[:remaining]
+		}
+		if sampler.mac != nil {
+			_, _ = sampler.mac.Write(chunk)
+		}
+		sampler.written += len(chunk)
+		n += len(chunk)
+		p = p[len(chunk):]
+
+		if sampler.written == sampler.shareSize {
+			sampler.end()
+		}
+	}
+	return n, nil
+}
+
+// begin decides whether the next share replaces one of the kept challenges,
+// such that every share is sampled with the same probability
+func (sampler *Sampler) begin() error {
+	sampler.slot = -1
+	sampler.mac = nil
+
+	if sampler.index < int64(sampler.count) {
+		sampler.slot = int(sampler.index)
+	} else {
+		r, err := rand.Int(rand.Reader, big.NewInt(sampler.index+1))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if r.Int64() < int64(sampler.count) {
+			sampler.slot = int(r.Int64())
+		}
+	}
+	if sampler.slot < 0 {
+		return nil
+	}
+
+	sampler.nonce = make([]byte, NonceSize)
+	if _, err := rand.Read(sampler.nonce); err != nil {
+		return Error.Wrap(err)
+	}
+	sampler.mac = hmac.New(sha256.New, sampler.nonce)
+	return nil
+}
+
+// end completes the current share
+func (sampler *Sampler) end() {
+	if sampler.mac != nil {
+		challenge := &pb.AuditChallenge{
+			StripeIndex: sampler.index,
+			Nonce:       sampler.nonce,
+			Hash:        sampler.mac.Sum(nil),
+		}
+		if sampler.slot < len(sampler.challenges) {
+			sampler.challenges[sampler.slot] = challenge
+		} else {
+			sampler.challenges = append(sampler.challenges, challenge)
+		}
+	}
+
+	sampler.index++
+	sampler.written = 0
+	sampler.slot = -1
+	sampler.mac = nil
+}
+
+// Challenges returns the challenges for the complete shares written so far
+func (sampler *Sampler) Challenges() []*pb.AuditChallenge {
+	return sampler.challenges
+}
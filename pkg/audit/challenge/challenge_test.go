@@ -0,0 +1,61 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package challenge
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampler(t *testing.T) {
+	const shareSize = 64
+
+	for _, tt := range []struct {
+		count     int
+		size      int
+		chunk     int
+		generated int
+	}{
+		{count: 4, size: 100 * shareSize, chunk: 7, generated: 4},
+		{count: 4, size: 100 * shareSize, chunk: 1000, generated: 4},
+		{count: 4, size: 2 * shareSize, chunk: shareSize, generated: 2},
+		{count: 4, size: 2*shareSize + 10, chunk: 3, generated: 2}, // incomplete shares are not challenged
+		{count: 0, size: 10 * shareSize, chunk: shareSize, generated: 0},
+	} {
+		data := make([]byte, tt.size)
+		_, err := rand.Read(data)
+		assert.NoError(t, err)
+
+		sampler := NewSampler(tt.count, shareSize)
+		for p := data; len(p) > 0; {
+			chunk := p
+			if len(chunk) > tt.chunk {
+				chunk = chunk[:tt.chunk]
+			}
+			n, err := sampler.Write(chunk)
+			assert.NoError(t, err)
+			assert.Equal(t, len(chunk), n)
+			p = p[len(chunk):]
+		}
+
+		challenges := sampler.Challenges()
+		assert.Len(t, challenges, tt.generated)
+
+		seen := map[int64]bool{}
+		for _, challenge := range challenges {
+			assert.False(t, seen[challenge.StripeIndex])
+			seen[challenge.StripeIndex] = true
+
+			assert.Len(t, challenge.Nonce, NonceSize)
+			share := data[challenge.StripeIndex*shareSize : (challenge.StripeIndex+1)*shareSize]
+			assert.True(t, Verify(challenge, Hash(challenge.Nonce, share)))
+
+			// the answer depends on the nonce and the share
+			assert.False(t, Verify(challenge, Hash(make([]byte, NonceSize), share)))
+			assert.False(t, Verify(challenge, Hash(challenge.Nonce, share[1:])))
+		}
+	}
+}
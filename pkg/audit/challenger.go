@@ -0,0 +1,188 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+
+	"czarcoin.org/czarcoin/pkg/audit/challenge"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/piecestore/psclient"
+	sdbproto "czarcoin.org/czarcoin/pkg/statdb/proto"
+	"czarcoin.org/czarcoin/pkg/utils"
+)
+
+type answer struct {
+	Error       error
+	PieceNumber int
+	Challenge   *pb.AuditChallenge
+	Hash        []byte
+}
+
+type challenger interface {
+	ChallengeShares(ctx context.Context, pointer *pb.Pointer, authorization *pb.SignedMessage) (answers map[int]answer, nodes map[int]*pb.Node, err error)
+}
+
+// hasChallenges returns whether the pieces of pointer can be audited with
+// the challenges committed when they were uploaded
+func hasChallenges(pointer *pb.Pointer) bool {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if len(piece.GetChallenges()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAnswers returns whether any node answered its challenge
+func hasAnswers(answers map[int]answer) bool {
+	for _, a := range answers {
+		if a.Error == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// consumeChallenges removes the challenges which were answered by the nodes
+// from pointer, as nodes could answer them again without storing the shares.
+// Challenges which didn't reach the nodes are kept for the next audits.
+func consumeChallenges(pointer *pb.Pointer, answers map[int]answer) {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		a, ok := answers[int(piece.GetPieceNum())]
+		if !ok || a.Error != nil || len(piece.Challenges) == 0 {
+			continue
+		}
+		if bytes.Equal(piece.Challenges[0].GetNonce(), a.Challenge.GetNonce()) {
+			piece.Challenges = piece.Challenges[1:]
+		}
+	}
+}
+
+// getAnswer sends the next challenge of a piece to a node and returns its answer
+func (d *defaultDownloader) getAnswer(ctx context.Context, shareSize int64, pieceNumber int,
+	id psclient.PieceID, next *pb.AuditChallenge, fromNode *pb.Node, authorization *pb.SignedMessage) (a answer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ps, err := psclient.NewPSClient(ctx, d.transport, fromNode, 0)
+	if err != nil {
+		return a, err
+	}
+	defer utils.LogClose(ps)
+
+	derivedPieceID, err := id.Derive(fromNode.Id.Bytes())
+	if err != nil {
+		return a, err
+	}
+
+	hash, err := ps.Challenge(ctx, derivedPieceID, next.GetStripeIndex(), shareSize, next.GetNonce(), authorization)
+	if err != nil {
+		return a, err
+	}
+
+	return answer{PieceNumber: pieceNumber, Challenge: next, Hash: hash}, nil
+}
+
+// ChallengeShares sends the next unused challenge of every piece to the node
+// storing it
+func (d *defaultDownloader) ChallengeShares(ctx context.Context, pointer *pb.Pointer,
+	authorization *pb.SignedMessage) (answers map[int]answer, nodes map[int]*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var pieces []*pb.RemotePiece
+	var nodeIds czarcoin.NodeIDList
+	for _, p := range pointer.Remote.GetRemotePieces() {
+		if len(p.GetChallenges()) == 0 {
+			continue
+		}
+		pieces = append(pieces, p)
+		nodeIds = append(nodeIds, p.NodeId)
+	}
+
+	nodeSlice, err := d.overlay.BulkLookup(ctx, nodeIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	answers = make(map[int]answer, len(nodeSlice))
+	nodes = make(map[int]*pb.Node, len(nodeSlice))
+
+	shareSize := int64(pointer.Remote.Redundancy.GetErasureShareSize())
+	pieceID := psclient.PieceID(pointer.Remote.GetPieceId())
+
+	for i, node := range nodeSlice {
+		if node == nil {
+			continue
+		}
+
+		pieceNumber := int(pieces[i].PieceNum)
+		next := pieces[i].Challenges[0]
+
+		a, err := d.getAnswer(ctx, shareSize, pieceNumber, pieceID, next, node, authorization)
+		if err != nil {
+			a = answer{
+				Error:       err,
+				PieceNumber: pieceNumber,
+				Challenge:   next,
+			}
+		}
+
+		answers[pieceNumber] = a
+		nodes[pieceNumber] = node
+	}
+
+	return answers, nodes, nil
+}
+
+// challenge sends challenges committed at upload to the nodes of pointer and
+// verifies their answers, without downloading any shares
+func (verifier *Verifier) challenge(ctx context.Context, pointer *pb.Pointer, authorization *pb.SignedMessage) (verifiedNodes []*sdbproto.Node, answers map[int]answer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	answers, nodes, err := verifier.challenger.ChallengeShares(ctx, pointer, authorization)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var offlineNodes, failedNodes czarcoin.NodeIDList
+	for pieceNum, a := range answers {
+		switch {
+		case a.Error != nil:
+			offlineNodes = append(offlineNodes, nodes[pieceNum].Id)
+		case !challenge.Verify(a.Challenge, a.Hash):
+			failedNodes = append(failedNodes, nodes[pieceNum].Id)
+		}
+	}
+
+	successNodes := getSuccessNodes(ctx, nodes, failedNodes, offlineNodes)
+	verifiedNodes = setVerifiedNodes(ctx, offlineNodes, failedNodes, successNodes)
+
+	return verifiedNodes, answers, nil
+}
+
+// pendingChallenges downloads the shares of stripe to rebuild the shares of
+// the nodes which didn't answer their challenges and returns a pending audit
+// for every one of them
+func (verifier *Verifier) pendingChallenges(ctx context.Context, stripe *Stripe, answers map[int]answer,
+	authorization *pb.SignedMessage) (pending []*PendingAudit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	offline := make(map[int]czarcoin.NodeID)
+	for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+		if a, ok := answers[int(piece.PieceNum)]; ok && a.Error != nil {
+			offline[int(piece.PieceNum)] = piece.NodeId
+		}
+	}
+	if len(offline) == 0 {
+		return nil, nil
+	}
+
+	shares, _, err := verifier.downloader.DownloadShares(ctx, stripe.Segment, stripe.Index, authorization)
+	if err != nil {
+		return nil, err
+	}
+	return createPendingAudits(ctx, stripe, shares, offline)
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vivint/infectious"
+
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/audit/challenge"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+type mockChallenger struct {
+	answers map[int]answer
+}
+
+func (m *mockChallenger) ChallengeShares(ctx context.Context, pointer *pb.Pointer,
+	authorization *pb.SignedMessage) (answers map[int]answer, nodes map[int]*pb.Node, err error) {
+	nodes = make(map[int]*pb.Node, len(m.answers))
+	for pieceNum := range m.answers {
+		nodes[pieceNum] = &pb.Node{Id: testczarcoin.NodeIDFromString(strconv.Itoa(pieceNum))}
+	}
+	return m.answers, nodes, nil
+}
+
+func TestChallengeAudit(t *testing.T) {
+	ctx := context.Background()
+
+	share := randData(1024)
+	answers := make(map[int]answer)
+	for i := 0; i < 10; i++ {
+		c := &pb.AuditChallenge{StripeIndex: int64(i), Nonce: randData(challenge.NonceSize)}
+		c.Hash = challenge.Hash(c.Nonce, share)
+
+		a := answer{PieceNumber: i, Challenge: c}
+		switch {
+		case i < 2: // offline
+			a.Error = Error.New("unable to get node")
+		case i < 5: // answered without the right share
+			a.Hash = challenge.Hash(c.Nonce, share[1:])
+		default:
+			a.Hash = challenge.Hash(c.Nonce, share)
+		}
+		answers[i] = a
+	}
+
+	verifier := &Verifier{challenger: &mockChallenger{answers: answers}}
+	verifiedNodes, got, err := verifier.challenge(ctx, makePointer(10), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, answers, got)
+	assert.Len(t, verifiedNodes, 10)
+
+	var offline, failed, success int
+	for _, node := range verifiedNodes {
+		switch {
+		case !node.IsUp:
+			offline++
+		case !node.AuditSuccess:
+			failed++
+		default:
+			success++
+		}
+	}
+	assert.Equal(t, 2, offline)
+	assert.Equal(t, 3, failed)
+	assert.Equal(t, 5, success)
+}
+
+func TestPendingChallenges(t *testing.T) {
+	ctx := context.Background()
+	pointer := makePointer(40)
+
+	f, err := infectious.NewFEC(20, 40)
+	assert.NoError(t, err)
+
+	shares := make(map[int]share)
+	err = f.Encode(randData(20*4), func(s infectious.Share) {
+		shares[s.Number] = share{PieceNumber: s.Number, Data: append([]byte{}, s.Data...)}
+	})
+	assert.NoError(t, err)
+
+	// the first two nodes didn't answer and don't return their shares either
+	answers := make(map[int]answer)
+	for i := 0; i < 40; i++ {
+		answers[i] = answer{PieceNumber: i}
+	}
+	for i := 0; i < 2; i++ {
+		answers[i] = answer{PieceNumber: i, Error: Error.New("timed out")}
+		shares[i] = share{PieceNumber: i, Error: Error.New("timed out")}
+	}
+
+	verifier := &Verifier{downloader: &mockDownloader{shares: shares}}
+	stripe := &Stripe{Index: 5, Path: "a/b", Segment: pointer}
+	pending, err := verifier.pendingChallenges(ctx, stripe, answers, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, pending, 2) {
+		nodes := czarcoin.NodeIDList{pending[0].NodeID, pending[1].NodeID}
+		assert.Contains(t, nodes, pointer.Remote.RemotePieces[0].NodeId)
+		assert.Contains(t, nodes, pointer.Remote.RemotePieces[1].NodeId)
+		assert.EqualValues(t, 5, pending[0].StripeIndex)
+	}
+
+	// nothing is downloaded when every node answered
+	verifier = &Verifier{downloader: nil}
+	pending, err = verifier.pendingChallenges(ctx, stripe, map[int]answer{3: {PieceNumber: 3}}, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestConsumeChallenges(t *testing.T) {
+	pointer := makePointer(3)
+	assert.False(t, hasChallenges(pointer))
+
+	pieces := pointer.GetRemote().GetRemotePieces()
+	for _, piece := range pieces {
+		for i := 0; i < 2; i++ {
+			piece.Challenges = append(piece.Challenges, &pb.AuditChallenge{Nonce: randData(challenge.NonceSize)})
+		}
+	}
+	assert.True(t, hasChallenges(pointer))
+
+	answered := pieces[0].Challenges[0]
+	stale := &pb.AuditChallenge{Nonce: randData(challenge.NonceSize)}
+	answers := map[int]answer{
+		0: {PieceNumber: 0, Challenge: answered},
+		1: {PieceNumber: 1, Challenge: stale}, // not the next challenge of the piece
+		2: {PieceNumber: 2, Challenge: pieces[2].Challenges[0], Error: errors.New("dial failed")},
+	}
+	assert.True(t, hasAnswers(answers))
+	consumeChallenges(pointer, answers)
+
+	assert.Len(t, pieces[0].Challenges, 1)
+	assert.NotEqual(t, answered, pieces[0].Challenges[0])
+	assert.Len(t, pieces[1].Challenges, 2)
+	// the challenge of the offline node was never delivered
+	assert.Len(t, pieces[2].Challenges, 2)
+
+	assert.False(t, hasAnswers(map[int]answer{2: answers[2]}))
+}
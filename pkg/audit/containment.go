@@ -0,0 +1,177 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+
+	"github.com/vivint/infectious"
+	"github.com/zeebo/errs"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/piecestore/psclient"
+)
+
+// ErrContainedNotFound is returned when a node has no pending audit
+var ErrContainedNotFound = errs.Class("pending audit not found")
+
+// PendingAudit is a share which a node failed to return during an audit,
+// the node is asked for the same share on its later audits
+type PendingAudit struct {
+	NodeID            czarcoin.NodeID
+	Path              czarcoin.Path
+	PieceID           psclient.PieceID
+	StripeIndex       int64
+	ShareSize         int64
+	ExpectedShareHash []byte
+	ReverifyCount     int64
+}
+
+// Containment holds the pending audits of the nodes in containment mode
+type Containment interface {
+	// Get returns the pending audit of a node or ErrContainedNotFound
+	Get(ctx context.Context, nodeID czarcoin.NodeID) (*PendingAudit, error)
+	// IncrementPending creates the pending audit of a node or increments the
+	// reverify count of the existing one
+	IncrementPending(ctx context.Context, pending *PendingAudit) error
+	// Delete removes the pending audit of a node
+	Delete(ctx context.Context, nodeID czarcoin.NodeID) (bool, error)
+}
+
+type reverifier interface {
+	DownloadShare(ctx context.Context, pointer *pb.Pointer, stripeIndex int, pieceNum int32, nodeID czarcoin.NodeID,
+		authorization *pb.SignedMessage) (s share, node *pb.Node, err error)
+}
+
+// DownloadShare downloads a single share from the node storing a piece
+func (d *defaultDownloader) DownloadShare(ctx context.Context, pointer *pb.Pointer, stripeIndex int, pieceNum int32,
+	nodeID czarcoin.NodeID, authorization *pb.SignedMessage) (s share, node *pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err = d.overlay.Lookup(ctx, nodeID)
+	if err != nil {
+		return s, nil, err
+	}
+
+	shareSize := int(pointer.Remote.Redundancy.GetErasureShareSize())
+	paddedSize := calcPadded(pointer.GetSegmentSize(), shareSize)
+	pieceSize := paddedSize / int64(pointer.Remote.Redundancy.GetMinReq())
+	pieceID := psclient.PieceID(pointer.Remote.GetPieceId())
+
+	s, err = d.getShare(ctx, stripeIndex, shareSize, int(pieceNum), pieceID, pieceSize, node, authorization)
+	if err != nil {
+		return share{Error: err, PieceNumber: int(pieceNum)}, node, nil
+	}
+	return s, node, nil
+}
+
+// reverify asks the node of pending for the share it failed to return and
+// returns whether it is still contained and whether it passed, for nodes
+// which are not contained anymore
+func (verifier *Verifier) reverify(ctx context.Context, pending *PendingAudit, pointer *pb.Pointer,
+	authorization *pb.SignedMessage, maxReverifyCount int) (contained, passed bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var pieceNum int32 = -1
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if piece.NodeId == pending.NodeID {
+			pieceNum = piece.PieceNum
+		}
+	}
+	if pieceNum < 0 {
+		return false, false, Error.New("node %s does not store a piece of %s", pending.NodeID, pending.Path)
+	}
+
+	s, _, err := verifier.reverifier.DownloadShare(ctx, pointer, int(pending.StripeIndex), pieceNum, pending.NodeID, authorization)
+	if err != nil {
+		// the node can't be reached, which counts as failing to return the share
+		s = share{Error: err, PieceNumber: int(pieceNum)}
+	}
+
+	if s.Error != nil {
+		// the node keeps failing to return the share
+		return pending.ReverifyCount+1 < int64(maxReverifyCount), false, nil
+	}
+
+	hash := sha256.Sum256(s.Data)
+	return false, bytes.Equal(hash[:], pending.ExpectedShareHash), nil
+}
+
+// createPendingAudits reconstructs the shares the offline pieces of stripe
+// should have had and returns a pending audit for every one of their nodes
+func createPendingAudits(ctx context.Context, stripe *Stripe, shares map[int]share, offline map[int]czarcoin.NodeID) (pending []*PendingAudit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(offline) == 0 {
+		return nil, nil
+	}
+
+	redundancy := stripe.Segment.GetRemote().GetRedundancy()
+	f, err := infectious.NewFEC(int(redundancy.GetMinReq()), int(redundancy.GetTotal()))
+	if err != nil {
+		return nil, err
+	}
+
+	copies, err := makeCopies(ctx, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	stripeData, err := f.Decode(nil, copies)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.Encode(stripeData, func(s infectious.Share) {
+		nodeID, ok := offline[s.Number]
+		if !ok {
+			return
+		}
+		hash := sha256.Sum256(s.Data)
+		pending = append(pending, &PendingAudit{
+			NodeID:            nodeID,
+			Path:              stripe.Path,
+			PieceID:           psclient.PieceID(stripe.Segment.GetRemote().GetPieceId()),
+			StripeIndex:       int64(stripe.Index),
+			ShareSize:         int64(redundancy.GetErasureShareSize()),
+			ExpectedShareHash: hash[:],
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// storesPiece returns whether the node stores a piece of pointer
+func storesPiece(pointer *pb.Pointer, nodeID czarcoin.NodeID) bool {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if piece.NodeId == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutNodes returns a copy of pointer without the pieces stored by nodes
+func withoutNodes(pointer *pb.Pointer, nodes map[czarcoin.NodeID]bool) *pb.Pointer {
+	if len(nodes) == 0 {
+		return pointer
+	}
+
+	remote := *pointer.GetRemote()
+	remote.RemotePieces = nil
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if !nodes[piece.NodeId] {
+			remote.RemotePieces = append(remote.RemotePieces, piece)
+		}
+	}
+
+	copied := *pointer
+	copied.Remote = &remote
+	return &copied
+}
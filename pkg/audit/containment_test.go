@@ -0,0 +1,134 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vivint/infectious"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+type mockReverifier struct {
+	share share
+	err   error
+}
+
+func (m *mockReverifier) DownloadShare(ctx context.Context, pointer *pb.Pointer, stripeIndex int, pieceNum int32,
+	nodeID czarcoin.NodeID, authorization *pb.SignedMessage) (s share, node *pb.Node, err error) {
+	if m.err != nil {
+		return share{}, nil, m.err
+	}
+	return m.share, &pb.Node{Id: nodeID}, nil
+}
+
+func TestCreatePendingAudits(t *testing.T) {
+	ctx := context.Background()
+	pointer := makePointer(40)
+
+	f, err := infectious.NewFEC(20, 40)
+	assert.NoError(t, err)
+
+	encoded := make(map[int][]byte)
+	err = f.Encode(randData(20*4), func(s infectious.Share) {
+		encoded[s.Number] = append([]byte{}, s.Data...)
+	})
+	assert.NoError(t, err)
+
+	// the first two nodes failed to return their shares
+	shares := make(map[int]share)
+	offline := make(map[int]czarcoin.NodeID)
+	for pieceNum, data := range encoded {
+		if pieceNum < 2 {
+			shares[pieceNum] = share{Error: Error.New("timed out"), PieceNumber: pieceNum}
+			offline[pieceNum] = pointer.Remote.RemotePieces[pieceNum].NodeId
+			continue
+		}
+		shares[pieceNum] = share{PieceNumber: pieceNum, Data: data}
+	}
+
+	stripe := &Stripe{Index: 5, Path: "a/b", Segment: pointer}
+	pending, err := createPendingAudits(ctx, stripe, shares, offline)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 2)
+
+	for _, p := range pending {
+		pieceNum := -1
+		for num, id := range offline {
+			if id == p.NodeID {
+				pieceNum = num
+			}
+		}
+		if !assert.True(t, pieceNum >= 0) {
+			continue
+		}
+
+		expected := sha256.Sum256(encoded[pieceNum])
+		assert.Equal(t, expected[:], p.ExpectedShareHash)
+		assert.Equal(t, czarcoin.Path("a/b"), p.Path)
+		assert.EqualValues(t, 5, p.StripeIndex)
+		assert.EqualValues(t, 4, p.ShareSize)
+		assert.EqualValues(t, "testId", p.PieceID)
+	}
+}
+
+func TestReverify(t *testing.T) {
+	ctx := context.Background()
+	pointer := makePointer(10)
+	data := randData(4)
+	hash := sha256.Sum256(data)
+
+	for i, tt := range []struct {
+		share         share
+		err           error
+		reverifyCount int64
+		contained     bool
+		passed        bool
+	}{
+		{share: share{Data: data}, contained: false, passed: true},
+		{share: share{Data: randData(4)}, contained: false, passed: false},
+		{share: share{Error: Error.New("timed out")}, reverifyCount: 0, contained: true, passed: false},
+		{share: share{Error: Error.New("timed out")}, reverifyCount: 2, contained: false, passed: false},
+		{err: Error.New("unable to get node"), reverifyCount: 0, contained: true, passed: false},
+		{err: Error.New("unable to get node"), reverifyCount: 2, contained: false, passed: false},
+	} {
+		verifier := &Verifier{reverifier: &mockReverifier{share: tt.share, err: tt.err}}
+		pending := &PendingAudit{
+			NodeID:            pointer.Remote.RemotePieces[3].NodeId,
+			ExpectedShareHash: hash[:],
+			ReverifyCount:     tt.reverifyCount,
+		}
+
+		contained, passed, err := verifier.reverify(ctx, pending, pointer, nil, 3)
+		assert.NoError(t, err, i)
+		assert.Equal(t, tt.contained, contained, i)
+		assert.Equal(t, tt.passed, passed, i)
+	}
+
+	// nodes which don't store a piece of the segment can't be asked
+	verifier := &Verifier{reverifier: &mockReverifier{share: share{Data: data}}}
+	_, _, err := verifier.reverify(ctx, &PendingAudit{}, pointer, nil, 3)
+	assert.Error(t, err)
+}
+
+func TestWithoutNodes(t *testing.T) {
+	pointer := makePointer(10)
+	assert.Equal(t, pointer, withoutNodes(pointer, nil))
+
+	skip := map[czarcoin.NodeID]bool{
+		pointer.Remote.RemotePieces[0].NodeId: true,
+		pointer.Remote.RemotePieces[5].NodeId: true,
+	}
+	copied := withoutNodes(pointer, skip)
+	assert.Len(t, copied.Remote.RemotePieces, 8)
+	assert.Len(t, pointer.Remote.RemotePieces, 10)
+	for _, piece := range copied.Remote.RemotePieces {
+		assert.False(t, skip[piece.NodeId])
+	}
+}
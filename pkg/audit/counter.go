@@ -0,0 +1,48 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"time"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+// auditCounter counts the audits of every node during the current day and
+// reports them as the audits_per_node_per_day metric when the day is over
+type auditCounter struct {
+	day    time.Time
+	counts map[czarcoin.NodeID]int64
+}
+
+// record counts the audits of nodes made at now
+func (counter *auditCounter) record(now time.Time, nodes []*pb.Node) {
+	day := now.UTC().Truncate(24 * time.Hour)
+	if !day.Equal(counter.day) {
+		if !counter.day.IsZero() {
+			counter.flush()
+		}
+		counter.day = day
+	}
+
+	for _, node := range nodes {
+		if !node.UpdateAuditSuccess {
+			continue
+		}
+		if counter.counts == nil {
+			counter.counts = map[czarcoin.NodeID]int64{}
+		}
+		counter.counts[node.Id]++
+	}
+}
+
+// flush reports the counts of the current day and resets them
+func (counter *auditCounter) flush() {
+	for _, count := range counter.counts {
+		mon.IntVal("audits_per_node_per_day").Observe(count)
+	}
+	mon.IntVal("audited_nodes_per_day").Observe(int64(len(counter.counts)))
+	counter.counts = nil
+}
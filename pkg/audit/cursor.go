@@ -8,12 +8,14 @@ import (
 	"crypto/rand"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/vivint/infectious"
 
 	"czarcoin.org/czarcoin/pkg/eestream"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/pointerdb/pdbclient"
+	sdbproto "czarcoin.org/czarcoin/pkg/statdb/proto"
 	"czarcoin.org/czarcoin/pkg/storage/meta"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
 )
@@ -21,20 +23,58 @@ import (
 // Stripe keeps track of a stripe's index and its parent segment
 type Stripe struct {
 	Index         int
+	Path          czarcoin.Path
 	Segment       *pb.Pointer
 	Authorization *pb.SignedMessage
 }
 
+// CursorDB keeps the position of the audit cursor across restarts
+type CursorDB interface {
+	// LastPath returns the last path listed by the cursor, or "" when none
+	// was saved
+	LastPath(ctx context.Context) (czarcoin.Path, error)
+	// SetLastPath saves the last path listed by the cursor
+	SetLastPath(ctx context.Context, path czarcoin.Path) error
+}
+
 // Cursor keeps track of audit location in pointer db
 type Cursor struct {
 	pointers pdbclient.Client
+	stats    statsSource
+	weights  Weights
+	db       CursorDB
 	lastPath czarcoin.Path
 	mutex    sync.Mutex
 }
 
-// NewCursor creates a Cursor which iterates over pointer db
-func NewCursor(pointers pdbclient.Client) *Cursor {
-	return &Cursor{pointers: pointers}
+// NewCursor creates a Cursor which iterates over pointer db, starting from
+// the last path saved in db. A nil db keeps the position in memory only.
+func NewCursor(ctx context.Context, pointers pdbclient.Client, db CursorDB) (*Cursor, error) {
+	cursor := &Cursor{pointers: pointers, db: db}
+	if err := cursor.load(ctx); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// NewWeightedCursor creates a Cursor which favors the segments stored on
+// nodes that were audited rarely or long ago. As the audit counts and times
+// are kept in statdb, coverage is not reset when the satellite restarts.
+func NewWeightedCursor(ctx context.Context, pointers pdbclient.Client, db CursorDB, stats statsSource, weights Weights) (*Cursor, error) {
+	cursor := &Cursor{pointers: pointers, db: db, stats: stats, weights: weights}
+	if err := cursor.load(ctx); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// load restores the last path saved in the cursor db
+func (cursor *Cursor) load(ctx context.Context) (err error) {
+	if cursor.db == nil {
+		return nil
+	}
+	cursor.lastPath, err = cursor.db.LastPath(ctx)
+	return err
 }
 
 // NextStripe returns a random stripe to be audited
@@ -59,24 +99,40 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 		return nil, nil
 	}
 
-	pointerItem, err := getRandomPointer(pointerItems)
-	if err != nil {
-		return nil, err
-	}
-
-	path = pointerItem.Path
-
 	// keep track of last path listed
 	if !more {
 		cursor.lastPath = ""
 	} else {
 		cursor.lastPath = pointerItems[len(pointerItems)-1].Path
 	}
+	if cursor.db != nil {
+		if err := cursor.db.SetLastPath(ctx, cursor.lastPath); err != nil {
+			return nil, err
+		}
+	}
 
-	// get pointer info
-	pointer, _, _, err := cursor.pointers.Get(ctx, path)
-	if err != nil {
-		return nil, err
+	var pointer *pb.Pointer
+	if cursor.stats != nil && cursor.weights.Candidates > 1 {
+		path, pointer, err = cursor.pickWeighted(ctx, pointerItems)
+		if err != nil {
+			return nil, err
+		}
+		if pointer == nil {
+			return nil, nil
+		}
+	} else {
+		pointerItem, err := getRandomPointer(pointerItems)
+		if err != nil {
+			return nil, err
+		}
+
+		path = pointerItem.Path
+
+		// get pointer info
+		pointer, _, _, err = cursor.pointers.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if pointer.GetType() != pb.Pointer_REMOTE {
@@ -100,7 +156,63 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 
 	authorization := cursor.pointers.SignedMessage()
 
-	return &Stripe{Index: index, Segment: pointer, Authorization: authorization}, nil
+	return &Stripe{Index: index, Path: path, Segment: pointer, Authorization: authorization}, nil
+}
+
+// pickWeighted gets a random sample of the listed pointers and picks one of
+// the remote ones by the weights of the nodes storing its pieces
+func (cursor *Cursor) pickWeighted(ctx context.Context, pointerItems []pdbclient.ListItem) (path czarcoin.Path, pointer *pb.Pointer, err error) {
+	indexes, err := sampleIndexes(len(pointerItems), cursor.weights.Candidates)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var paths []czarcoin.Path
+	var pointers []*pb.Pointer
+	var nodeIDs czarcoin.NodeIDList
+	seen := map[czarcoin.NodeID]bool{}
+	for _, index := range indexes {
+		candidate, _, _, err := cursor.pointers.Get(ctx, pointerItems[index].Path)
+		if err != nil {
+			return "", nil, err
+		}
+		if candidate.GetType() != pb.Pointer_REMOTE || candidate.GetSegmentSize() == 0 {
+			continue
+		}
+
+		paths = append(paths, pointerItems[index].Path)
+		pointers = append(pointers, candidate)
+		for _, piece := range candidate.GetRemote().GetRemotePieces() {
+			if !seen[piece.NodeId] {
+				seen[piece.NodeId] = true
+				nodeIDs = append(nodeIDs, piece.NodeId)
+			}
+		}
+	}
+	if len(pointers) == 0 {
+		return "", nil, nil
+	}
+
+	res, err := cursor.stats.GetBatch(ctx, &sdbproto.GetBatchRequest{NodeIds: nodeIDs})
+	if err != nil {
+		return "", nil, err
+	}
+	stats := map[czarcoin.NodeID]*pb.NodeStats{}
+	for _, nodeStats := range res.GetStatsList() {
+		stats[nodeStats.NodeId] = nodeStats
+	}
+
+	now := time.Now()
+	segmentWeights := make([]float64, len(pointers))
+	for i, candidate := range pointers {
+		segmentWeights[i] = cursor.weights.segmentWeight(candidate, stats, now)
+	}
+
+	picked, err := pickWeighted(segmentWeights)
+	if err != nil {
+		return "", nil, err
+	}
+	return paths[picked], pointers[picked], nil
 }
 
 func makeErasureScheme(rs *pb.RedundancyScheme) (eestream.ErasureScheme, error) {
@@ -59,6 +59,10 @@ func (pbd *pointerDBWrapper) Delete(ctx context.Context, in *pb.DeleteRequest, o
 	return pbd.s.Delete(ctx, in)
 }
 
+func (pbd *pointerDBWrapper) CompareAndSwap(ctx context.Context, in *pb.CompareAndSwapRequest, opts ...grpc.CallOption) (*pb.CompareAndSwapResponse, error) {
+	return pbd.s.CompareAndSwap(ctx, in)
+}
+
 func (pbd *pointerDBWrapper) PayerBandwidthAllocation(ctx context.Context, in *pb.PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*pb.PayerBandwidthAllocationResponse, error) {
 	return pbd.s.PayerBandwidthAllocation(ctx, in)
 }
@@ -129,13 +133,16 @@ func TestAuditSegment(t *testing.T) {
 	db := teststore.New()
 	c := pointerdb.Config{MaxInlineSegmentSize: 8000}
 
-	cache := overlay.NewOverlayCache(teststore.New(), nil, nil)
+	cache := overlay.NewOverlayCache(overlay.NewKeyValueDB(teststore.New()), nil, nil)
 
 	pdbw := newPointerDBWrapper(pointerdb.NewServer(db, cache, zap.NewNop(), c, identity))
 	pointers := pdbclient.New(pdbw)
 
 	// create a pdb client and instance of audit
-	cursor := NewCursor(pointers)
+	cursor, err := NewCursor(ctx, pointers, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	// put 10 paths in db
 	t.Run("putToDB", func(t *testing.T) {
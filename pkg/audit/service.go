@@ -7,20 +7,31 @@ import (
 	"context"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"go.uber.org/zap"
 
+	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/piecestore/psclient"
 	"czarcoin.org/czarcoin/pkg/pointerdb/pdbclient"
 	"czarcoin.org/czarcoin/pkg/provider"
+	"czarcoin.org/czarcoin/pkg/statdb"
 	"czarcoin.org/czarcoin/pkg/transport"
+	"czarcoin.org/czarcoin/storage"
 )
 
 // Service helps coordinate Cursor and Verifier to run the audit process continuously
 type Service struct {
-	Cursor   *Cursor
-	Verifier *Verifier
-	Reporter reporter
-	ticker   *time.Ticker
+	Cursor     *Cursor
+	Verifier   *Verifier
+	Reporter   reporter
+	ticker     *time.Ticker
+	challenges bool
+	counter    auditCounter
+
+	containment      Containment
+	maxReverifyCount int
 }
 
 // Config contains configurable values for audit service
@@ -29,6 +40,11 @@ type Config struct {
 	SatelliteAddr    string        `help:"address to contact services on the satellite"`
 	MaxRetriesStatDB int           `help:"max number of times to attempt updating a statdb batch" default:"3"`
 	Interval         time.Duration `help:"how frequently segments are audited" default:"30s"`
+	Challenges       bool          `help:"audit segments with the challenges committed at upload instead of downloading shares when they have any" default:"true"`
+	Candidates       int           `help:"number of listed segments to choose from by how rarely and how long ago their nodes were audited, 1 picks uniformly" default:"8"`
+	VettingBoost     float64       `help:"how much more likely segments on nodes which statdb didn't vet yet are to be audited" default:"10"`
+	VettingTargets   string        `help:"comma separated nodeid=audits pairs overriding the vetting of single nodes by statdb" default:""`
+	MaxReverifyCount int           `help:"number of times a node may fail to return the share of its pending audit before it fails the audit" default:"3"`
 }
 
 // Run runs the repairer with the configured values
@@ -42,8 +58,25 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 	if err != nil {
 		return err
 	}
+	targets, err := ParseVettingTargets(c.VettingTargets)
+	if err != nil {
+		return err
+	}
+	weights := Weights{
+		Candidates:     c.Candidates,
+		VettingBoost:   c.VettingBoost,
+		VettingTargets: targets,
+	}
+	db, ok := ctx.Value("masterdb").(interface {
+		Containment() Containment
+		AuditCursor() CursorDB
+	})
+	if !ok {
+		return Error.New("unable to get satellite master db instance")
+	}
 	transport := transport.NewClient(identity)
-	service, err := NewService(ctx, c.SatelliteAddr, c.Interval, c.MaxRetriesStatDB, pointers, transport, overlay, *identity, c.APIKey)
+	service, err := NewService(ctx, c.SatelliteAddr, c.Interval, c.MaxRetriesStatDB, pointers, transport, overlay, *identity, c.APIKey, c.Challenges, weights,
+		db.Containment(), db.AuditCursor(), c.MaxReverifyCount)
 	if err != nil {
 		return err
 	}
@@ -56,8 +89,16 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 
 // NewService instantiates a Service with access to a Cursor and Verifier
 func NewService(ctx context.Context, statDBPort string, interval time.Duration, maxRetries int, pointers pdbclient.Client, transport transport.Client, overlay overlay.Client,
-	identity provider.FullIdentity, apiKey string) (service *Service, err error) {
-	cursor := NewCursor(pointers)
+	identity provider.FullIdentity, apiKey string, challenges bool, weights Weights, containment Containment, cursorDB CursorDB, maxReverifyCount int) (service *Service, err error) {
+	var cursor *Cursor
+	if sdb := statdb.LoadFromContext(ctx); sdb != nil {
+		cursor, err = NewWeightedCursor(ctx, pointers, cursorDB, sdb, weights)
+	} else {
+		cursor, err = NewCursor(ctx, pointers, cursorDB)
+	}
+	if err != nil {
+		return nil, err
+	}
 	verifier := NewVerifier(transport, overlay, identity)
 	reporter, err := NewReporter(ctx, statDBPort, maxRetries, apiKey)
 	if err != nil {
@@ -65,10 +106,14 @@ func NewService(ctx context.Context, statDBPort string, interval time.Duration,
 	}
 
 	return &Service{
-		Cursor:   cursor,
-		Verifier: verifier,
-		Reporter: reporter,
-		ticker:   time.NewTicker(interval),
+		Cursor:     cursor,
+		Verifier:   verifier,
+		Reporter:   reporter,
+		ticker:     time.NewTicker(interval),
+		challenges: challenges,
+
+		containment:      containment,
+		maxReverifyCount: maxReverifyCount,
 	}, nil
 }
 
@@ -102,15 +147,185 @@ func (service *Service) process(ctx context.Context) error {
 	}
 
 	authorization := service.Cursor.pointers.SignedMessage()
-	verifiedNodes, err := service.Verifier.verify(ctx, stripe.Index, stripe.Segment, authorization)
+
+	// contained nodes are asked for their pending shares instead
+	contained, err := service.reverify(ctx, stripe.Segment, authorization)
 	if err != nil {
 		return err
 	}
+	stripe = &Stripe{
+		Index:         stripe.Index,
+		Path:          stripe.Path,
+		Segment:       withoutNodes(stripe.Segment, contained),
+		Authorization: stripe.Authorization,
+	}
+
+	if service.challenges && hasChallenges(stripe.Segment) {
+		return service.processChallenges(ctx, stripe, authorization)
+	}
 
-	err = service.Reporter.RecordAudits(ctx, verifiedNodes)
+	verifiedNodes, pending, err := service.Verifier.verify(ctx, stripe, authorization)
 	if err != nil {
 		return err
 	}
 
+	if err := service.contain(ctx, pending); err != nil {
+		return err
+	}
+
+	return service.recordAudits(ctx, verifiedNodes)
+}
+
+// contain puts the nodes of the pending audits into containment
+func (service *Service) contain(ctx context.Context, pending []*PendingAudit) error {
+	if service.containment == nil {
+		return nil
+	}
+	for _, p := range pending {
+		if err := service.containment.IncrementPending(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reverify asks the contained nodes storing pieces of segment for the shares
+// of their pending audits and reports the nodes leaving containment. It
+// returns the nodes which were contained when the audit started.
+func (service *Service) reverify(ctx context.Context, segment *pb.Pointer, authorization *pb.SignedMessage) (contained map[czarcoin.NodeID]bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.containment == nil {
+		return nil, nil
+	}
+
+	contained = make(map[czarcoin.NodeID]bool)
+	var reported []*pb.Node
+	for _, piece := range segment.GetRemote().GetRemotePieces() {
+		pending, err := service.containment.Get(ctx, piece.NodeId)
+		if err != nil {
+			if ErrContainedNotFound.Has(err) {
+				continue
+			}
+			return nil, err
+		}
+		contained[piece.NodeId] = true
+
+		// the pending share can't be requested anymore when its segment was
+		// deleted or replaced, the node is not penalized then
+		pointer, _, _, err := service.Cursor.pointers.Get(ctx, pending.Path)
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return nil, err
+		}
+		if pointer == nil || psclient.PieceID(pointer.GetRemote().GetPieceId()) != pending.PieceID ||
+			!storesPiece(pointer, pending.NodeID) {
+			if _, err := service.containment.Delete(ctx, pending.NodeID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		stillContained, passed, err := service.Verifier.reverify(ctx, pending, pointer, authorization, service.maxReverifyCount)
+		if err != nil {
+			// the audit of the other nodes goes on, the node failed to return its share
+			zap.L().Warn("failed to reverify node", zap.Stringer("node", pending.NodeID), zap.Error(err))
+			stillContained = pending.ReverifyCount+1 < int64(service.maxReverifyCount)
+		}
+
+		if stillContained {
+			if err := service.containment.IncrementPending(ctx, pending); err != nil {
+				return nil, err
+			}
+			reported = append(reported, setOfflineStatus(ctx, czarcoin.NodeIDList{pending.NodeID})...)
+			continue
+		}
+
+		if _, err := service.containment.Delete(ctx, pending.NodeID); err != nil {
+			return nil, err
+		}
+		if passed {
+			reported = append(reported, setSuccessStatus(ctx, czarcoin.NodeIDList{pending.NodeID})...)
+		} else {
+			reported = append(reported, setAuditFailStatus(ctx, czarcoin.NodeIDList{pending.NodeID})...)
+		}
+	}
+
+	if len(reported) > 0 {
+		if err := service.recordAudits(ctx, reported); err != nil {
+			return nil, err
+		}
+	}
+	return contained, nil
+}
+
+// recordAudits reports the audited nodes to statdb and counts their audits
+func (service *Service) recordAudits(ctx context.Context, verifiedNodes []*pb.Node) error {
+	if err := service.Reporter.RecordAudits(ctx, verifiedNodes); err != nil {
+		return err
+	}
+
+	service.counter.record(time.Now(), verifiedNodes)
 	return nil
 }
+
+// processChallenges audits the segment of stripe with the challenges
+// committed at upload and removes the used challenges from its pointer
+func (service *Service) processChallenges(ctx context.Context, stripe *Stripe, authorization *pb.SignedMessage) error {
+	verifiedNodes, answers, err := service.Verifier.challenge(ctx, stripe.Segment, authorization)
+	if err != nil {
+		return err
+	}
+
+	if hasAnswers(answers) {
+		if err := service.consumeChallenges(ctx, stripe, answers); err != nil {
+			return err
+		}
+	}
+
+	// nodes which didn't answer are contained like the ones which fail to
+	// return their shares, the shares they should have are rebuilt for them
+	if service.containment != nil {
+		pending, err := service.Verifier.pendingChallenges(ctx, stripe, answers, authorization)
+		if err != nil {
+			// the nodes are only reported offline when their shares can't be rebuilt
+			zap.L().Warn("failed to create pending audits", zap.Error(err))
+		}
+		if err := service.contain(ctx, pending); err != nil {
+			return err
+		}
+	}
+
+	return service.recordAudits(ctx, verifiedNodes)
+}
+
+// maxConsumeRetries is how many times the challenges are consumed again when
+// the pointer was changed meanwhile
+const maxConsumeRetries = 3
+
+// consumeChallenges removes the answered challenges from the pointer of the
+// segment of stripe, unless the segment has been replaced in the meantime
+func (service *Service) consumeChallenges(ctx context.Context, stripe *Stripe, answers map[int]answer) error {
+	pointers := service.Cursor.pointers
+
+	for retry := 0; ; retry++ {
+		pointer, _, _, err := pointers.Get(ctx, stripe.Path)
+		if err != nil {
+			return err
+		}
+		if pointer.GetRemote().GetPieceId() != stripe.Segment.GetRemote().GetPieceId() {
+			return nil
+		}
+
+		// the swap fails when a repair changed the pointer after it was read
+		updated := proto.Clone(pointer).(*pb.Pointer)
+		consumeChallenges(updated, answers)
+		err = pointers.CompareAndSwap(ctx, stripe.Path, pointer, updated)
+		if !pdbclient.ErrPointerChanged.Has(err) {
+			return err
+		}
+		if retry >= maxConsumeRetries {
+			zap.L().Warn("dropped consumed challenges of changed pointer", zap.String("path", stripe.Path))
+			return nil
+		}
+	}
+}
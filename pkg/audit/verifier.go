@@ -11,6 +11,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/vivint/infectious"
+	"go.uber.org/zap"
 	"gopkg.in/spacemonkeygo/monkit.v2"
 
 	"czarcoin.org/czarcoin/pkg/overlay"
@@ -34,6 +35,8 @@ type share struct {
 // Verifier helps verify the correctness of a given stripe
 type Verifier struct {
 	downloader downloader
+	challenger challenger
+	reverifier reverifier
 }
 
 type downloader interface {
@@ -55,7 +58,8 @@ func newDefaultDownloader(transport transport.Client, overlay overlay.Client, id
 
 // NewVerifier creates a Verifier
 func NewVerifier(transport transport.Client, overlay overlay.Client, id provider.FullIdentity) *Verifier {
-	return &Verifier{downloader: newDefaultDownloader(transport, overlay, id)}
+	d := newDefaultDownloader(transport, overlay, id)
+	return &Verifier{downloader: d, challenger: d, reverifier: d}
 }
 
 // getShare use piece store clients to download shares from a given node
@@ -207,19 +211,24 @@ func calcPadded(size int64, blockSize int) int64 {
 	return size + int64(blockSize) - mod
 }
 
-// verify downloads shares then verifies the data correctness at the given stripe
-func (verifier *Verifier) verify(ctx context.Context, stripeIndex int, pointer *pb.Pointer, authorization *pb.SignedMessage) (verifiedNodes []*sdbproto.Node, err error) {
+// verify downloads shares then verifies the data correctness at the given
+// stripe, it returns pending audits for the nodes which failed to return
+// their shares
+func (verifier *Verifier) verify(ctx context.Context, stripe *Stripe, authorization *pb.SignedMessage) (verifiedNodes []*sdbproto.Node, pending []*PendingAudit, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	shares, nodes, err := verifier.downloader.DownloadShares(ctx, pointer, stripeIndex, authorization)
+	pointer := stripe.Segment
+	shares, nodes, err := verifier.downloader.DownloadShares(ctx, pointer, stripe.Index, authorization)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var offlineNodes czarcoin.NodeIDList
+	offline := make(map[int]czarcoin.NodeID)
 	for pieceNum := range shares {
 		if shares[pieceNum].Error != nil {
 			offlineNodes = append(offlineNodes, nodes[pieceNum].Id)
+			offline[pieceNum] = nodes[pieceNum].Id
 		}
 	}
 
@@ -227,7 +236,14 @@ func (verifier *Verifier) verify(ctx context.Context, stripeIndex int, pointer *
 	total := int(pointer.Remote.Redundancy.GetTotal())
 	pieceNums, err := auditShares(ctx, required, total, shares)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	pending, err = createPendingAudits(ctx, stripe, shares, offline)
+	if err != nil {
+		// the nodes are only reported offline when their shares can't be rebuilt
+		zap.L().Warn("failed to create pending audits", zap.Error(err))
+		pending = nil
 	}
 
 	var failedNodes czarcoin.NodeIDList
@@ -238,7 +254,7 @@ func (verifier *Verifier) verify(ctx context.Context, stripeIndex int, pointer *
 	successNodes := getSuccessNodes(ctx, nodes, failedNodes, offlineNodes)
 	verifiedNodes = setVerifiedNodes(ctx, offlineNodes, failedNodes, successNodes)
 
-	return verifiedNodes, nil
+	return verifiedNodes, pending, nil
 }
 
 // getSuccessNodes uses the failed nodes and offline nodes arrays to determine which nodes passed the audit
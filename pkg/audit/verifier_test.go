@@ -44,7 +44,7 @@ func TestPassingAudit(t *testing.T) {
 		md := mockDownloader{shares: mockShares}
 		verifier := &Verifier{downloader: &md}
 		pointer := makePointer(tt.nodeAmt)
-		verifiedNodes, err := verifier.verify(ctx, 6, pointer, nil)
+		verifiedNodes, _, err := verifier.verify(ctx, &Stripe{Index: 6, Segment: pointer}, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -87,7 +87,7 @@ func TestSomeNodesPassAudit(t *testing.T) {
 		md := mockDownloader{shares: mockShares}
 		verifier := &Verifier{downloader: &md}
 		pointer := makePointer(tt.nodeAmt)
-		verifiedNodes, err := verifier.verify(ctx, 6, pointer, nil)
+		verifiedNodes, _, err := verifier.verify(ctx, &Stripe{Index: 6, Segment: pointer}, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -0,0 +1,157 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+	sdbproto "czarcoin.org/czarcoin/pkg/statdb/proto"
+)
+
+// maxStaleness caps how much the time since its last audit adds to the
+// weight of a node, nodes never audited are treated as this stale
+const maxStaleness = 30 * 24 * time.Hour
+
+// statsSource looks up the audit stats of nodes, it is implemented by statdb
+type statsSource interface {
+	GetBatch(ctx context.Context, getBatchReq *sdbproto.GetBatchRequest) (*sdbproto.GetBatchResponse, error)
+}
+
+// Weights configures how segments are weighted when choosing which one to audit
+type Weights struct {
+	// Candidates is the number of segments of a listed page to choose from
+	Candidates int
+	// VettingBoost multiplies the weight of nodes which statdb didn't vet yet
+	VettingBoost float64
+	// VettingTargets overrides the vetting of statdb for single nodes with
+	// the number of audits they need
+	VettingTargets map[czarcoin.NodeID]int64
+}
+
+// ParseVettingTargets parses a comma separated list of nodeid=audits pairs
+func ParseVettingTargets(targets string) (map[czarcoin.NodeID]int64, error) {
+	parsed := map[czarcoin.NodeID]int64{}
+	for _, target := range strings.Split(targets, ",") {
+		target = strings.TrimSpace(target)
+		if target == "" {
+			continue
+		}
+
+		parts := strings.SplitN(target, "=", 2)
+		if len(parts) != 2 {
+			return nil, Error.New("invalid vetting target %q", target)
+		}
+		id, err := czarcoin.NodeIDFromString(parts[0])
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		audits, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		parsed[id] = audits
+	}
+	return parsed, nil
+}
+
+// vetting returns whether the node is still in vetting, stats is nil for
+// nodes without an entry in statdb
+func (weights Weights) vetting(id czarcoin.NodeID, stats *pb.NodeStats) bool {
+	if audits, ok := weights.VettingTargets[id]; ok {
+		return stats.GetAuditCount() < audits
+	}
+	return !stats.GetVetted()
+}
+
+// nodeWeight favors nodes which were audited rarely or long ago, stats is
+// nil for nodes without an entry in statdb
+func (weights Weights) nodeWeight(id czarcoin.NodeID, stats *pb.NodeStats, now time.Time) float64 {
+	var audits int64
+	staleness := maxStaleness
+	if stats != nil && stats.AuditCount > 0 {
+		audits = stats.AuditCount
+		staleness = now.Sub(time.Unix(stats.AuditedUnixSec, 0))
+		if staleness < 0 {
+			staleness = 0
+		}
+		if staleness > maxStaleness {
+			staleness = maxStaleness
+		}
+	}
+
+	weight := (1 + staleness.Hours()/24) / float64(1+audits)
+	if weights.VettingBoost > 0 && weights.vetting(id, stats) {
+		weight *= weights.VettingBoost
+	}
+	return weight
+}
+
+// segmentWeight sums the weights of the nodes storing the pieces of pointer
+func (weights Weights) segmentWeight(pointer *pb.Pointer, stats map[czarcoin.NodeID]*pb.NodeStats, now time.Time) float64 {
+	var weight float64
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		weight += weights.nodeWeight(piece.NodeId, stats[piece.NodeId], now)
+	}
+	return weight
+}
+
+// sampleIndexes returns count distinct random indexes below n
+func sampleIndexes(n, count int) ([]int, error) {
+	indexes := make([]int, n)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	if count > n {
+		count = n
+	}
+
+	// partial Fisher-Yates shuffle
+	for i := 0; i < count; i++ {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(n-i)))
+		if err != nil {
+			return nil, err
+		}
+		k := i + int(j.Int64())
+		indexes[i], indexes[k] = indexes[k], indexes[i]
+	}
+	return indexes[:count], nil
+}
+
+// pickWeighted returns a random index of weights, with a probability
+// proportional to its weight
+func pickWeighted(weights []float64) (int, error) {
+	var total float64
+	for _, weight := range weights {
+		total += weight
+	}
+	if total <= 0 {
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(weights))))
+		if err != nil {
+			return -1, err
+		}
+		return int(index.Int64()), nil
+	}
+
+	// 53 bits of randomness give a uniform float64 in [0, 1)
+	random, err := rand.Int(rand.Reader, big.NewInt(1<<53))
+	if err != nil {
+		return -1, err
+	}
+	point := float64(random.Int64()) / (1 << 53) * total
+
+	for i, weight := range weights {
+		if point < weight {
+			return i, nil
+		}
+		point -= weight
+	}
+	return len(weights) - 1, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"czarcoin.org/czarcoin/internal/identity"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/auth"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/pointerdb"
+	"czarcoin.org/czarcoin/pkg/pointerdb/pdbclient"
+	sdbproto "czarcoin.org/czarcoin/pkg/statdb/proto"
+	"czarcoin.org/czarcoin/storage/teststore"
+)
+
+type mockStats struct {
+	stats []*pb.NodeStats
+}
+
+func (m *mockStats) GetBatch(ctx context.Context, getBatchReq *sdbproto.GetBatchRequest) (*sdbproto.GetBatchResponse, error) {
+	return &sdbproto.GetBatchResponse{StatsList: m.stats}, nil
+}
+
+func TestNodeWeight(t *testing.T) {
+	now := time.Now()
+	vetted := testczarcoin.NodeIDFromString("vetted")
+	weights := Weights{
+		VettingBoost:   5,
+		VettingTargets: map[czarcoin.NodeID]int64{vetted: 0},
+	}
+	id := testczarcoin.NodeIDFromString("node")
+
+	for i, tt := range []struct {
+		id     czarcoin.NodeID
+		stats  *pb.NodeStats
+		weight float64
+	}{
+		{ // never audited
+			id: id, stats: nil, weight: 31 * 5,
+		},
+		{ // audited recently, not vetted yet
+			id: id, stats: &pb.NodeStats{AuditCount: 1, AuditedUnixSec: now.Unix()}, weight: 0.5 * 5,
+		},
+		{ // audited a day ago, vetted by statdb
+			id: id, stats: &pb.NodeStats{AuditCount: 19, AuditedUnixSec: now.Add(-24 * time.Hour).Unix(), Vetted: true}, weight: 0.1,
+		},
+		{ // staleness is capped
+			id: id, stats: &pb.NodeStats{AuditCount: 30, AuditedUnixSec: now.Add(-365 * 24 * time.Hour).Unix(), Vetted: true}, weight: 1,
+		},
+		{ // vetting target of the node overrides the default
+			id: vetted, stats: &pb.NodeStats{AuditCount: 1, AuditedUnixSec: now.Unix()}, weight: 0.5,
+		},
+	} {
+		assert.InDelta(t, tt.weight, weights.nodeWeight(tt.id, tt.stats, now), 0.001, i)
+	}
+}
+
+func TestParseVettingTargets(t *testing.T) {
+	id := testczarcoin.NodeIDFromString("node")
+
+	targets, err := ParseVettingTargets("")
+	assert.NoError(t, err)
+	assert.Empty(t, targets)
+
+	targets, err = ParseVettingTargets(id.String() + "=20, ")
+	assert.NoError(t, err)
+	assert.Equal(t, map[czarcoin.NodeID]int64{id: 20}, targets)
+
+	_, err = ParseVettingTargets(id.String())
+	assert.Error(t, err)
+
+	_, err = ParseVettingTargets(id.String() + "=many")
+	assert.Error(t, err)
+}
+
+func TestPickWeighted(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		picked, err := pickWeighted([]float64{0, 1, 0})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, picked)
+	}
+
+	picked, err := pickWeighted([]float64{0, 0})
+	assert.NoError(t, err)
+	assert.True(t, picked == 0 || picked == 1)
+}
+
+func TestSampleIndexes(t *testing.T) {
+	indexes, err := sampleIndexes(10, 4)
+	assert.NoError(t, err)
+	assert.Len(t, indexes, 4)
+
+	seen := map[int]bool{}
+	for _, index := range indexes {
+		assert.False(t, seen[index])
+		assert.True(t, index >= 0 && index < 10)
+		seen[index] = true
+	}
+
+	indexes, err = sampleIndexes(3, 8)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{0, 1, 2}, indexes)
+}
+
+func TestWeightedCursor(t *testing.T) {
+	ctx := auth.WithAPIKey(context.Background(), nil)
+
+	ca, err := testidentity.NewTestCA(ctx)
+	assert.NoError(t, err)
+	identity, err := ca.NewIdentity()
+	assert.NoError(t, err)
+
+	// pointerdb gets the identity of the caller from the connection
+	info := credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{identity.Leaf, identity.CA}}}
+	ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: info})
+
+	pdbw := newPointerDBWrapper(pointerdb.NewServer(teststore.New(), overlay.NewOverlayCache(overlay.NewKeyValueDB(teststore.New()), nil, nil),
+		zap.NewNop(), pointerdb.Config{MaxInlineSegmentSize: 8000}, identity))
+	pointers := pdbclient.New(pdbw)
+
+	audited := testczarcoin.NodeIDFromString("testId")
+	fresh := testczarcoin.NodeIDFromString("fresh")
+
+	// all segments are on an audited node except one on a new node
+	for _, path := range []czarcoin.Path{"a", "b", "c", "d"} {
+		req := makePutRequest(path)
+		if path == "c" {
+			req.Pointer.Remote.RemotePieces[0].NodeId = fresh
+		}
+		_, err := pdbw.Put(ctx, &pb.PutRequest{Path: path, Pointer: req.Pointer})
+		assert.NoError(t, err)
+	}
+
+	stats := &mockStats{stats: []*pb.NodeStats{
+		{NodeId: audited, AuditCount: 1000, AuditedUnixSec: time.Now().Unix(), Vetted: true},
+	}}
+	cursor, err := NewWeightedCursor(ctx, pointers, nil, stats, Weights{Candidates: 4, VettingBoost: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		stripe, err := cursor.NextStripe(ctx)
+		assert.NoError(t, err)
+		if assert.NotNil(t, stripe) {
+			assert.Equal(t, czarcoin.Path("c"), stripe.Path)
+		}
+	}
+}
+
+func TestAuditCounter(t *testing.T) {
+	day := time.Date(2018, 11, 1, 12, 0, 0, 0, time.UTC)
+	id := testczarcoin.NodeIDFromString("node")
+	nodes := []*pb.Node{
+		{Id: id, UpdateAuditSuccess: true},
+		{Id: testczarcoin.NodeIDFromString("offline"), UpdateUptime: true},
+	}
+
+	var counter auditCounter
+	counter.record(day, nodes)
+	counter.record(day.Add(time.Hour), nodes)
+	assert.Equal(t, map[czarcoin.NodeID]int64{id: 2}, counter.counts)
+
+	counter.record(day.Add(24*time.Hour), nodes)
+	assert.Equal(t, map[czarcoin.NodeID]int64{id: 1}, counter.counts)
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for bloom filters
+var Error = errs.Class("bloom filter error")
+
+const (
+	version    = 1
+	headerSize = 3 // version, seed and hash count
+)
+
+// Filter is a bloom filter over arbitrary byte strings
+type Filter struct {
+	seed      byte
+	hashCount byte
+	table     []byte
+}
+
+// NewOptimal returns a filter sized to hold expectedElements with the given
+// probability of false positives
+func NewOptimal(expectedElements int, falsePositiveRate float64, seed byte) *Filter {
+	if expectedElements < 1 {
+		expectedElements = 1
+	}
+
+	bits := -float64(expectedElements) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)
+	hashCount := math.Ceil(bits / float64(expectedElements) * math.Ln2)
+	if hashCount < 1 {
+		hashCount = 1
+	}
+	if hashCount > math.MaxUint8 {
+		hashCount = math.MaxUint8
+	}
+
+	return &Filter{
+		seed:      seed,
+		hashCount: byte(hashCount),
+		table:     make([]byte, int(math.Ceil(bits/8))+1),
+	}
+}
+
+// NewFromBytes decodes a filter serialized with Bytes
+func NewFromBytes(data []byte) (*Filter, error) {
+	if len(data) <= headerSize {
+		return nil, Error.New("not enough data")
+	}
+	if data[0] != version {
+		return nil, Error.New("unsupported version %d", data[0])
+	}
+	if data[2] == 0 {
+		return nil, Error.New("invalid hash count")
+	}
+
+	return &Filter{
+		seed:      data[1],
+		hashCount: data[2],
+		table:     append([]byte{}, data[headerSize:]...),
+	}, nil
+}
+
+// Add adds element to the filter
+func (filter *Filter) Add(element []byte) {
+	bits := uint64(len(filter.table)) * 8
+	h1, h2 := filter.hash(element)
+	for i := uint64(0); i < uint64(filter.hashCount); i++ {
+		bit := (h1 + i*h2) % bits
+		filter.table[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Contains returns whether element may have been added to the filter, it
+// never returns false for an added element
+func (filter *Filter) Contains(element []byte) bool {
+	bits := uint64(len(filter.table)) * 8
+	h1, h2 := filter.hash(element)
+	for i := uint64(0); i < uint64(filter.hashCount); i++ {
+		bit := (h1 + i*h2) % bits
+		if filter.table[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the size of the filter table in bytes
+func (filter *Filter) Size() int {
+	return len(filter.table)
+}
+
+// Bytes serializes the filter
+func (filter *Filter) Bytes() []byte {
+	data := make([]byte, 0, headerSize+len(filter.table))
+	data = append(data, version, filter.seed, filter.hashCount)
+	return append(data, filter.table...)
+}
+
+// hash derives the two hashes used for double hashing of element
+func (filter *Filter) hash(element []byte) (h1, h2 uint64) {
+	hasher := sha256.New()
+	_, _ = hasher.Write([]byte{filter.seed})
+	_, _ = hasher.Write(element)
+	sum := hasher.Sum(nil)
+
+	// the step must not be zero, otherwise all hashes would set the same bit
+	return binary.LittleEndian.Uint64(sum[0:8]), binary.LittleEndian.Uint64(sum[8:16]) | 1
+}
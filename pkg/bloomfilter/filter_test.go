@@ -0,0 +1,67 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/pkg/bloomfilter"
+)
+
+func randomElements(t *testing.T, count int) [][]byte {
+	elements := make([][]byte, count)
+	for i := range elements {
+		elements[i] = make([]byte, 32)
+		_, err := rand.Read(elements[i])
+		assert.NoError(t, err)
+	}
+	return elements
+}
+
+func TestFilter(t *testing.T) {
+	const count = 10000
+	const falsePositiveRate = 0.05
+
+	added := randomElements(t, count)
+	filter := bloomfilter.NewOptimal(count, falsePositiveRate, 7)
+	for _, element := range added {
+		filter.Add(element)
+	}
+
+	decoded, err := bloomfilter.NewFromBytes(filter.Bytes())
+	assert.NoError(t, err)
+
+	for _, f := range []*bloomfilter.Filter{filter, decoded} {
+		for _, element := range added {
+			assert.True(t, f.Contains(element))
+		}
+
+		falsePositives := 0
+		for _, element := range randomElements(t, count) {
+			if f.Contains(element) {
+				falsePositives++
+			}
+		}
+		assert.True(t, float64(falsePositives)/count < 2*falsePositiveRate, "false positives: %d", falsePositives)
+	}
+}
+
+func TestNewFromBytes(t *testing.T) {
+	for _, data := range [][]byte{
+		nil,
+		{1, 0, 1},
+		{2, 0, 1, 0xff},
+		{1, 0, 0, 0xff},
+	} {
+		_, err := bloomfilter.NewFromBytes(data)
+		assert.Error(t, err)
+	}
+
+	filter, err := bloomfilter.NewFromBytes([]byte{1, 0, 1, 0})
+	assert.NoError(t, err)
+	assert.False(t, filter.Contains([]byte("butts")))
+}
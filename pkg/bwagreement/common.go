@@ -7,5 +7,11 @@ import (
 	"github.com/zeebo/errs"
 )
 
-// BwAgreementError the default bwagreement errs class
-var BwAgreementError = errs.Class("bwagreement error")
+var (
+	// BwAgreementError the default bwagreement errs class
+	BwAgreementError = errs.Class("bwagreement error")
+	// ErrReplayed is returned when a storage node settles a serial number twice
+	ErrReplayed = errs.Class("serial number replayed")
+	// ErrMaxSizeExceeded is returned when the renter allocations of a serial number exceed its max size
+	ErrMaxSizeExceeded = errs.Class("max size exceeded")
+)
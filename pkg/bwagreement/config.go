@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"czarcoin.org/czarcoin/pkg/accounting/projectusage"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/provider"
 )
@@ -35,7 +36,12 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 	if !ok {
 		return errs.New("unable to get satellite master db instance")
 	}
-	pb.RegisterBandwidthServer(server.GRPC(), NewServer(db.BandwidthAgreement(), zap.L(), k))
+	// the egress of projects is accounted when the master db keeps the usage of projects
+	var usage projectusage.DB
+	if usageDB, ok := ctx.Value("masterdb").(interface{ ProjectUsage() projectusage.DB }); ok {
+		usage = usageDB.ProjectUsage()
+	}
+	pb.RegisterBandwidthServer(server.GRPC(), NewServer(db.BandwidthAgreement(), usage, zap.L(), k))
 
 	return server.Run(ctx)
 }
@@ -4,6 +4,7 @@
 package bwagreement
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/ecdsa"
@@ -14,23 +15,28 @@ import (
 	"github.com/gtank/cryptopasta"
 	"go.uber.org/zap"
 
+	"czarcoin.org/czarcoin/pkg/accounting/projectusage"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/peertls"
+	"czarcoin.org/czarcoin/pkg/provider"
 )
 
 // DB interface for database operations
 type DB interface {
-	// CreateAgreement creates bandwidth agreement in database
-	CreateAgreement(context.Context, Agreement) error
+	// CreateAgreement records the serial and creates the bandwidth agreement in database,
+	// it fails with ErrReplayed or ErrMaxSizeExceeded when the serial can't be settled
+	CreateAgreement(context.Context, Serial, Agreement) error
 	// GetAgreements gets all bandwidth agreements
 	GetAgreements(context.Context) ([]Agreement, error)
-	// GetAgreementsSince gets all bandwidth agreements since specific time
+	// GetAgreementsSince gets all bandwidth agreements created at or after a specific time
 	GetAgreementsSince(context.Context, time.Time) ([]Agreement, error)
 }
 
 // Server is an implementation of the pb.BandwidthServer interface
 type Server struct {
 	db     DB
+	usage  projectusage.DB // nil when projects aren't accounted
 	pkey   crypto.PublicKey
 	logger *zap.Logger
 }
@@ -42,16 +48,28 @@ type Agreement struct {
 	CreatedAt time.Time
 }
 
+// Serial is the serial number of a payer allocation settled by a storage node
+type Serial struct {
+	SerialNumber  string
+	StorageNodeID czarcoin.NodeID
+	Total         int64 // bytes the renter allocated to the storage node
+	MaxSize       int64 // bytes the payer pays for across all storage nodes
+	Action        pb.PayerBandwidthAllocation_Action
+	ProjectID     []byte // project the payer attributed the allocation to, if any
+}
+
 // NewServer creates instance of Server
-func NewServer(db DB, logger *zap.Logger, pkey crypto.PublicKey) *Server {
+func NewServer(db DB, usage projectusage.DB, logger *zap.Logger, pkey crypto.PublicKey) *Server {
 	return &Server{
 		db:     db,
+		usage:  usage,
 		logger: logger,
 		pkey:   pkey,
 	}
 }
 
-// BandwidthAgreements receives and stores bandwidth agreements from storage nodes
+// BandwidthAgreements receives and stores bandwidth agreements from storage nodes,
+// rejected agreements are answered with the reason
 func (s *Server) BandwidthAgreements(ctx context.Context, req *pb.RenterBandwidthAllocation) (reply *pb.AgreementsSummary, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -61,61 +79,178 @@ func (s *Server) BandwidthAgreements(ctx context.Context, req *pb.RenterBandwidt
 		Status: pb.AgreementsSummary_FAIL,
 	}
 
-	if err = s.verifySignature(ctx, req); err != nil {
-		return reply, err
+	serial, reason, err := s.verifyAgreement(ctx, req)
+	if err != nil {
+		if reason == pb.AgreementsSummary_NONE {
+			return reply, err
+		}
+		s.logger.Debug("Rejected Agreement", zap.Stringer("reason", reason), zap.Error(err))
+		reply.Reason = reason
+		return reply, nil
 	}
 
-	err = s.db.CreateAgreement(ctx, Agreement{
+	err = s.db.CreateAgreement(ctx, serial, Agreement{
 		Signature: req.GetSignature(),
 		Agreement: req.GetData(),
 	})
-
-	if err != nil {
+	switch {
+	case ErrReplayed.Has(err):
+		reply.Reason = pb.AgreementsSummary_REPLAYED
+	case ErrMaxSizeExceeded.Has(err):
+		reply.Reason = pb.AgreementsSummary_MAX_SIZE_EXCEEDED
+	case err != nil:
 		return reply, err
 	}
+	if reply.Reason != pb.AgreementsSummary_NONE {
+		s.logger.Debug("Rejected Agreement", zap.Stringer("reason", reply.Reason), zap.Error(err))
+		return reply, nil
+	}
 
 	reply.Status = pb.AgreementsSummary_OK
 
 	s.logger.Debug("Stored Agreement...")
 
+	s.addEgress(ctx, serial)
+
 	return reply, nil
 }
 
-func (s *Server) verifySignature(ctx context.Context, ba *pb.RenterBandwidthAllocation) error {
-	// TODO(security): detect replay attacks
+// SettleAgreements receives and stores a batch of bandwidth agreements, the
+// summaries are in the order of the agreements
+func (s *Server) SettleAgreements(ctx context.Context, req *pb.SettleRequest) (resp *pb.SettleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
 
+	resp = &pb.SettleResponse{
+		Summaries: make([]*pb.AgreementsSummary, 0, len(req.GetAgreements())),
+	}
+	for _, agreement := range req.GetAgreements() {
+		summary, err := s.BandwidthAgreements(ctx, agreement)
+		if err != nil {
+			s.logger.Error("Failed to store agreement", zap.Error(err))
+		}
+		resp.Summaries = append(resp.Summaries, summary)
+	}
+	return resp, nil
+}
+
+// verifyAgreement verifies the signatures, expiration and serial number of the
+// agreement, the returned reason is set when the agreement is rejected
+func (s *Server) verifyAgreement(ctx context.Context, ba *pb.RenterBandwidthAllocation) (Serial, pb.AgreementsSummary_Reason, error) {
 	//Deserealize RenterBandwidthAllocation.GetData() so we can get public key
 	rbad := &pb.RenterBandwidthAllocation_Data{}
 	if err := proto.Unmarshal(ba.GetData(), rbad); err != nil {
-		return BwAgreementError.New("Failed to unmarshal RenterBandwidthAllocation: %+v", err)
+		return Serial{}, pb.AgreementsSummary_MALFORMED, BwAgreementError.New("Failed to unmarshal RenterBandwidthAllocation: %+v", err)
+	}
+
+	pbad := &pb.PayerBandwidthAllocation_Data{}
+	if err := proto.Unmarshal(rbad.GetPayerAllocation().GetData(), pbad); err != nil {
+		return Serial{}, pb.AgreementsSummary_MALFORMED, BwAgreementError.New("Failed to unmarshal PayerBandwidthAllocation: %+v", err)
 	}
 
+	if reason, err := s.verifySignature(ctx, ba, rbad, pbad); err != nil {
+		return Serial{}, reason, err
+	}
+
+	if pbad.GetExpirationUnixSec() < time.Now().Unix() {
+		return Serial{}, pb.AgreementsSummary_EXPIRED, BwAgreementError.New("PayerBandwidthAllocation expired at %d", pbad.GetExpirationUnixSec())
+	}
+	if pbad.GetSerialNumber() == "" {
+		return Serial{}, pb.AgreementsSummary_INVALID_SERIAL_NUMBER, BwAgreementError.New("PayerBandwidthAllocation has no serial number")
+	}
+
+	return Serial{
+		SerialNumber:  pbad.GetSerialNumber(),
+		StorageNodeID: rbad.StorageNodeId,
+		Total:         rbad.GetTotal(),
+		MaxSize:       pbad.GetMaxSize(),
+		Action:        pbad.GetAction(),
+		ProjectID:     pbad.GetProjectId(),
+	}, pb.AgreementsSummary_NONE, nil
+}
+
+// addEgress adds the downloaded bytes of a settled agreement to the egress of its project,
+// failures are only logged as the agreement is already stored
+func (s *Server) addEgress(ctx context.Context, serial Serial) {
+	if s.usage == nil || serial.Action != pb.PayerBandwidthAllocation_GET || len(serial.ProjectID) == 0 {
+		return
+	}
+	projectID, err := projectusage.ParseProjectID(serial.ProjectID)
+	if err != nil {
+		s.logger.Error("invalid project id of agreement", zap.Error(err))
+		return
+	}
+	err = s.usage.AddEgress(ctx, projectID, projectusage.Month(time.Now()), serial.Total)
+	if err != nil {
+		s.logger.Error("Failed to account project egress", zap.Error(err))
+	}
+}
+
+func (s *Server) verifySignature(ctx context.Context, ba *pb.RenterBandwidthAllocation, rbad *pb.RenterBandwidthAllocation_Data, pbad *pb.PayerBandwidthAllocation_Data) (pb.AgreementsSummary_Reason, error) {
 	// Extract renter's public key from RenterBandwidthAllocation_Data
 	// TODO: Look this public key up in a database
 	pubkey, err := x509.ParsePKIXPublicKey(rbad.GetPubKey())
 	if err != nil {
-		return BwAgreementError.New("Failed to extract Public Key from RenterBandwidthAllocation: %+v", err)
+		return pb.AgreementsSummary_MALFORMED, BwAgreementError.New("Failed to extract Public Key from RenterBandwidthAllocation: %+v", err)
 	}
 
 	// Typecast public key
 	k, ok := pubkey.(*ecdsa.PublicKey)
 	if !ok {
-		return peertls.ErrUnsupportedKey.New("%T", pubkey)
+		return pb.AgreementsSummary_INVALID_SIGNATURE, peertls.ErrUnsupportedKey.New("%T", pubkey)
+	}
+
+	// the renter's key must be the leaf key of the uplink the payer allocation was issued to
+	if reason, err := verifyRenter(rbad, pbad); err != nil {
+		return reason, err
 	}
 
 	// verify Renter's (uplink) signature
 	if ok := cryptopasta.Verify(ba.GetData(), ba.GetSignature(), k); !ok {
-		return BwAgreementError.New("Failed to verify Renter's Signature")
+		return pb.AgreementsSummary_INVALID_SIGNATURE, BwAgreementError.New("Failed to verify Renter's Signature")
 	}
 
 	k, ok = s.pkey.(*ecdsa.PublicKey)
 	if !ok {
-		return peertls.ErrUnsupportedKey.New("%T", s.pkey)
+		return pb.AgreementsSummary_NONE, peertls.ErrUnsupportedKey.New("%T", s.pkey)
 	}
 
-	// verify Payer's (satellite) signature
+	// verify Payer's (satellite) signature with our own key
 	if ok := cryptopasta.Verify(rbad.GetPayerAllocation().GetData(), rbad.GetPayerAllocation().GetSignature(), k); !ok {
-		return BwAgreementError.New("Failed to verify Payer's Signature")
+		return pb.AgreementsSummary_INVALID_SIGNATURE, BwAgreementError.New("Failed to verify Payer's Signature")
+	}
+	return pb.AgreementsSummary_NONE, nil
+}
+
+// verifyRenter checks that the public key of the renter is the leaf key of the
+// certificate chain it carries, and that the chain belongs to the uplink of the
+// payer allocation
+func verifyRenter(rbad *pb.RenterBandwidthAllocation_Data, pbad *pb.PayerBandwidthAllocation_Data) (pb.AgreementsSummary_Reason, error) {
+	if len(rbad.GetChain()) < 2 {
+		return pb.AgreementsSummary_MALFORMED, BwAgreementError.New("RenterBandwidthAllocation has no certificate chain")
+	}
+	chain, err := provider.ParseCertChain(rbad.GetChain()[:2])
+	if err != nil {
+		return pb.AgreementsSummary_MALFORMED, BwAgreementError.Wrap(err)
+	}
+	leaf, ca := chain[0], chain[1]
+	if err := leaf.CheckSignatureFrom(ca); err != nil {
+		return pb.AgreementsSummary_INVALID_SIGNATURE, BwAgreementError.Wrap(err)
+	}
+
+	leafKey, err := x509.MarshalPKIXPublicKey(leaf.PublicKey)
+	if err != nil {
+		return pb.AgreementsSummary_INVALID_SIGNATURE, BwAgreementError.Wrap(err)
+	}
+	if !bytes.Equal(leafKey, rbad.GetPubKey()) {
+		return pb.AgreementsSummary_INVALID_SIGNATURE, BwAgreementError.New("Renter's Public Key is not the leaf key of its certificate chain")
+	}
+
+	renter, err := provider.PeerIdentityFromCerts(leaf, ca, nil)
+	if err != nil {
+		return pb.AgreementsSummary_INVALID_SIGNATURE, BwAgreementError.Wrap(err)
+	}
+	if renter.ID != pbad.UplinkId {
+		return pb.AgreementsSummary_INVALID_SIGNATURE, BwAgreementError.New("Renter %s is not the uplink %s of the PayerBandwidthAllocation", renter.ID, pbad.UplinkId)
 	}
-	return nil
+	return pb.AgreementsSummary_NONE, nil
 }
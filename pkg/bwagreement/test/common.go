@@ -6,7 +6,9 @@ package test
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/rand"
 	"crypto/x509"
+	"encoding/hex"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -14,27 +16,43 @@ import (
 	"github.com/zeebo/errs"
 
 	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
 )
 
-//GeneratePayerBandwidthAllocation creates a signed PayerBandwidthAllocation from a PayerBandwidthAllocation_Action
-func GeneratePayerBandwidthAllocation(action pb.PayerBandwidthAllocation_Action, satelliteKey crypto.PrivateKey) (*pb.PayerBandwidthAllocation, error) {
-	satelliteKeyEcdsa, ok := satelliteKey.(*ecdsa.PrivateKey)
-	if !ok {
-		return nil, errs.New("Satellite Private Key is not a valid *ecdsa.PrivateKey")
+//GeneratePayerBandwidthAllocation creates a signed PayerBandwidthAllocation of the uplink from a PayerBandwidthAllocation_Action
+func GeneratePayerBandwidthAllocation(action pb.PayerBandwidthAllocation_Action, satelliteKey crypto.PrivateKey, uplinkID czarcoin.NodeID) (*pb.PayerBandwidthAllocation, error) {
+	serialNumber := make([]byte, 16)
+	if _, err := rand.Read(serialNumber); err != nil {
+		return nil, errs.Wrap(err)
 	}
 
-	// Generate PayerBandwidthAllocation_Data
-	data, _ := proto.Marshal(
+	return SignPayerBandwidthAllocation(
 		&pb.PayerBandwidthAllocation_Data{
 			SatelliteId:       testczarcoin.NodeIDFromString("SatelliteID"),
-			UplinkId:          testczarcoin.NodeIDFromString("UplinkID"),
+			UplinkId:          uplinkID,
+			MaxSize:           1024,
 			ExpirationUnixSec: time.Now().Add(time.Hour * 24 * 10).Unix(),
-			SerialNumber:      "SerialNumber",
+			SerialNumber:      hex.EncodeToString(serialNumber),
 			Action:            action,
 			CreatedUnixSec:    time.Now().Unix(),
 		},
+		satelliteKey,
 	)
+}
+
+//SignPayerBandwidthAllocation creates a PayerBandwidthAllocation from the data signed with the satellite key
+func SignPayerBandwidthAllocation(pbad *pb.PayerBandwidthAllocation_Data, satelliteKey crypto.PrivateKey) (*pb.PayerBandwidthAllocation, error) {
+	satelliteKeyEcdsa, ok := satelliteKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errs.New("Satellite Private Key is not a valid *ecdsa.PrivateKey")
+	}
+
+	data, err := proto.Marshal(pbad)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
 
 	// Sign the PayerBandwidthAllocation_Data with the "Satellite" Private Key
 	s, err := cryptopasta.Sign(data, satelliteKeyEcdsa)
@@ -49,10 +67,15 @@ func GeneratePayerBandwidthAllocation(action pb.PayerBandwidthAllocation_Action,
 	}, nil
 }
 
-//GenerateRenterBandwidthAllocation creates a signed RenterBandwidthAllocation from a PayerBandwidthAllocation
-func GenerateRenterBandwidthAllocation(pba *pb.PayerBandwidthAllocation, uplinkKey crypto.PrivateKey) (*pb.RenterBandwidthAllocation, error) {
+//GenerateRenterBandwidthAllocation creates a RenterBandwidthAllocation from a PayerBandwidthAllocation signed by the uplink
+func GenerateRenterBandwidthAllocation(pba *pb.PayerBandwidthAllocation, uplink *provider.FullIdentity) (*pb.RenterBandwidthAllocation, error) {
+	return GenerateRenterBandwidthAllocationFor(pba, testczarcoin.NodeIDFromString("StorageNodeID"), 666, uplink)
+}
+
+//GenerateRenterBandwidthAllocationFor creates a RenterBandwidthAllocation of total bytes for the storage node signed by the uplink
+func GenerateRenterBandwidthAllocationFor(pba *pb.PayerBandwidthAllocation, storageNodeID czarcoin.NodeID, total int64, uplink *provider.FullIdentity) (*pb.RenterBandwidthAllocation, error) {
 	// get "Uplink" Public Key
-	uplinkKeyEcdsa, ok := uplinkKey.(*ecdsa.PrivateKey)
+	uplinkKeyEcdsa, ok := uplink.Key.(*ecdsa.PrivateKey)
 	if !ok {
 		return nil, errs.New("Uplink Private Key is not a valid *ecdsa.PrivateKey")
 	}
@@ -67,8 +90,9 @@ func GenerateRenterBandwidthAllocation(pba *pb.PayerBandwidthAllocation, uplinkK
 		&pb.RenterBandwidthAllocation_Data{
 			PayerAllocation: pba,
 			PubKey:          pubbytes, // TODO: Take this out. It will be kept in a database on the satellite
-			StorageNodeId:   testczarcoin.NodeIDFromString("StorageNodeID"),
-			Total:           int64(666),
+			StorageNodeId:   storageNodeID,
+			Total:           total,
+			Chain:           [][]byte{uplink.Leaf.Raw, uplink.CA.Raw},
 		},
 	)
 
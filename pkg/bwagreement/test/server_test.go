@@ -7,16 +7,26 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"flag"
+	"fmt"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/gtank/cryptopasta"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
 	"czarcoin.org/czarcoin/internal/identity"
 	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/accounting/projectusage"
 	"czarcoin.org/czarcoin/pkg/bwagreement"
 	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
 	"czarcoin.org/czarcoin/satellite/satellitedb"
 )
 
@@ -30,17 +40,141 @@ var (
 )
 
 func TestBandwidthAgreements(t *testing.T) {
-	testBandwidthAgreements := func(ctx context.Context, t *testing.T, service *bwagreement.Server, satelliteKey *ecdsa.PrivateKey, uplinkKey *ecdsa.PrivateKey) {
-		pba, err := GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_GET, satelliteKey)
+	testBandwidthAgreements := func(ctx context.Context, t *testing.T, service *bwagreement.Server, satelliteKey *ecdsa.PrivateKey, uplink *provider.FullIdentity) {
+		pba, err := GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_GET, satelliteKey, uplink.ID)
 		assert.NoError(t, err)
 
-		rba, err := GenerateRenterBandwidthAllocation(pba, uplinkKey)
+		rba, err := GenerateRenterBandwidthAllocation(pba, uplink)
 		assert.NoError(t, err)
 
 		/* emulate sending the bwagreement stream from piecestore node */
 		replay, err := service.BandwidthAgreements(ctx, rba)
 		assert.NoError(t, err)
 		assert.Equal(t, pb.AgreementsSummary_OK, replay.Status)
+
+		reject := func(rba *pb.RenterBandwidthAllocation, reason pb.AgreementsSummary_Reason) {
+			reply, err := service.BandwidthAgreements(ctx, rba)
+			if assert.NoError(t, err) {
+				assert.Equal(t, pb.AgreementsSummary_FAIL, reply.Status)
+				assert.Equal(t, reason, reply.Reason)
+			}
+		}
+
+		// the same agreement can't be settled twice
+		reject(rba, pb.AgreementsSummary_REPLAYED)
+
+		// nor a new agreement of the same serial number and storage node
+		rba, err = GenerateRenterBandwidthAllocation(pba, uplink)
+		assert.NoError(t, err)
+		reject(rba, pb.AgreementsSummary_REPLAYED)
+
+		// other storage nodes share the max size of the serial number
+		rba, err = GenerateRenterBandwidthAllocationFor(pba, testczarcoin.NodeIDFromString("other"), 300, uplink)
+		assert.NoError(t, err)
+		replay, err = service.BandwidthAgreements(ctx, rba)
+		assert.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, replay.Status)
+
+		rba, err = GenerateRenterBandwidthAllocationFor(pba, testczarcoin.NodeIDFromString("greedy"), 100, uplink)
+		assert.NoError(t, err)
+		reject(rba, pb.AgreementsSummary_MAX_SIZE_EXCEEDED)
+
+		// the rejected node can settle a new serial number
+		pba, err = GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_GET, satelliteKey, uplink.ID)
+		assert.NoError(t, err)
+		rba, err = GenerateRenterBandwidthAllocationFor(pba, testczarcoin.NodeIDFromString("greedy"), 100, uplink)
+		assert.NoError(t, err)
+		replay, err = service.BandwidthAgreements(ctx, rba)
+		assert.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, replay.Status)
+
+		// concurrent settlements of a serial number don't exceed its max size
+		pba, err = GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_GET, satelliteKey, uplink.ID)
+		assert.NoError(t, err)
+		var settled int64
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			rba, err := GenerateRenterBandwidthAllocationFor(pba, testczarcoin.NodeIDFromString(fmt.Sprint("concurrent", i)), 300, uplink)
+			assert.NoError(t, err)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				reply, err := service.BandwidthAgreements(ctx, rba)
+				if err == nil && reply.Status == pb.AgreementsSummary_OK {
+					atomic.AddInt64(&settled, 300)
+				}
+			}()
+		}
+		wg.Wait()
+		assert.True(t, settled > 0)
+		assert.True(t, settled <= 1024)
+
+		// agreements are only settled with the uplink the payer allocation was issued to
+		other, err := testidentity.NewTestIdentity()
+		assert.NoError(t, err)
+		pba, err = GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_GET, satelliteKey, uplink.ID)
+		assert.NoError(t, err)
+		rba, err = GenerateRenterBandwidthAllocation(pba, other)
+		assert.NoError(t, err)
+		reject(rba, pb.AgreementsSummary_INVALID_SIGNATURE)
+
+		// and with the certificate chain of the key which signed them
+		rbad := &pb.RenterBandwidthAllocation_Data{}
+		assert.NoError(t, proto.Unmarshal(rba.GetData(), rbad))
+		rbad.Chain = [][]byte{uplink.Leaf.Raw, uplink.CA.Raw}
+		rba.Data, err = proto.Marshal(rbad)
+		assert.NoError(t, err)
+		rba.Signature, err = cryptopasta.Sign(rba.Data, other.Key.(*ecdsa.PrivateKey))
+		assert.NoError(t, err)
+		reject(rba, pb.AgreementsSummary_INVALID_SIGNATURE)
+
+		rbad.Chain = nil
+		rba.Data, err = proto.Marshal(rbad)
+		assert.NoError(t, err)
+		reject(rba, pb.AgreementsSummary_MALFORMED)
+
+		for _, tt := range []struct {
+			data   pb.PayerBandwidthAllocation_Data
+			key    *ecdsa.PrivateKey
+			reason pb.AgreementsSummary_Reason
+		}{
+			{
+				data:   pb.PayerBandwidthAllocation_Data{UplinkId: uplink.ID, SerialNumber: "expired", MaxSize: 1024, ExpirationUnixSec: time.Now().Add(-time.Hour).Unix()},
+				key:    satelliteKey,
+				reason: pb.AgreementsSummary_EXPIRED,
+			},
+			{
+				data:   pb.PayerBandwidthAllocation_Data{UplinkId: uplink.ID, MaxSize: 1024, ExpirationUnixSec: time.Now().Add(time.Hour).Unix()},
+				key:    satelliteKey,
+				reason: pb.AgreementsSummary_INVALID_SERIAL_NUMBER,
+			},
+			{
+				data:   pb.PayerBandwidthAllocation_Data{UplinkId: uplink.ID, SerialNumber: "forged", MaxSize: 1024, ExpirationUnixSec: time.Now().Add(time.Hour).Unix()},
+				key:    uplink.Key.(*ecdsa.PrivateKey),
+				reason: pb.AgreementsSummary_INVALID_SIGNATURE,
+			},
+		} {
+			pba, err := SignPayerBandwidthAllocation(&tt.data, tt.key)
+			assert.NoError(t, err)
+			rba, err := GenerateRenterBandwidthAllocation(pba, uplink)
+			assert.NoError(t, err)
+			reject(rba, tt.reason)
+		}
+
+		reject(&pb.RenterBandwidthAllocation{Data: []byte("garbage")}, pb.AgreementsSummary_MALFORMED)
+
+		// batches are settled with a summary per agreement
+		pba, err = GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_PUT, satelliteKey, uplink.ID)
+		assert.NoError(t, err)
+		rba, err = GenerateRenterBandwidthAllocation(pba, uplink)
+		assert.NoError(t, err)
+		resp, err := service.SettleAgreements(ctx, &pb.SettleRequest{
+			Agreements: []*pb.RenterBandwidthAllocation{rba, rba},
+		})
+		if assert.NoError(t, err) && assert.Len(t, resp.Summaries, 2) {
+			assert.Equal(t, pb.AgreementsSummary_OK, resp.Summaries[0].Status)
+			assert.Equal(t, pb.AgreementsSummary_REPLAYED, resp.Summaries[1].Reason)
+		}
 	}
 
 	t.Run("Sqlite", func(t *testing.T) {
@@ -59,10 +193,10 @@ func TestBandwidthAgreements(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		satellitePubKey, satellitePrivKey, uplinkPrivKey := generateKeys(ctx, t)
-		server := bwagreement.NewServer(db.BandwidthAgreement(), zap.NewNop(), satellitePubKey)
+		satellitePubKey, satellitePrivKey, uplink := generateKeys(ctx, t)
+		server := bwagreement.NewServer(db.BandwidthAgreement(), nil, zap.NewNop(), satellitePubKey)
 
-		testBandwidthAgreements(ctx, t, server, satellitePrivKey, uplinkPrivKey)
+		testBandwidthAgreements(ctx, t, server, satellitePrivKey, uplink)
 	})
 
 	t.Run("Postgres", func(t *testing.T) {
@@ -84,14 +218,14 @@ func TestBandwidthAgreements(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		satellitePubKey, satellitePrivKey, uplinkPrivKey := generateKeys(ctx, t)
-		server := bwagreement.NewServer(db.BandwidthAgreement(), zap.NewNop(), satellitePubKey)
+		satellitePubKey, satellitePrivKey, uplink := generateKeys(ctx, t)
+		server := bwagreement.NewServer(db.BandwidthAgreement(), nil, zap.NewNop(), satellitePubKey)
 
-		testBandwidthAgreements(ctx, t, server, satellitePrivKey, uplinkPrivKey)
+		testBandwidthAgreements(ctx, t, server, satellitePrivKey, uplink)
 	})
 }
 
-func generateKeys(ctx context.Context, t *testing.T) (satellitePubKey *ecdsa.PublicKey, satellitePrivKey *ecdsa.PrivateKey, uplinkPrivKey *ecdsa.PrivateKey) {
+func generateKeys(ctx context.Context, t *testing.T) (satellitePubKey *ecdsa.PublicKey, satellitePrivKey *ecdsa.PrivateKey, uplink *provider.FullIdentity) {
 	fiS, err := testidentity.NewTestIdentity()
 	assert.NoError(t, err)
 
@@ -101,10 +235,63 @@ func generateKeys(ctx context.Context, t *testing.T) (satellitePubKey *ecdsa.Pub
 	satellitePrivKey, ok = fiS.Key.(*ecdsa.PrivateKey)
 	assert.True(t, ok)
 
-	fiU, err := testidentity.NewTestIdentity()
+	uplink, err = testidentity.NewTestIdentity()
 	assert.NoError(t, err)
-
-	uplinkPrivKey, ok = fiU.Key.(*ecdsa.PrivateKey)
-	assert.True(t, ok)
 	return
 }
+
+func TestProjectEgress(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := satellitedb.NewDB("sqlite3://file:projectegress?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(db.Close)
+
+	err = db.CreateTables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	satellitePubKey, satellitePrivKey, uplink := generateKeys(ctx, t)
+	server := bwagreement.NewServer(db.BandwidthAgreement(), db.ProjectUsage(), zap.NewNop(), satellitePubKey)
+
+	projectID, err := uuid.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	settle := func(action pb.PayerBandwidthAllocation_Action) {
+		pbad := &pb.PayerBandwidthAllocation_Data{
+			SatelliteId:       testczarcoin.NodeIDFromString("SatelliteID"),
+			UplinkId:          uplink.ID,
+			MaxSize:           1024,
+			ExpirationUnixSec: time.Now().Add(time.Hour).Unix(),
+			SerialNumber:      action.String(),
+			Action:            action,
+			CreatedUnixSec:    time.Now().Unix(),
+			ProjectId:         projectID[:],
+		}
+		pba, err := SignPayerBandwidthAllocation(pbad, satellitePrivKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rba, err := GenerateRenterBandwidthAllocationFor(pba, testczarcoin.NodeIDFromString("StorageNodeID"), 100, uplink)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reply, err := server.BandwidthAgreements(ctx, rba)
+		assert.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+	}
+
+	// only downloads count towards the egress of the project
+	settle(pb.PayerBandwidthAllocation_GET)
+	settle(pb.PayerBandwidthAllocation_PUT)
+
+	egress, err := db.ProjectUsage().GetEgress(ctx, *projectID, projectusage.Month(time.Now()))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 100, egress)
+}
@@ -29,7 +29,7 @@ type Checker interface {
 type checker struct {
 	statdb      *statdb.StatDB
 	pointerdb   *pointerdb.Server
-	repairQueue *queue.Queue
+	repairQueue queue.RepairQueue
 	overlay     pb.OverlayServer
 	irrdb       *irreparabledb.Database
 	limit       int
@@ -38,7 +38,7 @@ type checker struct {
 }
 
 // newChecker creates a new instance of checker
-func newChecker(pointerdb *pointerdb.Server, sdb *statdb.StatDB, repairQueue *queue.Queue, overlay pb.OverlayServer, irrdb *irreparabledb.Database, limit int, logger *zap.Logger, interval time.Duration) *checker {
+func newChecker(pointerdb *pointerdb.Server, sdb *statdb.StatDB, repairQueue queue.RepairQueue, overlay pb.OverlayServer, irrdb *irreparabledb.Database, limit int, logger *zap.Logger, interval time.Duration) *checker {
 	return &checker{
 		statdb:      sdb,
 		pointerdb:   pointerdb,
@@ -81,63 +81,9 @@ func (c *checker) identifyInjuredSegments(ctx context.Context) (err error) {
 				lim = storage.LookupLimit
 			}
 			for ; lim > 0 && it.Next(&item); lim-- {
-				pointer := &pb.Pointer{}
-				err = proto.Unmarshal(item.Value, pointer)
+				_, _, err := c.checkSegment(ctx, item.Key, item.Value)
 				if err != nil {
-					return Error.New("error unmarshalling pointer %s", err)
-				}
-				remote := pointer.GetRemote()
-				if remote == nil {
-					c.logger.Debug("no remote segment on pointer")
-					continue
-				}
-				pieces := remote.GetRemotePieces()
-				if pieces == nil {
-					c.logger.Debug("no pieces on remote segment")
-					continue
-				}
-				var nodeIDs czarcoin.NodeIDList
-				for _, p := range pieces {
-					nodeIDs = append(nodeIDs, p.NodeId)
-				}
-
-				// Find all offline nodes
-				offlineNodes, err := c.offlineNodes(ctx, nodeIDs)
-				if err != nil {
-					return Error.New("error getting offline nodes %s", err)
-				}
-
-				invalidNodes, err := c.invalidNodes(ctx, nodeIDs)
-				if err != nil {
-					return Error.New("error getting invalid nodes %s", err)
-				}
-
-				missingPieces := combineOfflineWithInvalid(offlineNodes, invalidNodes)
-
-				numHealthy := len(nodeIDs) - len(missingPieces)
-				if (int32(numHealthy) >= pointer.Remote.Redundancy.MinReq) && (int32(numHealthy) < pointer.Remote.Redundancy.RepairThreshold) {
-					err = c.repairQueue.Enqueue(&pb.InjuredSegment{
-						Path:       string(item.Key),
-						LostPieces: missingPieces,
-					})
-					if err != nil {
-						return Error.New("error adding injured segment to queue %s", err)
-					}
-				} else if int32(numHealthy) < pointer.Remote.Redundancy.MinReq {
-					// make an entry in to the irreparable table
-					segmentInfo := &irreparabledb.RemoteSegmentInfo{
-						EncryptedSegmentPath:   item.Key,
-						EncryptedSegmentDetail: item.Value,
-						LostPiecesCount:        int64(len(missingPieces)),
-						RepairUnixSec:          time.Now().Unix(),
-						RepairAttemptCount:     int64(1),
-					}
-
-					//add the entry if new or update attempt count if already exists
-					err := c.irrdb.IncrementRepairAttempts(ctx, segmentInfo)
-					if err != nil {
-						return Error.New("error handling irreparable segment to queue %s", err)
-					}
+					return err
 				}
 			}
 			return nil
@@ -146,6 +92,75 @@ func (c *checker) identifyInjuredSegments(ctx context.Context) (err error) {
 	return err
 }
 
+// checkSegment queues the segment for repair or records it as irreparable,
+// depending on how many of its pieces are healthy
+func (c *checker) checkSegment(ctx context.Context, path storage.Key, value storage.Value) (numHealthy int, status pb.RetryIrreparableResponse_Status, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer := &pb.Pointer{}
+	err = proto.Unmarshal(value, pointer)
+	if err != nil {
+		return 0, status, Error.New("error unmarshalling pointer %s", err)
+	}
+	remote := pointer.GetRemote()
+	if remote == nil {
+		c.logger.Debug("no remote segment on pointer")
+		return 0, pb.RetryIrreparableResponse_HEALTHY, nil
+	}
+	pieces := remote.GetRemotePieces()
+	if pieces == nil {
+		c.logger.Debug("no pieces on remote segment")
+		return 0, pb.RetryIrreparableResponse_HEALTHY, nil
+	}
+	var nodeIDs czarcoin.NodeIDList
+	for _, p := range pieces {
+		nodeIDs = append(nodeIDs, p.NodeId)
+	}
+
+	// Find all offline nodes
+	offlineNodes, err := c.offlineNodes(ctx, nodeIDs)
+	if err != nil {
+		return 0, status, Error.New("error getting offline nodes %s", err)
+	}
+
+	invalidNodes, err := c.invalidNodes(ctx, nodeIDs)
+	if err != nil {
+		return 0, status, Error.New("error getting invalid nodes %s", err)
+	}
+
+	missingPieces := combineOfflineWithInvalid(offlineNodes, invalidNodes)
+
+	numHealthy = len(nodeIDs) - len(missingPieces)
+	if (int32(numHealthy) >= pointer.Remote.Redundancy.MinReq) && (int32(numHealthy) < pointer.Remote.Redundancy.RepairThreshold) {
+		err = c.repairQueue.Enqueue(&pb.InjuredSegment{
+			Path:       string(path),
+			LostPieces: missingPieces,
+			NumHealthy: int32(numHealthy),
+		})
+		if err != nil {
+			return numHealthy, status, Error.New("error adding injured segment to queue %s", err)
+		}
+		return numHealthy, pb.RetryIrreparableResponse_QUEUED, nil
+	} else if int32(numHealthy) < pointer.Remote.Redundancy.MinReq {
+		// make an entry in to the irreparable table
+		segmentInfo := &irreparabledb.RemoteSegmentInfo{
+			EncryptedSegmentPath:   path,
+			EncryptedSegmentDetail: value,
+			LostPiecesCount:        int64(len(missingPieces)),
+			RepairUnixSec:          time.Now().Unix(),
+			RepairAttemptCount:     int64(1),
+		}
+
+		//add the entry if new or update attempt count if already exists
+		err := c.irrdb.IncrementRepairAttempts(ctx, segmentInfo)
+		if err != nil {
+			return numHealthy, status, Error.New("error handling irreparable segment to queue %s", err)
+		}
+		return numHealthy, pb.RetryIrreparableResponse_IRREPARABLE, nil
+	}
+	return numHealthy, pb.RetryIrreparableResponse_HEALTHY, nil
+}
+
 // returns the indices of offline nodes
 func (c *checker) offlineNodes(ctx context.Context, nodeIDs czarcoin.NodeIDList) (offline []int32, err error) {
 	responses, err := c.overlay.BulkLookup(ctx, pb.NodeIDsToLookupRequests(nodeIDs))
@@ -163,12 +178,13 @@ func (c *checker) offlineNodes(ctx context.Context, nodeIDs czarcoin.NodeIDList)
 
 // Find invalidNodes by checking the audit results that are place in statdb
 func (c *checker) invalidNodes(ctx context.Context, nodeIDs czarcoin.NodeIDList) (invalidNodes []int32, err error) {
-	// filter if nodeIDs have invalid pieces from auditing results
+	// pieces on disqualified nodes or nodes scoring below the disqualification
+	// thresholds are considered missing
 	findInvalidNodesReq := &statpb.FindInvalidNodesRequest{
 		NodeIds: nodeIDs,
 		MaxStats: &pb.NodeStats{
-			AuditSuccessRatio: 0, // TODO: update when we have stats added to statdb
-			UptimeRatio:       0, // TODO: update when we have stats added to statdb
+			AuditReputation:  c.statdb.Reputation.AuditDisqualification,
+			UptimeReputation: c.statdb.Reputation.UptimeDisqualification,
 		},
 	}
 
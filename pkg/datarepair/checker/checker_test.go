@@ -88,6 +88,7 @@ func TestIdentifyInjuredSegments(t *testing.T) {
 			seg := &pb.InjuredSegment{
 				Path:       p.Remote.PieceId,
 				LostPieces: pieces[selection:],
+				NumHealthy: int32(selection),
 			}
 			segs = append(segs, seg)
 		}
@@ -231,6 +232,7 @@ func BenchmarkIdentifyInjuredSegments(b *testing.B) {
 			seg := &pb.InjuredSegment{
 				Path:       p.Remote.PieceId,
 				LostPieces: pieces[selection:],
+				NumHealthy: int32(selection),
 			}
 			segs = append(segs, seg)
 		}
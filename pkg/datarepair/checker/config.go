@@ -12,6 +12,7 @@ import (
 	"czarcoin.org/czarcoin/pkg/datarepair/irreparabledb"
 	"czarcoin.org/czarcoin/pkg/datarepair/queue"
 	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/pointerdb"
 	"czarcoin.org/czarcoin/pkg/provider"
 	"czarcoin.org/czarcoin/pkg/statdb"
@@ -21,12 +22,13 @@ import (
 // Config contains configurable values for checker
 type Config struct {
 	QueueAddress     string        `help:"data checker queue address" default:"redis://127.0.0.1:6378?db=1&password=abc123"`
+	DurableQueue     bool          `help:"queue injured segments in the satellite database instead of at the queue address" default:"false"`
 	Interval         time.Duration `help:"how frequently checker should audit segments" default:"30s"`
 	IrreparabledbURL string        `help:"the database connection string to use" default:"sqlite3://$CONFDIR/irreparabledb.db"`
 }
 
 // Initialize a Checker struct
-func (c Config) initialize(ctx context.Context) (Checker, error) {
+func (c Config) initialize(ctx context.Context) (*checker, error) {
 	pdb := pointerdb.LoadFromContext(ctx)
 	if pdb == nil {
 		return nil, Error.New("failed to load pointerdb from context")
@@ -42,11 +44,22 @@ func (c Config) initialize(ctx context.Context) (Checker, error) {
 		return nil, err
 	}
 	o := overlay.LoadServerFromContext(ctx)
-	redisQ, err := redis.NewQueueFrom(c.QueueAddress)
-	if err != nil {
-		return nil, Error.Wrap(err)
+
+	var repairQueue queue.RepairQueue
+	if c.DurableQueue {
+		db, ok := ctx.Value("masterdb").(interface{ RepairQueueDB() queue.DB })
+		if !ok {
+			return nil, Error.New("unable to get satellite master db instance")
+		}
+		// the checker only enqueues, segments are never leased by it
+		repairQueue = queue.NewDBQueue(db.RepairQueueDB(), 0)
+	} else {
+		redisQ, err := redis.NewQueueFrom(c.QueueAddress)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		repairQueue = queue.NewQueue(redisQ)
 	}
-	repairQueue := queue.NewQueue(redisQ)
 	return newChecker(pdb, sdb, repairQueue, o, irrdb, 0, zap.L(), c.Interval), nil
 }
 
@@ -56,6 +69,8 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 	if err != nil {
 		return err
 	}
+	pb.RegisterIrreparableServer(server.GRPC(), &irreparableServer{checker: check})
+
 	ctx, cancel := context.WithCancel(ctx)
 
 	go func() {
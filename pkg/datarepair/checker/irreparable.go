@@ -0,0 +1,122 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"czarcoin.org/czarcoin/pkg/datarepair/irreparabledb"
+	irrdbx "czarcoin.org/czarcoin/pkg/datarepair/irreparabledb/dbx"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/storage"
+)
+
+// defaultIrreparableLimit is the page size used when a list request has none
+const defaultIrreparableLimit = 50
+
+// irreparableServer implements the Irreparable RPC service
+type irreparableServer struct {
+	checker *checker
+}
+
+// ListIrreparable returns a page of irreparable segments
+func (srv *irreparableServer) ListIrreparable(ctx context.Context, req *pb.ListIrreparableRequest) (resp *pb.ListIrreparableResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = defaultIrreparableLimit
+	}
+
+	infos, err := srv.checker.irrdb.List(ctx, limit, req.GetOffset())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp = &pb.ListIrreparableResponse{}
+	for _, info := range infos {
+		segment, err := convertSegmentInfo(info)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		resp.Segments = append(resp.Segments, segment)
+	}
+	return resp, nil
+}
+
+// GetIrreparable returns the details of a single irreparable segment
+func (srv *irreparableServer) GetIrreparable(ctx context.Context, req *pb.GetIrreparableRequest) (resp *pb.GetIrreparableResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := srv.checker.irrdb.Get(ctx, []byte(req.GetPath()))
+	if err != nil {
+		if dbxErr, ok := errs.Unwrap(err).(*irrdbx.Error); ok && dbxErr.Code == irrdbx.ErrorCode_NoRows {
+			return nil, status.Errorf(codes.NotFound, "segment %q is not irreparable", req.GetPath())
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	segment, err := convertSegmentInfo(info)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &pb.GetIrreparableResponse{Segment: segment}, nil
+}
+
+// RetryIrreparable checks the pieces of an irreparable segment again, it is
+// removed from the irreparable segments unless it is still irreparable
+func (srv *irreparableServer) RetryIrreparable(ctx context.Context, req *pb.RetryIrreparableRequest) (resp *pb.RetryIrreparableResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := storage.Key(req.GetPath())
+	value, err := srv.checker.pointerdb.DB.Get(path)
+	if err != nil {
+		if !storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		if err := srv.checker.irrdb.Delete(ctx, path); err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		return &pb.RetryIrreparableResponse{Status: pb.RetryIrreparableResponse_DELETED}, nil
+	}
+
+	numHealthy, segmentStatus, err := srv.checker.checkSegment(ctx, path, value)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	if segmentStatus != pb.RetryIrreparableResponse_IRREPARABLE {
+		if err := srv.checker.irrdb.Delete(ctx, path); err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+	}
+
+	srv.checker.logger.Info("Retried irreparable segment",
+		zap.String("path", req.GetPath()),
+		zap.Stringer("status", segmentStatus),
+		zap.Int("healthy", numHealthy),
+	)
+	return &pb.RetryIrreparableResponse{Status: segmentStatus, HealthyPieces: int32(numHealthy)}, nil
+}
+
+// convertSegmentInfo converts an irreparabledb entry to its protobuf message
+func convertSegmentInfo(info *irreparabledb.RemoteSegmentInfo) (*pb.IrreparableSegment, error) {
+	pointer := &pb.Pointer{}
+	if err := proto.Unmarshal(info.EncryptedSegmentDetail, pointer); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &pb.IrreparableSegment{
+		Path:                     string(info.EncryptedSegmentPath),
+		SegmentDetail:            pointer,
+		LostPieces:               info.LostPiecesCount,
+		LastRepairAttemptUnixSec: info.RepairUnixSec,
+		RepairAttemptCount:       info.RepairAttemptCount,
+	}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/auth"
+	"czarcoin.org/czarcoin/pkg/datarepair/irreparabledb"
+	"czarcoin.org/czarcoin/pkg/datarepair/queue"
+	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/overlay/mocks"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/pointerdb"
+	"czarcoin.org/czarcoin/pkg/statdb"
+	"czarcoin.org/czarcoin/storage/testqueue"
+	"czarcoin.org/czarcoin/storage/teststore"
+)
+
+func TestIrreparableServer(t *testing.T) {
+	logger := zap.NewNop()
+	pointers := pointerdb.NewServer(teststore.New(), &overlay.Cache{}, logger, pointerdb.Config{}, nil)
+
+	sdb, err := statdb.NewStatDB("sqlite3", fmt.Sprintf("file:memdb%d?mode=memory&cache=shared", rand.Int63()), logger)
+	assert.NoError(t, err)
+
+	irrdb, err := irreparabledb.New(fmt.Sprintf("sqlite3://file:irrdb%d?mode=memory&cache=shared", rand.Int63()))
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, irrdb.Close()) }()
+
+	ids := testczarcoin.NodeIDsFromStrings("a", "b", "c", "d")
+	var nodes []*pb.Node
+	var pieces []*pb.RemotePiece
+	for i, id := range ids {
+		nodes = append(nodes, &pb.Node{Id: id, Type: pb.NodeType_STORAGE, Address: &pb.NodeAddress{}})
+		pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: id})
+	}
+
+	path := "irreparable/segment"
+	_, err = pointers.Put(auth.WithAPIKey(ctx, nil), &pb.PutRequest{Path: path, Pointer: &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			Redundancy:   &pb.RedundancyScheme{MinReq: 2, RepairThreshold: 3, Total: 4},
+			RemotePieces: pieces,
+		},
+	}})
+	assert.NoError(t, err)
+
+	// only one of the nodes is online
+	checker := newChecker(pointers, sdb, queue.NewQueue(testqueue.New()), mocks.NewOverlay(nodes[:1]), irrdb, 0, logger, time.Second)
+	assert.NoError(t, checker.identifyInjuredSegments(ctx))
+
+	srv := &irreparableServer{checker: checker}
+
+	list, err := srv.ListIrreparable(ctx, &pb.ListIrreparableRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, list.Segments, 1) {
+		assert.Equal(t, path, list.Segments[0].Path)
+		assert.EqualValues(t, 3, list.Segments[0].LostPieces)
+		assert.Len(t, list.Segments[0].SegmentDetail.Remote.RemotePieces, 4)
+	}
+
+	retried, err := srv.RetryIrreparable(ctx, &pb.RetryIrreparableRequest{Path: path})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.RetryIrreparableResponse_IRREPARABLE, retried.Status)
+	assert.EqualValues(t, 1, retried.HealthyPieces)
+
+	got, err := srv.GetIrreparable(ctx, &pb.GetIrreparableRequest{Path: path})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, got.Segment.RepairAttemptCount)
+
+	// the nodes came back online
+	checker.overlay = mocks.NewOverlay(nodes)
+	retried, err = srv.RetryIrreparable(ctx, &pb.RetryIrreparableRequest{Path: path})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.RetryIrreparableResponse_HEALTHY, retried.Status)
+	assert.EqualValues(t, 4, retried.HealthyPieces)
+
+	_, err = srv.GetIrreparable(ctx, &pb.GetIrreparableRequest{Path: path})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	retried, err = srv.RetryIrreparable(ctx, &pb.RetryIrreparableRequest{Path: "deleted"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.RetryIrreparableResponse_DELETED, retried.Status)
+}
@@ -108,6 +108,24 @@ func (db *Database) Get(ctx context.Context, segmentPath []byte) (resp *RemoteSe
 	}, nil
 }
 
+// List returns a page of irreparable segments ordered by their path
+func (db *Database) List(ctx context.Context, limit int, offset int64) (resp []*RemoteSegmentInfo, err error) {
+	rows, err := db.db.Limited_Irreparabledb_OrderBy_Asc_Segmentpath(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	for _, dbxInfo := range rows {
+		resp = append(resp, &RemoteSegmentInfo{
+			EncryptedSegmentPath:   dbxInfo.Segmentpath,
+			EncryptedSegmentDetail: dbxInfo.Segmentdetail,
+			LostPiecesCount:        dbxInfo.PiecesLostCount,
+			RepairUnixSec:          dbxInfo.SegDamagedUnixSec,
+			RepairAttemptCount:     dbxInfo.RepairAttemptCount,
+		})
+	}
+	return resp, nil
+}
+
 // Delete a irreparable's segment info from the db
 func (db *Database) Delete(ctx context.Context, segmentPath []byte) (err error) {
 	_, err = db.db.Delete_Irreparabledb_By_Segmentpath(ctx, dbx.Irreparabledb_Segmentpath(segmentPath))
@@ -82,6 +82,28 @@ func testDatabase(ctx context.Context, t *testing.T, irrdb *irreparabledb.Databa
 		assert.Equal(t, segmentInfo, dbxInfo)
 	}
 
+	{ //List the entries a page at a time
+		other := *segmentInfo
+		other.EncryptedSegmentPath = []byte("IamAnotherSegmentkeyinfo")
+		err := irrdb.IncrementRepairAttempts(ctx, &other)
+		assert.NoError(t, err)
+
+		page, err := irrdb.List(ctx, 1, 0)
+		assert.NoError(t, err)
+		if assert.Len(t, page, 1) {
+			assert.Equal(t, other.EncryptedSegmentPath, page[0].EncryptedSegmentPath)
+		}
+
+		page, err = irrdb.List(ctx, 10, 1)
+		assert.NoError(t, err)
+		if assert.Len(t, page, 1) {
+			assert.Equal(t, segmentInfo, page[0])
+		}
+
+		err = irrdb.Delete(ctx, other.EncryptedSegmentPath)
+		assert.NoError(t, err)
+	}
+
 	{ //Delete existing entry
 		err := irrdb.Delete(ctx, segmentInfo.EncryptedSegmentPath)
 		assert.NoError(t, err)
@@ -0,0 +1,67 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queue
+
+import (
+	"context"
+	"time"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/storage"
+)
+
+// DB stores injured segments keyed by their path, the segments with the
+// fewest healthy pieces are repaired first
+type DB interface {
+	// Enqueue adds an injured segment or updates the entry of its path
+	Enqueue(ctx context.Context, seg *pb.InjuredSegment) error
+	// Claim leases the most urgent injured segment which isn't leased yet,
+	// it returns storage.ErrEmptyQueue when there is none
+	Claim(ctx context.Context, lease time.Duration) (*pb.InjuredSegment, error)
+	// Delete removes the injured segment of path
+	Delete(ctx context.Context, path string) error
+	// Peek returns up to limit injured segments, the most urgent first
+	Peek(ctx context.Context, limit int) ([]pb.InjuredSegment, error)
+}
+
+// DBQueue implements the RepairQueue interface on top of a DB, dequeued
+// segments stay in the DB until they are completed and are handed out again
+// when their lease expires
+type DBQueue struct {
+	db    DB
+	lease time.Duration
+}
+
+// NewDBQueue returns a repair queue which leases segments of db for lease
+func NewDBQueue(db DB, lease time.Duration) *DBQueue {
+	return &DBQueue{db: db, lease: lease}
+}
+
+// Enqueue adds a repair segment to the queue, segments already queued are
+// updated instead of added again
+func (q *DBQueue) Enqueue(qi *pb.InjuredSegment) error {
+	return q.db.Enqueue(context.TODO(), qi)
+}
+
+// Dequeue leases the repair segment with the fewest healthy pieces
+func (q *DBQueue) Dequeue() (pb.InjuredSegment, error) {
+	seg, err := q.db.Claim(context.TODO(), q.lease)
+	if err != nil {
+		return pb.InjuredSegment{}, err
+	}
+	return *seg, nil
+}
+
+// Peekqueue returns upto 'limit' of the entries from the repair queue
+func (q *DBQueue) Peekqueue(limit int) ([]pb.InjuredSegment, error) {
+	if limit < 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+	return q.db.Peek(context.TODO(), limit)
+}
+
+// Complete removes a repaired segment from the queue
+func (q *DBQueue) Complete(path string) error {
+	return q.db.Delete(context.TODO(), path)
+}
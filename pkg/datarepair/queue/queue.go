@@ -16,6 +16,7 @@ type RepairQueue interface {
 	Enqueue(qi *pb.InjuredSegment) error
 	Dequeue() (pb.InjuredSegment, error)
 	Peekqueue(limit int) ([]pb.InjuredSegment, error)
+	Complete(path string) error
 }
 
 // Queue implements the RepairQueue interface
@@ -79,3 +80,8 @@ func (q *Queue) Peekqueue(limit int) ([]pb.InjuredSegment, error) {
 	}
 	return segs, nil
 }
+
+// Complete does nothing, segments are removed from the queue when they are dequeued
+func (q *Queue) Complete(path string) error {
+	return nil
+}
@@ -24,6 +24,8 @@ import (
 // Config contains configurable values for repairer
 type Config struct {
 	QueueAddress string        `help:"data repair queue address" default:"redis://127.0.0.1:6378?db=1&password=abc123"`
+	DurableQueue bool          `help:"repair injured segments queued in the satellite database instead of at the queue address" default:"false"`
+	Lease        time.Duration `help:"how long a segment is hidden from other repairers after it is dequeued from the durable queue" default:"1h"`
 	MaxRepair    int           `help:"maximum segments that can be repaired concurrently" default:"100"`
 	Interval     time.Duration `help:"how frequently checker should audit segments" default:"3600s"`
 	miniogw.ClientConfig
@@ -32,19 +34,33 @@ type Config struct {
 
 // Run runs the repairer with configured values
 func (c Config) Run(ctx context.Context, server *provider.Provider) (err error) {
-	redisQ, err := redis.NewQueueFrom(c.QueueAddress)
-	if err != nil {
-		return Error.Wrap(err)
+	var repairQueue queue.RepairQueue
+	if c.DurableQueue {
+		db, ok := ctx.Value("masterdb").(interface{ RepairQueueDB() queue.DB })
+		if !ok {
+			return Error.New("unable to get satellite master db instance")
+		}
+		repairQueue = queue.NewDBQueue(db.RepairQueueDB(), c.Lease)
+	} else {
+		redisQ, err := redis.NewQueueFrom(c.QueueAddress)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		repairQueue = queue.NewQueue(redisQ)
 	}
 
-	queue := queue.NewQueue(redisQ)
-
 	ss, err := c.getSegmentStore(ctx, server.Identity())
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	repairer := newRepairer(queue, ss, c.Interval, c.MaxRepair)
+	// the history is only kept when the repairer runs with a satellite database
+	var history History = noHistory{}
+	if db, ok := ctx.Value("masterdb").(interface{ RepairHistory() History }); ok {
+		history = db.RepairHistory()
+	}
+
+	repairer := newRepairer(repairQueue, ss, history, c.Interval, c.MaxRepair)
 
 	ctx, cancel := context.WithCancel(ctx)
 
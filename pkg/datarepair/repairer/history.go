@@ -0,0 +1,43 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"time"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+)
+
+// Record is the outcome of a single repair
+type Record struct {
+	Path          czarcoin.Path
+	HealthyPieces int64
+	RebuiltPieces int64
+	NewNodes      czarcoin.NodeIDList
+	// Failure is the reason the repair failed, empty if it succeeded
+	Failure    string
+	RepairedAt time.Time
+}
+
+// History stores the outcome of every repair
+type History interface {
+	// Add records the outcome of a repair
+	Add(ctx context.Context, record *Record) error
+	// List returns up to limit records of path, or of all paths if path is
+	// empty, the newest first
+	List(ctx context.Context, path czarcoin.Path, limit, offset int) ([]*Record, error)
+}
+
+// noHistory is the History of repairers without a satellite database, it
+// drops the records
+type noHistory struct{}
+
+// Add drops the record
+func (noHistory) Add(ctx context.Context, record *Record) error { return nil }
+
+// List returns no records
+func (noHistory) List(ctx context.Context, path czarcoin.Path, limit, offset int) ([]*Record, error) {
+	return nil, nil
+}
@@ -27,14 +27,16 @@ type Repairer interface {
 type repairer struct {
 	queue   queue.RepairQueue
 	store   segment.Store
+	history History
 	limiter *sync2.Limiter
 	ticker  *time.Ticker
 }
 
-func newRepairer(queue queue.RepairQueue, ss segment.Store, interval time.Duration, concurrency int) *repairer {
+func newRepairer(queue queue.RepairQueue, ss segment.Store, history History, interval time.Duration, concurrency int) *repairer {
 	return &repairer{
 		queue:   queue,
 		store:   ss,
+		history: history,
 		limiter: sync2.NewLimiter(concurrency),
 		ticker:  time.NewTicker(interval),
 	}
@@ -72,11 +74,44 @@ func (r *repairer) process(ctx context.Context) error {
 	}
 
 	r.limiter.Go(ctx, func() {
-		err := r.store.Repair(ctx, seg.GetPath(), seg.GetLostPieces())
+		report, err := r.store.Repair(ctx, seg.GetPath(), seg.GetLostPieces())
+		r.record(ctx, seg.GetPath(), report, err)
 		if err != nil {
-			zap.L().Error("Repair failed", zap.Error(err))
+			zap.L().Error("Repair failed", zap.String("path", seg.GetPath()), zap.Error(err))
+			return
+		}
+		if err := r.queue.Complete(seg.GetPath()); err != nil {
+			zap.L().Error("Completing repair failed", zap.Error(err))
 		}
 	})
 
 	return nil
 }
+
+// record adds the outcome of a repair to the repair history
+func (r *repairer) record(ctx context.Context, path string, report segment.RepairReport, repairErr error) {
+	record := &Record{
+		Path:          path,
+		HealthyPieces: int64(report.HealthyPieces),
+		RebuiltPieces: int64(report.RebuiltPieces),
+		NewNodes:      report.NewNodes,
+		RepairedAt:    time.Now(),
+	}
+	if repairErr != nil {
+		record.Failure = repairErr.Error()
+		mon.Meter("repair_failed").Mark(1)
+	} else {
+		mon.Meter("repair_success").Mark(1)
+		zap.L().Info("Repaired segment",
+			zap.String("path", path),
+			zap.Int("healthy", report.HealthyPieces),
+			zap.Int("rebuilt", report.RebuiltPieces),
+			zap.Any("new nodes", report.NewNodes),
+		)
+	}
+	mon.IntVal("repair_rebuilt_pieces").Observe(int64(report.RebuiltPieces))
+
+	if err := r.history.Add(ctx, record); err != nil {
+		zap.L().Error("Recording repair failed", zap.Error(err))
+	}
+}
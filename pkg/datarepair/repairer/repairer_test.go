@@ -2,3 +2,76 @@
 // See LICENSE for copying information.
 
 package repairer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/datarepair/queue"
+	"czarcoin.org/czarcoin/pkg/pb"
+	segment "czarcoin.org/czarcoin/pkg/storage/segments"
+	"czarcoin.org/czarcoin/storage/testqueue"
+)
+
+type mockHistory struct {
+	mu      sync.Mutex
+	records []*Record
+}
+
+func (m *mockHistory) Add(ctx context.Context, record *Record) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records = append(m.records, record)
+	return nil
+}
+
+func (m *mockHistory) List(ctx context.Context, path czarcoin.Path, limit, offset int) ([]*Record, error) {
+	return m.records, nil
+}
+
+func TestProcess(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newNode := testczarcoin.NodeIDFromString("new")
+	store := segment.NewMockStore(ctrl)
+	store.EXPECT().Repair(gomock.Any(), "a", []int32{1}).Return(segment.RepairReport{
+		HealthyPieces: 3,
+		RebuiltPieces: 1,
+		NewNodes:      czarcoin.NodeIDList{newNode},
+	}, nil)
+	store.EXPECT().Repair(gomock.Any(), "b", []int32{2}).Return(segment.RepairReport{
+		HealthyPieces: 3,
+	}, errors.New("not enough nodes"))
+
+	repairQueue := queue.NewQueue(testqueue.New())
+	assert.NoError(t, repairQueue.Enqueue(&pb.InjuredSegment{Path: "a", LostPieces: []int32{1}}))
+	assert.NoError(t, repairQueue.Enqueue(&pb.InjuredSegment{Path: "b", LostPieces: []int32{2}}))
+
+	history := &mockHistory{}
+	repairer := newRepairer(repairQueue, store, history, time.Hour, 1)
+	assert.NoError(t, repairer.process(ctx))
+	assert.NoError(t, repairer.process(ctx))
+	// the queue is empty
+	assert.NoError(t, repairer.process(ctx))
+	repairer.limiter.Wait()
+
+	if assert.Len(t, history.records, 2) {
+		assert.Equal(t, "a", history.records[0].Path)
+		assert.EqualValues(t, 1, history.records[0].RebuiltPieces)
+		assert.Equal(t, czarcoin.NodeIDList{newNode}, history.records[0].NewNodes)
+		assert.Empty(t, history.records[0].Failure)
+
+		assert.Equal(t, "b", history.records[1].Path)
+		assert.Equal(t, "not enough nodes", history.records[1].Failure)
+	}
+}
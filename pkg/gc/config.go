@@ -0,0 +1,51 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pointerdb"
+	"czarcoin.org/czarcoin/pkg/provider"
+	"czarcoin.org/czarcoin/pkg/transport"
+)
+
+// Config contains configurable values for garbage collection
+type Config struct {
+	Interval          time.Duration `help:"how frequently retain filters are sent to storage nodes" default:"24h"`
+	GracePeriod       time.Duration `help:"how long before the start of a collection pieces are kept regardless of the filter" default:"1h"`
+	MaxFilterMemory   int           `help:"the maximum size in bytes of the filters built in one pass over pointerdb" default:"0x8000000"`
+	FalsePositiveRate float64       `help:"the false positive rate of the filters, higher rates create smaller filters" default:"0.1"`
+}
+
+// Run runs the garbage collection service with configured values
+func (c Config) Run(ctx context.Context, server *provider.Provider) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pdb := pointerdb.LoadFromContext(ctx)
+	if pdb == nil {
+		return Error.New("failed to load pointerdb from context")
+	}
+
+	cache := overlay.LoadFromContext(ctx)
+	if cache == nil {
+		return Error.New("failed to load overlay cache from context")
+	}
+
+	service := NewService(zap.L(), c, pdb, cache, transport.NewClient(server.Identity()), server.Identity())
+
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		if err := service.Run(ctx); err != nil {
+			defer cancel()
+			zap.L().Error("Error running garbage collection", zap.Error(err))
+		}
+	}()
+
+	return server.Run(ctx)
+}
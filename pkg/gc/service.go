@@ -0,0 +1,246 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/pkg/auth"
+	"czarcoin.org/czarcoin/pkg/bloomfilter"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/piecestore"
+	"czarcoin.org/czarcoin/pkg/piecestore/psclient"
+	"czarcoin.org/czarcoin/pkg/pointerdb"
+	"czarcoin.org/czarcoin/pkg/provider"
+	"czarcoin.org/czarcoin/pkg/transport"
+	"czarcoin.org/czarcoin/storage"
+)
+
+// Service periodically sends every storage node a signed filter of the
+// pieces the satellite still references, so that the node can trash the rest
+type Service struct {
+	log       *zap.Logger
+	config    Config
+	pointerdb *pointerdb.Server
+	overlay   *overlay.Cache
+	transport transport.Client
+	identity  *provider.FullIdentity
+}
+
+// NewService creates a new garbage collection service
+func NewService(log *zap.Logger, config Config, pointerdb *pointerdb.Server, overlay *overlay.Cache, transport transport.Client, identity *provider.FullIdentity) *Service {
+	return &Service{
+		log:       log,
+		config:    config,
+		pointerdb: pointerdb,
+		overlay:   overlay,
+		transport: transport,
+		identity:  identity,
+	}
+}
+
+// Run the garbage collection loop
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ticker := time.NewTicker(service.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := service.Collect(ctx); err != nil {
+			service.log.Error("garbage collection failed", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Collect builds the retain filters from pointerdb and sends them to the
+// nodes, in batches so that the filters in memory stay below MaxFilterMemory
+func (service *Service) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// pieces uploaded while pointerdb is walked may not be in the filters yet
+	created := time.Now().Add(-service.config.GracePeriod)
+
+	counts, err := service.CountPieces(ctx)
+	if err != nil {
+		return err
+	}
+
+	// nodes without any pieces get an empty filter, so that they trash all of them
+	err = overlay.IterateNodes(ctx, service.overlay.DB, func(nodes []*pb.Node) error {
+		for _, node := range nodes {
+			if _, ok := counts[node.Id]; !ok && node.Type == pb.NodeType_STORAGE {
+				counts[node.Id] = 0
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	nodeIDs := make(czarcoin.NodeIDList, 0, len(counts))
+	for nodeID := range counts {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Sort(nodeIDs)
+
+	seed := byte(rand.Intn(256))
+	for len(nodeIDs) > 0 {
+		// a batch holds at least one filter, however large it is
+		filters := map[czarcoin.NodeID]*bloomfilter.Filter{}
+		var size int
+		for len(nodeIDs) > 0 && (len(filters) == 0 || size < service.config.MaxFilterMemory) {
+			filter := bloomfilter.NewOptimal(counts[nodeIDs[0]], service.config.FalsePositiveRate, seed)
+			filters[nodeIDs[0]] = filter
+			size += filter.Size()
+			nodeIDs = nodeIDs[1:]
+		}
+
+		if err := service.BuildFilters(ctx, filters); err != nil {
+			return err
+		}
+
+		for nodeID, filter := range filters {
+			if err := service.Send(ctx, nodeID, created, filter); err != nil {
+				service.log.Warn("failed to send retain filter", zap.String("node", nodeID.String()), zap.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// CountPieces walks pointerdb and returns the number of pieces stored by
+// every node holding remote pieces
+func (service *Service) CountPieces(ctx context.Context) (_ map[czarcoin.NodeID]int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	counts := map[czarcoin.NodeID]int{}
+	err = service.iteratePieces(ctx, func(pieceID psclient.PieceID, piece *pb.RemotePiece) error {
+		counts[piece.NodeId]++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// BuildFilters walks pointerdb and adds the ids stored by the nodes of
+// filters to their filter
+func (service *Service) BuildFilters(ctx context.Context, filters map[czarcoin.NodeID]*bloomfilter.Filter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// nodes keep the pieces of a satellite under ids namespaced by its id
+	namespace := service.identity.ID.Bytes()
+
+	return service.iteratePieces(ctx, func(pieceID psclient.PieceID, piece *pb.RemotePiece) error {
+		filter, ok := filters[piece.NodeId]
+		if !ok {
+			return nil
+		}
+
+		derived, err := pieceID.Derive(piece.NodeId.Bytes())
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		id, err := pstore.NamespacedID([]byte(derived), namespace)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		filter.Add([]byte(id))
+		return nil
+	})
+}
+
+// iteratePieces calls fn for every remote piece referenced by pointerdb
+func (service *Service) iteratePieces(ctx context.Context, fn func(psclient.PieceID, *pb.RemotePiece) error) error {
+	return service.pointerdb.Iterate(ctx, &pb.IterateRequest{Recurse: true},
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.New("error unmarshalling pointer %s", err)
+				}
+
+				remote := pointer.GetRemote()
+				if remote == nil {
+					continue
+				}
+
+				pieceID := psclient.PieceID(remote.GetPieceId())
+				for _, piece := range remote.GetRemotePieces() {
+					if err := fn(pieceID, piece); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// Send signs filter and sends it to the node, which trashes the pieces of
+// this satellite stored before created that are not in the filter
+func (service *Service) Send(ctx context.Context, nodeID czarcoin.NodeID, created time.Time, filter *bloomfilter.Filter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	data, err := proto.Marshal(&pb.RetainRequest_Data{
+		SatelliteId:    service.identity.ID,
+		CreatedUnixSec: created.Unix(),
+		Filter:         filter.Bytes(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	signature, err := auth.GenerateSignature(data, service.identity)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := service.transport.DialNode(ctx, node)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if closeErr := conn.Close(); closeErr != nil {
+			service.log.Warn("failed to close connection", zap.Error(closeErr))
+		}
+	}()
+
+	summary, err := pb.NewPieceStoreRoutesClient(conn).Retain(ctx, &pb.RetainRequest{
+		Signature: signature,
+		Data:      data,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Debug("sent retain filter", zap.String("node", nodeID.String()), zap.Int64("trashed", summary.GetTrashed()))
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/internal/identity"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/auth"
+	"czarcoin.org/czarcoin/pkg/bloomfilter"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/piecestore"
+	"czarcoin.org/czarcoin/pkg/piecestore/psclient"
+	"czarcoin.org/czarcoin/pkg/pointerdb"
+	"czarcoin.org/czarcoin/storage/teststore"
+)
+
+var ctx = context.Background()
+
+func TestBuildFilters(t *testing.T) {
+	logger := zap.NewNop()
+	identity, err := testidentity.NewTestIdentity()
+	assert.NoError(t, err)
+
+	pdb := pointerdb.NewServer(teststore.New(), &overlay.Cache{}, logger, pointerdb.Config{}, nil)
+	ids := testczarcoin.NodeIDsFromStrings("a", "b", "c")
+
+	// every segment is stored on the first two nodes
+	const segments = 10
+	var pointers []*pb.Pointer
+	for i := 0; i < segments; i++ {
+		pointer := &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				PieceId: psclient.NewPieceID().String(),
+				RemotePieces: []*pb.RemotePiece{
+					{PieceNum: 0, NodeId: ids[0]},
+					{PieceNum: 1, NodeId: ids[1]},
+				},
+			},
+		}
+		_, err := pdb.Put(auth.WithAPIKey(ctx, nil), &pb.PutRequest{Path: strconv.Itoa(i), Pointer: pointer})
+		assert.NoError(t, err)
+		pointers = append(pointers, pointer)
+	}
+
+	service := NewService(logger, Config{FalsePositiveRate: 0.01}, pdb, nil, nil, identity)
+	counts, err := service.CountPieces(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[czarcoin.NodeID]int{ids[0]: segments, ids[1]: segments}, counts)
+
+	// only the filters of the batch are built
+	filters := map[czarcoin.NodeID]*bloomfilter.Filter{
+		ids[0]: bloomfilter.NewOptimal(counts[ids[0]], 0.01, 0),
+		ids[1]: bloomfilter.NewOptimal(counts[ids[1]], 0.01, 0),
+	}
+	assert.NoError(t, service.BuildFilters(ctx, filters))
+	assert.Len(t, filters, 2)
+
+	for _, pointer := range pointers {
+		remote := pointer.GetRemote()
+		for _, piece := range remote.GetRemotePieces() {
+			// the filter contains the id the node stores the piece under
+			derived, err := psclient.PieceID(remote.GetPieceId()).Derive(piece.NodeId.Bytes())
+			assert.NoError(t, err)
+			id, err := pstore.NamespacedID([]byte(derived), identity.ID.Bytes())
+			assert.NoError(t, err)
+
+			assert.True(t, filters[piece.NodeId].Contains([]byte(id)))
+		}
+	}
+}
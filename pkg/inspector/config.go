@@ -10,11 +10,14 @@ import (
 	"go.uber.org/zap"
 	"gopkg.in/spacemonkeygo/monkit.v2"
 
+	"czarcoin.org/czarcoin/pkg/accounting"
+	"czarcoin.org/czarcoin/pkg/datarepair/repairer"
 	"czarcoin.org/czarcoin/pkg/kademlia"
 	"czarcoin.org/czarcoin/pkg/overlay"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/provider"
 	"czarcoin.org/czarcoin/pkg/statdb"
+	"czarcoin.org/czarcoin/pkg/utils"
 )
 
 var (
@@ -25,7 +28,8 @@ var (
 
 // Config is passed to CaptPlanet for bootup and configuration
 type Config struct {
-	Enabled bool `help:"enable or disable the inspector" default:"true"`
+	Enabled               bool   `help:"enable or disable the inspector" default:"true"`
+	AccountingDatabaseURL string `help:"the accounting database connection string to read bandwidth rollups from" default:"sqlite3://$CONFDIR/stats.db"`
 }
 
 // Run starts up the server and loads configs
@@ -61,6 +65,17 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 		metrics:  monkit.Default,
 	}
 
+	// the repair history is only available on satellites
+	if db, ok := ctx.Value("masterdb").(interface{ RepairHistory() repairer.History }); ok {
+		srv.repairs = db.RepairHistory()
+
+		srv.rollups, err = accounting.NewDb(c.AccountingDatabaseURL)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		defer func() { err = utils.CombineErrors(err, srv.rollups.Close()) }()
+	}
+
 	pb.RegisterInspectorServer(server.GRPC(), srv)
 
 	return server.Run(ctx)
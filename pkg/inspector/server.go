@@ -5,11 +5,16 @@ package inspector
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"czarcoin.org/czarcoin/pkg/accounting"
+	dbx "czarcoin.org/czarcoin/pkg/accounting/dbx"
+	"czarcoin.org/czarcoin/pkg/datarepair/repairer"
 	"czarcoin.org/czarcoin/pkg/dht"
 	"czarcoin.org/czarcoin/pkg/node"
 	"czarcoin.org/czarcoin/pkg/overlay"
@@ -30,6 +35,8 @@ type Server struct {
 	dht      dht.DHT
 	cache    *overlay.Cache
 	statdb   *statdb.StatDB
+	repairs  repairer.History
+	rollups  *dbx.DB
 	logger   *zap.Logger
 	metrics  *monkit.Registry
 	identity *provider.FullIdentity
@@ -41,7 +48,7 @@ type Server struct {
 
 // CountNodes returns the number of nodes in the cache and in kademlia
 func (srv *Server) CountNodes(ctx context.Context, req *pb.CountNodesRequest) (*pb.CountNodesResponse, error) {
-	overlayKeys, err := srv.cache.DB.List(nil, 0)
+	overlayCount, err := srv.cache.DB.Count(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +59,7 @@ func (srv *Server) CountNodes(ctx context.Context, req *pb.CountNodesRequest) (*
 
 	return &pb.CountNodesResponse{
 		Kademlia: int64(len(kadNodes)),
-		Overlay:  int64(len(overlayKeys)),
+		Overlay:  int64(overlayCount),
 	}, nil
 }
 
@@ -142,6 +149,47 @@ func (srv *Server) LookupNode(ctx context.Context, req *pb.LookupNodeRequest) (*
 	}, nil
 }
 
+// VersionDistribution returns how many cached nodes of each type run each version
+func (srv *Server) VersionDistribution(ctx context.Context, req *pb.VersionDistributionRequest) (*pb.VersionDistributionResponse, error) {
+	type key struct {
+		nodeType pb.NodeType
+		version  string
+	}
+	counts := map[key]*pb.VersionCount{}
+	err := overlay.IterateNodes(ctx, srv.cache.DB, func(nodes []*pb.Node) error {
+		for _, node := range nodes {
+			k := key{node.GetType(), node.GetMetadata().GetVersion()}
+			count, ok := counts[k]
+			if !ok {
+				count = &pb.VersionCount{
+					Type:    k.nodeType,
+					Version: k.version,
+					Allowed: srv.cache.MinVersions.Allowed(node),
+				}
+				counts[k] = count
+			}
+			count.Count++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	resp := &pb.VersionDistributionResponse{}
+	for _, count := range counts {
+		resp.Versions = append(resp.Versions, count)
+	}
+	sort.Slice(resp.Versions, func(i, j int) bool {
+		a, b := resp.Versions[i], resp.Versions[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Version < b.Version
+	})
+	return resp, nil
+}
+
 // ---------------------
 // StatDB commands:
 // ---------------------
@@ -186,3 +234,60 @@ func (srv *Server) CreateStats(ctx context.Context, req *pb.CreateStatsRequest)
 
 	return &pb.CreateStatsResponse{}, nil
 }
+
+// --------------------
+// Repair commands:
+// --------------------
+
+// ListRepairs returns the repair history of a segment or of all segments
+func (srv *Server) ListRepairs(ctx context.Context, req *pb.ListRepairsRequest) (*pb.ListRepairsResponse, error) {
+	if srv.repairs == nil {
+		return nil, ServerError.New("repair history is not available")
+	}
+
+	records, err := srv.repairs.List(ctx, req.Path, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListRepairsResponse{}
+	for _, record := range records {
+		resp.Repairs = append(resp.Repairs, &pb.RepairRecord{
+			Path:            record.Path,
+			HealthyPieces:   record.HealthyPieces,
+			RebuiltPieces:   record.RebuiltPieces,
+			NewNodes:        record.NewNodes,
+			Failure:         record.Failure,
+			RepairedUnixSec: record.RepairedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// --------------------
+// Accounting commands:
+// --------------------
+
+// ListBandwidthRollups returns the hourly bandwidth rollups starting within the period
+func (srv *Server) ListBandwidthRollups(ctx context.Context, req *pb.ListBandwidthRollupsRequest) (*pb.ListBandwidthRollupsResponse, error) {
+	if srv.rollups == nil {
+		return nil, ServerError.New("bandwidth rollups are not available")
+	}
+
+	rollups, err := accounting.GetBandwidthRollups(ctx, srv.rollups, time.Unix(req.StartUnixSec, 0), time.Unix(req.EndUnixSec, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListBandwidthRollupsResponse{}
+	for _, rollup := range rollups {
+		resp.Rollups = append(resp.Rollups, &pb.BandwidthRollup{
+			StorageNodeId:        rollup.StorageNodeID,
+			UplinkId:             rollup.UplinkID,
+			Action:               rollup.Action,
+			IntervalStartUnixSec: rollup.IntervalStart.Unix(),
+			Total:                rollup.Total,
+		})
+	}
+	return resp, nil
+}
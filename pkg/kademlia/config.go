@@ -6,6 +6,7 @@ package kademlia
 import (
 	"context"
 	"flag"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -15,6 +16,7 @@ import (
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/provider"
 	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/pkg/version"
 )
 
 var (
@@ -48,10 +50,12 @@ type FarmerConfig struct {
 // Config defines all of the things that are needed to start up Kademlia
 // server endpoints (and not necessarily client code).
 type Config struct {
-	BootstrapAddr   string `help:"the kademlia node to bootstrap against" default:"bootstrap-dev.czarcoin.org:8080"`
-	DBPath          string `help:"the path for our db services to be created on" default:"$CONFDIR/kademlia"`
-	Alpha           int    `help:"alpha is a system wide concurrency parameter." default:"5"`
-	ExternalAddress string `help:"the public address of the kademlia node; defaults to the gRPC server address." default:""`
+	BootstrapAddr   string        `help:"the kademlia node to bootstrap against" default:"bootstrap-dev.czarcoin.org:8080"`
+	DBPath          string        `help:"the path for our db services to be created on" default:"$CONFDIR/kademlia"`
+	Alpha           int           `help:"alpha is a system wide concurrency parameter." default:"5"`
+	ExternalAddress string        `help:"the public address of the kademlia node; defaults to the gRPC server address." default:""`
+	DiscoverAddress bool          `help:"without an external address, ask the bootstrap node for the address it sees this node from and use it once the node is reachable there" default:"true"`
+	RefreshInterval time.Duration `help:"how often buckets which weren't updated within the interval are refreshed" default:"1h"`
 	Farmer          FarmerConfig
 }
 
@@ -68,8 +72,9 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (
 	}
 
 	metadata := &pb.NodeMetadata{
-		Email:  c.Farmer.Email,
-		Wallet: c.Farmer.Wallet,
+		Email:   c.Farmer.Email,
+		Wallet:  c.Farmer.Wallet,
+		Version: version.Version,
 	}
 
 	nodeType := pb.NodeType_STORAGE
@@ -85,17 +90,36 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (
 	}
 	defer func() { err = utils.CombineErrors(err, kad.Disconnect()) }()
 
-	pb.RegisterNodesServer(server.GRPC(), node.NewServer(kad))
+	pb.RegisterNodesServer(server.GRPC(), node.NewServer(kad, server.Identity()))
 
 	go func() {
-		if err = kad.Bootstrap(ctx); err != nil {
+		if c.ExternalAddress == "" && c.DiscoverAddress {
+			c.discoverAddress(ctx, kad, []pb.Node{*in})
+		}
+		if err := kad.Bootstrap(ctx); err != nil {
 			zap.L().Error("Failed to bootstrap Kademlia", zap.String("ID", server.Identity().ID.String()))
 		}
+		kad.RunRefresh(ctx, c.RefreshInterval)
 	}()
 
 	return server.Run(context.WithValue(ctx, ctxKeyKad, kad))
 }
 
+// discoverAddress advertises the address peers can reach the node on, or logs
+// why they can't
+func (c Config) discoverAddress(ctx context.Context, kad *Kademlia, peers []pb.Node) {
+	address, err := kad.DiscoverAddress(ctx, peers, nil)
+	if err != nil {
+		zap.L().Error("Node is not reachable from the network, forward its port or set an external address", zap.Error(err))
+		return
+	}
+	if err := kad.SetAddress(address); err != nil {
+		zap.L().Error("Failed to set the external address", zap.String("address", address), zap.Error(err))
+		return
+	}
+	zap.L().Info("Using discovered external address", zap.String("address", address))
+}
+
 // LoadFromContext loads an existing Kademlia from the Provider context
 // stack if one exists.
 func LoadFromContext(ctx context.Context) *Kademlia {
@@ -75,13 +75,12 @@ func NewKademlia(id czarcoin.NodeID, nodeType pb.NodeType, bootstrapNodes []pb.N
 	bucketIdentifier := id.String()[:5] // need a way to differentiate between nodes if running more than one simultaneously
 	dbpath := filepath.Join(path, fmt.Sprintf("kademlia_%s.db", bucketIdentifier))
 
-	dbs, err := boltdb.NewShared(dbpath, KademliaBucket, NodeBucket)
+	db, err := boltdb.New(dbpath, RoutingTableBucket)
 	if err != nil {
 		return nil, BootstrapErr.Wrap(err)
 	}
-	kdb, ndb := dbs[0], dbs[1]
 
-	rt, err := NewRoutingTable(self, kdb, ndb)
+	rt, err := NewRoutingTable(self, db)
 	if err != nil {
 		return nil, BootstrapErr.Wrap(err)
 	}
@@ -259,7 +258,7 @@ func (k *Kademlia) ListenAndServe() error {
 	}
 
 	grpcServer := grpc.NewServer(identOpt)
-	mn := node.NewServer(k)
+	mn := node.NewServer(k, k.identity)
 
 	pb.RegisterNodesServer(grpcServer, mn)
 	lis, err := net.Listen("tcp", k.address)
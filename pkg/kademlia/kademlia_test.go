@@ -166,7 +166,7 @@ func testNode(t *testing.T, bn []pb.Node) (*Kademlia, *grpc.Server, func()) {
 
 	k, err := NewKademlia(fid.ID, pb.NodeType_STORAGE, bn, lis.Addr().String(), nil, fid, dir, defaultAlpha)
 	assert.NoError(t, err)
-	s := node.NewServer(k)
+	s := node.NewServer(k, k.identity)
 	// new ident opts
 	identOpt, err := fid.ServerOption()
 	assert.NoError(t, err)
@@ -459,3 +459,7 @@ func (mn *mockNodesServer) Ping(ctx context.Context, req *pb.PingRequest) (*pb.P
 	atomic.AddInt32(&mn.pingCalled, 1)
 	return &pb.PingResponse{}, nil
 }
+
+func (mn *mockNodesServer) ExternalAddress(ctx context.Context, req *pb.ExternalAddressRequest) (*pb.ExternalAddressResponse, error) {
+	return &pb.ExternalAddressResponse{}, nil
+}
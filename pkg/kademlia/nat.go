@@ -0,0 +1,77 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/utils"
+)
+
+// PortMapper maps a port of the node on its gateway, e.g. with UPnP or NAT-PMP
+type PortMapper interface {
+	// MapPort maps the local port and returns the port it is reachable on from outside
+	MapPort(ctx context.Context, port int) (external int, err error)
+}
+
+// DiscoverAddress asks peers for the address they see the node from and returns
+// the first one a peer could dial the node back on. When mapper isn't nil the
+// listening port is mapped on the gateway first.
+func (k *Kademlia) DiscoverAddress(ctx context.Context, peers []pb.Node, mapper PortMapper) (address string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, portString, err := net.SplitHostPort(k.address)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	port, err := strconv.Atoi(portString)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	if mapper != nil {
+		external, err := mapper.MapPort(ctx, port)
+		if err != nil {
+			zap.L().Warn("Failed to map port on the gateway", zap.Int("port", port), zap.Error(err))
+		} else {
+			port = external
+		}
+	}
+
+	var seen string
+	var errs []error
+	for _, peer := range peers {
+		resp, err := k.nodeClient.ExternalAddress(ctx, peer, port, true)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if resp.Reachable {
+			return resp.Address, nil
+		}
+		seen = resp.Address
+		errs = append(errs, Error.New("%s", resp.DialbackError))
+	}
+
+	if seen != "" {
+		return "", Error.New("inbound connections to %s are blocked: %v", seen, utils.CombineErrors(errs...))
+	}
+	return "", Error.New("no peer could see the node: %v", utils.CombineErrors(errs...))
+}
+
+// SetAddress changes the address the node advertises to other nodes
+func (k *Kademlia) SetAddress(address string) error {
+	self := k.routingTable.Local()
+	self.Address = &pb.NodeAddress{Transport: defaultTransport, Address: address}
+
+	if err := k.routingTable.setSelf(self); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(k.nodeClient.SetSelf(self))
+}
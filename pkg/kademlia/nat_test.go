@@ -0,0 +1,61 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testplanet"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+// fakePortMapper maps every port to external
+type fakePortMapper struct {
+	external int
+	mapped   []int
+}
+
+func (mapper *fakePortMapper) MapPort(ctx context.Context, port int) (int, error) {
+	mapper.mapped = append(mapper.mapped, port)
+	return mapper.external, nil
+}
+
+func TestDiscoverAddress(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	satellite := planet.Satellites[0]
+	storageNode := planet.StorageNodes[0]
+	peers := []pb.Node{satellite.Info}
+
+	address, err := storageNode.Kademlia.DiscoverAddress(ctx, peers, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, storageNode.Addr(), address)
+
+	// nothing listens on the mapped port
+	blocked := &fakePortMapper{external: 1}
+	_, err = storageNode.Kademlia.DiscoverAddress(ctx, peers, blocked)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "inbound connections to 127.0.0.1:1 are blocked")
+	assert.Len(t, blocked.mapped, 1)
+
+	assert.NoError(t, storageNode.Kademlia.SetAddress("127.0.0.2:7777"))
+	rt, err := storageNode.Kademlia.GetRoutingTable(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "127.0.0.2:7777", rt.Local().Address.Address)
+}
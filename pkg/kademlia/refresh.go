@@ -0,0 +1,139 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/utils"
+)
+
+// refreshPingTimeout is how long a node in a refreshed bucket has to answer a ping
+const refreshPingTimeout = 5 * time.Second
+
+// RunRefresh refreshes the buckets which weren't updated within interval,
+// every interval until ctx is canceled
+func (k *Kademlia) RunRefresh(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		if err := k.Refresh(ctx, interval); err != nil && ctx.Err() == nil {
+			zap.L().Warn("Failed to refresh Kademlia buckets", zap.Error(err))
+		}
+	}
+}
+
+// Refresh refreshes every bucket which wasn't updated within threshold. Its
+// nodes are pinged, unreachable ones are evicted in favor of the freshest node
+// of the replacement cache, and a random ID in the bucket is looked up to find
+// new nodes.
+func (k *Kademlia) Refresh(ctx context.Context, threshold time.Duration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bIDs, err := k.routingTable.GetBucketIds()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var errs []error
+	var low bucketID
+	now := time.Now()
+	for _, key := range bIDs {
+		bID := keyToBucketID(key)
+		start := low
+		low = bID
+
+		updated, err := k.routingTable.GetBucketTimestamp(bID[:], nil)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if now.Sub(updated) < threshold {
+			continue
+		}
+
+		if err := k.evictUnreachable(ctx, bID); err != nil {
+			errs = append(errs, err)
+		}
+
+		target, err := randomIDInBucket(start, bID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		err = k.lookup(ctx, target, discoveryOptions{
+			concurrency: k.alpha, retries: defaultRetries, bootstrap: false, bootstrapNodes: k.bootstrapNodes,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		if err := k.routingTable.SetBucketTimestamp(bID[:], time.Now()); err != nil {
+			errs = append(errs, err)
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return Error.Wrap(utils.CombineErrors(errs...))
+}
+
+// evictUnreachable pings the nodes of the bucket and removes the ones which
+// don't answer from the routing table
+func (k *Kademlia) evictUnreachable(ctx context.Context, bID bucketID) error {
+	nodes, err := k.routingTable.getUnmarshaledNodesFromBucket(bID)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if node.Id == k.routingTable.self.Id {
+			continue
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, refreshPingTimeout)
+		_, err := k.Ping(pingCtx, *node)
+		cancel()
+		if err == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		zap.L().Debug("Evicting unreachable node", zap.String("ID", node.Id.String()), zap.Error(err))
+		if err := k.routingTable.ConnectionFailed(node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// randomIDInBucket returns a random node ID in the range (low, high] covered by a bucket
+func randomIDInBucket(low, high bucketID) (czarcoin.NodeID, error) {
+	l := new(big.Int).SetBytes(low[:])
+	h := new(big.Int).SetBytes(high[:])
+
+	n, err := rand.Int(rand.Reader, new(big.Int).Sub(h, l))
+	if err != nil {
+		return czarcoin.NodeID{}, err
+	}
+	n.Add(n, l).Add(n, big.NewInt(1))
+
+	var id czarcoin.NodeID
+	b := n.Bytes()
+	copy(id[len(id)-len(b):], b)
+	return id, nil
+}
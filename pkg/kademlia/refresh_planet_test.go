@@ -0,0 +1,76 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/internal/testplanet"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+func TestRefresh(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 4, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+	// wait for the storage nodes to bootstrap off the satellite
+	time.Sleep(2 * time.Second)
+
+	satellite := planet.Satellites[0]
+	rt, err := satellite.Kademlia.GetRoutingTable(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a node which went offline after it was added
+	dead := &pb.Node{
+		Id:      testczarcoin.NodeIDFromString("dead"),
+		Address: &pb.NodeAddress{Address: "127.0.0.1:1"},
+	}
+	assert.NoError(t, rt.ConnectionSuccess(dead))
+
+	contains := func(node *pb.Node) bool {
+		nodes, err := rt.FindNear(node.Id, rt.K())
+		assert.NoError(t, err)
+		for _, n := range nodes {
+			if n.Id == node.Id {
+				return true
+			}
+		}
+		return false
+	}
+	assert.True(t, contains(dead))
+
+	// buckets which were updated recently are left alone
+	assert.NoError(t, satellite.Kademlia.Refresh(ctx, time.Hour))
+	assert.True(t, contains(dead))
+
+	before := time.Now()
+	// the dead node fails to answer, so an error is expected from the refresh
+	_ = satellite.Kademlia.Refresh(ctx, 0)
+	assert.False(t, contains(dead))
+	for _, node := range planet.StorageNodes {
+		assert.True(t, contains(&node.Info), node.ID().String())
+	}
+
+	ids, err := rt.GetBucketIds()
+	assert.NoError(t, err)
+	for _, id := range ids {
+		updated, err := rt.GetBucketTimestamp(id, nil)
+		assert.NoError(t, err)
+		assert.False(t, updated.Before(before.Truncate(time.Second)))
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomIDInBucket(t *testing.T) {
+	low := bucketID{0x40}
+	high := bucketID{0x80}
+	for i := 0; i < 100; i++ {
+		id, err := randomIDInBucket(low, high)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, bytes.Compare(id[:], low[:]) > 0)
+		assert.True(t, bytes.Compare(id[:], high[:]) <= 0)
+	}
+
+	// a bucket covering a single ID
+	next := low
+	next[len(next)-1]++
+	id, err := randomIDInBucket(low, next)
+	assert.NoError(t, err)
+	assert.Equal(t, next[:], id[:])
+}
@@ -7,8 +7,16 @@ import (
 	"czarcoin.org/czarcoin/pkg/pb"
 )
 
+// addToReplacementCache adds the node as the freshest one of the bucket's
+// replacement cache, dropping the stalest one when the cache is full
 func (rt *RoutingTable) addToReplacementCache(kadBucketID bucketID, node *pb.Node) {
 	nodes := rt.replacementCache[kadBucketID]
+	for i, n := range nodes {
+		if n.Id == node.Id {
+			nodes = append(nodes[:i], nodes[i+1:]...)
+			break
+		}
+	}
 	nodes = append(nodes, node)
 	if len(nodes) > rt.rcBucketSize {
 		copy(nodes, nodes[1:])
@@ -31,3 +31,29 @@ func TestAddToReplacementCache(t *testing.T) {
 	rt.addToReplacementCache(kadBucketID2, node4)
 	assert.Equal(t, []*pb.Node{node3, node4}, rt.replacementCache[kadBucketID2])
 }
+
+func TestReplacementCacheFreshest(t *testing.T) {
+	rt, cleanup := createRoutingTable(t, testczarcoin.NodeIDFromString("AA"))
+	defer cleanup()
+	kadBucketID := rt.createFirstBucketID()
+
+	node := testczarcoin.MockNode("BB")
+	ok, err := rt.addNode(node)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	stale := testczarcoin.MockNode("CC")
+	fresh := testczarcoin.MockNode("DD")
+	rt.addToReplacementCache(kadBucketID, stale)
+	rt.addToReplacementCache(kadBucketID, fresh)
+	// seeing stale again makes it the freshest
+	rt.addToReplacementCache(kadBucketID, stale)
+	assert.Equal(t, []*pb.Node{fresh, stale}, rt.replacementCache[kadBucketID])
+
+	assert.NoError(t, rt.ConnectionFailed(node))
+	_, err = rt.nodeBucketDB.Get(stale.Id.Bytes())
+	assert.NoError(t, err)
+	_, err = rt.nodeBucketDB.Get(fresh.Id.Bytes())
+	assert.Error(t, err)
+	assert.Equal(t, []*pb.Node{fresh}, rt.replacementCache[kadBucketID])
+}
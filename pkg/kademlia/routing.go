@@ -9,18 +9,20 @@ import (
 	"time"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
 	"czarcoin.org/czarcoin/pkg/dht"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
-	"czarcoin.org/czarcoin/pkg/utils"
 	"czarcoin.org/czarcoin/storage"
 )
 
 const (
-	// KademliaBucket is the string representing the bucket used for the kademlia routing table k-bucket ids
+	// RoutingTableBucket is the string representing the bucket used for the kademlia routing table
+	RoutingTableBucket = "routingtable"
+	// KademliaBucket is the key prefix of the kademlia routing table k-bucket ids
 	KademliaBucket = "kbuckets"
-	// NodeBucket is the string representing the bucket used for the kademlia routing table node ids
+	// NodeBucket is the key prefix of the kademlia routing table node ids
 	NodeBucket = "nodes"
 )
 
@@ -33,8 +35,9 @@ type bucketID [len(czarcoin.NodeID{})]byte
 // RoutingTable implements the RoutingTable interface
 type RoutingTable struct {
 	self             pb.Node
-	kadBucketDB      storage.KeyValueStore
-	nodeBucketDB     storage.KeyValueStore
+	db               storage.KeyValueStore // shared by k-buckets and nodes, so both can change in one batch
+	kadBucketDB      *prefixedStore
+	nodeBucketDB     *prefixedStore
 	transport        *pb.NodeTransport
 	mutex            *sync.Mutex
 	seen             map[czarcoin.NodeID]*pb.Node
@@ -44,12 +47,14 @@ type RoutingTable struct {
 
 }
 
-// NewRoutingTable returns a newly configured instance of a RoutingTable
-func NewRoutingTable(localNode pb.Node, kdb, ndb storage.KeyValueStore) (*RoutingTable, error) {
+// NewRoutingTable returns a newly configured instance of a RoutingTable,
+// which stores its k-buckets and nodes in db
+func NewRoutingTable(localNode pb.Node, db storage.KeyValueStore) (*RoutingTable, error) {
 	rt := &RoutingTable{
 		self:         localNode,
-		kadBucketDB:  kdb,
-		nodeBucketDB: ndb,
+		db:           db,
+		kadBucketDB:  newPrefixedStore(db, KademliaBucket),
+		nodeBucketDB: newPrefixedStore(db, NodeBucket),
 		transport:    &defaultTransport,
 
 		mutex:            &sync.Mutex{},
@@ -59,6 +64,13 @@ func NewRoutingTable(localNode pb.Node, kdb, ndb storage.KeyValueStore) (*Routin
 		bucketSize:   *flagBucketSize,
 		rcBucketSize: *flagReplacementCacheSize,
 	}
+	repaired, err := rt.checkConsistency()
+	if err != nil {
+		return nil, RoutingErr.New("could not check routing table consistency: %s", err)
+	}
+	if repaired > 0 {
+		zap.L().Warn("Repaired inconsistent routing table entries", zap.Int("count", repaired))
+	}
 	ok, err := rt.addNode(&localNode)
 	if !ok || err != nil {
 		return nil, RoutingErr.New("could not add localNode to routing table: %s", err)
@@ -66,19 +78,29 @@ func NewRoutingTable(localNode pb.Node, kdb, ndb storage.KeyValueStore) (*Routin
 	return rt, nil
 }
 
-// Close closes underlying databases
+// Close closes the underlying database
 func (rt *RoutingTable) Close() error {
-	return utils.CombineErrors(
-		rt.kadBucketDB.Close(),
-		rt.nodeBucketDB.Close(),
-	)
+	return rt.db.Close()
 }
 
 // Local returns the local nodes ID
 func (rt *RoutingTable) Local() pb.Node {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
 	return rt.self
 }
 
+// setSelf replaces the local node, e.g. when its external address was discovered
+func (rt *RoutingTable) setSelf(self pb.Node) error {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+	if err := rt.putNode(&self); err != nil {
+		return err
+	}
+	rt.self = self
+	return nil
+}
+
 // K returns the currently configured maximum of nodes to store in a bucket
 func (rt *RoutingTable) K() int {
 	return rt.bucketSize
@@ -191,9 +213,13 @@ func (rt *RoutingTable) ConnectionSuccess(node *pb.Node) error {
 	return nil
 }
 
-// ConnectionFailed removes a node from the routing table when
-// a connection fails for the node on the network
+// ConnectionFailed removes a node from the routing table and the seen nodes
+// when a connection fails for the node on the network, and replaces it
+// with the freshest node of the bucket's replacement cache
 func (rt *RoutingTable) ConnectionFailed(node *pb.Node) error {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+	delete(rt.seen, node.Id)
 	err := rt.removeNode(node.Id)
 	if err != nil {
 		return RoutingErr.New("could not remove node %s", err)
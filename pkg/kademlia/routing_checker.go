@@ -0,0 +1,82 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/storage"
+)
+
+// checkConsistency repairs the routing table entries a crash could have left
+// inconsistent and returns how many were repaired. It removes k-bucket ids
+// and nodes which can't be decoded or don't match their key, resets invalid
+// k-bucket timestamps and restores the first k-bucket when it's missing.
+func (rt *RoutingTable) checkConsistency() (repaired int, err error) {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	var batch storage.Batch
+	now := time.Now()
+
+	firstBucket := rt.createFirstBucketID()
+	hasFirstBucket, hasEntries := false, false
+
+	err = rt.kadBucketDB.Iterate(storage.IterateOptions{Recurse: true}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			if len(item.Key) != len(bucketID{}) {
+				batch.Delete(rt.kadBucketDB.key(item.Key))
+				continue
+			}
+			hasEntries = true
+			bID := keyToBucketID(item.Key)
+			if bID == firstBucket {
+				hasFirstBucket = true
+			}
+			if _, n := binary.Varint(item.Value); n <= 0 {
+				rt.batchPutKBucket(&batch, bID, now)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, RoutingErr.Wrap(err)
+	}
+
+	err = rt.nodeBucketDB.Iterate(storage.IterateOptions{Recurse: true}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			id, err := czarcoin.NodeIDFromBytes(item.Key)
+			node := &pb.Node{}
+			if err != nil || proto.Unmarshal(item.Value, node) != nil || node.Id != id {
+				batch.Delete(rt.nodeBucketDB.key(item.Key))
+				continue
+			}
+			hasEntries = true
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, RoutingErr.Wrap(err)
+	}
+
+	// every node id belongs to a k-bucket as long as the first one exists
+	if hasEntries && !hasFirstBucket {
+		rt.batchPutKBucket(&batch, firstBucket, now)
+	}
+
+	if len(batch) == 0 {
+		return 0, nil
+	}
+	if err := rt.db.Apply(batch); err != nil {
+		return 0, RoutingErr.Wrap(err)
+	}
+	return len(batch), nil
+}
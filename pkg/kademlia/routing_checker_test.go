@@ -0,0 +1,59 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/storage"
+	"czarcoin.org/czarcoin/storage/teststore"
+)
+
+func TestCheckConsistency(t *testing.T) {
+	db := teststore.New()
+	kadBucketDB := newPrefixedStore(db, KademliaBucket)
+	nodeBucketDB := newPrefixedStore(db, NodeBucket)
+
+	valid := &pb.Node{Id: testczarcoin.NodeIDFromString("valid")}
+	validData, err := proto.Marshal(valid)
+	assert.NoError(t, err)
+	mismatched := testczarcoin.NodeIDFromString("mismatched")
+
+	// a crash left nodes without the first k-bucket and entries which can't be decoded
+	assert.NoError(t, nodeBucketDB.Put(valid.Id.Bytes(), validData))
+	assert.NoError(t, nodeBucketDB.Put(mismatched.Bytes(), validData))
+	assert.NoError(t, nodeBucketDB.Put(testczarcoin.NodeIDFromString("garbage").Bytes(), storage.Value("garbage")))
+	assert.NoError(t, nodeBucketDB.Put(storage.Key("short"), validData))
+	assert.NoError(t, kadBucketDB.Put(storage.Key("short"), storage.Value("")))
+
+	local := pb.Node{Id: testczarcoin.NodeIDFromString("local")}
+	rt, err := NewRoutingTable(local, db)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { assert.NoError(t, rt.Close()) }()
+
+	repaired, err := rt.checkConsistency()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, repaired)
+
+	first := rt.createFirstBucketID()
+	buckets, err := kadBucketDB.List(nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.Keys{first[:]}, buckets)
+
+	nodes, err := nodeBucketDB.List(nil, 0)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, storage.Keys{valid.Id.Bytes(), local.Id.Bytes()}, nodes)
+
+	bucket, ok := rt.GetBucket(valid.Id)
+	if assert.True(t, ok) {
+		assert.Len(t, bucket.Nodes(), 2)
+	}
+}
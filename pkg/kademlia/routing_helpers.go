@@ -26,13 +26,13 @@ func (rt *RoutingTable) addNode(node *pb.Node) (bool, error) {
 	nodeIDBytes := node.Id.Bytes()
 
 	if bytes.Equal(nodeIDBytes, rt.self.Id.Bytes()) {
-		err := rt.createOrUpdateKBucket(rt.createFirstBucketID(), time.Now())
-		if err != nil {
-			return false, RoutingErr.New("could not create initial K bucket: %s", err)
+		var batch storage.Batch
+		rt.batchPutKBucket(&batch, rt.createFirstBucketID(), time.Now())
+		if err := rt.batchPutNode(&batch, node); err != nil {
+			return false, err
 		}
-		err = rt.putNode(node)
-		if err != nil {
-			return false, RoutingErr.New("could not add initial node to nodeBucketDB: %s", err)
+		if err := rt.db.Apply(batch); err != nil {
+			return false, RoutingErr.New("could not add initial node and K bucket: %s", err)
 		}
 		return true, nil
 	}
@@ -82,13 +82,13 @@ func (rt *RoutingTable) addNode(node *pb.Node) (bool, error) {
 			return false, nil
 		}
 	}
-	err = rt.putNode(node)
-	if err != nil {
-		return false, RoutingErr.New("could not add node to nodeBucketDB: %s", err)
+	var batch storage.Batch
+	if err := rt.batchPutNode(&batch, node); err != nil {
+		return false, err
 	}
-	err = rt.createOrUpdateKBucket(kadBucketID, time.Now())
-	if err != nil {
-		return false, RoutingErr.New("could not create or update K bucket: %s", err)
+	rt.batchPutKBucket(&batch, kadBucketID, time.Now())
+	if err := rt.db.Apply(batch); err != nil {
+		return false, RoutingErr.New("could not add node and update K bucket: %s", err)
 	}
 	return true, nil
 }
@@ -114,47 +114,63 @@ func (rt *RoutingTable) removeNode(nodeID czarcoin.NodeID) error {
 	} else if err != nil {
 		return RoutingErr.New("could not get node %s", err)
 	}
-	err = rt.nodeBucketDB.Delete(nodeID.Bytes())
-	if err != nil {
-		return RoutingErr.New("could not delete node %s", err)
-	}
+	// the node is replaced in the same batch, so it can't get lost in between
+	var batch storage.Batch
+	batch.Delete(rt.nodeBucketDB.key(nodeID.Bytes()))
 	nodes := rt.replacementCache[kadBucketID]
-	if len(nodes) == 0 {
-		return nil
+	if len(nodes) > 0 {
+		if err := rt.batchPutNode(&batch, nodes[len(nodes)-1]); err != nil {
+			return err
+		}
 	}
-	err = rt.putNode(nodes[len(nodes)-1])
-	if err != nil {
-		return err
+	if err := rt.db.Apply(batch); err != nil {
+		return RoutingErr.New("could not delete node %s", err)
+	}
+	if len(nodes) > 0 {
+		rt.replacementCache[kadBucketID] = nodes[:len(nodes)-1]
 	}
-	rt.replacementCache[kadBucketID] = nodes[:len(nodes)-1]
 	return nil
 }
 
 // putNode: helper, adds or updates Node and ID to nodeBucketDB
 func (rt *RoutingTable) putNode(node *pb.Node) error {
-	v, err := proto.Marshal(node)
-	if err != nil {
-		return RoutingErr.Wrap(err)
+	var batch storage.Batch
+	if err := rt.batchPutNode(&batch, node); err != nil {
+		return err
+	}
+	if err := rt.db.Apply(batch); err != nil {
+		return RoutingErr.New("could not add key value pair to nodeBucketDB: %s", err)
 	}
+	return nil
+}
 
-	err = rt.nodeBucketDB.Put(node.Id.Bytes(), v)
+// batchPutNode: helper, adds the put of node to batch
+func (rt *RoutingTable) batchPutNode(batch *storage.Batch, node *pb.Node) error {
+	v, err := proto.Marshal(node)
 	if err != nil {
-		return RoutingErr.New("could not add key value pair to nodeBucketDB: %s", err)
+		return RoutingErr.Wrap(err)
 	}
+	batch.Put(rt.nodeBucketDB.key(node.Id.Bytes()), v)
 	return nil
 }
 
 // createOrUpdateKBucket: helper, adds or updates given kbucket
 func (rt *RoutingTable) createOrUpdateKBucket(bID bucketID, now time.Time) error {
-	dateTime := make([]byte, binary.MaxVarintLen64)
-	binary.PutVarint(dateTime, now.UnixNano())
-	err := rt.kadBucketDB.Put(bID[:], dateTime)
-	if err != nil {
+	var batch storage.Batch
+	rt.batchPutKBucket(&batch, bID, now)
+	if err := rt.db.Apply(batch); err != nil {
 		return RoutingErr.New("could not add or update k bucket: %s", err)
 	}
 	return nil
 }
 
+// batchPutKBucket: helper, adds the put of kbucket with its last updated time to batch
+func (rt *RoutingTable) batchPutKBucket(batch *storage.Batch, bID bucketID, now time.Time) {
+	dateTime := make([]byte, binary.MaxVarintLen64)
+	binary.PutVarint(dateTime, now.UnixNano())
+	batch.Put(rt.kadBucketDB.key(bID[:]), dateTime)
+}
+
 // getKBucketID: helper, returns the id of the corresponding k bucket given a node id.
 // The node doesn't have to be in the routing table at time of search
 func (rt *RoutingTable) getKBucketID(nodeID czarcoin.NodeID) (bucketID, error) {
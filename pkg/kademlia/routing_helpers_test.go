@@ -26,10 +26,12 @@ import (
 
 // newTestRoutingTable returns a newly configured instance of a RoutingTable
 func newTestRoutingTable(localNode pb.Node) (*RoutingTable, error) {
+	db := storelogger.New(zap.L(), teststore.New())
 	rt := &RoutingTable{
 		self:         localNode,
-		kadBucketDB:  storelogger.New(zap.L(), teststore.New()),
-		nodeBucketDB: storelogger.New(zap.L(), teststore.New()),
+		db:           db,
+		kadBucketDB:  newPrefixedStore(db, KademliaBucket),
+		nodeBucketDB: newPrefixedStore(db, NodeBucket),
 		transport:    &defaultTransport,
 
 		mutex:            &sync.Mutex{},
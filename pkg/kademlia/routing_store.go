@@ -0,0 +1,101 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"czarcoin.org/czarcoin/storage"
+)
+
+// prefixedStore is the view of the keys with a prefix in the shared routing
+// table store, keys passed to and returned by it don't include the prefix
+type prefixedStore struct {
+	db     storage.KeyValueStore
+	prefix storage.Key
+}
+
+// newPrefixedStore returns the view of the keys starting with name + "/" in db
+func newPrefixedStore(db storage.KeyValueStore, name string) *prefixedStore {
+	return &prefixedStore{db: db, prefix: storage.Key(name + string(storage.Delimiter))}
+}
+
+// key returns the key in the shared store
+func (store *prefixedStore) key(key storage.Key) storage.Key {
+	if key.IsZero() {
+		return nil
+	}
+	return append(storage.CloneKey(store.prefix), key...)
+}
+
+// Put adds a value to store
+func (store *prefixedStore) Put(key storage.Key, value storage.Value) error {
+	return store.db.Put(store.key(key), value)
+}
+
+// Get gets a value to store
+func (store *prefixedStore) Get(key storage.Key) (storage.Value, error) {
+	return store.db.Get(store.key(key))
+}
+
+// GetAll gets all values from the store
+func (store *prefixedStore) GetAll(keys storage.Keys) (storage.Values, error) {
+	prefixed := make(storage.Keys, 0, len(keys))
+	for _, key := range keys {
+		prefixed = append(prefixed, store.key(key))
+	}
+	return store.db.GetAll(prefixed)
+}
+
+// Delete deletes key and the value
+func (store *prefixedStore) Delete(key storage.Key) error {
+	return store.db.Delete(store.key(key))
+}
+
+// List lists all keys starting from start and upto limit items
+func (store *prefixedStore) List(first storage.Key, limit int) (storage.Keys, error) {
+	return storage.ListKeys(store, first, limit)
+}
+
+// ReverseList lists all keys in reverse order
+func (store *prefixedStore) ReverseList(first storage.Key, limit int) (storage.Keys, error) {
+	return storage.ReverseListKeys(store, first, limit)
+}
+
+// Iterate iterates over items based on opts
+func (store *prefixedStore) Iterate(opts storage.IterateOptions, fn func(storage.Iterator) error) error {
+	return store.db.Iterate(storage.IterateOptions{
+		Prefix:  append(storage.CloneKey(store.prefix), opts.Prefix...),
+		First:   store.key(opts.First),
+		Recurse: opts.Recurse,
+		Reverse: opts.Reverse,
+	}, func(it storage.Iterator) error {
+		return fn(storage.IteratorFunc(func(item *storage.ListItem) bool {
+			if !it.Next(item) {
+				return false
+			}
+			item.Key = item.Key[len(store.prefix):]
+			return true
+		}))
+	})
+}
+
+// Apply applies the batch with the keys prefixed
+func (store *prefixedStore) Apply(batch storage.Batch) error {
+	prefixed := make(storage.Batch, 0, len(batch))
+	for _, op := range batch {
+		op.Key = store.key(op.Key)
+		prefixed = append(prefixed, op)
+	}
+	return store.db.Apply(prefixed)
+}
+
+// CompareAndSwap compares and swaps the value of the prefixed key
+func (store *prefixedStore) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+	return store.db.CompareAndSwap(store.key(key), oldValue, newValue)
+}
+
+// Close does nothing, the shared store is closed by the routing table
+func (store *prefixedStore) Close() error { return nil }
@@ -0,0 +1,36 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/storage"
+	"czarcoin.org/czarcoin/storage/teststore"
+	"czarcoin.org/czarcoin/storage/testsuite"
+)
+
+func TestPrefixedStore(t *testing.T) {
+	db := teststore.New()
+	testsuite.RunTests(t, newPrefixedStore(db, "prefix"))
+
+	// keys of other prefixes are not visible
+	assert.NoError(t, db.Put(storage.Key("other/key"), storage.Value("value")))
+	store := newPrefixedStore(db, "prefix")
+	assert.NoError(t, store.Put(storage.Key("key"), storage.Value("prefixed")))
+
+	keys, err := store.List(nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.Keys{storage.Key("key")}, keys)
+
+	keys, err = store.ReverseList(nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.Keys{storage.Key("key")}, keys)
+
+	value, err := db.Get(storage.Key("prefix/key"))
+	assert.NoError(t, err)
+	assert.Equal(t, storage.Value("prefixed"), value)
+}
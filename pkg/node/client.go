@@ -19,9 +19,13 @@ var NodeClientErr = errs.Class("node client error")
 // NewNodeClient instantiates a node client
 func NewNodeClient(identity *provider.FullIdentity, self pb.Node, dht dht.DHT) (Client, error) {
 	node := &Node{
-		dht:  dht,
-		self: self,
-		pool: NewConnectionPool(identity),
+		dht:      dht,
+		identity: identity,
+		pool:     NewConnectionPool(identity),
+	}
+
+	if err := node.SetSelf(self); err != nil {
+		return nil, err
 	}
 
 	node.pool.Init()
@@ -33,5 +37,7 @@ func NewNodeClient(identity *provider.FullIdentity, self pb.Node, dht dht.DHT) (
 type Client interface {
 	Lookup(ctx context.Context, to pb.Node, find pb.Node) ([]*pb.Node, error)
 	Ping(ctx context.Context, to pb.Node) (bool, error)
+	ExternalAddress(ctx context.Context, to pb.Node, port int, dialback bool) (*pb.ExternalAddressResponse, error)
+	SetSelf(self pb.Node) error
 	Disconnect() error
 }
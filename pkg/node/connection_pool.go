@@ -10,6 +10,7 @@ import (
 	"unsafe"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	"czarcoin.org/czarcoin/pkg/pb"
@@ -95,8 +96,15 @@ func (pool *ConnectionPool) Dial(ctx context.Context, n *pb.Node) (pb.NodesClien
 	id := n.Id
 	pool.mu.Lock()
 	conn, ok := pool.items[id]
+	if ok && conn.addr != n.GetAddress().GetAddress() {
+		// the node moved, so the connection to the old address is replaced
+		if err := pool.disconnect(id); err != nil {
+			zap.L().Debug("failed closing connection", zap.String("nodeID", id.String()), zap.Error(err))
+		}
+		ok = false
+	}
 	if !ok {
-		conn = NewConn(n.GetAddress().Address)
+		conn = NewConn(n.GetAddress().GetAddress())
 		pool.items[id] = conn
 	}
 	pool.mu.Unlock()
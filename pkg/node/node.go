@@ -5,16 +5,38 @@ package node
 
 import (
 	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
 
 	"czarcoin.org/czarcoin/pkg/dht"
 	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
 )
 
 // Node is the czarcoin definition for a node in the network
 type Node struct {
-	dht  dht.DHT
-	self pb.Node
-	pool *ConnectionPool
+	dht      dht.DHT
+	identity *provider.FullIdentity
+	pool     *ConnectionPool
+
+	mu     sync.Mutex
+	self   pb.Node
+	record *pb.SignedNodeRecord
+}
+
+// SetSelf changes the node sent to the queried nodes and signs a new record of it
+func (n *Node) SetSelf(self pb.Node) error {
+	record, err := NewSignedRecord(n.identity, self, time.Now().UnixNano())
+	if err != nil {
+		return NodeClientErr.Wrap(err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.self, n.record = self, record
+	return nil
 }
 
 // Lookup queries nodes looking for a particular node in the network
@@ -24,7 +46,11 @@ func (n *Node) Lookup(ctx context.Context, to pb.Node, find pb.Node) ([]*pb.Node
 		return nil, NodeClientErr.Wrap(err)
 	}
 
-	resp, err := c.Query(ctx, &pb.QueryRequest{Limit: 20, Sender: &n.self, Target: &find, Pingback: true})
+	n.mu.Lock()
+	self, record := n.self, n.record
+	n.mu.Unlock()
+
+	resp, err := c.Query(ctx, &pb.QueryRequest{Limit: 20, Sender: &self, SenderRecord: record, Target: &find, Pingback: true})
 	if err != nil {
 		return nil, NodeClientErr.Wrap(err)
 	}
@@ -34,11 +60,38 @@ func (n *Node) Lookup(ctx context.Context, to pb.Node, find pb.Node) ([]*pb.Node
 		return nil, NodeClientErr.Wrap(err)
 	}
 
-	if err := rt.ConnectionSuccess(&to); err != nil {
+	if err := rt.ConnectionSuccess(responder(to, resp.ResponderRecord)); err != nil {
 		return nil, NodeClientErr.Wrap(err)
 	}
 
-	return resp.Response, nil
+	// only nodes which signed what they claim are passed on
+	nodes := make([]*pb.Node, 0, len(resp.Response))
+	for _, node := range resp.Response {
+		if node == nil {
+			continue
+		}
+		if err := VerifyNode(node); err != nil {
+			zap.L().Debug("dropped unverified node", zap.String("nodeID", node.Id.String()), zap.Error(err))
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// responder returns the queried node with its record when the record is valid
+// and claims the address the node was reached on
+func responder(to pb.Node, signed *pb.SignedNodeRecord) *pb.Node {
+	if signed == nil {
+		return &to
+	}
+	record, err := VerifySignedRecord(signed)
+	if err != nil || record.Id != to.Id || record.Address.GetAddress() != to.Address.GetAddress() {
+		return &to
+	}
+	to.Address, to.Type, to.Restrictions = record.Address, record.Type, record.Restrictions
+	to.Record = signed
+	return &to
 }
 
 // Ping attempts to establish a connection with a node to verify it is alive
@@ -56,6 +109,21 @@ func (n *Node) Ping(ctx context.Context, to pb.Node) (bool, error) {
 	return true, nil
 }
 
+// ExternalAddress asks to for the address it sees the node from with port,
+// and if dialback is set whether it could connect to the node on it
+func (n *Node) ExternalAddress(ctx context.Context, to pb.Node, port int, dialback bool) (*pb.ExternalAddressResponse, error) {
+	c, err := n.pool.Dial(ctx, &to)
+	if err != nil {
+		return nil, NodeClientErr.Wrap(err)
+	}
+
+	resp, err := c.ExternalAddress(ctx, &pb.ExternalAddressRequest{Port: int32(port), Dialback: dialback})
+	if err != nil {
+		return nil, NodeClientErr.Wrap(err)
+	}
+	return resp, nil
+}
+
 // Disconnect closes all connections within the pool
 func (n *Node) Disconnect() error {
 	return n.pool.DisconnectAll()
@@ -103,7 +103,7 @@ func TestPing(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mdht := mock_dht.NewMockDHT(ctrl)
 		// set up a node server
-		srv := NewServer(mdht)
+		srv := NewServer(mdht, v.ident)
 
 		msrv, _, err := newTestServer(ctx, srv, v.ident)
 		assert.NoError(t, err)
@@ -148,6 +148,10 @@ func (mn *mockNodeServer) Ping(ctx context.Context, req *pb.PingRequest) (*pb.Pi
 	return &pb.PingResponse{}, nil
 }
 
+func (mn *mockNodeServer) ExternalAddress(ctx context.Context, req *pb.ExternalAddressRequest) (*pb.ExternalAddressResponse, error) {
+	return &pb.ExternalAddressResponse{}, nil
+}
+
 func newTestIdentity(t *testing.T) *provider.FullIdentity {
 	ca, err := testidentity.NewTestCA(ctx)
 	assert.NoError(t, err)
@@ -0,0 +1,121 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package node
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gtank/cryptopasta"
+	"github.com/zeebo/errs"
+
+	"czarcoin.org/czarcoin/pkg/auth"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
+)
+
+// RecordErr is the class for invalid node records
+var RecordErr = errs.Class("node record error")
+
+// NewSignedRecord returns the record of node signed with the leaf key of identity
+func NewSignedRecord(identity *provider.FullIdentity, node pb.Node, sequence int64) (*pb.SignedNodeRecord, error) {
+	record, err := proto.Marshal(&pb.NodeRecord{
+		Id:           node.Id,
+		Address:      node.Address,
+		Type:         node.Type,
+		Restrictions: node.Restrictions,
+		Sequence:     sequence,
+	})
+	if err != nil {
+		return nil, RecordErr.Wrap(err)
+	}
+
+	signature, err := auth.GenerateSignature(record, identity)
+	if err != nil {
+		return nil, RecordErr.Wrap(err)
+	}
+	return &pb.SignedNodeRecord{
+		Record:    record,
+		Signature: signature,
+		Chain:     [][]byte{identity.Leaf.Raw, identity.CA.Raw},
+	}, nil
+}
+
+// VerifyRecord checks that the record was signed by the leaf key of peer and
+// is about peer
+func VerifyRecord(peer *provider.PeerIdentity, signed *pb.SignedNodeRecord) (*pb.NodeRecord, error) {
+	if signed == nil || len(signed.Signature) == 0 {
+		return nil, RecordErr.New("record is not signed")
+	}
+
+	key, ok := peer.Leaf.PublicKey.(*ecdsa.PublicKey)
+	if !ok || !cryptopasta.Verify(signed.Record, signed.Signature, key) {
+		return nil, RecordErr.New("invalid record signature")
+	}
+
+	record := &pb.NodeRecord{}
+	if err := proto.Unmarshal(signed.Record, record); err != nil {
+		return nil, RecordErr.Wrap(err)
+	}
+	if record.Id != peer.ID {
+		return nil, RecordErr.New("record of %s signed by %s", record.Id, peer.ID)
+	}
+	return record, nil
+}
+
+// VerifySignedRecord checks that the record was signed by the leaf of the
+// certificate chain it carries, and that the chain belongs to the node
+func VerifySignedRecord(signed *pb.SignedNodeRecord) (*pb.NodeRecord, error) {
+	if len(signed.GetChain()) < 2 {
+		return nil, RecordErr.New("record has no certificate chain")
+	}
+	chain, err := provider.ParseCertChain(signed.GetChain()[:2])
+	if err != nil {
+		return nil, RecordErr.Wrap(err)
+	}
+	leaf, ca := chain[0], chain[1]
+	if err := leaf.CheckSignatureFrom(ca); err != nil {
+		return nil, RecordErr.Wrap(err)
+	}
+
+	peer, err := provider.PeerIdentityFromCerts(leaf, ca, nil)
+	if err != nil {
+		return nil, RecordErr.Wrap(err)
+	}
+	return VerifyRecord(peer, signed)
+}
+
+// VerifyNode checks that the node carries a valid record which matches it
+func VerifyNode(node *pb.Node) error {
+	record, err := VerifySignedRecord(node.GetRecord())
+	if err != nil {
+		return err
+	}
+	if !Matches(record, node) {
+		return RecordErr.New("node %s does not match its record", node.Id)
+	}
+	return nil
+}
+
+// Matches returns whether node is what the record claims
+func Matches(record *pb.NodeRecord, node *pb.Node) bool {
+	return node != nil &&
+		node.Id == record.Id &&
+		node.Type == record.Type &&
+		proto.Equal(node.Address, record.Address) &&
+		proto.Equal(node.Restrictions, record.Restrictions)
+}
+
+// sequence returns the sequence of the record the node carries, the record
+// isn't verified again
+func sequence(node *pb.Node) (int64, bool) {
+	if node.GetRecord() == nil {
+		return 0, false
+	}
+	record := &pb.NodeRecord{}
+	if err := proto.Unmarshal(node.GetRecord().GetRecord(), record); err != nil {
+		return 0, false
+	}
+	return record.Sequence, true
+}
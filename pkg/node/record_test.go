@@ -0,0 +1,100 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/identity"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+func TestVerifyNode(t *testing.T) {
+	nodeIdentity, err := testidentity.NewTestIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherIdentity, err := testidentity.NewTestIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	node := pb.Node{Id: nodeIdentity.ID, Address: &pb.NodeAddress{Address: "127.0.0.1:7777"}}
+	record, err := NewSignedRecord(nodeIdentity, node, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a record about the node signed by another node with its own chain
+	forged, err := NewSignedRecord(otherIdentity, node, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the chain of the node with the signature of another node
+	stolen := *forged
+	stolen.Chain = record.Chain
+
+	withRecord := func(node pb.Node, record *pb.SignedNodeRecord) *pb.Node {
+		node.Record = record
+		return &node
+	}
+	moved := node
+	moved.Address = &pb.NodeAddress{Address: "10.0.0.1:7777"}
+
+	for _, tt := range []struct {
+		caseName string
+		node     *pb.Node
+		valid    bool
+	}{
+		{"valid record", withRecord(node, record), true},
+		{"copied node", pb.CopyNode(withRecord(node, record)), true},
+		{"no record", &node, false},
+		{"no chain", withRecord(node, &pb.SignedNodeRecord{Record: record.Record, Signature: record.Signature}), false},
+		{"signed by another node", withRecord(node, forged), false},
+		{"signature of another node", withRecord(node, &stolen), false},
+		{"spoofed address", withRecord(moved, record), false},
+	} {
+		err := VerifyNode(tt.node)
+		if tt.valid {
+			assert.NoError(t, err, tt.caseName)
+		} else {
+			assert.Error(t, err, tt.caseName)
+		}
+	}
+}
+
+func TestResponder(t *testing.T) {
+	nodeIdentity, err := testidentity.NewTestIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	self := pb.Node{
+		Id:           nodeIdentity.ID,
+		Address:      &pb.NodeAddress{Address: "127.0.0.1:7777"},
+		Type:         pb.NodeType_STORAGE,
+		Restrictions: &pb.NodeRestrictions{FreeDisk: 10},
+	}
+	record, err := NewSignedRecord(nodeIdentity, self, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the node was only known by its address
+	to := pb.Node{Id: nodeIdentity.ID, Address: &pb.NodeAddress{Address: "127.0.0.1:7777"}}
+	responded := responder(to, record)
+	assert.NoError(t, VerifyNode(responded))
+	assert.Equal(t, pb.NodeType_STORAGE, responded.Type)
+
+	// a record claiming another address is ignored
+	to.Address = &pb.NodeAddress{Address: "10.0.0.1:7777"}
+	assert.Nil(t, responder(to, record).Record)
+
+	// as is a record of another node
+	to.Id = testczarcoin.NodeIDFromString("other")
+	to.Address = self.Address
+	assert.Nil(t, responder(to, record).Record)
+}
@@ -5,25 +5,50 @@ package node
 
 import (
 	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcpeer "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"czarcoin.org/czarcoin/pkg/dht"
 	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
+	"czarcoin.org/czarcoin/pkg/transport"
 )
 
+// dialbackTimeout is how long dialing back a node on its external address may take
+const dialbackTimeout = 10 * time.Second
+
 // Server implements the grpc Node Server
 type Server struct {
-	dht    dht.DHT
-	logger *zap.Logger
+	dht       dht.DHT
+	transport transport.Client
+	logger    *zap.Logger
+	identity  *provider.FullIdentity
+
+	mu     sync.Mutex
+	self   *pb.NodeRecord       // what record claims about the local node
+	record *pb.SignedNodeRecord // record of self, signed again when self changes
 }
 
-// NewServer returns a newly instantiated Node Server
-func NewServer(dht dht.DHT) *Server {
-	return &Server{
+// NewServer returns a newly instantiated Node Server, identity is used to
+// dial nodes back on their external address
+func NewServer(dht dht.DHT, identity *provider.FullIdentity) *Server {
+	s := &Server{
 		dht:    dht,
 		logger: zap.L(),
 	}
+	if identity != nil {
+		s.transport = transport.NewClient(identity)
+		s.identity = identity
+	}
+	return s
 }
 
 // Query is a node to node communication query
@@ -38,17 +63,23 @@ func (s *Server) Query(ctx context.Context, req *pb.QueryRequest) (*pb.QueryResp
 	}
 
 	if req.GetPingback() {
-		_, err = s.dht.Ping(ctx, *req.Sender)
-		if err != nil {
-			err = rt.ConnectionFailed(req.Sender)
-			if err != nil {
-				s.logger.Error("could not respond to connection failed", zap.Error(err))
-			}
-			s.logger.Error("connection to node failed", zap.Error(err), zap.String("nodeID", req.Sender.Id.String()))
+		if err := s.verifySender(ctx, rt, req); err != nil {
+			s.logger.Warn("rejected query sender", zap.Error(err))
+			return nil, err
 		}
 
-		err = rt.ConnectionSuccess(req.Sender)
+		// the record is kept with the node, so nodes which learn about the
+		// sender from this node can verify it as well
+		sender := *req.Sender
+		sender.Record = req.SenderRecord
+
+		_, err = s.dht.Ping(ctx, sender)
 		if err != nil {
+			s.logger.Error("connection to node failed", zap.Error(err), zap.String("nodeID", sender.Id.String()))
+			if err := rt.ConnectionFailed(&sender); err != nil {
+				s.logger.Error("could not respond to connection failed", zap.Error(err))
+			}
+		} else if err := rt.ConnectionSuccess(&sender); err != nil {
 			s.logger.Error("could not respond to connection success", zap.Error(err))
 		}
 	}
@@ -58,7 +89,63 @@ func (s *Server) Query(ctx context.Context, req *pb.QueryRequest) (*pb.QueryResp
 		return &pb.QueryResponse{}, NodeClientErr.New("could not find near %s", err)
 	}
 
-	return &pb.QueryResponse{Sender: req.Sender, Response: nodes}, nil
+	record, err := s.selfRecord(rt)
+	if err != nil {
+		s.logger.Error("could not sign node record", zap.Error(err))
+	}
+
+	return &pb.QueryResponse{Sender: req.Sender, Response: nodes, ResponderRecord: record}, nil
+}
+
+// selfRecord returns the signed record of the local node, nil when the server
+// has no identity to sign it with
+func (s *Server) selfRecord(rt dht.RoutingTable) (*pb.SignedNodeRecord, error) {
+	if s.identity == nil {
+		return nil, nil
+	}
+	self := rt.Local()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.record == nil || !Matches(s.self, &self) {
+		record, err := NewSignedRecord(s.identity, self, time.Now().UnixNano())
+		if err != nil {
+			return nil, err
+		}
+		s.record = record
+		s.self = &pb.NodeRecord{Id: self.Id, Address: self.Address, Type: self.Type, Restrictions: self.Restrictions}
+	}
+	return s.record, nil
+}
+
+// verifySender checks that the sender of the query is described by a record
+// signed by the calling node which doesn't replace a newer one in the routing table
+func (s *Server) verifySender(ctx context.Context, rt dht.RoutingTable, req *pb.QueryRequest) error {
+	peer, err := provider.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	record, err := VerifyRecord(peer, req.GetSenderRecord())
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if !Matches(record, req.GetSender()) {
+		return status.Error(codes.PermissionDenied, "sender does not match its record")
+	}
+
+	known, err := rt.FindNear(record.Id, 1)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	// an older record is only stale when it claims something else, the
+	// same claim may be signed at different times by clients of the node
+	if len(known) > 0 && known[0].Id == record.Id && !Matches(record, known[0]) {
+		if latest, ok := sequence(known[0]); ok && record.Sequence < latest {
+			return status.Errorf(codes.InvalidArgument, "stale record %d, latest is %d", record.Sequence, latest)
+		}
+	}
+	return nil
 }
 
 // Ping provides an easy way to verify a node is online and accepting requests
@@ -66,3 +153,60 @@ func (s *Server) Ping(ctx context.Context, req *pb.PingRequest) (*pb.PingRespons
 	//TODO
 	return &pb.PingResponse{}, nil
 }
+
+// ExternalAddress returns the address the caller is seen from with the port it
+// listens on, and if asked whether the caller can be dialed back on it
+func (s *Server) ExternalAddress(ctx context.Context, req *pb.ExternalAddressRequest) (*pb.ExternalAddressResponse, error) {
+	if req.Port <= 0 || req.Port > 65535 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid port %d", req.Port)
+	}
+
+	p, ok := grpcpeer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil, status.Error(codes.Internal, "unable to get the caller address")
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.ExternalAddressResponse{Address: net.JoinHostPort(host, strconv.Itoa(int(req.Port)))}
+	if !req.Dialback {
+		return resp, nil
+	}
+
+	peer, err := provider.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := s.dialback(ctx, pb.Node{Id: peer.ID, Address: &pb.NodeAddress{Address: resp.Address}}); err != nil {
+		resp.DialbackError = err.Error()
+		return resp, nil
+	}
+	resp.Reachable = true
+	return resp, nil
+}
+
+// dialback pings the node on a new connection, so neither a pooled connection
+// nor the one of the caller can make it look reachable
+func (s *Server) dialback(ctx context.Context, node pb.Node) (err error) {
+	if s.transport == nil {
+		return NodeClientErr.New("dialback is not supported")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialbackTimeout)
+	defer cancel()
+
+	conn, err := s.transport.DialNode(ctx, &node, grpc.WithBlock())
+	if err != nil {
+		return NodeClientErr.Wrap(err)
+	}
+	defer func() {
+		if cerr := conn.Close(); cerr != nil {
+			s.logger.Debug("failed to close dialback connection", zap.Error(cerr))
+		}
+	}()
+
+	_, err = pb.NewNodesClient(conn).Ping(ctx, &pb.PingRequest{})
+	return NodeClientErr.Wrap(err)
+}
@@ -5,25 +5,50 @@ package node
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
+	"czarcoin.org/czarcoin/internal/identity"
 	"czarcoin.org/czarcoin/internal/testczarcoin"
 	"czarcoin.org/czarcoin/pkg/dht"
 	"czarcoin.org/czarcoin/pkg/dht/mocks"
 	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
 )
 
+// peerContext returns a context with the TLS peer of identity
+func peerContext(identity *provider.FullIdentity) context.Context {
+	info := credentials.TLSInfo{State: tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{identity.Leaf, identity.CA},
+	}}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: info})
+}
+
 func TestQuery(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockDHT := mock_dht.NewMockDHT(ctrl)
 	mockRT := mock_dht.NewMockRoutingTable(ctrl)
-	s := &Server{dht: mockDHT}
-	sender := &pb.Node{Id: testczarcoin.NodeIDFromString("A")}
+	s := NewServer(mockDHT, nil)
+	senderIdentity, err := testidentity.NewTestIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := &pb.Node{Id: senderIdentity.ID}
+	record, err := NewSignedRecord(senderIdentity, *sender, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
 	target := &pb.Node{Id: testczarcoin.NodeIDFromString("B")}
 	node := &pb.Node{Id: testczarcoin.NodeIDFromString("C")}
 	cases := []struct {
@@ -68,8 +93,9 @@ func TestQuery(t *testing.T) {
 		},
 	}
 	for i, v := range cases {
-		req := pb.QueryRequest{Pingback: true, Sender: sender, Target: &pb.Node{Id: testczarcoin.NodeIDFromString("B")}, Limit: int64(2)}
+		req := pb.QueryRequest{Pingback: true, Sender: sender, SenderRecord: record, Target: &pb.Node{Id: testczarcoin.NodeIDFromString("B")}, Limit: int64(2)}
 		mockDHT.EXPECT().GetRoutingTable(gomock.Any()).Return(v.rt, v.getRTErr)
+		mockRT.EXPECT().FindNear(sender.Id, 1).Return(nil, nil)
 		mockDHT.EXPECT().Ping(gomock.Any(), gomock.Any()).Return(v.pingNode, v.pingErr)
 		if v.pingErr != nil {
 			mockRT.EXPECT().ConnectionFailed(gomock.Any()).Return(v.failErr)
@@ -79,7 +105,7 @@ func TestQuery(t *testing.T) {
 				mockRT.EXPECT().FindNear(gomock.Any(), v.limit).Return(v.findNear, v.nearErr)
 			}
 		}
-		res, err := s.Query(context.Background(), &req)
+		res, err := s.Query(peerContext(senderIdentity), &req)
 		if !assert.Equal(t, v.res, res) {
 			fmt.Printf("case %s (%v) failed\n", v.caseName, i)
 		}
@@ -88,3 +114,128 @@ func TestQuery(t *testing.T) {
 		}
 	}
 }
+
+func TestQuerySenderRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDHT := mock_dht.NewMockDHT(ctrl)
+	mockRT := mock_dht.NewMockRoutingTable(ctrl)
+	s := NewServer(mockDHT, nil)
+
+	senderIdentity, err := testidentity.NewTestIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherIdentity, err := testidentity.NewTestIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := &pb.Node{Id: senderIdentity.ID, Address: &pb.NodeAddress{Address: "127.0.0.1:7777"}}
+	spoofed := &pb.Node{Id: senderIdentity.ID, Address: &pb.NodeAddress{Address: "10.0.0.1:7777"}}
+	moved := &pb.Node{Id: senderIdentity.ID, Address: &pb.NodeAddress{Address: "127.0.0.1:8888"}}
+
+	sign := func(identity *provider.FullIdentity, node *pb.Node, sequence int64) *pb.SignedNodeRecord {
+		record, err := NewSignedRecord(identity, *node, sequence)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return record
+	}
+	forged := sign(otherIdentity, sender, 5)
+
+	var known []*pb.Node
+
+	for _, tt := range []struct {
+		caseName string
+		identity *provider.FullIdentity
+		sender   *pb.Node
+		record   *pb.SignedNodeRecord
+		code     codes.Code
+	}{
+		{"valid record", senderIdentity, sender, sign(senderIdentity, sender, 5), codes.OK},
+		{"same sequence again", senderIdentity, sender, sign(senderIdentity, sender, 5), codes.OK},
+		{"same claim signed earlier", senderIdentity, sender, sign(senderIdentity, sender, 3), codes.OK},
+		{"unsigned record", senderIdentity, sender, nil, codes.Unauthenticated},
+		{"signed by another node", senderIdentity, sender, forged, codes.Unauthenticated},
+		{"record of another node", otherIdentity, sender, forged, codes.Unauthenticated},
+		{"spoofed address", senderIdentity, spoofed, sign(senderIdentity, sender, 6), codes.PermissionDenied},
+		{"moved address", senderIdentity, moved, sign(senderIdentity, moved, 7), codes.OK},
+		{"stale record", senderIdentity, sender, sign(senderIdentity, sender, 6), codes.InvalidArgument},
+		{"newer record", senderIdentity, sender, sign(senderIdentity, sender, 8), codes.OK},
+	} {
+		mockDHT.EXPECT().GetRoutingTable(gomock.Any()).Return(mockRT, nil)
+		if tt.code == codes.OK || tt.code == codes.InvalidArgument {
+			// the routing table keeps the latest record of the sender
+			mockRT.EXPECT().FindNear(gomock.Any(), 1).Return(known, nil)
+		}
+		if tt.code == codes.OK {
+			mockDHT.EXPECT().Ping(gomock.Any(), gomock.Any()).Return(*tt.sender, nil)
+			mockRT.EXPECT().ConnectionSuccess(gomock.Any()).DoAndReturn(func(node *pb.Node) error {
+				assert.Equal(t, tt.record, node.Record, tt.caseName)
+				known = []*pb.Node{node}
+				return nil
+			})
+			mockRT.EXPECT().FindNear(gomock.Any(), 2).Return(nil, nil)
+		}
+
+		req := &pb.QueryRequest{Pingback: true, Sender: tt.sender, SenderRecord: tt.record, Target: &pb.Node{}, Limit: 2}
+		_, err := s.Query(peerContext(tt.identity), req)
+		assert.Equal(t, tt.code, status.Code(err), tt.caseName)
+	}
+}
+
+func TestQueryResponderRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDHT := mock_dht.NewMockDHT(ctrl)
+	mockRT := mock_dht.NewMockRoutingTable(ctrl)
+
+	identity, err := testidentity.NewTestIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewServer(mockDHT, identity)
+	self := pb.Node{Id: identity.ID, Address: &pb.NodeAddress{Address: "127.0.0.1:7777"}}
+
+	var records []*pb.SignedNodeRecord
+	for i := 0; i < 2; i++ {
+		mockDHT.EXPECT().GetRoutingTable(gomock.Any()).Return(mockRT, nil)
+		mockRT.EXPECT().FindNear(gomock.Any(), 2).Return(nil, nil)
+		mockRT.EXPECT().Local().Return(self)
+
+		res, err := s.Query(context.Background(), &pb.QueryRequest{Target: &pb.Node{}, Limit: 2})
+		if !assert.NoError(t, err) {
+			return
+		}
+		responder := self
+		responder.Record = res.ResponderRecord
+		assert.NoError(t, VerifyNode(&responder))
+		records = append(records, res.ResponderRecord)
+	}
+	// the record is only signed again when the node changes
+	assert.Equal(t, records[0], records[1])
+}
+
+func TestExternalAddress(t *testing.T) {
+	s := NewServer(nil, nil)
+	senderIdentity, err := testidentity.NewTestIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, _ := peer.FromContext(peerContext(senderIdentity))
+	p.Addr = &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 51234}
+	ctx := peer.NewContext(context.Background(), p)
+
+	res, err := s.ExternalAddress(ctx, &pb.ExternalAddressRequest{Port: 7777})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.ExternalAddressResponse{Address: "203.0.113.7:7777"}, res)
+
+	res, err = s.ExternalAddress(ctx, &pb.ExternalAddressRequest{Port: 7777, Dialback: true})
+	assert.NoError(t, err)
+	assert.False(t, res.Reachable)
+	assert.NotEmpty(t, res.DialbackError)
+
+	_, err = s.ExternalAddress(ctx, &pb.ExternalAddressRequest{Port: 0})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -5,16 +5,18 @@ package overlay
 
 import (
 	"context"
+	"time"
 
-	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
 	"czarcoin.org/czarcoin/pkg/dht"
+	"czarcoin.org/czarcoin/pkg/node"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/statdb"
+	"czarcoin.org/czarcoin/pkg/version"
 	statproto "czarcoin.org/czarcoin/pkg/statdb/proto"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
-	"czarcoin.org/czarcoin/storage"
 )
 
 const (
@@ -31,33 +33,25 @@ var ErrBucketNotFound = errs.New("Bucket not found")
 // OverlayError creates class of errors for stack traces
 var OverlayError = errs.Class("Overlay Error")
 
-// Cache is used to store overlay data in Redis
+// Cache is used to store overlay data
 type Cache struct {
-	DB     storage.KeyValueStore
+	DB     DB
 	DHT    dht.DHT
 	StatDB *statdb.StatDB
+	// Crawler contacts nodes on Bootstrap and Walk, crawling is disabled when nil
+	Crawler *Crawler
+	// MinVersions are the versions nodes need to run to be selected or returned for downloads
+	MinVersions version.Minimums
 }
 
 // NewOverlayCache returns a new Cache
-func NewOverlayCache(db storage.KeyValueStore, dht dht.DHT, sdb *statdb.StatDB) *Cache {
+func NewOverlayCache(db DB, dht dht.DHT, sdb *statdb.StatDB) *Cache {
 	return &Cache{DB: db, DHT: dht, StatDB: sdb}
 }
 
 // Get looks up the provided nodeID from the overlay cache
 func (o *Cache) Get(ctx context.Context, nodeID czarcoin.NodeID) (*pb.Node, error) {
-	b, err := o.DB.Get(nodeID.Bytes())
-	if err != nil {
-		return nil, err
-	}
-	if b.IsZero() {
-		// TODO: log? return an error?
-		return nil, nil
-	}
-	na := &pb.Node{}
-	if err := proto.Unmarshal(b, na); err != nil {
-		return nil, err
-	}
-	return na, nil
+	return o.DB.Get(ctx, nodeID)
 }
 
 // GetAll looks up the provided nodeIDs from the overlay cache
@@ -65,28 +59,7 @@ func (o *Cache) GetAll(ctx context.Context, nodeIDs czarcoin.NodeIDList) ([]*pb.
 	if len(nodeIDs) == 0 {
 		return nil, OverlayError.New("no nodeIDs provided")
 	}
-	var ks storage.Keys
-	for _, v := range nodeIDs {
-		ks = append(ks, v.Bytes())
-	}
-	vs, err := o.DB.GetAll(ks)
-	if err != nil {
-		return nil, err
-	}
-	var ns []*pb.Node
-	for _, v := range vs {
-		if v == nil {
-			ns = append(ns, nil)
-			continue
-		}
-		na := &pb.Node{}
-		err := proto.Unmarshal(v, na)
-		if err != nil {
-			return nil, OverlayError.New("could not unmarshal non-nil node: %v", err)
-		}
-		ns = append(ns, na)
-	}
-	return ns, nil
+	return o.DB.GetAll(ctx, nodeIDs)
 }
 
 // Put adds a nodeID to the redis cache with a binary representation of proto defined Node
@@ -107,50 +80,45 @@ func (o *Cache) Put(ctx context.Context, nodeID czarcoin.NodeID, value pb.Node)
 		return err
 	}
 	stats := res.Stats
+
+	// keep the timestamps of the cached entry
+	previous, err := o.Get(ctx, nodeID)
+	if err == nil && previous != nil {
+		value.FirstSeenUnixSec = previous.FirstSeenUnixSec
+		if previous.LastContactUnixSec > value.LastContactUnixSec {
+			value.LastContactUnixSec = previous.LastContactUnixSec
+		}
+	}
+	if value.FirstSeenUnixSec == 0 {
+		value.FirstSeenUnixSec = time.Now().Unix()
+	}
 	value.Reputation = &pb.NodeStats{
 		AuditSuccessRatio: stats.AuditSuccessRatio,
 		AuditCount:        stats.AuditCount,
 		UptimeRatio:       stats.UptimeRatio,
 		UptimeCount:       stats.UptimeCount,
+		AuditReputation:   stats.AuditReputation,
+		UptimeReputation:  stats.UptimeReputation,
+		Vetted:            stats.Vetted,
+		Disqualified:      stats.Disqualified,
 	}
 
-	data, err := proto.Marshal(&value)
-	if err != nil {
-		return err
-	}
-
-	return o.DB.Put(nodeID.Bytes(), data)
-}
-
-// Bootstrap walks the initialized network and populates the cache
-func (o *Cache) Bootstrap(ctx context.Context) error {
-	// TODO(coyle): make Bootstrap work
-	// look in our routing table
-	// get every node we know about
-	// ask every node for every node they know about
-	// for each newly known node, ask those nodes for every node they know about
-	// continue until no new nodes are found
-	return nil
+	return o.DB.Update(ctx, &value)
 }
 
-// Refresh updates the cache db with the current DHT.
-// We currently do not penalize nodes that are unresponsive,
-// but should in the future.
+// Refresh updates the cache db with the current DHT
 func (o *Cache) Refresh(ctx context.Context) error {
-	// TODO(coyle): make refresh work by looking on the network for new ndoes
 	nodes := o.DHT.Seen()
 
 	for _, v := range nodes {
+		// only nodes which signed what they claim are cached
+		if err := node.VerifyNode(v); err != nil {
+			zap.L().Debug("not caching unverified node", zap.String("nodeID", v.Id.String()), zap.Error(err))
+			continue
+		}
 		if err := o.Put(ctx, v.Id, *v); err != nil {
 			return err
 		}
 	}
-
-	return nil
-}
-
-// Walk iterates over each node in each bucket to traverse the network
-func (o *Cache) Walk(ctx context.Context) error {
-	// TODO: This should walk the cache, rather than be a duplicate of refresh
 	return nil
 }
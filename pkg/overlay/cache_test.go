@@ -13,6 +13,7 @@ import (
 	"czarcoin.org/czarcoin/internal/testplanet"
 	"czarcoin.org/czarcoin/internal/testczarcoin"
 	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/statdb"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/storage"
@@ -30,7 +31,7 @@ var (
 )
 
 func testCache(ctx context.Context, t *testing.T, store storage.KeyValueStore, sdb *statdb.StatDB) {
-	cache := overlay.Cache{DB: store, StatDB: sdb}
+	cache := overlay.Cache{DB: overlay.NewKeyValueDB(store), StatDB: sdb}
 
 	{ // Put
 		err := cache.Put(ctx, valid1ID, *testczarcoin.MockNode("valid1"))
@@ -165,3 +166,83 @@ func TestCache_Refresh(t *testing.T) {
 	err = planet.Satellites[0].Overlay.Refresh(ctx)
 	assert.NoError(t, err)
 }
+
+// deletingStore deletes the last key of the first listing before its value is
+// read, like the crawler evicting a node while the cache is listed
+type deletingStore struct {
+	storage.KeyValueStore
+	deleted bool
+}
+
+func (store *deletingStore) List(first storage.Key, limit int) (storage.Keys, error) {
+	keys, err := store.KeyValueStore.List(first, limit)
+	if err != nil || len(keys) == 0 || store.deleted {
+		return keys, err
+	}
+	store.deleted = true
+	return keys, store.KeyValueStore.Delete(keys[len(keys)-1])
+}
+
+func TestIterateNodes_Deleted(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := teststore.New()
+	db := overlay.NewKeyValueDB(store)
+
+	// more nodes than fit in a page
+	var expected czarcoin.NodeIDList
+	for i := 1; i <= 1500; i++ {
+		id := czarcoin.NodeID{byte(i >> 8), byte(i)}
+		assert.NoError(t, db.Update(ctx, &pb.Node{Id: id}))
+		if i != 1000 {
+			expected = append(expected, id)
+		}
+	}
+
+	var ids czarcoin.NodeIDList
+	err := overlay.IterateNodes(ctx, overlay.NewKeyValueDB(&deletingStore{KeyValueStore: store}), func(nodes []*pb.Node) error {
+		for _, node := range nodes {
+			if assert.NotNil(t, node) {
+				ids = append(ids, node.Id)
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ids)
+}
+
+func TestFindStorageNodes_Limit(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db := overlay.NewKeyValueDB(teststore.New())
+
+	// more nodes than fit in a page, every other one vetted
+	for i := 1; i <= 1500; i++ {
+		id := czarcoin.NodeID{byte(i >> 8), byte(i)}
+		node := &pb.Node{Id: id, Type: pb.NodeType_STORAGE, Reputation: &pb.NodeStats{Vetted: i%2 == 0}}
+		assert.NoError(t, db.Update(ctx, node))
+	}
+
+	nodes, err := db.FindStorageNodes(ctx, &overlay.NodeCriteria{Vetted: true, Limit: 10})
+	if assert.NoError(t, err) && assert.Len(t, nodes, 10) {
+		for _, node := range nodes {
+			assert.True(t, node.GetReputation().GetVetted())
+		}
+	}
+
+	// a limit above the matching nodes returns each of them once, wherever the sample starts
+	excluded := czarcoin.NodeID{0, 1}
+	nodes, err = db.FindStorageNodes(ctx, &overlay.NodeCriteria{Unvetted: true, Excluded: czarcoin.NodeIDList{excluded}, Limit: 2000})
+	if assert.NoError(t, err) && assert.Len(t, nodes, 749) {
+		seen := map[czarcoin.NodeID]bool{}
+		for _, node := range nodes {
+			assert.False(t, node.GetReputation().GetVetted())
+			assert.False(t, seen[node.Id])
+			seen[node.Id] = true
+		}
+		assert.False(t, seen[excluded])
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gtank/cryptopasta"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
+	statproto "czarcoin.org/czarcoin/pkg/statdb/proto"
+)
+
+const (
+	// maxCheckInSkew is how far the timestamp of a check-in may be off
+	maxCheckInSkew = 10 * time.Minute
+	// pingbackTimeout is how long the satellite waits for a node to answer the pingback
+	pingbackTimeout = 10 * time.Second
+)
+
+// CheckInConfig is a configuration struct for the check-ins of storage nodes
+type CheckInConfig struct {
+	Interval time.Duration `help:"the minimum time between two check-ins of a node, more frequent check-ins are rejected" default:"1m"`
+}
+
+// WalletDB stores the wallets storage nodes report in their check-ins, which
+// are signed by the nodes, so payouts don't rely on the overlay cache
+type WalletDB interface {
+	// SetWallet saves the wallet of the node
+	SetWallet(ctx context.Context, nodeID czarcoin.NodeID, wallet string) error
+	// GetWallets returns the wallets of the nodes, nodes without one are left out
+	GetWallets(ctx context.Context, nodeIDs czarcoin.NodeIDList) (map[czarcoin.NodeID]string, error)
+}
+
+// SetWalletDB makes check-ins save the wallets of the nodes in wallets
+func (o *Server) SetWalletDB(wallets WalletDB) {
+	o.wallets = wallets
+}
+
+// CheckIn verifies a signed check-in of a storage node, pings the node back and
+// records its capacity in the overlay cache and its uptime in statdb
+func (o *Server) CheckIn(ctx context.Context, req *pb.CheckInRequest) (resp *pb.CheckInResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := o.verifyCheckIn(ctx, req)
+	if err != nil {
+		o.logger.Debug("rejected check-in", zap.Error(err))
+		return nil, err
+	}
+	nodeID := info.Node.Id
+
+	// every check-in records an uptime result and dials the node back, so
+	// nodes can't check in more often than the interval
+	if !o.allowCheckIn(nodeID, time.Now()) {
+		return nil, status.Errorf(codes.ResourceExhausted, "nodes can check in at most every %v", o.checkIn.Interval)
+	}
+
+	metadata := &pb.NodeMetadata{Version: info.Version}
+	if m := info.Node.GetMetadata(); m != nil {
+		metadata.Email, metadata.Wallet = m.Email, m.Wallet
+	}
+	if o.wallets != nil && metadata.Wallet != "" {
+		if err := o.wallets.SetWallet(ctx, nodeID, metadata.Wallet); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	node := pb.Node{
+		Id:           nodeID,
+		Type:         pb.NodeType_STORAGE,
+		Address:      info.Node.GetAddress(),
+		Restrictions: info.Capacity,
+		Metadata:     metadata,
+	}
+
+	resp = &pb.CheckInResponse{PingbackSuccess: true}
+	pingCtx, cancel := context.WithTimeout(ctx, pingbackTimeout)
+	_, err = o.dht.Ping(pingCtx, node)
+	cancel()
+	if err != nil {
+		resp.PingbackSuccess = false
+		resp.PingbackError = err.Error()
+	} else {
+		// the self reported address is only trusted once the node answered
+		// on it, otherwise the previous record is kept
+		node.LastContactUnixSec = time.Now().Unix()
+		if err := o.cache.Put(ctx, nodeID, node); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	_, err = o.cache.StatDB.UpdateUptime(ctx, &statproto.UpdateUptimeRequest{
+		Node: &pb.Node{Id: nodeID, IsUp: resp.PingbackSuccess},
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	o.logger.Debug("node checked in",
+		zap.String("nodeID", nodeID.String()),
+		zap.String("version", info.Version),
+		zap.Bool("pingback", resp.PingbackSuccess))
+	return resp, nil
+}
+
+// verifyCheckIn checks that the check-in was signed recently by the node
+// which sent it
+func (o *Server) verifyCheckIn(ctx context.Context, req *pb.CheckInRequest) (*pb.CheckInInfo, error) {
+	peer, err := provider.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	info := &pb.CheckInInfo{}
+	if err := proto.Unmarshal(req.GetInfo(), info); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if info.Node == nil || info.Node.Id != peer.ID {
+		return nil, status.Error(codes.PermissionDenied, "check-in is not for the calling node")
+	}
+
+	key, ok := peer.Leaf.PublicKey.(*ecdsa.PublicKey)
+	if !ok || !cryptopasta.Verify(req.GetInfo(), req.GetSignature(), key) {
+		return nil, status.Error(codes.Unauthenticated, "invalid check-in signature")
+	}
+
+	skew := time.Since(time.Unix(info.TimestampUnixSec, 0))
+	if skew > maxCheckInSkew || skew < -maxCheckInSkew {
+		return nil, status.Errorf(codes.InvalidArgument, "check-in timestamp is off by %v", skew)
+	}
+	return info, nil
+}
+
+// allowCheckIn returns whether the interval elapsed since the last check-in
+// of the node and records the check-in when it did, check-ins older than the
+// interval are dropped once per interval so only recent ones are kept
+func (o *Server) allowCheckIn(nodeID czarcoin.NodeID, now time.Time) bool {
+	o.checkInMu.Lock()
+	defer o.checkInMu.Unlock()
+
+	if now.Sub(o.lastCheckInSweep) >= o.checkIn.Interval {
+		for id, last := range o.lastCheckIn {
+			if now.Sub(last) >= o.checkIn.Interval {
+				delete(o.lastCheckIn, id)
+			}
+		}
+		o.lastCheckInSweep = now
+	}
+
+	if last, ok := o.lastCheckIn[nodeID]; ok && now.Sub(last) < o.checkIn.Interval {
+		return false
+	}
+	o.lastCheckIn[nodeID] = now
+	return true
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+)
+
+func TestAllowCheckIn(t *testing.T) {
+	server := NewServer(zap.NewNop(), nil, nil, NodeSelectionConfig{}, CheckInConfig{Interval: time.Hour})
+
+	now := time.Now()
+	first, second := testczarcoin.NodeIDFromString("first"), testczarcoin.NodeIDFromString("second")
+
+	assert.True(t, server.allowCheckIn(first, now))
+	// check-ins in a loop are rejected until the interval elapsed
+	assert.False(t, server.allowCheckIn(first, now.Add(time.Minute)))
+	assert.False(t, server.allowCheckIn(first, now.Add(59*time.Minute)))
+	assert.True(t, server.allowCheckIn(second, now.Add(30*time.Minute)))
+	assert.True(t, server.allowCheckIn(first, now.Add(time.Hour)))
+
+	// check-ins older than the interval are forgotten
+	assert.True(t, server.allowCheckIn(first, now.Add(3*time.Hour)))
+	assert.Len(t, server.lastCheckIn, 1)
+	assert.True(t, server.allowCheckIn(second, now.Add(3*time.Hour)))
+	assert.False(t, server.allowCheckIn(second, now.Add(3*time.Hour+time.Minute)))
+}
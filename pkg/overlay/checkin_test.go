@@ -0,0 +1,109 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testplanet"
+	"czarcoin.org/czarcoin/pkg/auth"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
+)
+
+func TestCheckIn(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	satellite := planet.Satellites[0]
+	storageNode := planet.StorageNodes[0]
+
+	conn, err := storageNode.Transport.DialNode(ctx, &satellite.Info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(conn.Close)
+	client := pb.NewCheckInClient(conn)
+
+	signedRequest := func(identity *provider.FullIdentity, info *pb.CheckInInfo) *pb.CheckInRequest {
+		data, err := proto.Marshal(info)
+		assert.NoError(t, err)
+		signature, err := auth.GenerateSignature(data, identity)
+		assert.NoError(t, err)
+		return &pb.CheckInRequest{Info: data, Signature: signature}
+	}
+
+	info := &pb.CheckInInfo{
+		Node:             &storageNode.Info,
+		Capacity:         &pb.NodeRestrictions{FreeBandwidth: 1000, FreeDisk: 2000},
+		Version:          "v1.0.0",
+		TimestampUnixSec: time.Now().Unix(),
+	}
+
+	{ // valid check-in
+		resp, err := client.CheckIn(ctx, signedRequest(storageNode.Identity, info))
+		if assert.NoError(t, err) {
+			assert.True(t, resp.PingbackSuccess, resp.PingbackError)
+		}
+
+		node, err := satellite.Overlay.Get(ctx, storageNode.ID())
+		if assert.NoError(t, err) {
+			assert.EqualValues(t, 2000, node.GetRestrictions().GetFreeDisk())
+			assert.EqualValues(t, 1000, node.GetRestrictions().GetFreeBandwidth())
+			assert.NotZero(t, node.LastContactUnixSec)
+			assert.Equal(t, "v1.0.0", node.GetMetadata().GetVersion())
+		}
+	}
+
+	{ // an unreachable address doesn't replace the cached one
+		self := storageNode.Info
+		self.Address = &pb.NodeAddress{Address: "127.0.0.1:1"}
+		unreachable := *info
+		unreachable.Node = &self
+		resp, err := client.CheckIn(ctx, signedRequest(storageNode.Identity, &unreachable))
+		if assert.NoError(t, err) {
+			assert.False(t, resp.PingbackSuccess)
+		}
+
+		node, err := satellite.Overlay.Get(ctx, storageNode.ID())
+		if assert.NoError(t, err) {
+			assert.Equal(t, storageNode.Info.GetAddress().GetAddress(), node.GetAddress().GetAddress())
+		}
+	}
+
+	{ // signed by a different node
+		req := signedRequest(planet.StorageNodes[1].Identity, info)
+		_, err := client.CheckIn(ctx, req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	{ // check-in for a different node
+		other := *info
+		other.Node = &planet.StorageNodes[1].Info
+		_, err := client.CheckIn(ctx, signedRequest(storageNode.Identity, &other))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+
+	{ // stale check-in
+		stale := *info
+		stale.TimestampUnixSec = time.Now().Add(-time.Hour).Unix()
+		_, err := client.CheckIn(ctx, signedRequest(storageNode.Identity, &stale))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
@@ -41,9 +41,9 @@ type Overlay struct {
 type Options struct {
 	Amount       int
 	Space        int64
-	Uptime       float64
+	Uptime       float64 // minimum uptime score
 	UptimeCount  int64
-	AuditSuccess float64
+	AuditSuccess float64 // minimum audit score
 	AuditCount   int64
 	Excluded     czarcoin.NodeIDList
 }
@@ -77,10 +77,10 @@ func (o *Overlay) Choose(ctx context.Context, op Options) ([]*pb.Node, error) {
 			Amount:       int64(op.Amount),
 			Restrictions: &pb.NodeRestrictions{FreeDisk: op.Space},
 			MinStats: &pb.NodeStats{
-				UptimeRatio:       op.Uptime,
-				UptimeCount:       op.UptimeCount,
-				AuditSuccessRatio: op.AuditSuccess,
-				AuditCount:        op.AuditCount,
+				UptimeReputation: op.Uptime,
+				UptimeCount:      op.UptimeCount,
+				AuditReputation:  op.AuditSuccess,
+				AuditCount:       op.AuditCount,
 			},
 			ExcludedNodes: exIDs,
 		},
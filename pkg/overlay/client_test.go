@@ -118,9 +118,9 @@ func TestChoose(t *testing.T) {
 			assert.NotContains(t, excludedNodes, n.Id)
 			assert.True(t, n.GetRestrictions().GetFreeDisk() >= v.space)
 			assert.True(t, n.GetRestrictions().GetFreeBandwidth() >= v.bandwidth)
-			assert.True(t, n.GetReputation().GetUptimeRatio() >= v.uptime)
+			assert.True(t, n.GetReputation().GetUptimeReputation() >= v.uptime)
 			assert.True(t, n.GetReputation().GetUptimeCount() >= v.uptimeCount)
-			assert.True(t, n.GetReputation().GetAuditSuccessRatio() >= v.auditSuccess)
+			assert.True(t, n.GetReputation().GetAuditReputation() >= v.auditSuccess)
 			assert.True(t, n.GetReputation().GetAuditCount() >= v.auditCount)
 
 		}
@@ -13,11 +13,13 @@ import (
 
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 	"czarcoin.org/czarcoin/pkg/kademlia"
+	"czarcoin.org/czarcoin/pkg/node"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/provider"
 	"czarcoin.org/czarcoin/pkg/statdb"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/pkg/version"
 	"czarcoin.org/czarcoin/storage"
 	"czarcoin.org/czarcoin/storage/boltdb"
 	"czarcoin.org/czarcoin/storage/redis"
@@ -33,7 +35,12 @@ var (
 // Overlay cache responsibility.
 type Config struct {
 	DatabaseURL     string        `help:"the database connection string to use" default:"bolt://$CONFDIR/overlay.db"`
+	SatelliteDB     bool          `help:"store the overlay cache in the satellite database, importing database-url once when it's empty" default:"false"`
 	RefreshInterval time.Duration `help:"the interval at which the cache refreshes itself in seconds" default:"1s"`
+	Node            NodeSelectionConfig
+	CheckIn         CheckInConfig
+	Crawler         CrawlerConfig
+	MinVersion      version.MinimumConfig
 }
 
 // LookupConfig is a configuration struct for querying the overlay cache with one or more node IDs
@@ -66,38 +73,48 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (
 		return Error.New("programmer error: statdb responsibility unstarted")
 	}
 
-	dburl, err := utils.ParseURL(c.DatabaseURL)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	var db storage.KeyValueStore
-
-	switch dburl.Scheme {
-	case "bolt":
-		db, err = boltdb.New(dburl.Path, OverlayBucket)
-		if err != nil {
+	var db DB
+	if c.SatelliteDB {
+		masterdb, ok := ctx.Value("masterdb").(interface{ OverlayCacheDB() DB })
+		if !ok {
+			return Error.New("unable to get satellite master db instance")
+		}
+		db = masterdb.OverlayCacheDB()
+		if err := c.importOnce(ctx, db); err != nil {
 			return err
 		}
-		zap.S().Info("Starting overlay cache with BoltDB")
-	case "redis":
-		db, err = redis.NewClientFrom(c.DatabaseURL)
+		zap.S().Info("Starting overlay cache with the satellite database")
+	} else {
+		store, err := openKeyValueStore(c.DatabaseURL)
 		if err != nil {
 			return err
 		}
-		zap.S().Info("Starting overlay cache with Redis")
-	default:
-		return Error.New("database scheme not supported: %s", dburl.Scheme)
+		db = NewKeyValueDB(store)
 	}
 
 	cache := NewOverlayCache(db, kad, sdb)
+	cache.MinVersions, err = c.MinVersion.Parse()
+	if err != nil {
+		return err
+	}
+
+	rt, err := kad.GetRoutingTable(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	client, err := node.NewNodeClient(server.Identity(), rt.Local(), kad)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = utils.CombineErrors(err, client.Disconnect()) }()
+	cache.Crawler = NewCrawler(client, c.Crawler)
 
 	go func() {
-		err = cache.Bootstrap(ctx)
-		if err != nil {
-			panic(err)
+		if err := cache.Bootstrap(ctx); err != nil && ctx.Err() == nil {
+			zap.L().Error("Error with cache bootstrap: ", zap.Error(err))
 		}
 	}()
+	go cache.RunWalk(ctx)
 
 	ticker := time.NewTicker(c.RefreshInterval)
 	defer ticker.Stop()
@@ -116,14 +133,68 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (
 		}
 	}()
 
-	srv := NewServer(zap.L(), cache, kad)
+	srv := NewServer(zap.L(), cache, kad, c.Node, c.CheckIn)
+	if masterdb, ok := ctx.Value("masterdb").(interface{ NodeWallets() WalletDB }); ok {
+		srv.SetWalletDB(masterdb.NodeWallets())
+	}
 	pb.RegisterOverlayServer(server.GRPC(), srv)
+	pb.RegisterCheckInServer(server.GRPC(), srv)
 
 	ctx2 := context.WithValue(ctx, ctxKeyOverlay, cache)
 	ctx2 = context.WithValue(ctx2, ctxKeyOverlayServer, srv)
 	return server.Run(ctx2)
 }
 
+// openKeyValueStore opens the bolt or redis overlay cache at databaseURL
+func openKeyValueStore(databaseURL string) (db storage.KeyValueStore, err error) {
+	dburl, err := utils.ParseURL(databaseURL)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	switch dburl.Scheme {
+	case "bolt":
+		db, err = boltdb.New(dburl.Path, OverlayBucket)
+		if err != nil {
+			return nil, err
+		}
+		zap.S().Info("Starting overlay cache with BoltDB")
+	case "redis":
+		db, err = redis.NewClientFrom(databaseURL)
+		if err != nil {
+			return nil, err
+		}
+		zap.S().Info("Starting overlay cache with Redis")
+	default:
+		return nil, Error.New("database scheme not supported: %s", dburl.Scheme)
+	}
+	return db, nil
+}
+
+// importOnce imports the bolt or redis overlay cache into db when db is empty
+func (c Config) importOnce(ctx context.Context, db DB) (err error) {
+	if c.DatabaseURL == "" {
+		return nil
+	}
+	count, err := db.Count(ctx)
+	if err != nil || count > 0 {
+		return Error.Wrap(err)
+	}
+
+	store, err := openKeyValueStore(c.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, store.Close()) }()
+
+	imported, err := Import(ctx, store, db)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	zap.S().Infof("Imported %d nodes into the overlay cache", imported)
+	return nil
+}
+
 // LoadFromContext gives access to the cache from the context, or returns nil
 func LoadFromContext(ctx context.Context) *Cache {
 	if v, ok := ctx.Value(ctxKeyOverlay).(*Cache); ok {
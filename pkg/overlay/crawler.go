@@ -0,0 +1,293 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/node"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+// CrawlerConfig is a configuration struct for crawling the network
+type CrawlerConfig struct {
+	WalkInterval time.Duration `help:"the interval at which the kademlia buckets are walked and stale nodes are pinged" default:"10m"`
+	StaleAfter   time.Duration `help:"how long after the last contact a node is pinged again" default:"1h"`
+	EvictAfter   time.Duration `help:"how long a node can stay unreachable before it is removed from the cache" default:"24h"`
+	RateLimit    float64       `help:"the maximum number of lookups and pings per second" default:"10"`
+	Timeout      time.Duration `help:"how long to wait for a node to answer a lookup or ping" default:"10s"`
+}
+
+// Crawler contacts nodes to discover the network and to check the cached nodes
+type Crawler struct {
+	client  node.Client
+	config  CrawlerConfig
+	limiter *rate.Limiter
+
+	mu sync.Mutex
+	// evicted are the nodes evicted within EvictAfter, which the crawler
+	// doesn't add back when other nodes still know them
+	evicted map[czarcoin.NodeID]time.Time
+}
+
+// NewCrawler creates a crawler which contacts nodes through client
+func NewCrawler(client node.Client, config CrawlerConfig) *Crawler {
+	return &Crawler{
+		client:  client,
+		config:  config,
+		limiter: rate.NewLimiter(rate.Limit(config.RateLimit), 1),
+		evicted: map[czarcoin.NodeID]time.Time{},
+	}
+}
+
+// evict records that the node was evicted and forgets the evictions older
+// than EvictAfter
+func (c *Crawler) evict(id czarcoin.NodeID, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for evictedID, evictedAt := range c.evicted {
+		if now.Sub(evictedAt) >= c.config.EvictAfter {
+			delete(c.evicted, evictedID)
+		}
+	}
+	c.evicted[id] = now
+}
+
+// recentlyEvicted returns whether the node was evicted within EvictAfter
+func (c *Crawler) recentlyEvicted(id czarcoin.NodeID, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	evictedAt, ok := c.evicted[id]
+	return ok && now.Sub(evictedAt) < c.config.EvictAfter
+}
+
+// Bootstrap walks the initialized network and populates the cache
+func (o *Cache) Bootstrap(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if o.Crawler == nil {
+		return nil
+	}
+
+	rt, err := o.DHT.GetRoutingTable(ctx)
+	if err != nil {
+		return OverlayError.Wrap(err)
+	}
+	self := rt.Local().Id
+
+	queue, err := o.routingTableNodes(ctx)
+	if err != nil {
+		return err
+	}
+	queue = append(queue, o.DHT.Seen()...)
+
+	// ask every node about its neighbors until no new nodes are found
+	visited := map[czarcoin.NodeID]bool{self: true}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if n == nil || n.Id == (czarcoin.NodeID{}) || visited[n.Id] {
+			continue
+		}
+		visited[n.Id] = true
+
+		neighbors, err := o.lookup(ctx, n, n.Id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		}
+		queue = append(queue, neighbors...)
+	}
+
+	return nil
+}
+
+// RunWalk walks the network every WalkInterval until ctx is canceled. Walks
+// are rate limited and run apart from Refresh, so a slow crawl doesn't delay
+// caching the nodes seen by kademlia.
+func (o *Cache) RunWalk(ctx context.Context) {
+	if o.Crawler == nil {
+		return
+	}
+	ticker := time.NewTicker(o.Crawler.config.WalkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		if err := o.Walk(ctx); err != nil && ctx.Err() == nil {
+			zap.L().Warn("Failed to walk the network", zap.Error(err))
+		}
+	}
+}
+
+// Walk looks up every kademlia bucket at the nodes of the bucket, then pings
+// the cached nodes which weren't contacted recently and evicts the unreachable ones
+func (o *Cache) Walk(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if o.Crawler == nil {
+		return nil
+	}
+
+	rt, err := o.DHT.GetRoutingTable(ctx)
+	if err != nil {
+		return OverlayError.Wrap(err)
+	}
+	bucketIDs, err := rt.GetBucketIds()
+	if err != nil {
+		return OverlayError.Wrap(err)
+	}
+
+	for _, bucketID := range bucketIDs {
+		target, err := czarcoin.NodeIDFromBytes(bucketID)
+		if err != nil {
+			continue
+		}
+		bucket, ok := rt.GetBucket(target)
+		if !ok {
+			continue
+		}
+		for _, n := range bucket.Nodes() {
+			if n.Id == rt.Local().Id {
+				continue
+			}
+			_, err := o.lookup(ctx, n, target)
+			if err != nil && ctx.Err() != nil {
+				return ctx.Err()
+			}
+		}
+	}
+
+	return o.pingStale(ctx)
+}
+
+// lookup asks to for the nodes near target and adds the unknown ones to the cache
+func (o *Cache) lookup(ctx context.Context, to *pb.Node, target czarcoin.NodeID) ([]*pb.Node, error) {
+	if err := o.Crawler.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, o.Crawler.config.Timeout)
+	neighbors, err := o.Crawler.client.Lookup(lookupCtx, *to, pb.Node{Id: target})
+	cancel()
+	if err != nil {
+		zap.L().Debug("lookup failed", zap.String("nodeID", to.Id.String()), zap.Error(err))
+		return nil, err
+	}
+	if err := o.contacted(ctx, to); err != nil {
+		return nil, err
+	}
+
+	for _, n := range neighbors {
+		if n == nil || n.Id == (czarcoin.NodeID{}) {
+			continue
+		}
+		if o.Crawler.recentlyEvicted(n.Id, time.Now()) {
+			continue
+		}
+		cached, err := o.DB.Get(ctx, n.Id)
+		if err == nil && cached != nil {
+			continue
+		}
+		if err := o.Put(ctx, n.Id, *n); err != nil {
+			return nil, err
+		}
+	}
+	return neighbors, nil
+}
+
+// pingStale pings the nodes which weren't contacted for StaleAfter and
+// deletes the ones which were unreachable for EvictAfter
+func (o *Cache) pingStale(ctx context.Context) (err error) {
+	now := time.Now()
+
+	rt, err := o.DHT.GetRoutingTable(ctx)
+	if err != nil {
+		return OverlayError.Wrap(err)
+	}
+
+	var stale []*pb.Node
+	err = IterateNodes(ctx, o.DB, func(nodes []*pb.Node) error {
+		for _, n := range nodes {
+			if n != nil && now.Sub(lastContact(n)) >= o.Crawler.config.StaleAfter {
+				stale = append(stale, n)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return OverlayError.Wrap(err)
+	}
+
+	for _, n := range stale {
+		if err := o.Crawler.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		pingCtx, cancel := context.WithTimeout(ctx, o.Crawler.config.Timeout)
+		ok, err := o.Crawler.client.Ping(pingCtx, *n)
+		cancel()
+		if err == nil && ok {
+			if err := o.contacted(ctx, n); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if now.Sub(lastContact(n)) >= o.Crawler.config.EvictAfter {
+			zap.L().Info("evicting unreachable node", zap.String("nodeID", n.Id.String()))
+			if err := o.DB.Delete(ctx, n.Id); err != nil {
+				return OverlayError.Wrap(err)
+			}
+			// otherwise Refresh would add it back from the routing table
+			if err := rt.ConnectionFailed(n); err != nil {
+				return OverlayError.Wrap(err)
+			}
+			o.Crawler.evict(n.Id, now)
+		}
+	}
+	return nil
+}
+
+// lastContact returns when the node was contacted last, or first seen
+// when it wasn't contacted yet
+func lastContact(n *pb.Node) time.Time {
+	if n.LastContactUnixSec == 0 {
+		return time.Unix(n.FirstSeenUnixSec, 0)
+	}
+	return time.Unix(n.LastContactUnixSec, 0)
+}
+
+// contacted records a successful contact with the node
+func (o *Cache) contacted(ctx context.Context, n *pb.Node) error {
+	contacted := *n
+	contacted.LastContactUnixSec = time.Now().Unix()
+	return o.Put(ctx, contacted.Id, contacted)
+}
+
+// routingTableNodes returns the nodes of all the kademlia buckets
+func (o *Cache) routingTableNodes(ctx context.Context) ([]*pb.Node, error) {
+	rt, err := o.DHT.GetRoutingTable(ctx)
+	if err != nil {
+		return nil, OverlayError.Wrap(err)
+	}
+	buckets, err := rt.GetBuckets()
+	if err != nil {
+		return nil, OverlayError.Wrap(err)
+	}
+
+	var nodes []*pb.Node
+	for _, bucket := range buckets {
+		nodes = append(nodes, bucket.Nodes()...)
+	}
+	return nodes, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/internal/testplanet"
+	"czarcoin.org/czarcoin/pkg/node"
+	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/storage/teststore"
+)
+
+func TestCrawler(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 4, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+	// we wait a second for all the nodes to complete bootstrapping off the satellite
+	time.Sleep(2 * time.Second)
+
+	satellite := planet.Satellites[0]
+	client, err := node.NewNodeClient(satellite.Identity, satellite.Info, satellite.Kademlia)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(client.Disconnect)
+
+	cache := overlay.NewOverlayCache(overlay.NewKeyValueDB(teststore.New()), satellite.Kademlia, satellite.StatDB)
+	cache.Crawler = overlay.NewCrawler(client, overlay.CrawlerConfig{
+		StaleAfter: 0,
+		EvictAfter: time.Hour,
+		RateLimit:  1000,
+		Timeout:    time.Second,
+	})
+
+	{ // Bootstrap
+		assert.NoError(t, cache.Bootstrap(ctx))
+		for _, storageNode := range planet.StorageNodes {
+			cached, err := cache.Get(ctx, storageNode.ID())
+			if assert.NoError(t, err) && assert.NotNil(t, cached) {
+				assert.NotZero(t, cached.FirstSeenUnixSec)
+				assert.NotZero(t, cached.LastContactUnixSec)
+			}
+		}
+	}
+
+	{ // Walk evicts nodes which are unreachable for too long
+		unreachable := testczarcoin.MockNode("unreachable")
+		unreachable.Address = &pb.NodeAddress{Address: "127.0.0.1:1"}
+		unreachable.LastContactUnixSec = time.Now().Add(-2 * time.Hour).Unix()
+		assert.NoError(t, cache.Put(ctx, unreachable.Id, *unreachable))
+
+		rt, err := satellite.Kademlia.GetRoutingTable(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NoError(t, rt.ConnectionSuccess(unreachable))
+
+		assert.NoError(t, cache.Walk(ctx))
+
+		_, err = cache.Get(ctx, unreachable.Id)
+		assert.Error(t, err)
+
+		// and refreshing doesn't add it back
+		assert.NoError(t, cache.Refresh(ctx))
+		_, err = cache.Get(ctx, unreachable.Id)
+		assert.Error(t, err)
+
+		for _, storageNode := range planet.StorageNodes {
+			cached, err := cache.Get(ctx, storageNode.ID())
+			if assert.NoError(t, err) {
+				assert.NotNil(t, cached)
+			}
+		}
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"crypto/rand"
+
+	"github.com/gogo/protobuf/proto"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/storage"
+)
+
+// DB implements the database for the overlay cache
+type DB interface {
+	// Get looks up the node by nodeID, it returns storage.ErrKeyNotFound
+	// when the node isn't cached
+	Get(ctx context.Context, nodeID czarcoin.NodeID) (*pb.Node, error)
+	// GetAll looks up the nodes by nodeIDs, nodes which aren't found are nil
+	GetAll(ctx context.Context, nodeIDs czarcoin.NodeIDList) ([]*pb.Node, error)
+	// List lists up to limit nodes in node ID order, starting with start
+	List(ctx context.Context, start czarcoin.NodeID, limit int) ([]*pb.Node, error)
+	// FindStorageNodes returns the storage nodes which meet the criteria, up
+	// to the limit of the criteria picked at random
+	FindStorageNodes(ctx context.Context, criteria *NodeCriteria) ([]*pb.Node, error)
+	// Count returns the number of cached nodes
+	Count(ctx context.Context) (int, error)
+	// Update creates or replaces the node
+	Update(ctx context.Context, node *pb.Node) error
+	// Delete deletes the node
+	Delete(ctx context.Context, nodeID czarcoin.NodeID) error
+}
+
+// NodeCriteria are the minimum requirements for storage nodes
+type NodeCriteria struct {
+	FreeBandwidth    int64
+	FreeDisk         int64
+	UptimeReputation float64
+	UptimeCount      int64
+	AuditReputation  float64
+	AuditCount       int64
+	Excluded         czarcoin.NodeIDList
+
+	// Vetted and Unvetted restrict the nodes to the vetted ones and to the
+	// ones which aren't vetted yet, setting neither includes both
+	Vetted   bool
+	Unvetted bool
+	// Limit is the number of nodes returned at most, 0 returns all of them
+	Limit int
+}
+
+// Matches returns whether the storage node meets the criteria, disqualified
+// nodes never do
+func (criteria *NodeCriteria) Matches(node *pb.Node) bool {
+	restrictions := node.GetRestrictions()
+	reputation := node.GetReputation()
+
+	return node.Type == pb.NodeType_STORAGE &&
+		restrictions.GetFreeBandwidth() >= criteria.FreeBandwidth &&
+		restrictions.GetFreeDisk() >= criteria.FreeDisk &&
+		!reputation.GetDisqualified() &&
+		!(criteria.Vetted && !reputation.GetVetted()) &&
+		!(criteria.Unvetted && reputation.GetVetted()) &&
+		reputation.GetUptimeReputation() >= criteria.UptimeReputation &&
+		reputation.GetUptimeCount() >= criteria.UptimeCount &&
+		reputation.GetAuditReputation() >= criteria.AuditReputation &&
+		reputation.GetAuditCount() >= criteria.AuditCount &&
+		!contains(criteria.Excluded, node.Id)
+}
+
+// keyValueDB stores the overlay cache as marshalled nodes in a key value store
+type keyValueDB struct {
+	store storage.KeyValueStore
+}
+
+// NewKeyValueDB returns an overlay cache database backed by store
+func NewKeyValueDB(store storage.KeyValueStore) DB {
+	return &keyValueDB{store: store}
+}
+
+// Get looks up the node by nodeID
+func (db *keyValueDB) Get(ctx context.Context, nodeID czarcoin.NodeID) (*pb.Node, error) {
+	value, err := db.store.Get(nodeID.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if value.IsZero() {
+		// TODO: log? return an error?
+		return nil, nil
+	}
+	node := &pb.Node{}
+	if err := proto.Unmarshal(value, node); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// GetAll looks up the nodes by nodeIDs, nodes which aren't found are nil
+func (db *keyValueDB) GetAll(ctx context.Context, nodeIDs czarcoin.NodeIDList) ([]*pb.Node, error) {
+	var keys storage.Keys
+	for _, id := range nodeIDs {
+		keys = append(keys, id.Bytes())
+	}
+	values, err := db.store.GetAll(keys)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalNodes(values)
+}
+
+// List lists up to limit nodes in node ID order, starting with start. Nodes
+// deleted between listing their keys and getting their values are skipped,
+// listing continues after the last listed key to fill the page.
+func (db *keyValueDB) List(ctx context.Context, start czarcoin.NodeID, limit int) ([]*pb.Node, error) {
+	var first storage.Key
+	if start != (czarcoin.NodeID{}) {
+		first = start.Bytes()
+	}
+	var nodes []*pb.Node
+	for len(nodes) < limit {
+		want := limit - len(nodes)
+		keys, err := db.store.List(first, want)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			return nodes, nil
+		}
+		values, err := db.store.GetAll(keys)
+		if err != nil {
+			return nil, err
+		}
+		page, err := unmarshalNodes(values)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range page {
+			if node != nil {
+				nodes = append(nodes, node)
+			}
+		}
+		if len(keys) < want {
+			return nodes, nil
+		}
+
+		// List includes first, so continue right after the last key
+		last, err := czarcoin.NodeIDFromBytes(keys[len(keys)-1])
+		if err != nil {
+			return nil, OverlayError.Wrap(err)
+		}
+		next := nextNodeID(last)
+		if next == (czarcoin.NodeID{}) {
+			return nodes, nil
+		}
+		first = next.Bytes()
+	}
+	return nodes, nil
+}
+
+// FindStorageNodes returns the storage nodes which meet the criteria, with a
+// limit it only lists nodes until enough of them matched, starting at a random
+// node ID and wrapping around
+func (db *keyValueDB) FindStorageNodes(ctx context.Context, criteria *NodeCriteria) (result []*pb.Node, err error) {
+	if criteria.Limit <= 0 {
+		err = IterateNodes(ctx, db, func(nodes []*pb.Node) error {
+			for _, node := range nodes {
+				if criteria.Matches(node) {
+					result = append(result, node)
+				}
+			}
+			return nil
+		})
+		return result, err
+	}
+
+	// node IDs are hashes, so the nodes following a random ID are a random sample
+	var start czarcoin.NodeID
+	_, _ = rand.Read(start[:])
+
+	first, wrapped := start, false
+	for {
+		listed, err := db.List(ctx, first, listLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range listed {
+			if wrapped && !node.Id.Less(start) {
+				return result, nil
+			}
+			if criteria.Matches(node) {
+				result = append(result, node)
+				if len(result) >= criteria.Limit {
+					return result, nil
+				}
+			}
+		}
+
+		if len(listed) == listLimit {
+			// List includes first, so continue right after the last node
+			first = nextNodeID(listed[len(listed)-1].Id)
+		}
+		if len(listed) < listLimit || first == (czarcoin.NodeID{}) {
+			if wrapped {
+				return result, nil
+			}
+			first, wrapped = czarcoin.NodeID{}, true
+		}
+	}
+}
+
+// Count returns the number of cached nodes
+func (db *keyValueDB) Count(ctx context.Context) (count int, err error) {
+	err = IterateNodes(ctx, db, func(nodes []*pb.Node) error {
+		count += len(nodes)
+		return nil
+	})
+	return count, err
+}
+
+// Update creates or replaces the node
+func (db *keyValueDB) Update(ctx context.Context, node *pb.Node) error {
+	data, err := proto.Marshal(node)
+	if err != nil {
+		return err
+	}
+	return db.store.Put(node.Id.Bytes(), data)
+}
+
+// Delete deletes the node
+func (db *keyValueDB) Delete(ctx context.Context, nodeID czarcoin.NodeID) error {
+	return db.store.Delete(nodeID.Bytes())
+}
+
+// IterateNodes calls fn with every page of nodes in db, nodes deleted while
+// iterating are left out
+func IterateNodes(ctx context.Context, db DB, fn func([]*pb.Node) error) error {
+	var start czarcoin.NodeID
+	for {
+		listed, err := db.List(ctx, start, listLimit)
+		if err != nil {
+			return err
+		}
+		nodes := make([]*pb.Node, 0, len(listed))
+		for _, node := range listed {
+			if node != nil {
+				nodes = append(nodes, node)
+			}
+		}
+		if len(nodes) > 0 {
+			if err := fn(nodes); err != nil {
+				return err
+			}
+		}
+		if len(listed) < listLimit || len(nodes) == 0 {
+			return nil
+		}
+		// List includes start, so continue right after the last node
+		start = nextNodeID(nodes[len(nodes)-1].Id)
+		if start == (czarcoin.NodeID{}) {
+			return nil
+		}
+	}
+}
+
+// nextNodeID returns the node ID which follows id
+func nextNodeID(id czarcoin.NodeID) czarcoin.NodeID {
+	for i := len(id) - 1; i >= 0; i-- {
+		id[i]++
+		if id[i] != 0 {
+			break
+		}
+	}
+	return id
+}
+
+// unmarshalNodes unmarshals the node values, keeping nil values as nil nodes
+func unmarshalNodes(values []storage.Value) ([]*pb.Node, error) {
+	var nodes []*pb.Node
+	for _, value := range values {
+		if value == nil {
+			nodes = append(nodes, nil)
+			continue
+		}
+		node := &pb.Node{}
+		if err := proto.Unmarshal(value, node); err != nil {
+			return nil, OverlayError.New("could not unmarshal non-nil node: %v", err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// Import copies the nodes of a bolt or redis overlay cache into db
+func Import(ctx context.Context, from storage.KeyValueStore, to DB) (imported int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = IterateNodes(ctx, NewKeyValueDB(from), func(nodes []*pb.Node) error {
+		for _, node := range nodes {
+			if node == nil {
+				continue
+			}
+			if err := to.Update(ctx, node); err != nil {
+				return err
+			}
+			imported++
+		}
+		return nil
+	})
+	return imported, OverlayError.Wrap(err)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"math/rand"
+	"net"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+// NodeSelectionConfig is a configuration struct for selecting the nodes of a new segment
+type NodeSelectionConfig struct {
+	DistinctIP        bool    `help:"require the selected nodes to be in distinct /24 (IPv4) or /64 (IPv6) subnets" default:"true"`
+	NewNodePercentage float64 `help:"the share of the selected nodes reserved for nodes which are not vetted yet" default:"0.05"`
+}
+
+// selectNodes picks amount nodes at random from the candidates, reserving a share
+// of the selection for new nodes and allowing at most one node per subnet
+func selectNodes(rng *rand.Rand, candidates []*pb.Node, amount int, config NodeSelectionConfig) []*pb.Node {
+	var vetted, unvetted []*pb.Node
+	for _, node := range candidates {
+		if node.GetReputation().GetVetted() {
+			vetted = append(vetted, node)
+		} else {
+			unvetted = append(unvetted, node)
+		}
+	}
+	rng.Shuffle(len(vetted), func(i, k int) { vetted[i], vetted[k] = vetted[k], vetted[i] })
+	rng.Shuffle(len(unvetted), func(i, k int) { unvetted[i], unvetted[k] = unvetted[k], unvetted[i] })
+
+	s := &selection{config: config, subnets: make(map[string]bool)}
+	newAmount := int(float64(amount) * config.NewNodePercentage)
+	if newAmount == 0 && config.NewNodePercentage > 0 && amount > 0 {
+		// small selections still give new nodes a chance to be vetted
+		newAmount = 1
+	}
+	unvetted = s.pick(unvetted, newAmount)
+	s.pick(vetted, amount-len(s.nodes))
+	// fill the remaining slots with new nodes when there are not enough vetted ones
+	s.pick(unvetted, amount-len(s.nodes))
+
+	return s.nodes
+}
+
+// selection keeps track of the picked nodes and their subnets
+type selection struct {
+	config  NodeSelectionConfig
+	subnets map[string]bool
+	nodes   []*pb.Node
+}
+
+// pick selects up to amount nodes from the shuffled candidates and returns the unused ones
+func (s *selection) pick(candidates []*pb.Node, amount int) (unused []*pb.Node) {
+	for i, node := range candidates {
+		if amount <= 0 {
+			return append(unused, candidates[i:]...)
+		}
+		if s.config.DistinctIP {
+			subnet := subnetOf(node.GetAddress().GetAddress())
+			if s.subnets[subnet] {
+				// another node of this subnet is already selected
+				continue
+			}
+			s.subnets[subnet] = true
+		}
+		s.nodes = append(s.nodes, node)
+		amount--
+	}
+	return unused
+}
+
+// subnetOf returns the /24 (IPv4) or /64 (IPv6) subnet of the address,
+// or the host name if the address doesn't contain an IP
+func subnetOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return host
+	}
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return ipv4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(64, 128)).String()
+}
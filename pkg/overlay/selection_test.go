@@ -0,0 +1,90 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+func TestSubnetOf(t *testing.T) {
+	for _, tt := range []struct {
+		address string
+		subnet  string
+	}{
+		{"10.1.2.3:7777", "10.1.2.0"},
+		{"10.1.2.200:8888", "10.1.2.0"},
+		{"[2001:db8:1:2:3:4:5:6]:7777", "2001:db8:1:2::"},
+		{"example.com:7777", "example.com"},
+		{"10.1.2.3", "10.1.2.0"},
+	} {
+		assert.Equal(t, tt.subnet, subnetOf(tt.address), tt.address)
+	}
+}
+
+func TestSelectNodes(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	var candidates []*pb.Node
+	addNodes := func(subnets, perSubnet int, vetted bool) {
+		for i := 0; i < subnets; i++ {
+			for k := 0; k < perSubnet; k++ {
+				candidates = append(candidates, &pb.Node{
+					Id:         testczarcoin.NodeIDFromString(fmt.Sprintf("%t-%d-%d", vetted, i, k)),
+					Address:    &pb.NodeAddress{Address: fmt.Sprintf("10.%d.%d.%d:7777", perSubnet, i, k)},
+					Reputation: &pb.NodeStats{Vetted: vetted},
+				})
+			}
+		}
+	}
+	addNodes(20, 3, true) // vetted
+	addNodes(5, 2, false) // new
+
+	config := NodeSelectionConfig{DistinctIP: true, NewNodePercentage: 0.2}
+
+	selected := selectNodes(rng, candidates, 10, config)
+	assert.Len(t, selected, 10)
+
+	subnets := map[string]bool{}
+	var unvetted int
+	for _, node := range selected {
+		subnet := subnetOf(node.Address.Address)
+		assert.False(t, subnets[subnet], "subnet %s selected twice", subnet)
+		subnets[subnet] = true
+		if !node.Reputation.Vetted {
+			unvetted++
+		}
+	}
+	assert.Equal(t, 2, unvetted)
+
+	// small selections reserve at least one slot for new nodes
+	config.NewNodePercentage = 0.05
+	selected = selectNodes(rng, candidates, 5, config)
+	unvetted = 0
+	for _, node := range selected {
+		if !node.Reputation.Vetted {
+			unvetted++
+		}
+	}
+	assert.Equal(t, 1, unvetted)
+	config.NewNodePercentage = 0.2
+
+	// new nodes fill the slots which vetted nodes can't
+	selected = selectNodes(rng, candidates, 25, config)
+	assert.Len(t, selected, 25)
+
+	// only one node per subnet is available
+	selected = selectNodes(rng, candidates, 30, config)
+	assert.Len(t, selected, 25)
+
+	config.DistinctIP = false
+	selected = selectNodes(rng, candidates, 30, config)
+	assert.Len(t, selected, 30)
+}
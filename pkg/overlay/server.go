@@ -7,8 +7,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/rand"
+	"sync"
+	"time"
 
-	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -18,7 +20,6 @@ import (
 	"czarcoin.org/czarcoin/pkg/dht"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
-	"czarcoin.org/czarcoin/storage"
 )
 
 // ServerError creates class of errors for stack traces
@@ -30,15 +31,37 @@ type Server struct {
 	dht     dht.DHT
 	cache   *Cache
 	metrics *monkit.Registry
+
+	selection NodeSelectionConfig
+	mu        sync.Mutex
+	rng       *rand.Rand
+
+	checkIn          CheckInConfig
+	checkInMu        sync.Mutex
+	lastCheckIn      map[czarcoin.NodeID]time.Time
+	lastCheckInSweep time.Time
+	wallets          WalletDB
 }
 
+// listLimit is the number of nodes read from the cache at once
+const listLimit = 1000
+
+// selectionOversampling is how many candidates are read from the cache per
+// requested node, as candidates of the same subnet or with an outdated
+// version are skipped
+const selectionOversampling = 3
+
 // NewServer creates a new Overlay Server
-func NewServer(log *zap.Logger, cache *Cache, dht dht.DHT) *Server {
+func NewServer(log *zap.Logger, cache *Cache, dht dht.DHT, selection NodeSelectionConfig, checkIn CheckInConfig) *Server {
 	return &Server{
-		dht:     dht,
-		cache:   cache,
-		logger:  log,
-		metrics: monkit.Default,
+		dht:         dht,
+		cache:       cache,
+		logger:      log,
+		metrics:     monkit.Default,
+		selection:   selection,
+		rng:         rand.New(rand.NewSource(time.Now().UnixNano())),
+		checkIn:     checkIn,
+		lastCheckIn: map[czarcoin.NodeID]time.Time{},
 	}
 }
 
@@ -67,137 +90,64 @@ func (o *Server) BulkLookup(ctx context.Context, reqs *pb.LookupRequests) (*pb.L
 
 // FindStorageNodes searches the overlay network for nodes that meet the provided requirements
 func (o *Server) FindStorageNodes(ctx context.Context, req *pb.FindStorageNodesRequest) (resp *pb.FindStorageNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	opts := req.GetOpts()
 	maxNodes := req.GetMaxNodes()
 	if maxNodes <= 0 {
 		maxNodes = opts.GetAmount()
 	}
+	if maxNodes <= 0 {
+		return &pb.FindStorageNodesResponse{}, nil
+	}
 
-	excluded := opts.ExcludedNodes
 	restrictions := opts.GetRestrictions()
 	reputation := opts.GetMinStats()
-
-	var startID czarcoin.NodeID
-	result := []*pb.Node{}
-	for {
-		var nodes []*pb.Node
-		nodes, startID, err = o.populate(ctx, req.Start, maxNodes, restrictions, reputation, excluded)
+	vettedCriteria := NodeCriteria{
+		FreeBandwidth:    restrictions.GetFreeBandwidth(),
+		FreeDisk:         restrictions.GetFreeDisk(),
+		UptimeReputation: reputation.GetUptimeReputation(),
+		UptimeCount:      reputation.GetUptimeCount(),
+		AuditReputation:  reputation.GetAuditReputation(),
+		AuditCount:       reputation.GetAuditCount(),
+		Excluded:         opts.ExcludedNodes,
+		Limit:            int(maxNodes) * selectionOversampling,
+	}
+	// new nodes are sampled separately, so their reserved share of the
+	// selection doesn't depend on how few of them there are
+	unvettedCriteria := vettedCriteria
+	vettedCriteria.Vetted, unvettedCriteria.Unvetted = true, true
+
+	var candidates []*pb.Node
+	for _, criteria := range []*NodeCriteria{&vettedCriteria, &unvettedCriteria} {
+		nodes, err := o.cache.DB.FindStorageNodes(ctx, criteria)
 		if err != nil {
+			o.logger.Error("Error finding storage nodes", zap.Error(err))
 			return nil, Error.Wrap(err)
 		}
+		candidates = append(candidates, nodes...)
+	}
 
-		resultNodes := []*pb.Node{}
-		usedAddrs := make(map[string]bool)
-		for _, n := range nodes {
-			addr := n.Address.GetAddress()
-			excluded = append(excluded, n.Id) // exclude all nodes on next iteration
-			if !usedAddrs[addr] {
-				resultNodes = append(resultNodes, n)
-				usedAddrs[addr] = true
-			}
-		}
-		if len(resultNodes) <= 0 {
-			break
-		}
-
-		result = append(result, resultNodes...)
-
-		if len(result) >= int(maxNodes) || startID == (czarcoin.NodeID{}) {
-			break
+	current := candidates[:0]
+	for _, node := range candidates {
+		if o.cache.MinVersions.Allowed(node) {
+			current = append(current, node)
 		}
-
 	}
 
+	o.mu.Lock()
+	result := selectNodes(o.rng, current, int(maxNodes), o.selection)
+	o.mu.Unlock()
+
 	if len(result) < int(maxNodes) {
 		return nil, status.Errorf(codes.ResourceExhausted, fmt.Sprintf("requested %d nodes, only %d nodes matched the criteria requested", maxNodes, len(result)))
 	}
 
-	if len(result) > int(maxNodes) {
-		result = result[:maxNodes]
-	}
-
 	return &pb.FindStorageNodesResponse{
 		Nodes: result,
 	}, nil
 }
 
-func (o *Server) getNodes(ctx context.Context, keys storage.Keys) ([]*pb.Node, error) {
-	values, err := o.cache.DB.GetAll(keys)
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-
-	nodes := []*pb.Node{}
-	for _, v := range values {
-		n := &pb.Node{}
-		if err := proto.Unmarshal(v, n); err != nil {
-			return nil, Error.Wrap(err)
-		}
-
-		nodes = append(nodes, n)
-	}
-
-	return nodes, nil
-
-}
-
-func (o *Server) populate(ctx context.Context, startID czarcoin.NodeID, maxNodes int64,
-	minRestrictions *pb.NodeRestrictions, minReputation *pb.NodeStats,
-	excluded czarcoin.NodeIDList) ([]*pb.Node, czarcoin.NodeID, error) {
-
-	limit := int(maxNodes * 2)
-	keys, err := o.cache.DB.List(startID.Bytes(), limit)
-	if err != nil {
-		o.logger.Error("Error listing nodes", zap.Error(err))
-		return nil, czarcoin.NodeID{}, Error.Wrap(err)
-	}
-
-	if len(keys) <= 0 {
-		o.logger.Info("No Keys returned from List operation")
-		return []*pb.Node{}, startID, nil
-	}
-
-	// TODO: should this be `var result []*pb.Node` ?
-	result := []*pb.Node{}
-	nodes, err := o.getNodes(ctx, keys)
-	if err != nil {
-		o.logger.Error("Error getting nodes", zap.Error(err))
-		return nil, czarcoin.NodeID{}, Error.Wrap(err)
-	}
-
-	for _, v := range nodes {
-		if v.Type != pb.NodeType_STORAGE {
-			continue
-		}
-
-		nodeRestrictions := v.GetRestrictions()
-		nodeReputation := v.GetReputation()
-
-		if nodeRestrictions.GetFreeBandwidth() < minRestrictions.GetFreeBandwidth() ||
-			nodeRestrictions.GetFreeDisk() < minRestrictions.GetFreeDisk() ||
-			nodeReputation.GetUptimeRatio() < minReputation.GetUptimeRatio() ||
-			nodeReputation.GetUptimeCount() < minReputation.GetUptimeCount() ||
-			nodeReputation.GetAuditSuccessRatio() < minReputation.GetAuditSuccessRatio() ||
-			nodeReputation.GetAuditCount() < minReputation.GetAuditCount() ||
-			contains(excluded, v.Id) {
-			continue
-		}
-		result = append(result, v)
-	}
-
-	var nextStart czarcoin.NodeID
-	if len(keys) < limit {
-		nextStart = czarcoin.NodeID{}
-	} else {
-		nextStart, err = czarcoin.NodeIDFromBytes(keys[len(keys)-1])
-	}
-	if err != nil {
-		return nil, czarcoin.NodeID{}, Error.Wrap(err)
-	}
-
-	return result, nextStart, nil
-}
-
 // contains checks if item exists in list
 func contains(nodeIDs czarcoin.NodeIDList, searchID czarcoin.NodeID) bool {
 	for _, id := range nodeIDs {
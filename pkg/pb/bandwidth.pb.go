@@ -41,11 +41,127 @@ func (x AgreementsSummary_Status) String() string {
 	return proto.EnumName(AgreementsSummary_Status_name, int32(x))
 }
 func (AgreementsSummary_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bandwidth_01db992f91c47bae, []int{0, 0}
+	return fileDescriptor_bandwidth_6a32bc72ca32f26f, []int{2, 0}
+}
+
+// Reason explains why an agreement was rejected
+type AgreementsSummary_Reason int32
+
+const (
+	AgreementsSummary_NONE                  AgreementsSummary_Reason = 0
+	AgreementsSummary_MALFORMED             AgreementsSummary_Reason = 1
+	AgreementsSummary_INVALID_SIGNATURE     AgreementsSummary_Reason = 2
+	AgreementsSummary_EXPIRED               AgreementsSummary_Reason = 3
+	AgreementsSummary_INVALID_SERIAL_NUMBER AgreementsSummary_Reason = 4
+	AgreementsSummary_REPLAYED              AgreementsSummary_Reason = 5
+	AgreementsSummary_MAX_SIZE_EXCEEDED     AgreementsSummary_Reason = 6
+)
+
+var AgreementsSummary_Reason_name = map[int32]string{
+	0: "NONE",
+	1: "MALFORMED",
+	2: "INVALID_SIGNATURE",
+	3: "EXPIRED",
+	4: "INVALID_SERIAL_NUMBER",
+	5: "REPLAYED",
+	6: "MAX_SIZE_EXCEEDED",
+}
+var AgreementsSummary_Reason_value = map[string]int32{
+	"NONE":                  0,
+	"MALFORMED":             1,
+	"INVALID_SIGNATURE":     2,
+	"EXPIRED":               3,
+	"INVALID_SERIAL_NUMBER": 4,
+	"REPLAYED":              5,
+	"MAX_SIZE_EXCEEDED":     6,
+}
+
+func (x AgreementsSummary_Reason) String() string {
+	return proto.EnumName(AgreementsSummary_Reason_name, int32(x))
+}
+func (AgreementsSummary_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bandwidth_6a32bc72ca32f26f, []int{2, 1}
+}
+
+type SettleRequest struct {
+	Agreements           []*RenterBandwidthAllocation `protobuf:"bytes,1,rep,name=agreements" json:"agreements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *SettleRequest) Reset()         { *m = SettleRequest{} }
+func (m *SettleRequest) String() string { return proto.CompactTextString(m) }
+func (*SettleRequest) ProtoMessage()    {}
+func (*SettleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bandwidth_6a32bc72ca32f26f, []int{0}
+}
+func (m *SettleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleRequest.Unmarshal(m, b)
+}
+func (m *SettleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleRequest.Marshal(b, m, deterministic)
+}
+func (dst *SettleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleRequest.Merge(dst, src)
+}
+func (m *SettleRequest) XXX_Size() int {
+	return xxx_messageInfo_SettleRequest.Size(m)
+}
+func (m *SettleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleRequest proto.InternalMessageInfo
+
+func (m *SettleRequest) GetAgreements() []*RenterBandwidthAllocation {
+	if m != nil {
+		return m.Agreements
+	}
+	return nil
+}
+
+type SettleResponse struct {
+	Summaries            []*AgreementsSummary `protobuf:"bytes,1,rep,name=summaries" json:"summaries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SettleResponse) Reset()         { *m = SettleResponse{} }
+func (m *SettleResponse) String() string { return proto.CompactTextString(m) }
+func (*SettleResponse) ProtoMessage()    {}
+func (*SettleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bandwidth_6a32bc72ca32f26f, []int{1}
+}
+func (m *SettleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleResponse.Unmarshal(m, b)
+}
+func (m *SettleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleResponse.Marshal(b, m, deterministic)
+}
+func (dst *SettleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleResponse.Merge(dst, src)
+}
+func (m *SettleResponse) XXX_Size() int {
+	return xxx_messageInfo_SettleResponse.Size(m)
+}
+func (m *SettleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleResponse proto.InternalMessageInfo
+
+func (m *SettleResponse) GetSummaries() []*AgreementsSummary {
+	if m != nil {
+		return m.Summaries
+	}
+	return nil
 }
 
 type AgreementsSummary struct {
 	Status               AgreementsSummary_Status `protobuf:"varint,1,opt,name=status,proto3,enum=bandwidth.AgreementsSummary_Status" json:"status,omitempty"`
+	Reason               AgreementsSummary_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=bandwidth.AgreementsSummary_Reason" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -55,7 +171,7 @@ func (m *AgreementsSummary) Reset()         { *m = AgreementsSummary{} }
 func (m *AgreementsSummary) String() string { return proto.CompactTextString(m) }
 func (*AgreementsSummary) ProtoMessage()    {}
 func (*AgreementsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bandwidth_01db992f91c47bae, []int{0}
+	return fileDescriptor_bandwidth_6a32bc72ca32f26f, []int{2}
 }
 func (m *AgreementsSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AgreementsSummary.Unmarshal(m, b)
@@ -82,9 +198,19 @@ func (m *AgreementsSummary) GetStatus() AgreementsSummary_Status {
 	return AgreementsSummary_FAIL
 }
 
+func (m *AgreementsSummary) GetReason() AgreementsSummary_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return AgreementsSummary_NONE
+}
+
 func init() {
+	proto.RegisterType((*SettleRequest)(nil), "bandwidth.SettleRequest")
+	proto.RegisterType((*SettleResponse)(nil), "bandwidth.SettleResponse")
 	proto.RegisterType((*AgreementsSummary)(nil), "bandwidth.AgreementsSummary")
 	proto.RegisterEnum("bandwidth.AgreementsSummary_Status", AgreementsSummary_Status_name, AgreementsSummary_Status_value)
+	proto.RegisterEnum("bandwidth.AgreementsSummary_Reason", AgreementsSummary_Reason_name, AgreementsSummary_Reason_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -99,6 +225,7 @@ const _ = grpc.SupportPackageIsVersion4
 
 type BandwidthClient interface {
 	BandwidthAgreements(ctx context.Context, in *RenterBandwidthAllocation, opts ...grpc.CallOption) (*AgreementsSummary, error)
+	SettleAgreements(ctx context.Context, in *SettleRequest, opts ...grpc.CallOption) (*SettleResponse, error)
 }
 
 type bandwidthClient struct {
@@ -118,10 +245,20 @@ func (c *bandwidthClient) BandwidthAgreements(ctx context.Context, in *RenterBan
 	return out, nil
 }
 
+func (c *bandwidthClient) SettleAgreements(ctx context.Context, in *SettleRequest, opts ...grpc.CallOption) (*SettleResponse, error) {
+	out := new(SettleResponse)
+	err := c.cc.Invoke(ctx, "/bandwidth.Bandwidth/SettleAgreements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Bandwidth service
 
 type BandwidthServer interface {
 	BandwidthAgreements(context.Context, *RenterBandwidthAllocation) (*AgreementsSummary, error)
+	SettleAgreements(context.Context, *SettleRequest) (*SettleResponse, error)
 }
 
 func RegisterBandwidthServer(s *grpc.Server, srv BandwidthServer) {
@@ -146,6 +283,24 @@ func _Bandwidth_BandwidthAgreements_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bandwidth_SettleAgreements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BandwidthServer).SettleAgreements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bandwidth.Bandwidth/SettleAgreements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BandwidthServer).SettleAgreements(ctx, req.(*SettleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Bandwidth_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "bandwidth.Bandwidth",
 	HandlerType: (*BandwidthServer)(nil),
@@ -154,26 +309,43 @@ var _Bandwidth_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BandwidthAgreements",
 			Handler:    _Bandwidth_BandwidthAgreements_Handler,
 		},
+		{
+			MethodName: "SettleAgreements",
+			Handler:    _Bandwidth_SettleAgreements_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bandwidth.proto",
 }
 
-func init() { proto.RegisterFile("bandwidth.proto", fileDescriptor_bandwidth_01db992f91c47bae) }
-
-var fileDescriptor_bandwidth_01db992f91c47bae = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x4a, 0xcc, 0x4b,
-	0x29, 0xcf, 0x4c, 0x29, 0xc9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x84, 0x0b, 0x48,
-	0x09, 0x14, 0x64, 0xa6, 0x26, 0xa7, 0x16, 0x97, 0xe4, 0x17, 0xa5, 0x42, 0x24, 0x95, 0x72, 0xb8,
-	0x04, 0x1d, 0xd3, 0x8b, 0x52, 0x53, 0x73, 0x53, 0xf3, 0x4a, 0x8a, 0x83, 0x4b, 0x73, 0x73, 0x13,
-	0x8b, 0x2a, 0x85, 0xac, 0xb9, 0xd8, 0x8a, 0x4b, 0x12, 0x4b, 0x4a, 0x8b, 0x25, 0x18, 0x15, 0x18,
-	0x35, 0xf8, 0x8c, 0x94, 0xf5, 0x10, 0x66, 0x62, 0xa8, 0xd6, 0x0b, 0x06, 0x2b, 0x0d, 0x82, 0x6a,
-	0x51, 0x92, 0xe2, 0x62, 0x83, 0x88, 0x08, 0x71, 0x70, 0xb1, 0xb8, 0x39, 0x7a, 0xfa, 0x08, 0x30,
-	0x08, 0xb1, 0x71, 0x31, 0xf9, 0x7b, 0x0b, 0x30, 0x1a, 0xe5, 0x73, 0x71, 0x3a, 0xc1, 0x4c, 0x12,
-	0x4a, 0xe2, 0x12, 0x86, 0x73, 0x10, 0xa6, 0x0a, 0x69, 0xeb, 0x21, 0x1c, 0x59, 0x94, 0x5f, 0x5a,
-	0x92, 0x5a, 0xac, 0x17, 0x94, 0x9a, 0x57, 0x92, 0x5a, 0x84, 0x50, 0x9c, 0x93, 0x93, 0x9f, 0x9c,
-	0x58, 0x92, 0x99, 0x9f, 0x27, 0x25, 0x83, 0xcf, 0x65, 0x4a, 0x0c, 0x4e, 0x2c, 0x51, 0x4c, 0x05,
-	0x49, 0x49, 0x6c, 0x60, 0xbf, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x38, 0x8e, 0x59,
-	0x1b, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("bandwidth.proto", fileDescriptor_bandwidth_6a32bc72ca32f26f) }
+
+var fileDescriptor_bandwidth_6a32bc72ca32f26f = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0xe9, 0x1a, 0xbb, 0xaf, 0xb6, 0x4e, 0x47, 0x0a, 0xdb, 0xc5, 0x83, 0xc4, 0x4b,
+	0x41, 0xc8, 0x61, 0xbd, 0xe9, 0x69, 0xd6, 0x4c, 0x65, 0x68, 0x92, 0x2d, 0x13, 0x2b, 0x6b, 0x11,
+	0x42, 0xd2, 0x3e, 0x34, 0xb0, 0x9b, 0x89, 0x99, 0x09, 0xe2, 0x07, 0xf0, 0x03, 0x79, 0xf5, 0xd3,
+	0x49, 0x92, 0x6e, 0xb2, 0xa2, 0x14, 0xbd, 0xce, 0xfb, 0xff, 0x7e, 0x33, 0xc3, 0xff, 0xc1, 0xe3,
+	0x2c, 0x2d, 0x6e, 0xbf, 0xe6, 0xb7, 0xe6, 0xb3, 0x57, 0x56, 0xca, 0x28, 0x3a, 0xe9, 0x0f, 0x66,
+	0xa4, 0xcc, 0xf1, 0x06, 0xb5, 0x51, 0x15, 0x76, 0x43, 0xf7, 0x23, 0x1c, 0xc6, 0x68, 0xcc, 0x1a,
+	0x25, 0x7e, 0xa9, 0x51, 0x1b, 0x7a, 0x01, 0x90, 0x7e, 0xaa, 0x10, 0x37, 0x58, 0x18, 0x3d, 0xb5,
+	0x9e, 0xed, 0x9d, 0x1d, 0xcc, 0x5f, 0x78, 0x03, 0x57, 0xa9, 0xda, 0xa0, 0xf6, 0x24, 0x16, 0x06,
+	0xab, 0xc5, 0xd6, 0xcc, 0xd6, 0x6b, 0x75, 0x93, 0x9a, 0x5c, 0x15, 0x72, 0x07, 0x77, 0x03, 0x38,
+	0xda, 0xda, 0x75, 0xa9, 0x0a, 0x8d, 0xf4, 0x15, 0x4c, 0x74, 0xbd, 0xd9, 0xa4, 0x55, 0x8e, 0x5b,
+	0xfb, 0x53, 0x6f, 0x78, 0x31, 0xeb, 0xd9, 0xb8, 0x4d, 0x7d, 0x93, 0x43, 0xdc, 0xfd, 0x61, 0xc3,
+	0xf1, 0x1f, 0x01, 0xfa, 0x1a, 0x1c, 0x6d, 0x52, 0x53, 0x37, 0x3a, 0xeb, 0xec, 0x68, 0xfe, 0xfc,
+	0x3e, 0x9d, 0x17, 0xb7, 0x51, 0x79, 0x87, 0x34, 0x70, 0x85, 0xa9, 0x56, 0xc5, 0xd4, 0xfe, 0x07,
+	0x58, 0xb6, 0x51, 0x79, 0x87, 0xb8, 0x33, 0x70, 0x3a, 0x1d, 0xdd, 0x87, 0xf1, 0x39, 0x13, 0x01,
+	0x19, 0x51, 0x07, 0xec, 0xe5, 0x05, 0xb1, 0xdc, 0xef, 0x16, 0x38, 0x5d, 0xbc, 0x19, 0x46, 0xcb,
+	0x88, 0x93, 0x11, 0x3d, 0x84, 0x49, 0xc8, 0x82, 0xf3, 0xa5, 0x0c, 0xb9, 0x4f, 0x2c, 0x7a, 0x02,
+	0xc7, 0x22, 0x7a, 0xcf, 0x02, 0xe1, 0x27, 0xb1, 0x78, 0x1b, 0xb1, 0x77, 0x57, 0x92, 0x13, 0x9b,
+	0x1e, 0xc0, 0x43, 0xbe, 0xba, 0x14, 0x92, 0xfb, 0x64, 0x8f, 0x9e, 0xc2, 0x49, 0x9f, 0xe1, 0x52,
+	0xb0, 0x20, 0x89, 0xae, 0xc2, 0x05, 0x97, 0x64, 0x4c, 0x1f, 0xc1, 0xbe, 0xe4, 0x97, 0x01, 0xfb,
+	0xc0, 0x7d, 0xf2, 0xa0, 0x91, 0x85, 0x6c, 0x95, 0xc4, 0xe2, 0x9a, 0x27, 0x7c, 0xf5, 0x86, 0x73,
+	0x9f, 0xfb, 0xc4, 0x99, 0xff, 0xb4, 0x60, 0xd2, 0xb7, 0x44, 0x33, 0x78, 0x32, 0x54, 0xd6, 0x7f,
+	0x8f, 0xfe, 0x4f, 0xbf, 0xb3, 0x7b, 0xeb, 0x72, 0x47, 0x54, 0x00, 0xe9, 0x3a, 0xdf, 0xb9, 0x60,
+	0xba, 0xc3, 0xfc, 0xb6, 0x6e, 0xb3, 0xd3, 0xbf, 0x4c, 0xba, 0x55, 0x71, 0x47, 0x8b, 0xf1, 0xb5,
+	0x5d, 0x66, 0x99, 0xd3, 0x6e, 0xea, 0xcb, 0x5f, 0x03, 0x00, 0xef, 0x66, 0x4d, 0x39, 0xd9, 0x02,
+	0x00, 0x00,
 }
@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkin.proto
+
+package pb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// CheckInInfo is the signed content of a check-in
+type CheckInInfo struct {
+	Node                 *Node             `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	Capacity             *NodeRestrictions `protobuf:"bytes,2,opt,name=capacity" json:"capacity,omitempty"`
+	Version              string            `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	TimestampUnixSec     int64             `protobuf:"varint,4,opt,name=timestamp_unix_sec,json=timestampUnixSec,proto3" json:"timestamp_unix_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CheckInInfo) Reset()         { *m = CheckInInfo{} }
+func (m *CheckInInfo) String() string { return proto.CompactTextString(m) }
+func (*CheckInInfo) ProtoMessage()    {}
+func (*CheckInInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_checkin_f26bea7248f38a0d, []int{0}
+}
+func (m *CheckInInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckInInfo.Unmarshal(m, b)
+}
+func (m *CheckInInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckInInfo.Marshal(b, m, deterministic)
+}
+func (dst *CheckInInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInInfo.Merge(dst, src)
+}
+func (m *CheckInInfo) XXX_Size() int {
+	return xxx_messageInfo_CheckInInfo.Size(m)
+}
+func (m *CheckInInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInInfo proto.InternalMessageInfo
+
+func (m *CheckInInfo) GetNode() *Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *CheckInInfo) GetCapacity() *NodeRestrictions {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *CheckInInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CheckInInfo) GetTimestampUnixSec() int64 {
+	if m != nil {
+		return m.TimestampUnixSec
+	}
+	return 0
+}
+
+type CheckInRequest struct {
+	// info is the marshalled CheckInInfo
+	Info []byte `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// signature of info by the node's identity
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckInRequest) Reset()         { *m = CheckInRequest{} }
+func (m *CheckInRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckInRequest) ProtoMessage()    {}
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_checkin_f26bea7248f38a0d, []int{1}
+}
+func (m *CheckInRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckInRequest.Unmarshal(m, b)
+}
+func (m *CheckInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckInRequest.Marshal(b, m, deterministic)
+}
+func (dst *CheckInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInRequest.Merge(dst, src)
+}
+func (m *CheckInRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckInRequest.Size(m)
+}
+func (m *CheckInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInRequest proto.InternalMessageInfo
+
+func (m *CheckInRequest) GetInfo() []byte {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *CheckInRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type CheckInResponse struct {
+	PingbackSuccess      bool     `protobuf:"varint,1,opt,name=pingback_success,json=pingbackSuccess,proto3" json:"pingback_success,omitempty"`
+	PingbackError        string   `protobuf:"bytes,2,opt,name=pingback_error,json=pingbackError,proto3" json:"pingback_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckInResponse) Reset()         { *m = CheckInResponse{} }
+func (m *CheckInResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckInResponse) ProtoMessage()    {}
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_checkin_f26bea7248f38a0d, []int{2}
+}
+func (m *CheckInResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckInResponse.Unmarshal(m, b)
+}
+func (m *CheckInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckInResponse.Marshal(b, m, deterministic)
+}
+func (dst *CheckInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInResponse.Merge(dst, src)
+}
+func (m *CheckInResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckInResponse.Size(m)
+}
+func (m *CheckInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInResponse proto.InternalMessageInfo
+
+func (m *CheckInResponse) GetPingbackSuccess() bool {
+	if m != nil {
+		return m.PingbackSuccess
+	}
+	return false
+}
+
+func (m *CheckInResponse) GetPingbackError() string {
+	if m != nil {
+		return m.PingbackError
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CheckInInfo)(nil), "checkin.CheckInInfo")
+	proto.RegisterType((*CheckInRequest)(nil), "checkin.CheckInRequest")
+	proto.RegisterType((*CheckInResponse)(nil), "checkin.CheckInResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for CheckIn service
+
+type CheckInClient interface {
+	// CheckIn updates the node's capacity and version and pings the node back
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+}
+
+type checkInClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCheckInClient(cc *grpc.ClientConn) CheckInClient {
+	return &checkInClient{cc}
+}
+
+func (c *checkInClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, "/checkin.CheckIn/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for CheckIn service
+
+type CheckInServer interface {
+	// CheckIn updates the node's capacity and version and pings the node back
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+}
+
+func RegisterCheckInServer(s *grpc.Server, srv CheckInServer) {
+	s.RegisterService(&_CheckIn_serviceDesc, srv)
+}
+
+func _CheckIn_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckInServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/checkin.CheckIn/CheckIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckInServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CheckIn_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "checkin.CheckIn",
+	HandlerType: (*CheckInServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckIn",
+			Handler:    _CheckIn_CheckIn_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "checkin.proto",
+}
+
+func init() { proto.RegisterFile("checkin.proto", fileDescriptor_checkin_f26bea7248f38a0d) }
+
+var fileDescriptor_checkin_f26bea7248f38a0d = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x6b, 0x32, 0x41,
+	0x0c, 0xc6, 0x59, 0x5d, 0x5e, 0x35, 0xfe, 0x25, 0x87, 0xb7, 0x8b, 0x94, 0x22, 0x42, 0xc1, 0x42,
+	0xf1, 0x60, 0xaf, 0x3d, 0x59, 0x4a, 0xf1, 0xd2, 0xc3, 0x48, 0x2f, 0xbd, 0xc8, 0x3a, 0x46, 0x3b,
+	0x88, 0x99, 0xed, 0x64, 0xb6, 0xd8, 0x8f, 0xd4, 0x6f, 0x59, 0x1c, 0xdd, 0xb5, 0xa5, 0xb7, 0xe4,
+	0xf7, 0x3c, 0x24, 0x4f, 0x08, 0xb4, 0xf5, 0x1b, 0xe9, 0xad, 0xe1, 0x71, 0xe6, 0xac, 0xb7, 0x58,
+	0x3b, 0xb5, 0x7d, 0x60, 0xbb, 0xa2, 0x23, 0x1c, 0x7e, 0x45, 0xd0, 0x7c, 0x38, 0xf0, 0x19, 0xcf,
+	0x78, 0x6d, 0xf1, 0x0a, 0xe2, 0x83, 0x9a, 0x44, 0x83, 0x68, 0xd4, 0x9c, 0xc0, 0x38, 0x58, 0x9f,
+	0xed, 0x8a, 0x54, 0xe0, 0x38, 0x81, 0xba, 0x4e, 0xb3, 0x54, 0x1b, 0xff, 0x99, 0x54, 0x82, 0xe7,
+	0xff, 0x0f, 0x0f, 0x89, 0x77, 0x46, 0x7b, 0x63, 0x59, 0x54, 0xe9, 0xc3, 0x04, 0x6a, 0x1f, 0xe4,
+	0xc4, 0x58, 0x4e, 0xaa, 0x83, 0x68, 0xd4, 0x50, 0x45, 0x8b, 0xb7, 0x80, 0xde, 0xec, 0x48, 0x7c,
+	0xba, 0xcb, 0x16, 0x39, 0x9b, 0xfd, 0x42, 0x48, 0x27, 0xf1, 0x20, 0x1a, 0x55, 0x55, 0xaf, 0x54,
+	0x5e, 0xd8, 0xec, 0xe7, 0xa4, 0x87, 0x53, 0xe8, 0x9c, 0xa2, 0x2a, 0x7a, 0xcf, 0x49, 0x3c, 0x22,
+	0xc4, 0x86, 0xd7, 0x36, 0xa4, 0x6d, 0xa9, 0x50, 0xe3, 0x25, 0x34, 0xc4, 0x6c, 0x38, 0xf5, 0xb9,
+	0xa3, 0x10, 0xb1, 0xa5, 0xce, 0x60, 0xa8, 0xa1, 0x5b, 0xce, 0x90, 0xcc, 0xb2, 0x10, 0xde, 0x40,
+	0x2f, 0x33, 0xbc, 0x59, 0xa6, 0x7a, 0xbb, 0x90, 0x5c, 0x6b, 0x12, 0x09, 0x03, 0xeb, 0xaa, 0x5b,
+	0xf0, 0xf9, 0x11, 0xe3, 0x35, 0x74, 0x4a, 0x2b, 0x39, 0x67, 0x5d, 0x58, 0xd0, 0x50, 0xed, 0x82,
+	0x3e, 0x1e, 0xe0, 0xe4, 0x09, 0x6a, 0xa7, 0x25, 0x78, 0x7f, 0x2e, 0x2f, 0xc6, 0xc5, 0x3f, 0x7e,
+	0x5f, 0xd1, 0x4f, 0xfe, 0x0a, 0xc7, 0x68, 0xd3, 0xf8, 0xb5, 0x92, 0x2d, 0x97, 0xff, 0xc2, 0xab,
+	0xee, 0xbe, 0x07, 0x00, 0xb8, 0xaf, 0xa6, 0x77, 0xd0, 0x01, 0x00, 0x00,
+}
@@ -7,6 +7,9 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
@@ -18,10 +21,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type RetryIrreparableResponse_Status int32
+
+const (
+	// IRREPARABLE segments still have too few healthy pieces
+	RetryIrreparableResponse_IRREPARABLE RetryIrreparableResponse_Status = 0
+	// QUEUED segments were added to the repair queue
+	RetryIrreparableResponse_QUEUED RetryIrreparableResponse_Status = 1
+	// HEALTHY segments don't need a repair anymore
+	RetryIrreparableResponse_HEALTHY RetryIrreparableResponse_Status = 2
+	// DELETED segments don't exist anymore
+	RetryIrreparableResponse_DELETED RetryIrreparableResponse_Status = 3
+)
+
+var RetryIrreparableResponse_Status_name = map[int32]string{
+	0: "IRREPARABLE",
+	1: "QUEUED",
+	2: "HEALTHY",
+	3: "DELETED",
+}
+var RetryIrreparableResponse_Status_value = map[string]int32{
+	"IRREPARABLE": 0,
+	"QUEUED":      1,
+	"HEALTHY":     2,
+	"DELETED":     3,
+}
+
+func (x RetryIrreparableResponse_Status) String() string {
+	return proto.EnumName(RetryIrreparableResponse_Status_name, int32(x))
+}
+func (RetryIrreparableResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_datarepair_8fc284894764d111, []int{7, 0}
+}
+
 // InjuredSegment is the queue item used for the data repair queue
 type InjuredSegment struct {
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	LostPieces           []int32  `protobuf:"varint,2,rep,packed,name=lost_pieces,json=lostPieces" json:"lost_pieces,omitempty"`
+	NumHealthy           int32    `protobuf:"varint,3,opt,name=num_healthy,json=numHealthy,proto3" json:"num_healthy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -31,7 +68,7 @@ func (m *InjuredSegment) Reset()         { *m = InjuredSegment{} }
 func (m *InjuredSegment) String() string { return proto.CompactTextString(m) }
 func (*InjuredSegment) ProtoMessage()    {}
 func (*InjuredSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_datarepair_13e4beab54f194bd, []int{0}
+	return fileDescriptor_datarepair_8fc284894764d111, []int{0}
 }
 func (m *InjuredSegment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InjuredSegment.Unmarshal(m, b)
@@ -65,20 +102,520 @@ func (m *InjuredSegment) GetLostPieces() []int32 {
 	return nil
 }
 
+func (m *InjuredSegment) GetNumHealthy() int32 {
+	if m != nil {
+		return m.NumHealthy
+	}
+	return 0
+}
+
+// IrreparableSegment is a segment which had fewer healthy pieces than required to repair it
+type IrreparableSegment struct {
+	Path                     string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SegmentDetail            *Pointer `protobuf:"bytes,2,opt,name=segment_detail,json=segmentDetail" json:"segment_detail,omitempty"`
+	LostPieces               int64    `protobuf:"varint,3,opt,name=lost_pieces,json=lostPieces,proto3" json:"lost_pieces,omitempty"`
+	LastRepairAttemptUnixSec int64    `protobuf:"varint,4,opt,name=last_repair_attempt_unix_sec,json=lastRepairAttemptUnixSec,proto3" json:"last_repair_attempt_unix_sec,omitempty"`
+	RepairAttemptCount       int64    `protobuf:"varint,5,opt,name=repair_attempt_count,json=repairAttemptCount,proto3" json:"repair_attempt_count,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *IrreparableSegment) Reset()         { *m = IrreparableSegment{} }
+func (m *IrreparableSegment) String() string { return proto.CompactTextString(m) }
+func (*IrreparableSegment) ProtoMessage()    {}
+func (*IrreparableSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_datarepair_8fc284894764d111, []int{1}
+}
+func (m *IrreparableSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IrreparableSegment.Unmarshal(m, b)
+}
+func (m *IrreparableSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IrreparableSegment.Marshal(b, m, deterministic)
+}
+func (dst *IrreparableSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IrreparableSegment.Merge(dst, src)
+}
+func (m *IrreparableSegment) XXX_Size() int {
+	return xxx_messageInfo_IrreparableSegment.Size(m)
+}
+func (m *IrreparableSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_IrreparableSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IrreparableSegment proto.InternalMessageInfo
+
+func (m *IrreparableSegment) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *IrreparableSegment) GetSegmentDetail() *Pointer {
+	if m != nil {
+		return m.SegmentDetail
+	}
+	return nil
+}
+
+func (m *IrreparableSegment) GetLostPieces() int64 {
+	if m != nil {
+		return m.LostPieces
+	}
+	return 0
+}
+
+func (m *IrreparableSegment) GetLastRepairAttemptUnixSec() int64 {
+	if m != nil {
+		return m.LastRepairAttemptUnixSec
+	}
+	return 0
+}
+
+func (m *IrreparableSegment) GetRepairAttemptCount() int64 {
+	if m != nil {
+		return m.RepairAttemptCount
+	}
+	return 0
+}
+
+type ListIrreparableRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListIrreparableRequest) Reset()         { *m = ListIrreparableRequest{} }
+func (m *ListIrreparableRequest) String() string { return proto.CompactTextString(m) }
+func (*ListIrreparableRequest) ProtoMessage()    {}
+func (*ListIrreparableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_datarepair_8fc284894764d111, []int{2}
+}
+func (m *ListIrreparableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIrreparableRequest.Unmarshal(m, b)
+}
+func (m *ListIrreparableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIrreparableRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListIrreparableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIrreparableRequest.Merge(dst, src)
+}
+func (m *ListIrreparableRequest) XXX_Size() int {
+	return xxx_messageInfo_ListIrreparableRequest.Size(m)
+}
+func (m *ListIrreparableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIrreparableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIrreparableRequest proto.InternalMessageInfo
+
+func (m *ListIrreparableRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListIrreparableRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListIrreparableResponse struct {
+	Segments             []*IrreparableSegment `protobuf:"bytes,1,rep,name=segments" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListIrreparableResponse) Reset()         { *m = ListIrreparableResponse{} }
+func (m *ListIrreparableResponse) String() string { return proto.CompactTextString(m) }
+func (*ListIrreparableResponse) ProtoMessage()    {}
+func (*ListIrreparableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_datarepair_8fc284894764d111, []int{3}
+}
+func (m *ListIrreparableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIrreparableResponse.Unmarshal(m, b)
+}
+func (m *ListIrreparableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIrreparableResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListIrreparableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIrreparableResponse.Merge(dst, src)
+}
+func (m *ListIrreparableResponse) XXX_Size() int {
+	return xxx_messageInfo_ListIrreparableResponse.Size(m)
+}
+func (m *ListIrreparableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIrreparableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIrreparableResponse proto.InternalMessageInfo
+
+func (m *ListIrreparableResponse) GetSegments() []*IrreparableSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type GetIrreparableRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIrreparableRequest) Reset()         { *m = GetIrreparableRequest{} }
+func (m *GetIrreparableRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIrreparableRequest) ProtoMessage()    {}
+func (*GetIrreparableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_datarepair_8fc284894764d111, []int{4}
+}
+func (m *GetIrreparableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIrreparableRequest.Unmarshal(m, b)
+}
+func (m *GetIrreparableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIrreparableRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetIrreparableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIrreparableRequest.Merge(dst, src)
+}
+func (m *GetIrreparableRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIrreparableRequest.Size(m)
+}
+func (m *GetIrreparableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIrreparableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIrreparableRequest proto.InternalMessageInfo
+
+func (m *GetIrreparableRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type GetIrreparableResponse struct {
+	Segment              *IrreparableSegment `protobuf:"bytes,1,opt,name=segment" json:"segment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetIrreparableResponse) Reset()         { *m = GetIrreparableResponse{} }
+func (m *GetIrreparableResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIrreparableResponse) ProtoMessage()    {}
+func (*GetIrreparableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_datarepair_8fc284894764d111, []int{5}
+}
+func (m *GetIrreparableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIrreparableResponse.Unmarshal(m, b)
+}
+func (m *GetIrreparableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIrreparableResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetIrreparableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIrreparableResponse.Merge(dst, src)
+}
+func (m *GetIrreparableResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIrreparableResponse.Size(m)
+}
+func (m *GetIrreparableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIrreparableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIrreparableResponse proto.InternalMessageInfo
+
+func (m *GetIrreparableResponse) GetSegment() *IrreparableSegment {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
+}
+
+type RetryIrreparableRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryIrreparableRequest) Reset()         { *m = RetryIrreparableRequest{} }
+func (m *RetryIrreparableRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryIrreparableRequest) ProtoMessage()    {}
+func (*RetryIrreparableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_datarepair_8fc284894764d111, []int{6}
+}
+func (m *RetryIrreparableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryIrreparableRequest.Unmarshal(m, b)
+}
+func (m *RetryIrreparableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryIrreparableRequest.Marshal(b, m, deterministic)
+}
+func (dst *RetryIrreparableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryIrreparableRequest.Merge(dst, src)
+}
+func (m *RetryIrreparableRequest) XXX_Size() int {
+	return xxx_messageInfo_RetryIrreparableRequest.Size(m)
+}
+func (m *RetryIrreparableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryIrreparableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryIrreparableRequest proto.InternalMessageInfo
+
+func (m *RetryIrreparableRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type RetryIrreparableResponse struct {
+	Status               RetryIrreparableResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=repair.RetryIrreparableResponse_Status" json:"status,omitempty"`
+	HealthyPieces        int32                           `protobuf:"varint,2,opt,name=healthy_pieces,json=healthyPieces,proto3" json:"healthy_pieces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *RetryIrreparableResponse) Reset()         { *m = RetryIrreparableResponse{} }
+func (m *RetryIrreparableResponse) String() string { return proto.CompactTextString(m) }
+func (*RetryIrreparableResponse) ProtoMessage()    {}
+func (*RetryIrreparableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_datarepair_8fc284894764d111, []int{7}
+}
+func (m *RetryIrreparableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryIrreparableResponse.Unmarshal(m, b)
+}
+func (m *RetryIrreparableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryIrreparableResponse.Marshal(b, m, deterministic)
+}
+func (dst *RetryIrreparableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryIrreparableResponse.Merge(dst, src)
+}
+func (m *RetryIrreparableResponse) XXX_Size() int {
+	return xxx_messageInfo_RetryIrreparableResponse.Size(m)
+}
+func (m *RetryIrreparableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryIrreparableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryIrreparableResponse proto.InternalMessageInfo
+
+func (m *RetryIrreparableResponse) GetStatus() RetryIrreparableResponse_Status {
+	if m != nil {
+		return m.Status
+	}
+	return RetryIrreparableResponse_IRREPARABLE
+}
+
+func (m *RetryIrreparableResponse) GetHealthyPieces() int32 {
+	if m != nil {
+		return m.HealthyPieces
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*InjuredSegment)(nil), "repair.InjuredSegment")
+	proto.RegisterType((*IrreparableSegment)(nil), "repair.IrreparableSegment")
+	proto.RegisterType((*ListIrreparableRequest)(nil), "repair.ListIrreparableRequest")
+	proto.RegisterType((*ListIrreparableResponse)(nil), "repair.ListIrreparableResponse")
+	proto.RegisterType((*GetIrreparableRequest)(nil), "repair.GetIrreparableRequest")
+	proto.RegisterType((*GetIrreparableResponse)(nil), "repair.GetIrreparableResponse")
+	proto.RegisterType((*RetryIrreparableRequest)(nil), "repair.RetryIrreparableRequest")
+	proto.RegisterType((*RetryIrreparableResponse)(nil), "repair.RetryIrreparableResponse")
+	proto.RegisterEnum("repair.RetryIrreparableResponse_Status", RetryIrreparableResponse_Status_name, RetryIrreparableResponse_Status_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Irreparable service
+
+type IrreparableClient interface {
+	// ListIrreparable returns a page of irreparable segments
+	ListIrreparable(ctx context.Context, in *ListIrreparableRequest, opts ...grpc.CallOption) (*ListIrreparableResponse, error)
+	// GetIrreparable returns the details of a single irreparable segment
+	GetIrreparable(ctx context.Context, in *GetIrreparableRequest, opts ...grpc.CallOption) (*GetIrreparableResponse, error)
+	// RetryIrreparable checks the pieces of an irreparable segment again
+	RetryIrreparable(ctx context.Context, in *RetryIrreparableRequest, opts ...grpc.CallOption) (*RetryIrreparableResponse, error)
+}
+
+type irreparableClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIrreparableClient(cc *grpc.ClientConn) IrreparableClient {
+	return &irreparableClient{cc}
+}
+
+func (c *irreparableClient) ListIrreparable(ctx context.Context, in *ListIrreparableRequest, opts ...grpc.CallOption) (*ListIrreparableResponse, error) {
+	out := new(ListIrreparableResponse)
+	err := c.cc.Invoke(ctx, "/repair.Irreparable/ListIrreparable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *irreparableClient) GetIrreparable(ctx context.Context, in *GetIrreparableRequest, opts ...grpc.CallOption) (*GetIrreparableResponse, error) {
+	out := new(GetIrreparableResponse)
+	err := c.cc.Invoke(ctx, "/repair.Irreparable/GetIrreparable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *irreparableClient) RetryIrreparable(ctx context.Context, in *RetryIrreparableRequest, opts ...grpc.CallOption) (*RetryIrreparableResponse, error) {
+	out := new(RetryIrreparableResponse)
+	err := c.cc.Invoke(ctx, "/repair.Irreparable/RetryIrreparable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Irreparable service
+
+type IrreparableServer interface {
+	// ListIrreparable returns a page of irreparable segments
+	ListIrreparable(context.Context, *ListIrreparableRequest) (*ListIrreparableResponse, error)
+	// GetIrreparable returns the details of a single irreparable segment
+	GetIrreparable(context.Context, *GetIrreparableRequest) (*GetIrreparableResponse, error)
+	// RetryIrreparable checks the pieces of an irreparable segment again
+	RetryIrreparable(context.Context, *RetryIrreparableRequest) (*RetryIrreparableResponse, error)
+}
+
+func RegisterIrreparableServer(s *grpc.Server, srv IrreparableServer) {
+	s.RegisterService(&_Irreparable_serviceDesc, srv)
+}
+
+func _Irreparable_ListIrreparable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIrreparableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IrreparableServer).ListIrreparable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repair.Irreparable/ListIrreparable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IrreparableServer).ListIrreparable(ctx, req.(*ListIrreparableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Irreparable_GetIrreparable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIrreparableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IrreparableServer).GetIrreparable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repair.Irreparable/GetIrreparable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IrreparableServer).GetIrreparable(ctx, req.(*GetIrreparableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Irreparable_RetryIrreparable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryIrreparableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IrreparableServer).RetryIrreparable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repair.Irreparable/RetryIrreparable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IrreparableServer).RetryIrreparable(ctx, req.(*RetryIrreparableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Irreparable_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "repair.Irreparable",
+	HandlerType: (*IrreparableServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListIrreparable",
+			Handler:    _Irreparable_ListIrreparable_Handler,
+		},
+		{
+			MethodName: "GetIrreparable",
+			Handler:    _Irreparable_GetIrreparable_Handler,
+		},
+		{
+			MethodName: "RetryIrreparable",
+			Handler:    _Irreparable_RetryIrreparable_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "datarepair.proto",
 }
 
-func init() { proto.RegisterFile("datarepair.proto", fileDescriptor_datarepair_13e4beab54f194bd) }
+func init() { proto.RegisterFile("datarepair.proto", fileDescriptor_datarepair_8fc284894764d111) }
 
-var fileDescriptor_datarepair_13e4beab54f194bd = []byte{
-	// 119 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0x49, 0x2c, 0x49,
-	0x2c, 0x4a, 0x2d, 0x48, 0xcc, 0x2c, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xf0,
-	0x94, 0x5c, 0xb9, 0xf8, 0x3c, 0xf3, 0xb2, 0x4a, 0x8b, 0x52, 0x53, 0x82, 0x53, 0xd3, 0x73, 0x53,
-	0xf3, 0x4a, 0x84, 0x84, 0xb8, 0x58, 0x0a, 0x12, 0x4b, 0x32, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38,
-	0x83, 0xc0, 0x6c, 0x21, 0x79, 0x2e, 0xee, 0x9c, 0xfc, 0xe2, 0x92, 0xf8, 0x82, 0xcc, 0xd4, 0xe4,
-	0xd4, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0xd6, 0x20, 0x2e, 0x90, 0x50, 0x00, 0x58, 0xc4, 0x89,
-	0x25, 0x8a, 0xa9, 0x20, 0x29, 0x89, 0x0d, 0x6c, 0xb6, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x13,
-	0xff, 0xff, 0x1e, 0x6f, 0x00, 0x00, 0x00,
+var fileDescriptor_datarepair_8fc284894764d111 = []byte{
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xc5, 0x71, 0xe3, 0xc2, 0x58, 0x4d, 0xac, 0x55, 0x49, 0xad, 0x08, 0x1a, 0xcb, 0x12, 0x22,
+	0x12, 0x22, 0x42, 0x01, 0x21, 0x71, 0xa1, 0x4a, 0x89, 0x69, 0x22, 0x45, 0x90, 0x6e, 0x9a, 0x03,
+	0x5c, 0x2c, 0x27, 0xd9, 0x10, 0x23, 0xc7, 0x36, 0xde, 0xb1, 0xd4, 0xfe, 0x04, 0x7e, 0x17, 0xff,
+	0x89, 0x33, 0xf2, 0xee, 0x86, 0xa6, 0xf9, 0x28, 0xdc, 0x3c, 0x33, 0xef, 0xcd, 0xbc, 0x9d, 0x37,
+	0x32, 0x58, 0xb3, 0x00, 0x83, 0x8c, 0xa5, 0x41, 0x98, 0xb5, 0xd2, 0x2c, 0xc1, 0x84, 0x18, 0x32,
+	0xaa, 0x57, 0xd3, 0x24, 0x8c, 0x91, 0x65, 0xb3, 0x89, 0x2c, 0xb8, 0x73, 0xa8, 0xf4, 0xe3, 0xef,
+	0x79, 0xc6, 0x66, 0x23, 0xf6, 0x6d, 0xc9, 0x62, 0x24, 0x04, 0x0e, 0xd2, 0x00, 0x17, 0xb6, 0xe6,
+	0x68, 0xcd, 0x47, 0x54, 0x7c, 0x93, 0x06, 0x98, 0x51, 0xc2, 0xd1, 0x4f, 0x43, 0x36, 0x65, 0xdc,
+	0x2e, 0x39, 0x7a, 0xb3, 0x4c, 0xa1, 0x48, 0x0d, 0x45, 0xa6, 0x00, 0xc4, 0xf9, 0xd2, 0x5f, 0xb0,
+	0x20, 0xc2, 0xc5, 0x8d, 0xad, 0x3b, 0x5a, 0x01, 0x88, 0xf3, 0x65, 0x4f, 0x66, 0xdc, 0xdf, 0x1a,
+	0x90, 0x7e, 0x56, 0xa8, 0xc8, 0x82, 0x49, 0xc4, 0xee, 0x1b, 0xf6, 0x0e, 0x2a, 0x5c, 0x96, 0xfd,
+	0x19, 0xc3, 0x20, 0x8c, 0xec, 0x92, 0xa3, 0x35, 0xcd, 0x36, 0x69, 0xdd, 0x8a, 0x1f, 0xca, 0x2f,
+	0x7a, 0xa4, 0x90, 0x5d, 0x01, 0xdc, 0xd4, 0x59, 0xc8, 0xd0, 0xef, 0xe8, 0x7c, 0x0f, 0x4f, 0xa2,
+	0x80, 0xa3, 0x2f, 0xd7, 0xe1, 0x07, 0x88, 0x6c, 0x99, 0xa2, 0x9f, 0xc7, 0xe1, 0xb5, 0xcf, 0xd9,
+	0xd4, 0x3e, 0x10, 0x0c, 0xbb, 0xc0, 0x50, 0x01, 0xe9, 0x48, 0xc4, 0x38, 0x0e, 0xaf, 0x47, 0x6c,
+	0x4a, 0x5e, 0xc1, 0xf1, 0x06, 0x75, 0x9a, 0xe4, 0x31, 0xda, 0x65, 0xc1, 0x23, 0xd9, 0x3a, 0xe7,
+	0x43, 0x51, 0x71, 0x3f, 0x42, 0x6d, 0x10, 0x72, 0x5c, 0x7b, 0x3b, 0x65, 0x3f, 0x72, 0xc6, 0x91,
+	0x1c, 0x43, 0x39, 0x0a, 0x97, 0x21, 0x8a, 0xc7, 0x97, 0xa9, 0x0c, 0x48, 0x0d, 0x8c, 0x64, 0x3e,
+	0xe7, 0x0c, 0xc5, 0xab, 0x75, 0xaa, 0x22, 0xf7, 0x12, 0x4e, 0xb6, 0xfa, 0xf0, 0x34, 0x89, 0x39,
+	0x23, 0x6f, 0xe1, 0xa1, 0x5a, 0x03, 0xb7, 0x35, 0x47, 0x6f, 0x9a, 0xed, 0x7a, 0x4b, 0xb9, 0xbf,
+	0xbd, 0x72, 0xfa, 0x17, 0xeb, 0xbe, 0x80, 0xc7, 0x17, 0x6c, 0x97, 0xb2, 0x1d, 0xae, 0xb8, 0x9f,
+	0xa0, 0x76, 0xc1, 0x76, 0x8e, 0x7f, 0x03, 0x87, 0xaa, 0xa5, 0x20, 0xdc, 0x3f, 0x7d, 0x05, 0x75,
+	0x5f, 0xc2, 0x09, 0x65, 0x98, 0xdd, 0xfc, 0xe7, 0xf8, 0x5f, 0x1a, 0xd8, 0xdb, 0x78, 0xa5, 0xe0,
+	0x0c, 0x0c, 0x8e, 0x01, 0xe6, 0x5c, 0x50, 0x2a, 0xed, 0xe7, 0x2b, 0x01, 0xfb, 0x18, 0xad, 0x91,
+	0x80, 0x53, 0x45, 0x23, 0xcf, 0xa0, 0xa2, 0x4e, 0xf7, 0xf6, 0xc4, 0x0b, 0x4f, 0x8e, 0x54, 0x56,
+	0x5e, 0x8f, 0x7b, 0x06, 0x86, 0x24, 0x92, 0x2a, 0x98, 0x7d, 0x4a, 0xbd, 0x61, 0x87, 0x76, 0xce,
+	0x07, 0x9e, 0xf5, 0x80, 0x00, 0x18, 0x97, 0x63, 0x6f, 0xec, 0x75, 0x2d, 0x8d, 0x98, 0x70, 0xd8,
+	0xf3, 0x3a, 0x83, 0xab, 0xde, 0x17, 0xab, 0x54, 0x04, 0x5d, 0x6f, 0xe0, 0x5d, 0x79, 0x5d, 0x4b,
+	0x6f, 0xff, 0x2c, 0x81, 0xb9, 0x26, 0x87, 0x50, 0xa8, 0x6e, 0x98, 0x4a, 0x4e, 0x57, 0xda, 0x77,
+	0x5f, 0x4d, 0xbd, 0xb1, 0xb7, 0xae, 0x96, 0xf1, 0x19, 0x2a, 0x77, 0x8d, 0x22, 0x4f, 0x57, 0x94,
+	0x9d, 0x6e, 0xd7, 0x4f, 0xf7, 0x95, 0x55, 0xc3, 0x31, 0x58, 0x9b, 0x7b, 0x24, 0x8d, 0xfd, 0x1b,
+	0x96, 0x4d, 0x9d, 0x7f, 0x59, 0x70, 0x7e, 0xf0, 0xb5, 0x94, 0x4e, 0x26, 0x86, 0xf8, 0x0d, 0xbd,
+	0xfe, 0x33, 0x00, 0x54, 0x72, 0xad, 0xee, 0xb3, 0x04, 0x00, 0x00,
 }
@@ -34,7 +34,7 @@ func (m *GetStatsRequest) Reset()         { *m = GetStatsRequest{} }
 func (m *GetStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatsRequest) ProtoMessage()    {}
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{0}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{0}
 }
 func (m *GetStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsRequest.Unmarshal(m, b)
@@ -68,7 +68,7 @@ func (m *GetStatsResponse) Reset()         { *m = GetStatsResponse{} }
 func (m *GetStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStatsResponse) ProtoMessage()    {}
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{1}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{1}
 }
 func (m *GetStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsResponse.Unmarshal(m, b)
@@ -132,7 +132,7 @@ func (m *CreateStatsRequest) Reset()         { *m = CreateStatsRequest{} }
 func (m *CreateStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateStatsRequest) ProtoMessage()    {}
 func (*CreateStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{2}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{2}
 }
 func (m *CreateStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStatsRequest.Unmarshal(m, b)
@@ -190,7 +190,7 @@ func (m *CreateStatsResponse) Reset()         { *m = CreateStatsResponse{} }
 func (m *CreateStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateStatsResponse) ProtoMessage()    {}
 func (*CreateStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{3}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{3}
 }
 func (m *CreateStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStatsResponse.Unmarshal(m, b)
@@ -223,7 +223,7 @@ func (m *CountNodesResponse) Reset()         { *m = CountNodesResponse{} }
 func (m *CountNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountNodesResponse) ProtoMessage()    {}
 func (*CountNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{4}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{4}
 }
 func (m *CountNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesResponse.Unmarshal(m, b)
@@ -267,7 +267,7 @@ func (m *CountNodesRequest) Reset()         { *m = CountNodesRequest{} }
 func (m *CountNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountNodesRequest) ProtoMessage()    {}
 func (*CountNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{5}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{5}
 }
 func (m *CountNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesRequest.Unmarshal(m, b)
@@ -287,6 +287,138 @@ func (m *CountNodesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CountNodesRequest proto.InternalMessageInfo
 
+// VersionDistribution
+type VersionDistributionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionDistributionRequest) Reset()         { *m = VersionDistributionRequest{} }
+func (m *VersionDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*VersionDistributionRequest) ProtoMessage()    {}
+func (*VersionDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{6}
+}
+func (m *VersionDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionDistributionRequest.Unmarshal(m, b)
+}
+func (m *VersionDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionDistributionRequest.Marshal(b, m, deterministic)
+}
+func (dst *VersionDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionDistributionRequest.Merge(dst, src)
+}
+func (m *VersionDistributionRequest) XXX_Size() int {
+	return xxx_messageInfo_VersionDistributionRequest.Size(m)
+}
+func (m *VersionDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionDistributionRequest proto.InternalMessageInfo
+
+type VersionDistributionResponse struct {
+	Versions             []*VersionCount `protobuf:"bytes,1,rep,name=versions" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *VersionDistributionResponse) Reset()         { *m = VersionDistributionResponse{} }
+func (m *VersionDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*VersionDistributionResponse) ProtoMessage()    {}
+func (*VersionDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{7}
+}
+func (m *VersionDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionDistributionResponse.Unmarshal(m, b)
+}
+func (m *VersionDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionDistributionResponse.Marshal(b, m, deterministic)
+}
+func (dst *VersionDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionDistributionResponse.Merge(dst, src)
+}
+func (m *VersionDistributionResponse) XXX_Size() int {
+	return xxx_messageInfo_VersionDistributionResponse.Size(m)
+}
+func (m *VersionDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionDistributionResponse proto.InternalMessageInfo
+
+func (m *VersionDistributionResponse) GetVersions() []*VersionCount {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type VersionCount struct {
+	Type    NodeType `protobuf:"varint,1,opt,name=type,proto3,enum=node.NodeType" json:"type,omitempty"`
+	Version string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Count   int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// allowed is false when the version is below the minimum for the node type
+	Allowed              bool     `protobuf:"varint,4,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionCount) Reset()         { *m = VersionCount{} }
+func (m *VersionCount) String() string { return proto.CompactTextString(m) }
+func (*VersionCount) ProtoMessage()    {}
+func (*VersionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{8}
+}
+func (m *VersionCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionCount.Unmarshal(m, b)
+}
+func (m *VersionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionCount.Marshal(b, m, deterministic)
+}
+func (dst *VersionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionCount.Merge(dst, src)
+}
+func (m *VersionCount) XXX_Size() int {
+	return xxx_messageInfo_VersionCount.Size(m)
+}
+func (m *VersionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionCount proto.InternalMessageInfo
+
+func (m *VersionCount) GetType() NodeType {
+	if m != nil {
+		return m.Type
+	}
+	return NodeType_ADMIN
+}
+
+func (m *VersionCount) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *VersionCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *VersionCount) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
 // GetBuckets
 type GetBucketsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -298,7 +430,7 @@ func (m *GetBucketsRequest) Reset()         { *m = GetBucketsRequest{} }
 func (m *GetBucketsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsRequest) ProtoMessage()    {}
 func (*GetBucketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{6}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{9}
 }
 func (m *GetBucketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsRequest.Unmarshal(m, b)
@@ -330,7 +462,7 @@ func (m *GetBucketsResponse) Reset()         { *m = GetBucketsResponse{} }
 func (m *GetBucketsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsResponse) ProtoMessage()    {}
 func (*GetBucketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{7}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{10}
 }
 func (m *GetBucketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsResponse.Unmarshal(m, b)
@@ -369,7 +501,7 @@ func (m *GetBucketRequest) Reset()         { *m = GetBucketRequest{} }
 func (m *GetBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketRequest) ProtoMessage()    {}
 func (*GetBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{8}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{11}
 }
 func (m *GetBucketRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketRequest.Unmarshal(m, b)
@@ -401,7 +533,7 @@ func (m *GetBucketResponse) Reset()         { *m = GetBucketResponse{} }
 func (m *GetBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketResponse) ProtoMessage()    {}
 func (*GetBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{9}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{12}
 }
 func (m *GetBucketResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketResponse.Unmarshal(m, b)
@@ -439,7 +571,7 @@ func (m *Bucket) Reset()         { *m = Bucket{} }
 func (m *Bucket) String() string { return proto.CompactTextString(m) }
 func (*Bucket) ProtoMessage()    {}
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{10}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{13}
 }
 func (m *Bucket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Bucket.Unmarshal(m, b)
@@ -477,7 +609,7 @@ func (m *BucketList) Reset()         { *m = BucketList{} }
 func (m *BucketList) String() string { return proto.CompactTextString(m) }
 func (*BucketList) ProtoMessage()    {}
 func (*BucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{11}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{14}
 }
 func (m *BucketList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketList.Unmarshal(m, b)
@@ -517,7 +649,7 @@ func (m *PingNodeRequest) Reset()         { *m = PingNodeRequest{} }
 func (m *PingNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*PingNodeRequest) ProtoMessage()    {}
 func (*PingNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{12}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{15}
 }
 func (m *PingNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeRequest.Unmarshal(m, b)
@@ -555,7 +687,7 @@ func (m *PingNodeResponse) Reset()         { *m = PingNodeResponse{} }
 func (m *PingNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*PingNodeResponse) ProtoMessage()    {}
 func (*PingNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{13}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{16}
 }
 func (m *PingNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeResponse.Unmarshal(m, b)
@@ -594,7 +726,7 @@ func (m *LookupNodeRequest) Reset()         { *m = LookupNodeRequest{} }
 func (m *LookupNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeRequest) ProtoMessage()    {}
 func (*LookupNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{14}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{17}
 }
 func (m *LookupNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeRequest.Unmarshal(m, b)
@@ -640,7 +772,7 @@ func (m *LookupNodeResponse) Reset()         { *m = LookupNodeResponse{} }
 func (m *LookupNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeResponse) ProtoMessage()    {}
 func (*LookupNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_4d95b6ca0d033b47, []int{15}
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{18}
 }
 func (m *LookupNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeResponse.Unmarshal(m, b)
@@ -674,6 +806,311 @@ func (m *LookupNodeResponse) GetMeta() *NodeMetadata {
 	return nil
 }
 
+// ListRepairs
+type ListRepairsRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRepairsRequest) Reset()         { *m = ListRepairsRequest{} }
+func (m *ListRepairsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRepairsRequest) ProtoMessage()    {}
+func (*ListRepairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{19}
+}
+func (m *ListRepairsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRepairsRequest.Unmarshal(m, b)
+}
+func (m *ListRepairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRepairsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRepairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRepairsRequest.Merge(dst, src)
+}
+func (m *ListRepairsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRepairsRequest.Size(m)
+}
+func (m *ListRepairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRepairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRepairsRequest proto.InternalMessageInfo
+
+func (m *ListRepairsRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ListRepairsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListRepairsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListRepairsResponse struct {
+	Repairs              []*RepairRecord `protobuf:"bytes,1,rep,name=repairs" json:"repairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListRepairsResponse) Reset()         { *m = ListRepairsResponse{} }
+func (m *ListRepairsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRepairsResponse) ProtoMessage()    {}
+func (*ListRepairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{20}
+}
+func (m *ListRepairsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRepairsResponse.Unmarshal(m, b)
+}
+func (m *ListRepairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRepairsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListRepairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRepairsResponse.Merge(dst, src)
+}
+func (m *ListRepairsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRepairsResponse.Size(m)
+}
+func (m *ListRepairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRepairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRepairsResponse proto.InternalMessageInfo
+
+func (m *ListRepairsResponse) GetRepairs() []*RepairRecord {
+	if m != nil {
+		return m.Repairs
+	}
+	return nil
+}
+
+type RepairRecord struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	HealthyPieces        int64    `protobuf:"varint,2,opt,name=healthy_pieces,json=healthyPieces,proto3" json:"healthy_pieces,omitempty"`
+	RebuiltPieces        int64    `protobuf:"varint,3,opt,name=rebuilt_pieces,json=rebuiltPieces,proto3" json:"rebuilt_pieces,omitempty"`
+	NewNodes             []NodeID `protobuf:"bytes,4,rep,name=new_nodes,json=newNodes,customtype=NodeID" json:"new_nodes"`
+	Failure              string   `protobuf:"bytes,5,opt,name=failure,proto3" json:"failure,omitempty"`
+	RepairedUnixSec      int64    `protobuf:"varint,6,opt,name=repaired_unix_sec,json=repairedUnixSec,proto3" json:"repaired_unix_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepairRecord) Reset()         { *m = RepairRecord{} }
+func (m *RepairRecord) String() string { return proto.CompactTextString(m) }
+func (*RepairRecord) ProtoMessage()    {}
+func (*RepairRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{21}
+}
+func (m *RepairRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairRecord.Unmarshal(m, b)
+}
+func (m *RepairRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairRecord.Marshal(b, m, deterministic)
+}
+func (dst *RepairRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairRecord.Merge(dst, src)
+}
+func (m *RepairRecord) XXX_Size() int {
+	return xxx_messageInfo_RepairRecord.Size(m)
+}
+func (m *RepairRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairRecord proto.InternalMessageInfo
+
+func (m *RepairRecord) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RepairRecord) GetHealthyPieces() int64 {
+	if m != nil {
+		return m.HealthyPieces
+	}
+	return 0
+}
+
+func (m *RepairRecord) GetRebuiltPieces() int64 {
+	if m != nil {
+		return m.RebuiltPieces
+	}
+	return 0
+}
+
+func (m *RepairRecord) GetFailure() string {
+	if m != nil {
+		return m.Failure
+	}
+	return ""
+}
+
+func (m *RepairRecord) GetRepairedUnixSec() int64 {
+	if m != nil {
+		return m.RepairedUnixSec
+	}
+	return 0
+}
+
+// ListBandwidthRollups
+type ListBandwidthRollupsRequest struct {
+	StartUnixSec         int64    `protobuf:"varint,1,opt,name=start_unix_sec,json=startUnixSec,proto3" json:"start_unix_sec,omitempty"`
+	EndUnixSec           int64    `protobuf:"varint,2,opt,name=end_unix_sec,json=endUnixSec,proto3" json:"end_unix_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBandwidthRollupsRequest) Reset()         { *m = ListBandwidthRollupsRequest{} }
+func (m *ListBandwidthRollupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBandwidthRollupsRequest) ProtoMessage()    {}
+func (*ListBandwidthRollupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{22}
+}
+func (m *ListBandwidthRollupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBandwidthRollupsRequest.Unmarshal(m, b)
+}
+func (m *ListBandwidthRollupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBandwidthRollupsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListBandwidthRollupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBandwidthRollupsRequest.Merge(dst, src)
+}
+func (m *ListBandwidthRollupsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBandwidthRollupsRequest.Size(m)
+}
+func (m *ListBandwidthRollupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBandwidthRollupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBandwidthRollupsRequest proto.InternalMessageInfo
+
+func (m *ListBandwidthRollupsRequest) GetStartUnixSec() int64 {
+	if m != nil {
+		return m.StartUnixSec
+	}
+	return 0
+}
+
+func (m *ListBandwidthRollupsRequest) GetEndUnixSec() int64 {
+	if m != nil {
+		return m.EndUnixSec
+	}
+	return 0
+}
+
+type ListBandwidthRollupsResponse struct {
+	Rollups              []*BandwidthRollup `protobuf:"bytes,1,rep,name=rollups" json:"rollups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListBandwidthRollupsResponse) Reset()         { *m = ListBandwidthRollupsResponse{} }
+func (m *ListBandwidthRollupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBandwidthRollupsResponse) ProtoMessage()    {}
+func (*ListBandwidthRollupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{23}
+}
+func (m *ListBandwidthRollupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBandwidthRollupsResponse.Unmarshal(m, b)
+}
+func (m *ListBandwidthRollupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBandwidthRollupsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListBandwidthRollupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBandwidthRollupsResponse.Merge(dst, src)
+}
+func (m *ListBandwidthRollupsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBandwidthRollupsResponse.Size(m)
+}
+func (m *ListBandwidthRollupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBandwidthRollupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBandwidthRollupsResponse proto.InternalMessageInfo
+
+func (m *ListBandwidthRollupsResponse) GetRollups() []*BandwidthRollup {
+	if m != nil {
+		return m.Rollups
+	}
+	return nil
+}
+
+type BandwidthRollup struct {
+	StorageNodeId        NodeID                          `protobuf:"bytes,1,opt,name=storage_node_id,json=storageNodeId,proto3,customtype=NodeID" json:"storage_node_id"`
+	UplinkId             NodeID                          `protobuf:"bytes,2,opt,name=uplink_id,json=uplinkId,proto3,customtype=NodeID" json:"uplink_id"`
+	Action               PayerBandwidthAllocation_Action `protobuf:"varint,3,opt,name=action,proto3,enum=piecestoreroutes.PayerBandwidthAllocation_Action" json:"action,omitempty"`
+	IntervalStartUnixSec int64                           `protobuf:"varint,4,opt,name=interval_start_unix_sec,json=intervalStartUnixSec,proto3" json:"interval_start_unix_sec,omitempty"`
+	Total                int64                           `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *BandwidthRollup) Reset()         { *m = BandwidthRollup{} }
+func (m *BandwidthRollup) String() string { return proto.CompactTextString(m) }
+func (*BandwidthRollup) ProtoMessage()    {}
+func (*BandwidthRollup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_ce6ab23365297fc8, []int{24}
+}
+func (m *BandwidthRollup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BandwidthRollup.Unmarshal(m, b)
+}
+func (m *BandwidthRollup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BandwidthRollup.Marshal(b, m, deterministic)
+}
+func (dst *BandwidthRollup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandwidthRollup.Merge(dst, src)
+}
+func (m *BandwidthRollup) XXX_Size() int {
+	return xxx_messageInfo_BandwidthRollup.Size(m)
+}
+func (m *BandwidthRollup) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandwidthRollup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BandwidthRollup proto.InternalMessageInfo
+
+func (m *BandwidthRollup) GetAction() PayerBandwidthAllocation_Action {
+	if m != nil {
+		return m.Action
+	}
+	return PayerBandwidthAllocation_PUT
+}
+
+func (m *BandwidthRollup) GetIntervalStartUnixSec() int64 {
+	if m != nil {
+		return m.IntervalStartUnixSec
+	}
+	return 0
+}
+
+func (m *BandwidthRollup) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GetStatsRequest)(nil), "inspector.GetStatsRequest")
 	proto.RegisterType((*GetStatsResponse)(nil), "inspector.GetStatsResponse")
@@ -681,6 +1118,9 @@ func init() {
 	proto.RegisterType((*CreateStatsResponse)(nil), "inspector.CreateStatsResponse")
 	proto.RegisterType((*CountNodesResponse)(nil), "inspector.CountNodesResponse")
 	proto.RegisterType((*CountNodesRequest)(nil), "inspector.CountNodesRequest")
+	proto.RegisterType((*VersionDistributionRequest)(nil), "inspector.VersionDistributionRequest")
+	proto.RegisterType((*VersionDistributionResponse)(nil), "inspector.VersionDistributionResponse")
+	proto.RegisterType((*VersionCount)(nil), "inspector.VersionCount")
 	proto.RegisterType((*GetBucketsRequest)(nil), "inspector.GetBucketsRequest")
 	proto.RegisterType((*GetBucketsResponse)(nil), "inspector.GetBucketsResponse")
 	proto.RegisterType((*GetBucketRequest)(nil), "inspector.GetBucketRequest")
@@ -691,6 +1131,12 @@ func init() {
 	proto.RegisterType((*PingNodeResponse)(nil), "inspector.PingNodeResponse")
 	proto.RegisterType((*LookupNodeRequest)(nil), "inspector.LookupNodeRequest")
 	proto.RegisterType((*LookupNodeResponse)(nil), "inspector.LookupNodeResponse")
+	proto.RegisterType((*ListRepairsRequest)(nil), "inspector.ListRepairsRequest")
+	proto.RegisterType((*ListRepairsResponse)(nil), "inspector.ListRepairsResponse")
+	proto.RegisterType((*RepairRecord)(nil), "inspector.RepairRecord")
+	proto.RegisterType((*ListBandwidthRollupsRequest)(nil), "inspector.ListBandwidthRollupsRequest")
+	proto.RegisterType((*ListBandwidthRollupsResponse)(nil), "inspector.ListBandwidthRollupsResponse")
+	proto.RegisterType((*BandwidthRollup)(nil), "inspector.BandwidthRollup")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -715,11 +1161,19 @@ type InspectorClient interface {
 	PingNode(ctx context.Context, in *PingNodeRequest, opts ...grpc.CallOption) (*PingNodeResponse, error)
 	// LookupNode triggers a Kademlia FindNode and returns the response
 	LookupNode(ctx context.Context, in *LookupNodeRequest, opts ...grpc.CallOption) (*LookupNodeResponse, error)
+	// VersionDistribution returns how many cached nodes run each version
+	VersionDistribution(ctx context.Context, in *VersionDistributionRequest, opts ...grpc.CallOption) (*VersionDistributionResponse, error)
 	// StatDB commands:
 	// GetStats returns the stats for a particular node ID
 	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error)
+	// Repair commands:
+	// ListRepairs returns the repair history of a segment or of all segments
+	ListRepairs(ctx context.Context, in *ListRepairsRequest, opts ...grpc.CallOption) (*ListRepairsResponse, error)
+	// Accounting commands:
+	// ListBandwidthRollups returns the hourly bandwidth rollups of a period
+	ListBandwidthRollups(ctx context.Context, in *ListBandwidthRollupsRequest, opts ...grpc.CallOption) (*ListBandwidthRollupsResponse, error)
 }
 
 type inspectorClient struct {
@@ -775,6 +1229,15 @@ func (c *inspectorClient) LookupNode(ctx context.Context, in *LookupNodeRequest,
 	return out, nil
 }
 
+func (c *inspectorClient) VersionDistribution(ctx context.Context, in *VersionDistributionRequest, opts ...grpc.CallOption) (*VersionDistributionResponse, error) {
+	out := new(VersionDistributionResponse)
+	err := c.cc.Invoke(ctx, "/inspector.Inspector/VersionDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inspectorClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
 	out := new(GetStatsResponse)
 	err := c.cc.Invoke(ctx, "/inspector.Inspector/GetStats", in, out, opts...)
@@ -793,6 +1256,24 @@ func (c *inspectorClient) CreateStats(ctx context.Context, in *CreateStatsReques
 	return out, nil
 }
 
+func (c *inspectorClient) ListRepairs(ctx context.Context, in *ListRepairsRequest, opts ...grpc.CallOption) (*ListRepairsResponse, error) {
+	out := new(ListRepairsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.Inspector/ListRepairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inspectorClient) ListBandwidthRollups(ctx context.Context, in *ListBandwidthRollupsRequest, opts ...grpc.CallOption) (*ListBandwidthRollupsResponse, error) {
+	out := new(ListBandwidthRollupsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.Inspector/ListBandwidthRollups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Inspector service
 
 type InspectorServer interface {
@@ -807,11 +1288,19 @@ type InspectorServer interface {
 	PingNode(context.Context, *PingNodeRequest) (*PingNodeResponse, error)
 	// LookupNode triggers a Kademlia FindNode and returns the response
 	LookupNode(context.Context, *LookupNodeRequest) (*LookupNodeResponse, error)
+	// VersionDistribution returns how many cached nodes run each version
+	VersionDistribution(context.Context, *VersionDistributionRequest) (*VersionDistributionResponse, error)
 	// StatDB commands:
 	// GetStats returns the stats for a particular node ID
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(context.Context, *CreateStatsRequest) (*CreateStatsResponse, error)
+	// Repair commands:
+	// ListRepairs returns the repair history of a segment or of all segments
+	ListRepairs(context.Context, *ListRepairsRequest) (*ListRepairsResponse, error)
+	// Accounting commands:
+	// ListBandwidthRollups returns the hourly bandwidth rollups of a period
+	ListBandwidthRollups(context.Context, *ListBandwidthRollupsRequest) (*ListBandwidthRollupsResponse, error)
 }
 
 func RegisterInspectorServer(s *grpc.Server, srv InspectorServer) {
@@ -908,6 +1397,24 @@ func _Inspector_LookupNode_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Inspector_VersionDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InspectorServer).VersionDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.Inspector/VersionDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InspectorServer).VersionDistribution(ctx, req.(*VersionDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Inspector_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStatsRequest)
 	if err := dec(in); err != nil {
@@ -944,6 +1451,42 @@ func _Inspector_CreateStats_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Inspector_ListRepairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InspectorServer).ListRepairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.Inspector/ListRepairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InspectorServer).ListRepairs(ctx, req.(*ListRepairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inspector_ListBandwidthRollups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBandwidthRollupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InspectorServer).ListBandwidthRollups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.Inspector/ListBandwidthRollups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InspectorServer).ListBandwidthRollups(ctx, req.(*ListBandwidthRollupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Inspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.Inspector",
 	HandlerType: (*InspectorServer)(nil),
@@ -968,6 +1511,10 @@ var _Inspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LookupNode",
 			Handler:    _Inspector_LookupNode_Handler,
 		},
+		{
+			MethodName: "VersionDistribution",
+			Handler:    _Inspector_VersionDistribution_Handler,
+		},
 		{
 			MethodName: "GetStats",
 			Handler:    _Inspector_GetStats_Handler,
@@ -976,53 +1523,93 @@ var _Inspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateStats",
 			Handler:    _Inspector_CreateStats_Handler,
 		},
+		{
+			MethodName: "ListRepairs",
+			Handler:    _Inspector_ListRepairs_Handler,
+		},
+		{
+			MethodName: "ListBandwidthRollups",
+			Handler:    _Inspector_ListBandwidthRollups_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
 }
 
-func init() { proto.RegisterFile("inspector.proto", fileDescriptor_inspector_4d95b6ca0d033b47) }
-
-var fileDescriptor_inspector_4d95b6ca0d033b47 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x8e, 0xd2, 0x40,
-	0x18, 0xb5, 0xa5, 0xcb, 0xc2, 0x07, 0x81, 0x65, 0x58, 0x13, 0x52, 0x70, 0xc1, 0xb9, 0x50, 0xe2,
-	0x05, 0x31, 0x78, 0x67, 0xe2, 0x0d, 0x18, 0x57, 0x14, 0x8d, 0xe9, 0xc6, 0x1b, 0x63, 0xb2, 0x99,
-	0xa5, 0x13, 0xd2, 0xf0, 0x33, 0xb5, 0x33, 0x35, 0xf1, 0x1d, 0x7c, 0x02, 0x9f, 0xc8, 0x67, 0xf0,
-	0x62, 0x6f, 0x7c, 0x11, 0xd3, 0x99, 0xe9, 0x1f, 0x2d, 0xd9, 0x8d, 0x77, 0xcc, 0x77, 0xce, 0x9c,
-	0xf9, 0xce, 0x9c, 0x8f, 0x29, 0xb4, 0xbd, 0x3d, 0xf7, 0xe9, 0x4a, 0xb0, 0x60, 0xe2, 0x07, 0x4c,
-	0x30, 0x54, 0x4f, 0x0a, 0x36, 0xac, 0xd9, 0x9a, 0xa9, 0xb2, 0x0d, 0x7b, 0xe6, 0x52, 0xf5, 0x1b,
-	0xbf, 0x84, 0xf6, 0x25, 0x15, 0x57, 0x82, 0x08, 0xee, 0xd0, 0x6f, 0x21, 0xe5, 0x02, 0x3d, 0x85,
-	0xd3, 0x88, 0x70, 0xed, 0xb9, 0x3d, 0x63, 0x64, 0x8c, 0x9b, 0xb3, 0xd6, 0xef, 0xdb, 0xe1, 0x83,
-	0x3f, 0xb7, 0xc3, 0xea, 0x47, 0xe6, 0xd2, 0xc5, 0x6b, 0xa7, 0x1a, 0xc1, 0x0b, 0x17, 0xff, 0x32,
-	0xe0, 0x2c, 0xdd, 0xcc, 0x7d, 0xb6, 0xe7, 0x14, 0x0d, 0xa1, 0x41, 0x42, 0xd7, 0x13, 0xd7, 0x2b,
-	0x16, 0xee, 0x85, 0x54, 0xa8, 0x38, 0x20, 0x4b, 0xf3, 0xa8, 0x92, 0x12, 0x02, 0x22, 0x3c, 0xd6,
-	0x33, 0x47, 0xc6, 0xd8, 0xd0, 0x04, 0x27, 0xaa, 0xa0, 0xc7, 0xd0, 0x0c, 0x7d, 0xe1, 0xed, 0xa8,
-	0x96, 0xa8, 0x48, 0x89, 0x86, 0xaa, 0x29, 0x8d, 0x94, 0xa2, 0x44, 0x2c, 0x29, 0xa2, 0x29, 0x52,
-	0x05, 0xff, 0x35, 0x00, 0xcd, 0x03, 0x4a, 0x04, 0xfd, 0x2f, 0x73, 0x87, 0x3e, 0xcc, 0x82, 0x8f,
-	0x09, 0x74, 0x15, 0x81, 0x87, 0xab, 0x15, 0xe5, 0x3c, 0xd7, 0x6d, 0x47, 0x42, 0x57, 0x0a, 0x39,
-	0xec, 0x59, 0x11, 0xad, 0xa2, 0xad, 0xe7, 0x70, 0xae, 0x29, 0x79, 0xcd, 0x13, 0x49, 0x45, 0x0a,
-	0xcb, 0x8a, 0xe2, 0x87, 0xd0, 0xcd, 0x99, 0x54, 0x21, 0xe0, 0x77, 0x80, 0x24, 0x1e, 0x79, 0x4a,
-	0xa3, 0xb1, 0xa1, 0xb6, 0x21, 0x2e, 0xdd, 0x6d, 0x3d, 0xa2, 0x73, 0x49, 0xd6, 0xa8, 0x07, 0xa7,
-	0xec, 0x3b, 0x0d, 0xb6, 0xe4, 0x87, 0xb6, 0x1a, 0x2f, 0x71, 0x17, 0x3a, 0x59, 0x2d, 0x79, 0x8d,
-	0x51, 0xf1, 0x92, 0x8a, 0x59, 0xb8, 0xda, 0xd0, 0xe4, 0x6e, 0xf1, 0x5b, 0x40, 0xd9, 0xa2, 0x3e,
-	0xf5, 0x1c, 0x4e, 0x04, 0x13, 0x64, 0xab, 0x8f, 0x54, 0x0b, 0x34, 0x80, 0x8a, 0xe7, 0xf2, 0x9e,
-	0x39, 0xaa, 0x8c, 0x9b, 0x33, 0xc8, 0xdc, 0x7f, 0x54, 0xc6, 0x53, 0x39, 0x58, 0x4a, 0x29, 0x4e,
-	0xee, 0x02, 0xcc, 0xa3, 0xa1, 0x99, 0x9e, 0x8b, 0x3f, 0x67, 0x5a, 0x4a, 0x0e, 0xbf, 0x63, 0x13,
-	0x1a, 0xc1, 0x49, 0x94, 0xb7, 0x6a, 0xa4, 0x31, 0x85, 0x89, 0xfc, 0x6b, 0x44, 0x04, 0x47, 0x01,
-	0xf8, 0x19, 0x54, 0x95, 0xe6, 0x3d, 0xb8, 0x13, 0x00, 0xc5, 0x5d, 0x7a, 0x3c, 0xc3, 0x37, 0x8e,
-	0xf1, 0xdf, 0x43, 0xfb, 0x93, 0xb7, 0x5f, 0xcb, 0xd2, 0xfd, 0x5c, 0x46, 0x39, 0x11, 0xd7, 0x0d,
-	0x28, 0xe7, 0x32, 0xa7, 0xba, 0x13, 0x2f, 0x31, 0x86, 0xb3, 0x54, 0x4c, 0xdb, 0x6f, 0x81, 0xc9,
-	0x36, 0x52, 0xad, 0xe6, 0x98, 0x6c, 0x83, 0x5f, 0x41, 0x67, 0xc9, 0xd8, 0x26, 0xf4, 0xb3, 0x47,
-	0xb6, 0x92, 0x23, 0xeb, 0x77, 0x1c, 0xf1, 0x15, 0x50, 0x76, 0x7b, 0x72, 0xc7, 0x56, 0x64, 0x47,
-	0x2a, 0xe4, 0x6d, 0xca, 0x3a, 0x7a, 0x02, 0xd6, 0x8e, 0x0a, 0x22, 0xc5, 0x1a, 0x53, 0x94, 0xe2,
-	0x1f, 0xa8, 0x20, 0x2e, 0x11, 0xc4, 0x91, 0xf8, 0xf4, 0xa7, 0x05, 0xf5, 0x45, 0xfc, 0x60, 0xa1,
-	0x05, 0x40, 0x3a, 0x76, 0x68, 0x30, 0x49, 0xdf, 0xb6, 0xc2, 0x34, 0xda, 0x8f, 0x8e, 0xa0, 0xba,
-	0xc1, 0x05, 0x40, 0x3a, 0x97, 0x39, 0xa9, 0xc2, 0x0c, 0xe7, 0xa4, 0x4a, 0x86, 0xf9, 0x0d, 0xd4,
-	0x93, 0x2a, 0xea, 0x97, 0x71, 0x63, 0xa1, 0x41, 0x39, 0xa8, 0x75, 0xe6, 0x50, 0x8b, 0xc3, 0x42,
-	0x76, 0x86, 0x79, 0x30, 0x0e, 0x76, 0xbf, 0x14, 0x4b, 0x7d, 0xa5, 0x71, 0xe4, 0x7c, 0x15, 0x42,
-	0xce, 0xf9, 0x2a, 0xc9, 0x70, 0x0e, 0xb5, 0xf8, 0x25, 0xcf, 0xf5, 0x73, 0xf0, 0x6d, 0xb0, 0xfb,
-	0xa5, 0x98, 0x16, 0x59, 0x42, 0x23, 0xf3, 0x18, 0xa1, 0x5c, 0x2a, 0x85, 0x97, 0xd8, 0xbe, 0x38,
-	0x06, 0x2b, 0xb5, 0x99, 0xf5, 0xc5, 0xf4, 0x6f, 0x6e, 0xaa, 0xf2, 0x33, 0xf5, 0xe2, 0x5f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x1b, 0x61, 0x84, 0xdb, 0xdc, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("inspector.proto", fileDescriptor_inspector_ce6ab23365297fc8) }
+
+var fileDescriptor_inspector_ce6ab23365297fc8 = []byte{
+	// 1137 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xc6, 0xbf, 0xb1, 0x8f, 0x5d, 0x3b, 0x99, 0x04, 0x6a, 0x6d, 0xd2, 0xc4, 0xac, 0x68, 0x1a,
+	0xb5, 0x92, 0x45, 0x5d, 0xe0, 0x02, 0x89, 0x8b, 0x26, 0x15, 0xad, 0x21, 0x54, 0xd1, 0xa6, 0xed,
+	0x05, 0x42, 0xb2, 0x26, 0xbb, 0x27, 0xc9, 0xc8, 0x9b, 0x9d, 0x65, 0x77, 0x36, 0x69, 0x24, 0x9e,
+	0x81, 0x07, 0xe0, 0x0d, 0x78, 0x13, 0x9e, 0x81, 0x8b, 0xde, 0xf0, 0x22, 0x68, 0x7e, 0xf6, 0xc7,
+	0xf6, 0x9a, 0x54, 0xdc, 0x79, 0xce, 0xf7, 0xcd, 0x37, 0x73, 0x7e, 0xf6, 0x9c, 0x31, 0xf4, 0x59,
+	0x10, 0x87, 0xe8, 0x0a, 0x1e, 0x8d, 0xc2, 0x88, 0x0b, 0x4e, 0xda, 0x99, 0xc1, 0x82, 0x0b, 0x7e,
+	0xc1, 0xb5, 0xd9, 0x82, 0x80, 0x7b, 0x68, 0x7e, 0xaf, 0x87, 0x0c, 0x5d, 0x8c, 0x05, 0x8f, 0x8c,
+	0xc5, 0xfe, 0x16, 0xfa, 0x2f, 0x51, 0x9c, 0x0a, 0x2a, 0x62, 0x07, 0x7f, 0x4d, 0x30, 0x16, 0xe4,
+	0x11, 0xac, 0xc9, 0x2d, 0x53, 0xe6, 0x0d, 0x2a, 0xc3, 0xca, 0x41, 0xf7, 0xb0, 0xf7, 0xd7, 0x87,
+	0xbd, 0x4f, 0xfe, 0xfe, 0xb0, 0xd7, 0x7c, 0xcd, 0x3d, 0x9c, 0xbc, 0x70, 0x9a, 0x12, 0x9e, 0x78,
+	0xf6, 0x1f, 0x15, 0x58, 0xcf, 0x37, 0xc7, 0x21, 0x0f, 0x62, 0x24, 0x7b, 0xd0, 0xa1, 0x89, 0xc7,
+	0xc4, 0xd4, 0xe5, 0x49, 0x20, 0x94, 0x42, 0xcd, 0x01, 0x65, 0x3a, 0x92, 0x96, 0x9c, 0x10, 0x51,
+	0xc1, 0xf8, 0xa0, 0x3a, 0xac, 0x1c, 0x54, 0x0c, 0xc1, 0x91, 0x16, 0xf2, 0x39, 0x74, 0x93, 0x50,
+	0xb0, 0x2b, 0x34, 0x12, 0x35, 0x25, 0xd1, 0xd1, 0x36, 0xad, 0x91, 0x53, 0xb4, 0x48, 0x5d, 0x89,
+	0x18, 0x8a, 0x52, 0xb1, 0xff, 0xa9, 0x00, 0x39, 0x8a, 0x90, 0x0a, 0xfc, 0x5f, 0xce, 0x2d, 0xfa,
+	0x51, 0x5d, 0xf2, 0x63, 0x04, 0x9b, 0x9a, 0x10, 0x27, 0xae, 0x8b, 0x71, 0x3c, 0x77, 0xdb, 0x0d,
+	0x05, 0x9d, 0x6a, 0x64, 0xf1, 0xce, 0x9a, 0x58, 0x5f, 0x76, 0xeb, 0x4b, 0xd8, 0x32, 0x94, 0x79,
+	0xcd, 0x86, 0xa2, 0x12, 0x8d, 0x15, 0x45, 0xed, 0x4f, 0x61, 0x73, 0xce, 0x49, 0x9d, 0x04, 0xfb,
+	0x07, 0x20, 0x0a, 0x97, 0x3e, 0xe5, 0xa9, 0xb1, 0xa0, 0x35, 0xa3, 0x1e, 0x5e, 0xf9, 0x8c, 0x9a,
+	0xbc, 0x64, 0x6b, 0x32, 0x80, 0x35, 0x7e, 0x8d, 0x91, 0x4f, 0x6f, 0x8d, 0xab, 0xe9, 0xd2, 0xde,
+	0x84, 0x8d, 0xa2, 0x96, 0x0a, 0xa3, 0xbd, 0x03, 0xd6, 0x3b, 0x8c, 0x62, 0xc6, 0x83, 0x17, 0x2c,
+	0x16, 0x11, 0x3b, 0x4b, 0x04, 0xe3, 0x41, 0x8a, 0x3a, 0xb0, 0x5d, 0x8a, 0x9a, 0x7b, 0x3c, 0x83,
+	0xd6, 0xb5, 0x86, 0xe3, 0x41, 0x65, 0x58, 0x3b, 0xe8, 0x8c, 0xef, 0x8f, 0xf2, 0x62, 0x36, 0x3b,
+	0xd5, 0x99, 0x4e, 0x46, 0xb4, 0x7f, 0x83, 0x6e, 0x11, 0x21, 0x36, 0xd4, 0xc5, 0x6d, 0x88, 0xca,
+	0x91, 0xde, 0xb8, 0x37, 0x52, 0x55, 0x2e, 0xef, 0xf8, 0xe6, 0x36, 0x44, 0x47, 0x61, 0xd2, 0x29,
+	0xb3, 0x5f, 0x39, 0xd5, 0x76, 0xd2, 0x25, 0xd9, 0x82, 0x46, 0x31, 0x5d, 0x7a, 0x21, 0xf9, 0xd4,
+	0xf7, 0xf9, 0x0d, 0x7a, 0x2a, 0x3b, 0x2d, 0x27, 0x5d, 0xca, 0x20, 0xbc, 0x44, 0x71, 0x98, 0xb8,
+	0x33, 0xcc, 0x6a, 0xc9, 0x7e, 0x05, 0xa4, 0x68, 0x34, 0xde, 0x6d, 0x41, 0x43, 0x70, 0x41, 0x7d,
+	0x13, 0x62, 0xbd, 0x20, 0x3b, 0x50, 0x63, 0x5e, 0x3c, 0xa8, 0x0e, 0x6b, 0x07, 0xdd, 0x43, 0x28,
+	0xd4, 0x9b, 0x34, 0xdb, 0x63, 0x58, 0xcf, 0x94, 0xd2, 0x4a, 0xdd, 0x85, 0xea, 0xca, 0x22, 0xad,
+	0x32, 0xcf, 0x7e, 0x5b, 0xb8, 0x52, 0x76, 0xf8, 0x1d, 0x9b, 0xc8, 0x10, 0x1a, 0x32, 0x50, 0xfa,
+	0x22, 0x9d, 0x31, 0xe4, 0x61, 0x73, 0x34, 0x60, 0x3f, 0x86, 0xa6, 0xd6, 0xfc, 0x08, 0xee, 0x08,
+	0x40, 0x73, 0x8f, 0x59, 0x5c, 0xe0, 0x57, 0x56, 0xf1, 0x7f, 0x84, 0xfe, 0x09, 0x0b, 0x2e, 0x94,
+	0xe9, 0xe3, 0xbc, 0x54, 0x29, 0xf1, 0xbc, 0x08, 0xe3, 0x38, 0x4d, 0xa1, 0x59, 0xda, 0x36, 0xac,
+	0xe7, 0x62, 0xc6, 0xfd, 0x1e, 0x54, 0xf9, 0x4c, 0xa9, 0xb5, 0x9c, 0x2a, 0x9f, 0xd9, 0xdf, 0xc1,
+	0xc6, 0x31, 0xe7, 0xb3, 0x24, 0x2c, 0x1e, 0xd9, 0xcb, 0x8e, 0x6c, 0xdf, 0x71, 0xc4, 0x2f, 0x40,
+	0x8a, 0xdb, 0xb3, 0x18, 0xd7, 0xa5, 0x3b, 0x4a, 0x61, 0xde, 0x4d, 0x65, 0x27, 0xfb, 0x50, 0xbf,
+	0x42, 0x41, 0x95, 0x58, 0x67, 0x4c, 0x72, 0xfc, 0x27, 0x14, 0xd4, 0xa3, 0x82, 0x3a, 0x0a, 0xb7,
+	0xdf, 0x01, 0x91, 0x71, 0x73, 0x30, 0xa4, 0x2c, 0xca, 0x1a, 0x14, 0x81, 0x7a, 0x48, 0xc5, 0xa5,
+	0xb9, 0x9f, 0xfa, 0x2d, 0x4b, 0xca, 0x67, 0x57, 0x4c, 0x77, 0xa1, 0x86, 0xa3, 0x17, 0xe4, 0x33,
+	0x68, 0xf2, 0xf3, 0xf3, 0x18, 0x75, 0x11, 0x37, 0x1c, 0xb3, 0xb2, 0x5f, 0xc1, 0xe6, 0x9c, 0xae,
+	0xb9, 0xf6, 0x53, 0x58, 0x8b, 0xb4, 0xa9, 0xe4, 0xa3, 0xd3, 0x64, 0x07, 0x5d, 0x1e, 0x79, 0x4e,
+	0xca, 0x93, 0x3d, 0xb4, 0x5b, 0x44, 0x4a, 0x2f, 0xf7, 0x10, 0x7a, 0x97, 0x48, 0x7d, 0x71, 0x79,
+	0x3b, 0xd5, 0xd3, 0xc5, 0x34, 0x90, 0x7b, 0xc6, 0x7a, 0xa2, 0x8c, 0x92, 0x16, 0xe1, 0x59, 0xc2,
+	0x7c, 0x91, 0xd2, 0xf4, 0xa7, 0x77, 0xcf, 0x58, 0x0d, 0xed, 0x09, 0xb4, 0x03, 0xbc, 0x99, 0xea,
+	0x42, 0xaa, 0x0f, 0x6b, 0x25, 0x65, 0xd1, 0x0a, 0xf0, 0x46, 0xfe, 0x8c, 0x65, 0xe6, 0xce, 0x29,
+	0xf3, 0x93, 0x08, 0x55, 0x8b, 0x6c, 0x3b, 0xe9, 0x92, 0x3c, 0x86, 0x0d, 0xed, 0x04, 0x7a, 0xd3,
+	0x24, 0x60, 0xef, 0xa7, 0x31, 0xba, 0x83, 0xa6, 0x3a, 0xb0, 0x9f, 0x02, 0x6f, 0x03, 0xf6, 0xfe,
+	0x14, 0x5d, 0x1b, 0x61, 0x5b, 0xc6, 0xeb, 0x90, 0x06, 0xde, 0x0d, 0xf3, 0xc4, 0xa5, 0xc3, 0x7d,
+	0x3f, 0x09, 0xb3, 0x84, 0x7c, 0x01, 0xbd, 0x58, 0xd0, 0x48, 0xe4, 0x3a, 0xfa, 0xc3, 0xee, 0x2a,
+	0xab, 0x11, 0x21, 0x43, 0xe8, 0x62, 0x50, 0x38, 0xcb, 0xcc, 0x0b, 0x0c, 0xb2, 0x63, 0xde, 0xc0,
+	0x4e, 0xf9, 0x31, 0x26, 0x3f, 0x5f, 0xc1, 0x5a, 0xa4, 0x4d, 0x26, 0x3f, 0x56, 0x21, 0x3f, 0x0b,
+	0xbb, 0x9c, 0x94, 0x6a, 0xff, 0x5e, 0x85, 0xfe, 0x02, 0x48, 0xbe, 0x81, 0xbe, 0x1c, 0xf1, 0xf4,
+	0x02, 0xa7, 0xff, 0x3d, 0xeb, 0xee, 0x19, 0xda, 0x6b, 0x3d, 0xf2, 0x9e, 0x40, 0x3b, 0x09, 0x7d,
+	0x16, 0xcc, 0xe4, 0x8e, 0x6a, 0xe9, 0x8e, 0x96, 0x26, 0x4c, 0x3c, 0x32, 0x81, 0x26, 0x75, 0x65,
+	0x5b, 0x57, 0x79, 0xec, 0x8d, 0x9f, 0x8e, 0xf2, 0xb7, 0x45, 0xc4, 0x13, 0x81, 0xf1, 0xe8, 0x84,
+	0xde, 0x62, 0x94, 0x5d, 0xee, 0xb9, 0xef, 0x73, 0x57, 0x8e, 0xe6, 0x60, 0xf4, 0x5c, 0x6d, 0x74,
+	0x8c, 0x00, 0xf9, 0x1a, 0xee, 0xb3, 0x40, 0x60, 0x74, 0x4d, 0xfd, 0xe9, 0x42, 0xa8, 0xf5, 0x90,
+	0xdc, 0x4a, 0xe1, 0xd3, 0x62, 0xc8, 0xb3, 0x46, 0xdb, 0x28, 0x34, 0xda, 0xf1, 0x9f, 0x4d, 0x68,
+	0x4f, 0xd2, 0xb8, 0x91, 0x09, 0x40, 0x3e, 0xbc, 0xc8, 0x4e, 0x21, 0xa2, 0x4b, 0x33, 0xcd, 0x7a,
+	0xb0, 0x02, 0x35, 0xf9, 0x99, 0x00, 0xe4, 0xdd, 0x7e, 0x4e, 0x6a, 0x69, 0x32, 0x58, 0x0f, 0x56,
+	0xa0, 0x46, 0xea, 0x7b, 0x68, 0x67, 0x56, 0xb2, 0x5d, 0xc6, 0x4d, 0x85, 0x76, 0xca, 0x41, 0xa3,
+	0x73, 0x04, 0xad, 0xb4, 0x05, 0x92, 0x62, 0xb5, 0x2c, 0x34, 0x59, 0x6b, 0xbb, 0x14, 0xcb, 0xfd,
+	0xca, 0x9b, 0xdc, 0x9c, 0x5f, 0x4b, 0xad, 0xd3, 0x7a, 0xb0, 0x02, 0x35, 0x52, 0x1e, 0x6c, 0x96,
+	0xcc, 0x7d, 0xf2, 0x70, 0x79, 0xba, 0x97, 0xbc, 0x1a, 0xac, 0xfd, 0xbb, 0x68, 0xb9, 0xd7, 0xe9,
+	0xab, 0x73, 0xce, 0xeb, 0x85, 0x77, 0xac, 0xb5, 0x5d, 0x8a, 0x19, 0x91, 0x63, 0xe8, 0x14, 0x1e,
+	0x4e, 0x64, 0x2e, 0xf7, 0x4b, 0xaf, 0x46, 0x6b, 0x77, 0x15, 0x9c, 0xab, 0x15, 0x5a, 0xee, 0x9c,
+	0xda, 0x72, 0x8b, 0xb7, 0x76, 0x57, 0xc1, 0x46, 0xed, 0x02, 0xb6, 0xca, 0x3a, 0x05, 0xd9, 0x5f,
+	0xd8, 0xb7, 0xa2, 0x63, 0x59, 0x8f, 0xee, 0xe4, 0xe9, 0x83, 0x0e, 0xeb, 0x3f, 0x57, 0xc3, 0xb3,
+	0xb3, 0xa6, 0xfa, 0x27, 0xf0, 0xec, 0xdf, 0x01, 0x00, 0x6d, 0x14, 0x45, 0x8e, 0x51, 0x0c, 0x00,
+	0x00,
 }
@@ -40,7 +40,7 @@ func (x NodeType) String() string {
 	return proto.EnumName(NodeType_name, int32(x))
 }
 func (NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_node_9c604679ec4520fa, []int{0}
+	return fileDescriptor_node_6711ce210915dbf0, []int{0}
 }
 
 // NodeTransport is an enum of possible transports for the overlay network
@@ -61,10 +61,10 @@ func (x NodeTransport) String() string {
 	return proto.EnumName(NodeTransport_name, int32(x))
 }
 func (NodeTransport) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_node_9c604679ec4520fa, []int{1}
+	return fileDescriptor_node_6711ce210915dbf0, []int{1}
 }
 
-//  NodeRestrictions contains all relevant data about a nodes ability to store data
+// NodeRestrictions contains all relevant data about a nodes ability to store data
 type NodeRestrictions struct {
 	FreeBandwidth        int64    `protobuf:"varint,1,opt,name=free_bandwidth,json=freeBandwidth,proto3" json:"free_bandwidth,omitempty"`
 	FreeDisk             int64    `protobuf:"varint,2,opt,name=free_disk,json=freeDisk,proto3" json:"free_disk,omitempty"`
@@ -77,7 +77,7 @@ func (m *NodeRestrictions) Reset()         { *m = NodeRestrictions{} }
 func (m *NodeRestrictions) String() string { return proto.CompactTextString(m) }
 func (*NodeRestrictions) ProtoMessage()    {}
 func (*NodeRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_9c604679ec4520fa, []int{0}
+	return fileDescriptor_node_6711ce210915dbf0, []int{0}
 }
 func (m *NodeRestrictions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeRestrictions.Unmarshal(m, b)
@@ -115,18 +115,24 @@ func (m *NodeRestrictions) GetFreeDisk() int64 {
 // Node represents a node in the overlay network
 // Node is info for a updating a single storagenode, used in the Update rpc calls
 type Node struct {
-	Id                   NodeID            `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
-	Address              *NodeAddress      `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
-	Type                 NodeType          `protobuf:"varint,3,opt,name=type,proto3,enum=node.NodeType" json:"type,omitempty"`
-	Restrictions         *NodeRestrictions `protobuf:"bytes,4,opt,name=restrictions" json:"restrictions,omitempty"`
-	Reputation           *NodeStats        `protobuf:"bytes,5,opt,name=reputation" json:"reputation,omitempty"`
-	Metadata             *NodeMetadata     `protobuf:"bytes,6,opt,name=metadata" json:"metadata,omitempty"`
-	LatencyList          []int64           `protobuf:"varint,7,rep,packed,name=latency_list,json=latencyList" json:"latency_list,omitempty"`
-	AuditSuccess         bool              `protobuf:"varint,8,opt,name=audit_success,json=auditSuccess,proto3" json:"audit_success,omitempty"`
-	IsUp                 bool              `protobuf:"varint,9,opt,name=is_up,json=isUp,proto3" json:"is_up,omitempty"`
-	UpdateLatency        bool              `protobuf:"varint,10,opt,name=update_latency,json=updateLatency,proto3" json:"update_latency,omitempty"`
-	UpdateAuditSuccess   bool              `protobuf:"varint,11,opt,name=update_audit_success,json=updateAuditSuccess,proto3" json:"update_audit_success,omitempty"`
-	UpdateUptime         bool              `protobuf:"varint,12,opt,name=update_uptime,json=updateUptime,proto3" json:"update_uptime,omitempty"`
+	Id                 NodeID            `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	Address            *NodeAddress      `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	Type               NodeType          `protobuf:"varint,3,opt,name=type,proto3,enum=node.NodeType" json:"type,omitempty"`
+	Restrictions       *NodeRestrictions `protobuf:"bytes,4,opt,name=restrictions" json:"restrictions,omitempty"`
+	Reputation         *NodeStats        `protobuf:"bytes,5,opt,name=reputation" json:"reputation,omitempty"`
+	Metadata           *NodeMetadata     `protobuf:"bytes,6,opt,name=metadata" json:"metadata,omitempty"`
+	LatencyList        []int64           `protobuf:"varint,7,rep,packed,name=latency_list,json=latencyList" json:"latency_list,omitempty"`
+	AuditSuccess       bool              `protobuf:"varint,8,opt,name=audit_success,json=auditSuccess,proto3" json:"audit_success,omitempty"`
+	IsUp               bool              `protobuf:"varint,9,opt,name=is_up,json=isUp,proto3" json:"is_up,omitempty"`
+	UpdateLatency      bool              `protobuf:"varint,10,opt,name=update_latency,json=updateLatency,proto3" json:"update_latency,omitempty"`
+	UpdateAuditSuccess bool              `protobuf:"varint,11,opt,name=update_audit_success,json=updateAuditSuccess,proto3" json:"update_audit_success,omitempty"`
+	UpdateUptime       bool              `protobuf:"varint,12,opt,name=update_uptime,json=updateUptime,proto3" json:"update_uptime,omitempty"`
+	// first_seen_unix_sec and last_contact_unix_sec are tracked by the overlay cache
+	FirstSeenUnixSec   int64 `protobuf:"varint,13,opt,name=first_seen_unix_sec,json=firstSeenUnixSec,proto3" json:"first_seen_unix_sec,omitempty"`
+	LastContactUnixSec int64 `protobuf:"varint,14,opt,name=last_contact_unix_sec,json=lastContactUnixSec,proto3" json:"last_contact_unix_sec,omitempty"`
+	// record is what the node signed about itself, it is verified before the
+	// node is added to a routing table or overlay cache
+	Record               *SignedNodeRecord `protobuf:"bytes,15,opt,name=record" json:"record,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -136,7 +142,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_9c604679ec4520fa, []int{1}
+	return fileDescriptor_node_6711ce210915dbf0, []int{1}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Node.Unmarshal(m, b)
@@ -233,6 +239,27 @@ func (m *Node) GetUpdateUptime() bool {
 	return false
 }
 
+func (m *Node) GetFirstSeenUnixSec() int64 {
+	if m != nil {
+		return m.FirstSeenUnixSec
+	}
+	return 0
+}
+
+func (m *Node) GetLastContactUnixSec() int64 {
+	if m != nil {
+		return m.LastContactUnixSec
+	}
+	return 0
+}
+
+func (m *Node) GetRecord() *SignedNodeRecord {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
 // NodeAddress contains the information needed to communicate with a node on the network
 type NodeAddress struct {
 	Transport            NodeTransport `protobuf:"varint,1,opt,name=transport,proto3,enum=node.NodeTransport" json:"transport,omitempty"`
@@ -246,7 +273,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_9c604679ec4520fa, []int{2}
+	return fileDescriptor_node_6711ce210915dbf0, []int{2}
 }
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
@@ -280,6 +307,128 @@ func (m *NodeAddress) GetAddress() string {
 	return ""
 }
 
+// NodeRecord is what a node claims about itself to other nodes
+type NodeRecord struct {
+	Id           NodeID            `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	Address      *NodeAddress      `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	Type         NodeType          `protobuf:"varint,3,opt,name=type,proto3,enum=node.NodeType" json:"type,omitempty"`
+	Restrictions *NodeRestrictions `protobuf:"bytes,4,opt,name=restrictions" json:"restrictions,omitempty"`
+	// sequence grows with every new record of a node, lower ones are stale
+	Sequence             int64    `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeRecord) Reset()         { *m = NodeRecord{} }
+func (m *NodeRecord) String() string { return proto.CompactTextString(m) }
+func (*NodeRecord) ProtoMessage()    {}
+func (*NodeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_6711ce210915dbf0, []int{3}
+}
+func (m *NodeRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeRecord.Unmarshal(m, b)
+}
+func (m *NodeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeRecord.Marshal(b, m, deterministic)
+}
+func (dst *NodeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeRecord.Merge(dst, src)
+}
+func (m *NodeRecord) XXX_Size() int {
+	return xxx_messageInfo_NodeRecord.Size(m)
+}
+func (m *NodeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeRecord proto.InternalMessageInfo
+
+func (m *NodeRecord) GetAddress() *NodeAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *NodeRecord) GetType() NodeType {
+	if m != nil {
+		return m.Type
+	}
+	return NodeType_ADMIN
+}
+
+func (m *NodeRecord) GetRestrictions() *NodeRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
+func (m *NodeRecord) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// SignedNodeRecord is a marshalled NodeRecord signed with the node's leaf key
+type SignedNodeRecord struct {
+	Record    []byte `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// chain is the leaf and CA certificate of the node, so the record can be
+	// verified by nodes which didn't connect to it
+	Chain                [][]byte `protobuf:"bytes,3,rep,name=chain" json:"chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignedNodeRecord) Reset()         { *m = SignedNodeRecord{} }
+func (m *SignedNodeRecord) String() string { return proto.CompactTextString(m) }
+func (*SignedNodeRecord) ProtoMessage()    {}
+func (*SignedNodeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_6711ce210915dbf0, []int{4}
+}
+func (m *SignedNodeRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedNodeRecord.Unmarshal(m, b)
+}
+func (m *SignedNodeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedNodeRecord.Marshal(b, m, deterministic)
+}
+func (dst *SignedNodeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedNodeRecord.Merge(dst, src)
+}
+func (m *SignedNodeRecord) XXX_Size() int {
+	return xxx_messageInfo_SignedNodeRecord.Size(m)
+}
+func (m *SignedNodeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedNodeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedNodeRecord proto.InternalMessageInfo
+
+func (m *SignedNodeRecord) GetRecord() []byte {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (m *SignedNodeRecord) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SignedNodeRecord) GetChain() [][]byte {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
 // NodeStats is the reputation characteristics of a node
 type NodeStats struct {
 	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
@@ -290,6 +439,11 @@ type NodeStats struct {
 	AuditSuccessCount    int64    `protobuf:"varint,6,opt,name=audit_success_count,json=auditSuccessCount,proto3" json:"audit_success_count,omitempty"`
 	UptimeCount          int64    `protobuf:"varint,7,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
 	UptimeSuccessCount   int64    `protobuf:"varint,8,opt,name=uptime_success_count,json=uptimeSuccessCount,proto3" json:"uptime_success_count,omitempty"`
+	AuditedUnixSec       int64    `protobuf:"varint,9,opt,name=audited_unix_sec,json=auditedUnixSec,proto3" json:"audited_unix_sec,omitempty"`
+	AuditReputation      float64  `protobuf:"fixed64,10,opt,name=audit_reputation,json=auditReputation,proto3" json:"audit_reputation,omitempty"`
+	UptimeReputation     float64  `protobuf:"fixed64,11,opt,name=uptime_reputation,json=uptimeReputation,proto3" json:"uptime_reputation,omitempty"`
+	Vetted               bool     `protobuf:"varint,12,opt,name=vetted,proto3" json:"vetted,omitempty"`
+	Disqualified         bool     `protobuf:"varint,13,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -299,7 +453,7 @@ func (m *NodeStats) Reset()         { *m = NodeStats{} }
 func (m *NodeStats) String() string { return proto.CompactTextString(m) }
 func (*NodeStats) ProtoMessage()    {}
 func (*NodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_9c604679ec4520fa, []int{3}
+	return fileDescriptor_node_6711ce210915dbf0, []int{5}
 }
 func (m *NodeStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeStats.Unmarshal(m, b)
@@ -368,9 +522,46 @@ func (m *NodeStats) GetUptimeSuccessCount() int64 {
 	return 0
 }
 
+func (m *NodeStats) GetAuditedUnixSec() int64 {
+	if m != nil {
+		return m.AuditedUnixSec
+	}
+	return 0
+}
+
+func (m *NodeStats) GetAuditReputation() float64 {
+	if m != nil {
+		return m.AuditReputation
+	}
+	return 0
+}
+
+func (m *NodeStats) GetUptimeReputation() float64 {
+	if m != nil {
+		return m.UptimeReputation
+	}
+	return 0
+}
+
+func (m *NodeStats) GetVetted() bool {
+	if m != nil {
+		return m.Vetted
+	}
+	return false
+}
+
+func (m *NodeStats) GetDisqualified() bool {
+	if m != nil {
+		return m.Disqualified
+	}
+	return false
+}
+
 type NodeMetadata struct {
-	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Wallet               string   `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Email  string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Wallet string `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	// version is the software version the node runs, e.g. v0.1.0
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -380,7 +571,7 @@ func (m *NodeMetadata) Reset()         { *m = NodeMetadata{} }
 func (m *NodeMetadata) String() string { return proto.CompactTextString(m) }
 func (*NodeMetadata) ProtoMessage()    {}
 func (*NodeMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_9c604679ec4520fa, []int{4}
+	return fileDescriptor_node_6711ce210915dbf0, []int{6}
 }
 func (m *NodeMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeMetadata.Unmarshal(m, b)
@@ -414,57 +605,80 @@ func (m *NodeMetadata) GetWallet() string {
 	return ""
 }
 
+func (m *NodeMetadata) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NodeRestrictions)(nil), "node.NodeRestrictions")
 	proto.RegisterType((*Node)(nil), "node.Node")
 	proto.RegisterType((*NodeAddress)(nil), "node.NodeAddress")
+	proto.RegisterType((*NodeRecord)(nil), "node.NodeRecord")
+	proto.RegisterType((*SignedNodeRecord)(nil), "node.SignedNodeRecord")
 	proto.RegisterType((*NodeStats)(nil), "node.NodeStats")
 	proto.RegisterType((*NodeMetadata)(nil), "node.NodeMetadata")
 	proto.RegisterEnum("node.NodeType", NodeType_name, NodeType_value)
 	proto.RegisterEnum("node.NodeTransport", NodeTransport_name, NodeTransport_value)
 }
 
-func init() { proto.RegisterFile("node.proto", fileDescriptor_node_9c604679ec4520fa) }
-
-var fileDescriptor_node_9c604679ec4520fa = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0x49, 0xe2, 0xfc, 0xf1, 0xb1, 0x93, 0x1b, 0x0e, 0x08, 0x59, 0xf7, 0xea, 0x5e, 0x82,
-	0xd1, 0x55, 0x23, 0x2a, 0xa5, 0x94, 0xae, 0xa8, 0xba, 0x09, 0x50, 0x21, 0x24, 0xa0, 0x68, 0x12,
-	0xba, 0x60, 0x63, 0x99, 0xcc, 0x94, 0x8e, 0x08, 0xb1, 0xe5, 0x19, 0x0b, 0xf1, 0x86, 0x5d, 0xf4,
-	0x09, 0xba, 0xe0, 0x15, 0xfa, 0x0a, 0xd5, 0x9c, 0x71, 0x88, 0xad, 0xaa, 0xbb, 0xcc, 0xf7, 0xfd,
-	0x7c, 0x8e, 0xe7, 0x7c, 0x27, 0x06, 0x58, 0x24, 0x5c, 0x8c, 0xd2, 0x2c, 0xd1, 0x09, 0x3a, 0xe6,
-	0xf7, 0xdf, 0x70, 0x97, 0xdc, 0x25, 0x56, 0x09, 0x3f, 0x43, 0xff, 0x32, 0xe1, 0x82, 0x09, 0xa5,
-	0x33, 0x39, 0xd3, 0x32, 0x59, 0x28, 0xfc, 0x1f, 0x7a, 0x5f, 0x32, 0x21, 0xa2, 0xdb, 0x78, 0xc1,
-	0x1f, 0x25, 0xd7, 0x5f, 0x83, 0xda, 0xa0, 0x36, 0x6c, 0xb0, 0xae, 0x51, 0x8f, 0x96, 0x22, 0xfe,
-	0x03, 0x2e, 0x61, 0x5c, 0xaa, 0xfb, 0xa0, 0x4e, 0x44, 0xc7, 0x08, 0x27, 0x52, 0xdd, 0x87, 0x3f,
-	0x1b, 0xe0, 0x98, 0xc2, 0xf8, 0x1f, 0xd4, 0x25, 0xa7, 0x02, 0xfe, 0x51, 0xef, 0xdb, 0xf3, 0xf6,
-	0xda, 0x8f, 0xe7, 0xed, 0x96, 0x71, 0xce, 0x4e, 0x58, 0x5d, 0x72, 0x7c, 0x0d, 0xed, 0x98, 0xf3,
-	0x4c, 0x28, 0x45, 0x35, 0xbc, 0x83, 0xf5, 0x11, 0xbd, 0xb0, 0x41, 0xc6, 0xd6, 0x60, 0x4b, 0x02,
-	0x43, 0x70, 0xf4, 0x53, 0x2a, 0x82, 0xc6, 0xa0, 0x36, 0xec, 0x1d, 0xf4, 0x56, 0xe4, 0xf4, 0x29,
-	0x15, 0x8c, 0x3c, 0x7c, 0x0f, 0x7e, 0x56, 0xba, 0x4d, 0xe0, 0x50, 0xd5, 0xad, 0x15, 0x5b, 0xbe,
-	0x2b, 0xab, 0xb0, 0xf8, 0x06, 0x20, 0x13, 0x69, 0xae, 0x63, 0x73, 0x0c, 0x9a, 0xf4, 0xe4, 0x5f,
-	0xab, 0x27, 0x27, 0x3a, 0xd6, 0x8a, 0x95, 0x10, 0x1c, 0x41, 0xe7, 0x41, 0xe8, 0x98, 0xc7, 0x3a,
-	0x0e, 0x5a, 0x84, 0xe3, 0x0a, 0xbf, 0x28, 0x1c, 0xf6, 0xc2, 0xe0, 0x0e, 0xf8, 0xf3, 0x58, 0x8b,
-	0xc5, 0xec, 0x29, 0x9a, 0x4b, 0xa5, 0x83, 0xf6, 0xa0, 0x31, 0x6c, 0x30, 0xaf, 0xd0, 0xce, 0xa5,
-	0xd2, 0xb8, 0x0b, 0xdd, 0x38, 0xe7, 0x52, 0x47, 0x2a, 0x9f, 0xcd, 0xcc, 0x58, 0x3a, 0x83, 0xda,
-	0xb0, 0xc3, 0x7c, 0x12, 0x27, 0x56, 0xc3, 0x0d, 0x68, 0x4a, 0x15, 0xe5, 0x69, 0xe0, 0x92, 0xe9,
-	0x48, 0x75, 0x9d, 0x9a, 0xdc, 0xf2, 0x94, 0xc7, 0x5a, 0x44, 0x45, 0xbd, 0x00, 0xc8, 0xed, 0x5a,
-	0xf5, 0xdc, 0x8a, 0xb8, 0x0f, 0x9b, 0x05, 0x56, 0xed, 0xe3, 0x11, 0x8c, 0xd6, 0x1b, 0x97, 0xbb,
-	0xed, 0x42, 0x51, 0x22, 0xca, 0x53, 0x2d, 0x1f, 0x44, 0xe0, 0xdb, 0x57, 0xb2, 0xe2, 0x35, 0x69,
-	0xe1, 0x0d, 0x78, 0xa5, 0xcc, 0xf0, 0x2d, 0xb8, 0x3a, 0x8b, 0x17, 0x2a, 0x4d, 0x32, 0x4d, 0xf1,
-	0xf7, 0x0e, 0x36, 0x4a, 0x79, 0x2d, 0x2d, 0xb6, 0xa2, 0x30, 0xa8, 0xae, 0x82, 0xfb, 0x92, 0x7b,
-	0xf8, 0xbd, 0x0e, 0xee, 0x4b, 0x00, 0xf8, 0x0a, 0xda, 0xa6, 0x50, 0xf4, 0xc7, 0xbd, 0x6a, 0x19,
-	0xfb, 0x8c, 0xe3, 0xbf, 0x00, 0xcb, 0x69, 0x1f, 0xee, 0x17, 0x2b, 0xea, 0x16, 0xca, 0xe1, 0x3e,
-	0x8e, 0x60, 0xa3, 0x32, 0x81, 0x28, 0x33, 0xa1, 0xd2, 0x72, 0xd5, 0xd8, 0x7a, 0x79, 0xde, 0xcc,
-	0x18, 0x26, 0x3c, 0x7b, 0xff, 0x02, 0x74, 0x08, 0xf4, 0xac, 0x66, 0x91, 0x6d, 0xf0, 0x6c, 0xc9,
-	0x59, 0x92, 0x2f, 0x34, 0x6d, 0x50, 0x83, 0x01, 0x49, 0xc7, 0x46, 0xf9, 0xbd, 0xa7, 0x05, 0x5b,
-	0x04, 0x56, 0x7a, 0x5a, 0x7e, 0xd5, 0xd3, 0x82, 0x6d, 0x02, 0x8b, 0x9e, 0x16, 0xa1, 0x3c, 0x09,
-	0xa9, 0xd6, 0xec, 0x10, 0x8a, 0xd6, 0x2b, 0x17, 0x0d, 0x3f, 0x80, 0x5f, 0xde, 0x4f, 0xdc, 0x84,
-	0xa6, 0x78, 0x88, 0xe5, 0x9c, 0xc6, 0xe9, 0x32, 0x7b, 0xc0, 0x2d, 0x68, 0x3d, 0xc6, 0xf3, 0xb9,
-	0xd0, 0x45, 0x1a, 0xc5, 0x69, 0x2f, 0x84, 0xce, 0xf2, 0x2f, 0x87, 0x2e, 0x34, 0xc7, 0x27, 0x17,
-	0x67, 0x97, 0xfd, 0x35, 0xf4, 0xa0, 0x3d, 0x99, 0x7e, 0x62, 0xe3, 0xd3, 0x8f, 0xfd, 0xda, 0xde,
-	0x0e, 0x74, 0x2b, 0x31, 0x63, 0x1f, 0xfc, 0xe9, 0xf1, 0x55, 0x34, 0x3d, 0x9f, 0x44, 0xa7, 0xec,
-	0xea, 0xb8, 0xbf, 0x76, 0xe4, 0xdc, 0xd4, 0xd3, 0xdb, 0xdb, 0x16, 0x7d, 0x86, 0xde, 0xfd, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0x35, 0xd5, 0x01, 0xa6, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("node.proto", fileDescriptor_node_6711ce210915dbf0) }
+
+var fileDescriptor_node_6711ce210915dbf0 = []byte{
+	// 842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xcd, 0x72, 0xdb, 0x36,
+	0x10, 0xc7, 0x2d, 0xeb, 0x93, 0x2b, 0x4a, 0xa6, 0xe1, 0xd4, 0xc3, 0x49, 0x3f, 0xac, 0x30, 0xd3,
+	0xa9, 0x9a, 0x4c, 0x55, 0xc7, 0x3d, 0xa5, 0x37, 0x7f, 0x74, 0x32, 0x9e, 0x71, 0xd2, 0x0c, 0x64,
+	0xe7, 0x90, 0x43, 0x39, 0x30, 0x01, 0x2b, 0x98, 0xc8, 0x24, 0x43, 0x80, 0x49, 0x7c, 0xec, 0xb5,
+	0x4f, 0xd6, 0x17, 0xe8, 0xa5, 0x87, 0x3c, 0x4b, 0x07, 0x0b, 0x50, 0x24, 0xdb, 0xe9, 0x03, 0xe4,
+	0x26, 0xfc, 0xff, 0x3f, 0xec, 0x02, 0xcb, 0x5d, 0x08, 0x20, 0xcd, 0xb8, 0x58, 0xe4, 0x45, 0xa6,
+	0x33, 0xd2, 0x33, 0xbf, 0xef, 0xc3, 0x2a, 0x5b, 0x65, 0x56, 0x89, 0x5e, 0x41, 0xf0, 0x22, 0xe3,
+	0x82, 0x0a, 0xa5, 0x0b, 0x99, 0x68, 0x99, 0xa5, 0x8a, 0x7c, 0x0b, 0xd3, 0x9b, 0x42, 0x88, 0xf8,
+	0x9a, 0xa5, 0xfc, 0x83, 0xe4, 0xfa, 0x4d, 0xd8, 0x99, 0x75, 0xe6, 0x5d, 0x3a, 0x31, 0xea, 0x49,
+	0x25, 0x92, 0x2f, 0xc1, 0x43, 0x8c, 0x4b, 0xf5, 0x36, 0xdc, 0x46, 0x62, 0x64, 0x84, 0x33, 0xa9,
+	0xde, 0x46, 0xbf, 0xf7, 0xa1, 0x67, 0x02, 0x93, 0x6f, 0x60, 0x5b, 0x72, 0x0c, 0xe0, 0x9f, 0x4c,
+	0xff, 0xfc, 0x74, 0xb0, 0xf5, 0xf7, 0xa7, 0x83, 0x81, 0x71, 0xce, 0xcf, 0xe8, 0xb6, 0xe4, 0xe4,
+	0x31, 0x0c, 0x19, 0xe7, 0x85, 0x50, 0x0a, 0x63, 0x8c, 0x8f, 0x76, 0x17, 0x78, 0x60, 0x83, 0x1c,
+	0x5b, 0x83, 0x56, 0x04, 0x89, 0xa0, 0xa7, 0xef, 0x72, 0x11, 0x76, 0x67, 0x9d, 0xf9, 0xf4, 0x68,
+	0x5a, 0x93, 0x97, 0x77, 0xb9, 0xa0, 0xe8, 0x91, 0x9f, 0xc1, 0x2f, 0x1a, 0xb7, 0x09, 0x7b, 0x18,
+	0x75, 0xbf, 0x66, 0x9b, 0x77, 0xa5, 0x2d, 0x96, 0xfc, 0x08, 0x50, 0x88, 0xbc, 0xd4, 0xcc, 0x2c,
+	0xc3, 0x3e, 0xee, 0xdc, 0xa9, 0x77, 0x2e, 0x35, 0xd3, 0x8a, 0x36, 0x10, 0xb2, 0x80, 0xd1, 0xad,
+	0xd0, 0x8c, 0x33, 0xcd, 0xc2, 0x01, 0xe2, 0xa4, 0xc6, 0x9f, 0x3b, 0x87, 0x6e, 0x18, 0xf2, 0x00,
+	0xfc, 0x35, 0xd3, 0x22, 0x4d, 0xee, 0xe2, 0xb5, 0x54, 0x3a, 0x1c, 0xce, 0xba, 0xf3, 0x2e, 0x1d,
+	0x3b, 0xed, 0x42, 0x2a, 0x4d, 0x1e, 0xc2, 0x84, 0x95, 0x5c, 0xea, 0x58, 0x95, 0x49, 0x62, 0xca,
+	0x32, 0x9a, 0x75, 0xe6, 0x23, 0xea, 0xa3, 0xb8, 0xb4, 0x1a, 0xd9, 0x83, 0xbe, 0x54, 0x71, 0x99,
+	0x87, 0x1e, 0x9a, 0x3d, 0xa9, 0xae, 0x72, 0xf3, 0xdd, 0xca, 0x9c, 0x33, 0x2d, 0x62, 0x17, 0x2f,
+	0x04, 0x74, 0x27, 0x56, 0xbd, 0xb0, 0x22, 0x39, 0x84, 0x7b, 0x0e, 0x6b, 0xe7, 0x19, 0x23, 0x4c,
+	0xac, 0x77, 0xdc, 0xcc, 0xf6, 0x10, 0x5c, 0x88, 0xb8, 0xcc, 0xb5, 0xbc, 0x15, 0xa1, 0x6f, 0x8f,
+	0x64, 0xc5, 0x2b, 0xd4, 0xc8, 0x0f, 0xb0, 0x77, 0x23, 0x0b, 0xa5, 0x63, 0x25, 0x44, 0x1a, 0x97,
+	0xa9, 0xfc, 0x18, 0x2b, 0x91, 0x84, 0x13, 0x6c, 0x8c, 0x00, 0xad, 0xa5, 0x10, 0xe9, 0x55, 0x2a,
+	0x3f, 0x2e, 0x45, 0x42, 0x9e, 0xc0, 0x17, 0x6b, 0xa6, 0x74, 0x9c, 0x64, 0xa9, 0x66, 0x89, 0xae,
+	0x37, 0x4c, 0x71, 0x03, 0x31, 0xe6, 0xa9, 0xf5, 0xaa, 0x2d, 0x0b, 0x18, 0x14, 0x22, 0xc9, 0x0a,
+	0x1e, 0xee, 0x34, 0xbf, 0xe9, 0x52, 0xae, 0x52, 0xc1, 0xed, 0x97, 0x35, 0x2e, 0x75, 0x54, 0xf4,
+	0x1a, 0xc6, 0x8d, 0x2e, 0x22, 0x4f, 0xc0, 0xd3, 0x05, 0x4b, 0x55, 0x9e, 0x15, 0x1a, 0x1b, 0x72,
+	0x7a, 0xb4, 0xd7, 0xe8, 0xa0, 0xca, 0xa2, 0x35, 0x45, 0xc2, 0x76, 0x73, 0x7a, 0x9b, 0x4e, 0x8c,
+	0xfe, 0xea, 0x00, 0xd4, 0x29, 0x3f, 0xaf, 0x2e, 0xbf, 0x0f, 0x23, 0x25, 0xde, 0x95, 0x22, 0x4d,
+	0x04, 0xf6, 0x78, 0x97, 0x6e, 0xd6, 0xd1, 0x6f, 0x10, 0xfc, 0xbb, 0x9e, 0x64, 0x7f, 0x53, 0x77,
+	0xbc, 0x60, 0x55, 0x5f, 0xf2, 0x15, 0x78, 0x4a, 0xae, 0x52, 0xa6, 0xcb, 0x42, 0xe0, 0xb5, 0x7c,
+	0x5a, 0x0b, 0xe4, 0x1e, 0xf4, 0x93, 0x37, 0x4c, 0xa6, 0x61, 0x77, 0xd6, 0x9d, 0xfb, 0xd4, 0x2e,
+	0xa2, 0x3f, 0x7a, 0xe0, 0x6d, 0x46, 0x89, 0x7c, 0x07, 0x43, 0x73, 0xe0, 0xf8, 0x7f, 0x6b, 0x37,
+	0x30, 0xf6, 0x39, 0x27, 0x5f, 0x03, 0x54, 0x73, 0xf3, 0xf4, 0xd0, 0x3d, 0x36, 0x9e, 0x53, 0x9e,
+	0x1e, 0x92, 0x05, 0xec, 0xb5, 0x7a, 0x39, 0x2e, 0xcc, 0x78, 0x62, 0x01, 0x3b, 0x74, 0xb7, 0x39,
+	0x39, 0xd4, 0x18, 0x66, 0x0c, 0x6d, 0x27, 0x3b, 0xb0, 0x87, 0xe0, 0xd8, 0x6a, 0x16, 0x39, 0x80,
+	0xb1, 0x0d, 0x99, 0x64, 0x65, 0xaa, 0x5d, 0x9d, 0x00, 0xa5, 0x53, 0xa3, 0xfc, 0x37, 0xa7, 0x05,
+	0x07, 0x08, 0xb6, 0x72, 0x5a, 0xbe, 0xce, 0x69, 0xc1, 0x21, 0x82, 0x2e, 0xa7, 0x45, 0x70, 0x32,
+	0x11, 0x69, 0xc7, 0x1c, 0xd9, 0x91, 0xb0, 0x5e, 0x2b, 0xe8, 0x1c, 0x02, 0xcc, 0x24, 0x78, 0x3d,
+	0x40, 0x1e, 0xd2, 0x53, 0xa7, 0x57, 0xc3, 0xf3, 0xbd, 0x23, 0xe3, 0xc6, 0x03, 0x07, 0x78, 0xed,
+	0x1d, 0xd4, 0xe9, 0x46, 0x26, 0x8f, 0x61, 0xb7, 0xaa, 0x4e, 0xcd, 0x8e, 0x91, 0x0d, 0x5c, 0x89,
+	0x6a, 0x78, 0x1f, 0x06, 0xef, 0x85, 0xd6, 0x82, 0xbb, 0x47, 0xc1, 0xad, 0x48, 0x04, 0x3e, 0x97,
+	0xea, 0x5d, 0xc9, 0xd6, 0xf2, 0x46, 0x0a, 0x8e, 0xef, 0xc0, 0x88, 0xb6, 0xb4, 0xe8, 0x15, 0xf8,
+	0xcd, 0x77, 0xd2, 0xb4, 0x8c, 0xb8, 0x65, 0x72, 0x8d, 0xcd, 0xe0, 0x51, 0xbb, 0x30, 0x19, 0x3e,
+	0xb0, 0xf5, 0x5a, 0x68, 0x37, 0x83, 0x6e, 0x65, 0x86, 0xf3, 0xbd, 0x28, 0x94, 0x39, 0x5c, 0xd7,
+	0x0e, 0xa7, 0x5b, 0x3e, 0x8a, 0x60, 0x54, 0x8d, 0x0b, 0xf1, 0xa0, 0x7f, 0x7c, 0xf6, 0xfc, 0xfc,
+	0x45, 0xb0, 0x45, 0xc6, 0x30, 0x5c, 0x5e, 0xfe, 0x4a, 0x8f, 0x9f, 0xfd, 0x12, 0x74, 0x1e, 0x3d,
+	0x80, 0x49, 0x6b, 0xec, 0x49, 0x00, 0xfe, 0xe5, 0xe9, 0xcb, 0xf8, 0xf2, 0x62, 0x19, 0x3f, 0xa3,
+	0x2f, 0x4f, 0x83, 0xad, 0x93, 0xde, 0xeb, 0xed, 0xfc, 0xfa, 0x7a, 0x80, 0x7f, 0x94, 0x3f, 0xfd,
+	0x33, 0x00, 0xda, 0x2d, 0x1f, 0xcb, 0x48, 0x07, 0x00, 0x00,
 }
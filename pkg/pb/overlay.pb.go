@@ -52,7 +52,7 @@ func (x Restriction_Operator) String() string {
 	return proto.EnumName(Restriction_Operator_name, int32(x))
 }
 func (Restriction_Operator) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{11, 0}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{13, 0}
 }
 
 type Restriction_Operand int32
@@ -75,7 +75,7 @@ func (x Restriction_Operand) String() string {
 	return proto.EnumName(Restriction_Operand_name, int32(x))
 }
 func (Restriction_Operand) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{11, 1}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{13, 1}
 }
 
 // LookupRequest is is request message for the lookup rpc call
@@ -90,7 +90,7 @@ func (m *LookupRequest) Reset()         { *m = LookupRequest{} }
 func (m *LookupRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupRequest) ProtoMessage()    {}
 func (*LookupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{0}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{0}
 }
 func (m *LookupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupRequest.Unmarshal(m, b)
@@ -122,7 +122,7 @@ func (m *LookupResponse) Reset()         { *m = LookupResponse{} }
 func (m *LookupResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupResponse) ProtoMessage()    {}
 func (*LookupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{1}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{1}
 }
 func (m *LookupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupResponse.Unmarshal(m, b)
@@ -161,7 +161,7 @@ func (m *LookupRequests) Reset()         { *m = LookupRequests{} }
 func (m *LookupRequests) String() string { return proto.CompactTextString(m) }
 func (*LookupRequests) ProtoMessage()    {}
 func (*LookupRequests) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{2}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{2}
 }
 func (m *LookupRequests) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupRequests.Unmarshal(m, b)
@@ -200,7 +200,7 @@ func (m *LookupResponses) Reset()         { *m = LookupResponses{} }
 func (m *LookupResponses) String() string { return proto.CompactTextString(m) }
 func (*LookupResponses) ProtoMessage()    {}
 func (*LookupResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{3}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{3}
 }
 func (m *LookupResponses) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupResponses.Unmarshal(m, b)
@@ -239,7 +239,7 @@ func (m *FindStorageNodesResponse) Reset()         { *m = FindStorageNodesRespon
 func (m *FindStorageNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*FindStorageNodesResponse) ProtoMessage()    {}
 func (*FindStorageNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{4}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{4}
 }
 func (m *FindStorageNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindStorageNodesResponse.Unmarshal(m, b)
@@ -282,7 +282,7 @@ func (m *FindStorageNodesRequest) Reset()         { *m = FindStorageNodesRequest
 func (m *FindStorageNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*FindStorageNodesRequest) ProtoMessage()    {}
 func (*FindStorageNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{5}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{5}
 }
 func (m *FindStorageNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindStorageNodesRequest.Unmarshal(m, b)
@@ -347,7 +347,7 @@ func (m *OverlayOptions) Reset()         { *m = OverlayOptions{} }
 func (m *OverlayOptions) String() string { return proto.CompactTextString(m) }
 func (*OverlayOptions) ProtoMessage()    {}
 func (*OverlayOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{6}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{6}
 }
 func (m *OverlayOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OverlayOptions.Unmarshal(m, b)
@@ -403,20 +403,21 @@ func (m *OverlayOptions) GetRestrictions() *NodeRestrictions {
 }
 
 type QueryRequest struct {
-	Sender               *Node    `protobuf:"bytes,1,opt,name=sender" json:"sender,omitempty"`
-	Target               *Node    `protobuf:"bytes,2,opt,name=target" json:"target,omitempty"`
-	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Pingback             bool     `protobuf:"varint,4,opt,name=pingback,proto3" json:"pingback,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Sender               *Node             `protobuf:"bytes,1,opt,name=sender" json:"sender,omitempty"`
+	Target               *Node             `protobuf:"bytes,2,opt,name=target" json:"target,omitempty"`
+	Limit                int64             `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Pingback             bool              `protobuf:"varint,4,opt,name=pingback,proto3" json:"pingback,omitempty"`
+	SenderRecord         *SignedNodeRecord `protobuf:"bytes,5,opt,name=sender_record,json=senderRecord" json:"sender_record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{7}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{7}
 }
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
@@ -464,19 +465,28 @@ func (m *QueryRequest) GetPingback() bool {
 	return false
 }
 
+func (m *QueryRequest) GetSenderRecord() *SignedNodeRecord {
+	if m != nil {
+		return m.SenderRecord
+	}
+	return nil
+}
+
 type QueryResponse struct {
-	Sender               *Node    `protobuf:"bytes,1,opt,name=sender" json:"sender,omitempty"`
-	Response             []*Node  `protobuf:"bytes,2,rep,name=response" json:"response,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Sender   *Node   `protobuf:"bytes,1,opt,name=sender" json:"sender,omitempty"`
+	Response []*Node `protobuf:"bytes,2,rep,name=response" json:"response,omitempty"`
+	// responder_record is the signed record of the queried node
+	ResponderRecord      *SignedNodeRecord `protobuf:"bytes,3,opt,name=responder_record,json=responderRecord" json:"responder_record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
 func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryResponse) ProtoMessage()    {}
 func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{8}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{8}
 }
 func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponse.Unmarshal(m, b)
@@ -510,6 +520,13 @@ func (m *QueryResponse) GetResponse() []*Node {
 	return nil
 }
 
+func (m *QueryResponse) GetResponderRecord() *SignedNodeRecord {
+	if m != nil {
+		return m.ResponderRecord
+	}
+	return nil
+}
+
 type PingRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -520,7 +537,7 @@ func (m *PingRequest) Reset()         { *m = PingRequest{} }
 func (m *PingRequest) String() string { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()    {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{9}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{9}
 }
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
@@ -550,7 +567,7 @@ func (m *PingResponse) Reset()         { *m = PingResponse{} }
 func (m *PingResponse) String() string { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()    {}
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{10}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{10}
 }
 func (m *PingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
@@ -570,6 +587,108 @@ func (m *PingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PingResponse proto.InternalMessageInfo
 
+type ExternalAddressRequest struct {
+	// port the caller listens on, it replaces the port of the outgoing connection
+	Port int32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// dialback asks to dial the caller on the seen address to check it is reachable
+	Dialback             bool     `protobuf:"varint,2,opt,name=dialback,proto3" json:"dialback,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalAddressRequest) Reset()         { *m = ExternalAddressRequest{} }
+func (m *ExternalAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*ExternalAddressRequest) ProtoMessage()    {}
+func (*ExternalAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{11}
+}
+func (m *ExternalAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalAddressRequest.Unmarshal(m, b)
+}
+func (m *ExternalAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalAddressRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExternalAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalAddressRequest.Merge(dst, src)
+}
+func (m *ExternalAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_ExternalAddressRequest.Size(m)
+}
+func (m *ExternalAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalAddressRequest proto.InternalMessageInfo
+
+func (m *ExternalAddressRequest) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *ExternalAddressRequest) GetDialback() bool {
+	if m != nil {
+		return m.Dialback
+	}
+	return false
+}
+
+type ExternalAddressResponse struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reachable            bool     `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	DialbackError        string   `protobuf:"bytes,3,opt,name=dialback_error,json=dialbackError,proto3" json:"dialback_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalAddressResponse) Reset()         { *m = ExternalAddressResponse{} }
+func (m *ExternalAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*ExternalAddressResponse) ProtoMessage()    {}
+func (*ExternalAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{12}
+}
+func (m *ExternalAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalAddressResponse.Unmarshal(m, b)
+}
+func (m *ExternalAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalAddressResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExternalAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalAddressResponse.Merge(dst, src)
+}
+func (m *ExternalAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_ExternalAddressResponse.Size(m)
+}
+func (m *ExternalAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalAddressResponse proto.InternalMessageInfo
+
+func (m *ExternalAddressResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExternalAddressResponse) GetReachable() bool {
+	if m != nil {
+		return m.Reachable
+	}
+	return false
+}
+
+func (m *ExternalAddressResponse) GetDialbackError() string {
+	if m != nil {
+		return m.DialbackError
+	}
+	return ""
+}
+
 type Restriction struct {
 	Operator             Restriction_Operator `protobuf:"varint,1,opt,name=operator,proto3,enum=overlay.Restriction_Operator" json:"operator,omitempty"`
 	Operand              Restriction_Operand  `protobuf:"varint,2,opt,name=operand,proto3,enum=overlay.Restriction_Operand" json:"operand,omitempty"`
@@ -583,7 +702,7 @@ func (m *Restriction) Reset()         { *m = Restriction{} }
 func (m *Restriction) String() string { return proto.CompactTextString(m) }
 func (*Restriction) ProtoMessage()    {}
 func (*Restriction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_99a51b72c26b0776, []int{11}
+	return fileDescriptor_overlay_1e7455e3c5d89d23, []int{13}
 }
 func (m *Restriction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Restriction.Unmarshal(m, b)
@@ -636,6 +755,8 @@ func init() {
 	proto.RegisterType((*QueryResponse)(nil), "overlay.QueryResponse")
 	proto.RegisterType((*PingRequest)(nil), "overlay.PingRequest")
 	proto.RegisterType((*PingResponse)(nil), "overlay.PingResponse")
+	proto.RegisterType((*ExternalAddressRequest)(nil), "overlay.ExternalAddressRequest")
+	proto.RegisterType((*ExternalAddressResponse)(nil), "overlay.ExternalAddressResponse")
 	proto.RegisterType((*Restriction)(nil), "overlay.Restriction")
 	proto.RegisterEnum("overlay.Restriction_Operator", Restriction_Operator_name, Restriction_Operator_value)
 	proto.RegisterEnum("overlay.Restriction_Operand", Restriction_Operand_name, Restriction_Operand_value)
@@ -790,6 +911,8 @@ var _Overlay_serviceDesc = grpc.ServiceDesc{
 type NodesClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// ExternalAddress returns the address the caller is seen from and optionally dials it back
+	ExternalAddress(ctx context.Context, in *ExternalAddressRequest, opts ...grpc.CallOption) (*ExternalAddressResponse, error)
 }
 
 type nodesClient struct {
@@ -818,11 +941,22 @@ func (c *nodesClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *nodesClient) ExternalAddress(ctx context.Context, in *ExternalAddressRequest, opts ...grpc.CallOption) (*ExternalAddressResponse, error) {
+	out := new(ExternalAddressResponse)
+	err := c.cc.Invoke(ctx, "/overlay.Nodes/ExternalAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Nodes service
 
 type NodesServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// ExternalAddress returns the address the caller is seen from and optionally dials it back
+	ExternalAddress(context.Context, *ExternalAddressRequest) (*ExternalAddressResponse, error)
 }
 
 func RegisterNodesServer(s *grpc.Server, srv NodesServer) {
@@ -865,6 +999,24 @@ func _Nodes_Ping_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nodes_ExternalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodesServer).ExternalAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/overlay.Nodes/ExternalAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodesServer).ExternalAddress(ctx, req.(*ExternalAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Nodes_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "overlay.Nodes",
 	HandlerType: (*NodesServer)(nil),
@@ -877,66 +1029,79 @@ var _Nodes_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _Nodes_Ping_Handler,
 		},
+		{
+			MethodName: "ExternalAddress",
+			Handler:    _Nodes_ExternalAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "overlay.proto",
 }
 
-func init() { proto.RegisterFile("overlay.proto", fileDescriptor_overlay_99a51b72c26b0776) }
-
-var fileDescriptor_overlay_99a51b72c26b0776 = []byte{
-	// 845 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x5e, 0xe7, 0xc7, 0xc9, 0x9e, 0x24, 0x5e, 0x6b, 0xd4, 0xee, 0x06, 0x03, 0xdd, 0x60, 0x55,
-	0xb0, 0x12, 0x55, 0x0a, 0x29, 0xaa, 0x68, 0x05, 0x02, 0xa2, 0xa4, 0x65, 0xd5, 0xa8, 0x4b, 0x27,
-	0x91, 0x2a, 0xc1, 0x85, 0xe5, 0xc4, 0x83, 0x31, 0xeb, 0x78, 0x8c, 0x67, 0x5c, 0xed, 0xf6, 0x09,
-	0x78, 0x13, 0x5e, 0x85, 0x67, 0xe0, 0x62, 0x1f, 0x81, 0x07, 0xe0, 0x0a, 0xcd, 0x8f, 0x1d, 0x67,
-	0x77, 0x53, 0xf5, 0x6a, 0xe6, 0x9c, 0xf3, 0x7d, 0x67, 0xe6, 0x3b, 0x73, 0xe6, 0x40, 0x8f, 0xbe,
-	0x21, 0x59, 0xec, 0x5f, 0x0e, 0xd3, 0x8c, 0x72, 0x8a, 0x5a, 0xda, 0x74, 0xee, 0x85, 0x94, 0x86,
-	0x31, 0x79, 0x28, 0xdd, 0xcb, 0xfc, 0xd7, 0x87, 0x41, 0x9e, 0xf9, 0x3c, 0xa2, 0x89, 0x02, 0x3a,
-	0x10, 0xd2, 0x90, 0x16, 0xfb, 0x84, 0x06, 0x44, 0xed, 0xdd, 0xaf, 0xa1, 0x37, 0xa3, 0xf4, 0x3c,
-	0x4f, 0x31, 0xf9, 0x23, 0x27, 0x8c, 0xa3, 0xcf, 0xa0, 0x25, 0xc2, 0x5e, 0x14, 0xf4, 0x8d, 0x81,
-	0x71, 0xd2, 0x1d, 0x5b, 0x7f, 0x5f, 0x1d, 0xef, 0xfd, 0x73, 0x75, 0x6c, 0xbe, 0xa4, 0x01, 0x39,
-	0x9d, 0x60, 0x53, 0x84, 0x4f, 0x03, 0xf7, 0x0b, 0xb0, 0x0a, 0x26, 0x4b, 0x69, 0xc2, 0x08, 0xba,
-	0x07, 0x0d, 0x11, 0x93, 0xbc, 0xce, 0x08, 0x86, 0xf2, 0x18, 0xc1, 0xc2, 0xd2, 0xef, 0x9e, 0x6d,
-	0x18, 0xf2, 0x2c, 0x86, 0xbe, 0x05, 0x2b, 0x96, 0x1e, 0x2f, 0x53, 0xae, 0xbe, 0x31, 0xa8, 0x9f,
-	0x74, 0x46, 0x87, 0xc3, 0x42, 0xe6, 0x16, 0x01, 0xf7, 0xe2, 0xaa, 0xe9, 0xce, 0xe1, 0x60, 0xfb,
-	0x0a, 0x0c, 0x7d, 0x0f, 0x07, 0x65, 0x46, 0xe5, 0xd3, 0x29, 0x8f, 0x6e, 0xa4, 0x54, 0x61, 0x6c,
-	0xc5, 0x5b, 0xb6, 0xfb, 0x0d, 0xf4, 0x9f, 0x45, 0x49, 0x30, 0xe7, 0x34, 0xf3, 0x43, 0x22, 0xae,
-	0xcf, 0x4a, 0x85, 0x03, 0x68, 0x0a, 0x25, 0x4c, 0xe7, 0xac, 0x4a, 0x54, 0x01, 0xf7, 0x5f, 0x03,
-	0x8e, 0x6e, 0xd2, 0x55, 0x69, 0x8f, 0xa1, 0x43, 0x97, 0xbf, 0x93, 0x15, 0xf7, 0x58, 0xf4, 0x56,
-	0x95, 0xa9, 0x8e, 0x41, 0xb9, 0xe6, 0xd1, 0x5b, 0x82, 0xc6, 0x70, 0xb0, 0xa2, 0x09, 0xcf, 0xfc,
-	0x15, 0xf7, 0x62, 0x92, 0x84, 0xfc, 0xb7, 0x7e, 0x4d, 0xd6, 0xf2, 0x83, 0xa1, 0x7a, 0xde, 0x61,
-	0xf1, 0xbc, 0xc3, 0x89, 0x7e, 0x5e, 0x6c, 0x15, 0x8c, 0x99, 0x24, 0xa0, 0xcf, 0xa1, 0x41, 0x53,
-	0xce, 0xfa, 0x75, 0x49, 0xdc, 0xa8, 0x3e, 0x53, 0xeb, 0x59, 0x2a, 0x58, 0x0c, 0x4b, 0x10, 0xba,
-	0x0f, 0x4d, 0xc6, 0xfd, 0x8c, 0xf7, 0x1b, 0xb7, 0x3e, 0xb5, 0x0a, 0xa2, 0x0f, 0x61, 0x7f, 0xed,
-	0x5f, 0x78, 0x4a, 0x79, 0x53, 0xde, 0xba, 0xbd, 0xf6, 0x2f, 0xa4, 0x36, 0xf7, 0xaf, 0x1a, 0x58,
-	0xdb, 0xb9, 0xd1, 0x53, 0xe8, 0x08, 0x7c, 0xec, 0x73, 0x92, 0xac, 0x2e, 0x75, 0x3b, 0xbc, 0x43,
-	0x02, 0xac, 0xfd, 0x8b, 0x99, 0x02, 0xa3, 0x07, 0xb0, 0xbf, 0x8e, 0x12, 0x8f, 0x71, 0x9f, 0x33,
-	0x2d, 0xfe, 0x60, 0x53, 0xe5, 0xb9, 0x70, 0xe3, 0xf6, 0x3a, 0x4a, 0xe4, 0x0e, 0xdd, 0x07, 0x4b,
-	0xa2, 0x53, 0x42, 0x02, 0xef, 0x7c, 0x99, 0x2a, 0xd9, 0x75, 0xdc, 0x15, 0x08, 0xe1, 0x7c, 0xb1,
-	0x4c, 0x19, 0x3a, 0x04, 0xd3, 0x5f, 0xd3, 0x3c, 0x51, 0x32, 0xeb, 0x58, 0x5b, 0xe8, 0x29, 0x74,
-	0x33, 0xc2, 0x78, 0x16, 0xad, 0xe4, 0xbd, 0xa5, 0x34, 0xd1, 0x7b, 0x9b, 0x47, 0xad, 0x44, 0xf1,
-	0x16, 0x16, 0x7d, 0x09, 0x16, 0xb9, 0x58, 0xc5, 0x79, 0x40, 0x02, 0x5d, 0x18, 0x73, 0x50, 0x3f,
-	0xe9, 0x8e, 0xa1, 0x52, 0xbe, 0x5e, 0x81, 0x50, 0x95, 0xfa, 0xd3, 0x80, 0xee, 0xab, 0x9c, 0x64,
-	0x97, 0x45, 0x3f, 0xb8, 0x60, 0x32, 0x92, 0x04, 0x24, 0xbb, 0xe5, 0xc7, 0xe8, 0x88, 0xc0, 0x70,
-	0x3f, 0x0b, 0x09, 0xd7, 0xc5, 0xd8, 0xc2, 0xa8, 0x08, 0xba, 0x03, 0xcd, 0x38, 0x5a, 0x47, 0x5c,
-	0x8b, 0x57, 0x06, 0x72, 0xa0, 0x9d, 0x46, 0x49, 0xb8, 0xf4, 0x57, 0xe7, 0x52, 0x77, 0x1b, 0x97,
-	0xb6, 0xfb, 0x0b, 0xf4, 0xf4, 0x4d, 0x74, 0x63, 0xbf, 0xcf, 0x55, 0x3e, 0x85, 0x76, 0xf9, 0xa7,
-	0x6a, 0x37, 0xfa, 0xbf, 0x8c, 0xb9, 0x3d, 0xe8, 0xfc, 0x14, 0x25, 0x61, 0xf1, 0x49, 0x2d, 0xe8,
-	0x2a, 0x53, 0x87, 0xff, 0x33, 0xa0, 0x53, 0x29, 0x2c, 0x7a, 0x02, 0x6d, 0x9a, 0x92, 0xcc, 0xe7,
-	0x54, 0x1d, 0x6e, 0x8d, 0x3e, 0x2e, 0x9b, 0xb6, 0x82, 0x1b, 0x9e, 0x69, 0x10, 0x2e, 0xe1, 0xe8,
-	0x31, 0xb4, 0xe4, 0x3e, 0x09, 0x64, 0x75, 0xac, 0xd1, 0x47, 0xbb, 0x99, 0x49, 0x80, 0x0b, 0xb0,
-	0x28, 0xd8, 0x1b, 0x3f, 0xce, 0x49, 0x51, 0x30, 0x69, 0xb8, 0x5f, 0x41, 0xbb, 0x38, 0x03, 0x99,
-	0x50, 0x9b, 0x2d, 0xec, 0x3d, 0xb1, 0x4e, 0x5f, 0xd9, 0x86, 0x58, 0x9f, 0x2f, 0xec, 0x1a, 0x6a,
-	0x41, 0x7d, 0xb6, 0x98, 0xda, 0x75, 0xb1, 0x79, 0xbe, 0x98, 0xda, 0x0d, 0xf7, 0x01, 0xb4, 0x74,
-	0x7e, 0x84, 0xc0, 0x7a, 0x86, 0xa7, 0x53, 0x6f, 0xfc, 0xc3, 0xcb, 0xc9, 0xeb, 0xd3, 0xc9, 0xe2,
-	0x47, 0x7b, 0x0f, 0xf5, 0x60, 0x5f, 0xfa, 0x26, 0xa7, 0xf3, 0x17, 0xb6, 0x31, 0xba, 0x32, 0xa0,
-	0xa5, 0x7f, 0x0b, 0x7a, 0x02, 0xa6, 0x1a, 0x45, 0x68, 0xc7, 0xb8, 0x73, 0x76, 0xcd, 0x2c, 0xf4,
-	0x1d, 0xc0, 0x38, 0x8f, 0xcf, 0x35, 0xfd, 0xe8, 0x76, 0x3a, 0x73, 0xfa, 0x3b, 0xf8, 0x0c, 0xbd,
-	0x06, 0xfb, 0xfa, 0x94, 0x42, 0x83, 0x12, 0xbd, 0x63, 0x80, 0x39, 0x9f, 0xbc, 0x03, 0xa1, 0x32,
-	0x8f, 0x38, 0x34, 0x55, 0xb6, 0xc7, 0xd0, 0x94, 0x2d, 0x86, 0xee, 0x96, 0xa4, 0x6a, 0xf3, 0x3b,
-	0x87, 0xd7, 0xdd, 0x5a, 0xda, 0x23, 0x68, 0x88, 0x76, 0x41, 0x77, 0xca, 0x78, 0xa5, 0x99, 0x9c,
-	0xbb, 0xd7, 0xbc, 0x8a, 0x34, 0x6e, 0xfc, 0x5c, 0x4b, 0x97, 0x4b, 0x53, 0x8e, 0x96, 0x47, 0xff,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0xae, 0x57, 0x27, 0x55, 0x24, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("overlay.proto", fileDescriptor_overlay_1e7455e3c5d89d23) }
+
+var fileDescriptor_overlay_1e7455e3c5d89d23 = []byte{
+	// 986 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdf, 0x8e, 0xdb, 0xc4,
+	0x17, 0x5e, 0xe7, 0x7f, 0x4e, 0x12, 0x27, 0x1a, 0xb5, 0xbb, 0xfe, 0xe5, 0x57, 0xba, 0xc1, 0x2a,
+	0xb0, 0x12, 0x55, 0x0a, 0x29, 0xaa, 0x68, 0x01, 0xc1, 0x46, 0x9b, 0xb6, 0xab, 0x46, 0x5d, 0x3a,
+	0x89, 0x54, 0x89, 0x1b, 0x6b, 0x12, 0x0f, 0xae, 0x59, 0xc7, 0x63, 0xc6, 0x93, 0x2a, 0xdb, 0x87,
+	0xe1, 0x96, 0x27, 0x41, 0x42, 0x3c, 0x02, 0x17, 0xfb, 0x08, 0x3c, 0x00, 0x57, 0x68, 0xfe, 0xd8,
+	0x9b, 0x6c, 0x36, 0x85, 0x2b, 0xcf, 0xf9, 0xce, 0x77, 0xce, 0x9c, 0xef, 0xcc, 0x9c, 0x31, 0xb4,
+	0xd8, 0x5b, 0xca, 0x23, 0x72, 0xd1, 0x4f, 0x38, 0x13, 0x0c, 0x55, 0x8d, 0xd9, 0xbd, 0x1b, 0x30,
+	0x16, 0x44, 0xf4, 0x81, 0x82, 0x67, 0xcb, 0x1f, 0x1f, 0xf8, 0x4b, 0x4e, 0x44, 0xc8, 0x62, 0x4d,
+	0xec, 0x42, 0xc0, 0x02, 0x96, 0xad, 0x63, 0xe6, 0x53, 0xbd, 0x76, 0xbf, 0x84, 0xd6, 0x98, 0xb1,
+	0xf3, 0x65, 0x82, 0xe9, 0xcf, 0x4b, 0x9a, 0x0a, 0xf4, 0x09, 0x54, 0xa5, 0xdb, 0x0b, 0x7d, 0xc7,
+	0xea, 0x59, 0x47, 0xcd, 0xa1, 0xfd, 0xfb, 0xe5, 0xe1, 0xde, 0x9f, 0x97, 0x87, 0x95, 0x97, 0xcc,
+	0xa7, 0xa7, 0x27, 0xb8, 0x22, 0xdd, 0xa7, 0xbe, 0xfb, 0x19, 0xd8, 0x59, 0x64, 0x9a, 0xb0, 0x38,
+	0xa5, 0xe8, 0x2e, 0x94, 0xa4, 0x4f, 0xc5, 0x35, 0x06, 0xd0, 0x57, 0xdb, 0xc8, 0x28, 0xac, 0x70,
+	0xf7, 0x0c, 0xec, 0x8d, 0xbd, 0x52, 0xf4, 0x0d, 0xd8, 0x91, 0x42, 0x3c, 0xae, 0x21, 0xc7, 0xea,
+	0x15, 0x8f, 0x1a, 0x83, 0xfd, 0x7e, 0x26, 0x73, 0x23, 0x00, 0xb7, 0xa2, 0x75, 0xd3, 0x9d, 0x40,
+	0x7b, 0xb3, 0x84, 0x14, 0x7d, 0x07, 0xed, 0x3c, 0xa3, 0xc6, 0x4c, 0xca, 0x83, 0xad, 0x94, 0xda,
+	0x8d, 0xed, 0x68, 0xc3, 0x76, 0xbf, 0x06, 0xe7, 0x69, 0x18, 0xfb, 0x13, 0xc1, 0x38, 0x09, 0xa8,
+	0x2c, 0x3f, 0xcd, 0x15, 0xf6, 0xa0, 0x2c, 0x95, 0xa4, 0x26, 0xe7, 0xba, 0x44, 0xed, 0x70, 0xff,
+	0xb2, 0xe0, 0x60, 0x3b, 0x5c, 0xb7, 0xf6, 0x10, 0x1a, 0x6c, 0xf6, 0x13, 0x9d, 0x0b, 0x2f, 0x0d,
+	0xdf, 0xe9, 0x36, 0x15, 0x31, 0x68, 0x68, 0x12, 0xbe, 0xa3, 0x68, 0x08, 0xed, 0x39, 0x8b, 0x05,
+	0x27, 0x73, 0xe1, 0x45, 0x34, 0x0e, 0xc4, 0x1b, 0xa7, 0xa0, 0x7a, 0xf9, 0xbf, 0xbe, 0x3e, 0xde,
+	0x7e, 0x76, 0xbc, 0xfd, 0x13, 0x73, 0xbc, 0xd8, 0xce, 0x22, 0xc6, 0x2a, 0x00, 0x7d, 0x0a, 0x25,
+	0x96, 0x88, 0xd4, 0x29, 0xf6, 0xac, 0x0d, 0xd5, 0x67, 0xfa, 0x7b, 0x96, 0xc8, 0xa8, 0x14, 0x2b,
+	0x12, 0xba, 0x07, 0xe5, 0x54, 0x10, 0x2e, 0x9c, 0xd2, 0x8d, 0x47, 0xad, 0x9d, 0xe8, 0xff, 0x50,
+	0x5f, 0x90, 0x95, 0xa7, 0x95, 0x97, 0x55, 0xd5, 0xb5, 0x05, 0x59, 0x29, 0x6d, 0xee, 0xaf, 0x05,
+	0xb0, 0x37, 0x73, 0xa3, 0x27, 0xd0, 0x90, 0xfc, 0x88, 0x08, 0x1a, 0xcf, 0x2f, 0x1c, 0xeb, 0xdf,
+	0x24, 0xc0, 0x82, 0xac, 0xc6, 0x9a, 0x8c, 0xee, 0x43, 0x7d, 0x11, 0xc6, 0x5e, 0x2a, 0x88, 0x48,
+	0x8d, 0xf8, 0xf6, 0x55, 0x97, 0x27, 0x12, 0xc6, 0xb5, 0x45, 0x18, 0xab, 0x15, 0xba, 0x07, 0xb6,
+	0x62, 0x27, 0x94, 0xfa, 0xde, 0xf9, 0x2c, 0xd1, 0xb2, 0x8b, 0xb8, 0x29, 0x19, 0x12, 0x7c, 0x31,
+	0x4b, 0x52, 0xb4, 0x0f, 0x15, 0xb2, 0x60, 0xcb, 0x58, 0xcb, 0x2c, 0x62, 0x63, 0xa1, 0x27, 0xd0,
+	0xe4, 0x34, 0x15, 0x3c, 0x9c, 0xab, 0xba, 0x95, 0x34, 0x79, 0xf7, 0xae, 0x0e, 0x75, 0xcd, 0x8b,
+	0x37, 0xb8, 0xe8, 0x73, 0xb0, 0xe9, 0x6a, 0x1e, 0x2d, 0x7d, 0xea, 0x9b, 0xc6, 0x54, 0x7a, 0xc5,
+	0xa3, 0xe6, 0x10, 0xd6, 0xda, 0xd7, 0xca, 0x18, 0xba, 0x53, 0xbf, 0x59, 0xd0, 0x7c, 0xb5, 0xa4,
+	0xfc, 0x22, 0xbb, 0x0f, 0x2e, 0x54, 0x52, 0x1a, 0xfb, 0x94, 0xdf, 0x30, 0x31, 0xc6, 0x23, 0x39,
+	0x82, 0xf0, 0x80, 0x0a, 0xa7, 0xb0, 0xcd, 0xd1, 0x1e, 0x74, 0x0b, 0xca, 0x51, 0xb8, 0x08, 0x85,
+	0x11, 0xaf, 0x0d, 0xd4, 0x85, 0x5a, 0x12, 0xc6, 0xc1, 0x8c, 0xcc, 0xcf, 0x95, 0xee, 0x1a, 0xce,
+	0x6d, 0xf4, 0x15, 0xb4, 0x74, 0x7e, 0x8f, 0xd3, 0x39, 0xe3, 0xfe, 0xa6, 0xf4, 0x49, 0x18, 0xc4,
+	0xba, 0x68, 0xac, 0xbc, 0xb8, 0xa9, 0xc9, 0xda, 0x72, 0x7f, 0xb1, 0xa0, 0x65, 0x74, 0x98, 0xb1,
+	0xf8, 0x2f, 0x42, 0x3e, 0x86, 0x5a, 0x3e, 0x91, 0x85, 0xad, 0xe9, 0xc9, 0x7d, 0xe8, 0x18, 0x3a,
+	0x7a, 0xbd, 0x56, 0x5d, 0xf1, 0xbd, 0xd5, 0xb5, 0x73, 0xbe, 0x29, 0xb0, 0x05, 0x8d, 0xef, 0xc3,
+	0x38, 0xc8, 0x5e, 0x09, 0x1b, 0x9a, 0xda, 0x34, 0x03, 0xfe, 0x1c, 0xf6, 0x47, 0x2b, 0x41, 0x79,
+	0x4c, 0xa2, 0x63, 0xdf, 0xe7, 0x34, 0xcd, 0x07, 0x14, 0x41, 0x29, 0x61, 0x5c, 0x28, 0x15, 0x65,
+	0xac, 0xd6, 0xb2, 0x8d, 0x7e, 0x48, 0x22, 0xd5, 0xc6, 0x82, 0x6e, 0x63, 0x66, 0xbb, 0x2b, 0x38,
+	0xd8, 0xca, 0x64, 0x64, 0x38, 0x50, 0x25, 0x1a, 0x52, 0xd9, 0xea, 0x38, 0x33, 0xd1, 0x1d, 0xa8,
+	0x73, 0x4a, 0xe6, 0x6f, 0xc8, 0x2c, 0xa2, 0x26, 0xe3, 0x15, 0x80, 0x3e, 0x02, 0x3b, 0x4b, 0xef,
+	0x51, 0xce, 0x19, 0x57, 0xe2, 0xeb, 0xb8, 0x95, 0xa1, 0x23, 0x09, 0xba, 0x7f, 0x5b, 0xd0, 0x58,
+	0xbb, 0x9d, 0xe8, 0x31, 0xd4, 0x58, 0x42, 0x39, 0x11, 0x4c, 0x9f, 0x81, 0x3d, 0xf8, 0x20, 0x9f,
+	0xfc, 0x35, 0x5e, 0xff, 0xcc, 0x90, 0x70, 0x4e, 0x47, 0x8f, 0xa0, 0xaa, 0xd6, 0xb1, 0xaf, 0xaa,
+	0xb1, 0x07, 0x77, 0x76, 0x47, 0xc6, 0x3e, 0xce, 0xc8, 0xf2, 0xd6, 0xbd, 0x25, 0xd1, 0x92, 0x66,
+	0xb7, 0x4e, 0x19, 0xee, 0x17, 0x50, 0xcb, 0xf6, 0x40, 0x15, 0x28, 0x8c, 0xa7, 0x9d, 0x3d, 0xf9,
+	0x1d, 0xbd, 0xea, 0x58, 0xf2, 0xfb, 0x6c, 0xda, 0x29, 0xa0, 0x2a, 0x14, 0xc7, 0xd3, 0x51, 0xa7,
+	0x28, 0x17, 0xcf, 0xa6, 0xa3, 0x4e, 0xc9, 0xbd, 0x0f, 0x55, 0x93, 0x1f, 0x21, 0xb0, 0x9f, 0xe2,
+	0xd1, 0xc8, 0x1b, 0x1e, 0xbf, 0x3c, 0x79, 0x7d, 0x7a, 0x32, 0x7d, 0xde, 0xd9, 0x43, 0x2d, 0xa8,
+	0x2b, 0xec, 0xe4, 0x74, 0xf2, 0xa2, 0x63, 0x0d, 0x2e, 0x2d, 0xa8, 0x9a, 0x27, 0x07, 0x3d, 0x86,
+	0x8a, 0x7e, 0xcf, 0xd1, 0x8e, 0x7f, 0x46, 0x77, 0xd7, 0xc3, 0x8f, 0xbe, 0x05, 0x18, 0x2e, 0xa3,
+	0x73, 0x13, 0x7e, 0x70, 0x73, 0x78, 0xda, 0x75, 0x76, 0xc4, 0xa7, 0xe8, 0x35, 0x74, 0xae, 0x3f,
+	0xf5, 0xa8, 0x97, 0xb3, 0x77, 0xfc, 0x05, 0xba, 0x1f, 0xbe, 0x87, 0xa1, 0x33, 0x0f, 0xfe, 0xb0,
+	0xa0, 0xac, 0xd3, 0x3d, 0x82, 0xb2, 0x1a, 0x35, 0x74, 0x3b, 0x8f, 0x5a, 0x7f, 0x42, 0xba, 0xfb,
+	0xd7, 0x61, 0xa3, 0xed, 0x21, 0x94, 0xe4, 0x9d, 0x47, 0xb7, 0x72, 0xff, 0xda, 0x44, 0x74, 0x6f,
+	0x5f, 0x43, 0x4d, 0xd0, 0x14, 0xda, 0xd7, 0xae, 0x33, 0x3a, 0xcc, 0x99, 0x37, 0x8f, 0x4c, 0xb7,
+	0xb7, 0x9b, 0xa0, 0xb3, 0x0e, 0x4b, 0x3f, 0x14, 0x92, 0xd9, 0xac, 0xa2, 0x9e, 0xfd, 0x87, 0xff,
+	0x0c, 0x00, 0x01, 0x4e, 0x78, 0xab, 0xc0, 0x08, 0x00, 0x00,
 }
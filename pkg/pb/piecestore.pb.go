@@ -42,7 +42,7 @@ func (x PayerBandwidthAllocation_Action) String() string {
 	return proto.EnumName(PayerBandwidthAllocation_Action_name, int32(x))
 }
 func (PayerBandwidthAllocation_Action) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{0, 0}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{0, 0}
 }
 
 type PayerBandwidthAllocation struct {
@@ -57,7 +57,7 @@ func (m *PayerBandwidthAllocation) Reset()         { *m = PayerBandwidthAllocati
 func (m *PayerBandwidthAllocation) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocation) ProtoMessage()    {}
 func (*PayerBandwidthAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{0}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{0}
 }
 func (m *PayerBandwidthAllocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocation.Unmarshal(m, b)
@@ -99,6 +99,7 @@ type PayerBandwidthAllocation_Data struct {
 	SerialNumber         string                          `protobuf:"bytes,5,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
 	Action               PayerBandwidthAllocation_Action `protobuf:"varint,6,opt,name=action,proto3,enum=piecestoreroutes.PayerBandwidthAllocation_Action" json:"action,omitempty"`
 	CreatedUnixSec       int64                           `protobuf:"varint,7,opt,name=created_unix_sec,json=createdUnixSec,proto3" json:"created_unix_sec,omitempty"`
+	ProjectId            []byte                          `protobuf:"bytes,8,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
 	XXX_unrecognized     []byte                          `json:"-"`
 	XXX_sizecache        int32                           `json:"-"`
@@ -108,7 +109,7 @@ func (m *PayerBandwidthAllocation_Data) Reset()         { *m = PayerBandwidthAll
 func (m *PayerBandwidthAllocation_Data) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocation_Data) ProtoMessage()    {}
 func (*PayerBandwidthAllocation_Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{0, 0}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{0, 0}
 }
 func (m *PayerBandwidthAllocation_Data) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocation_Data.Unmarshal(m, b)
@@ -163,6 +164,13 @@ func (m *PayerBandwidthAllocation_Data) GetCreatedUnixSec() int64 {
 	return 0
 }
 
+func (m *PayerBandwidthAllocation_Data) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
 type RenterBandwidthAllocation struct {
 	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
 	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -175,7 +183,7 @@ func (m *RenterBandwidthAllocation) Reset()         { *m = RenterBandwidthAlloca
 func (m *RenterBandwidthAllocation) String() string { return proto.CompactTextString(m) }
 func (*RenterBandwidthAllocation) ProtoMessage()    {}
 func (*RenterBandwidthAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{1}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{1}
 }
 func (m *RenterBandwidthAllocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenterBandwidthAllocation.Unmarshal(m, b)
@@ -214,6 +222,7 @@ type RenterBandwidthAllocation_Data struct {
 	Total                int64                     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	StorageNodeId        NodeID                    `protobuf:"bytes,3,opt,name=storage_node_id,json=storageNodeId,proto3,customtype=NodeID" json:"storage_node_id"`
 	PubKey               []byte                    `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Chain                [][]byte                  `protobuf:"bytes,5,rep,name=chain" json:"chain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -223,7 +232,7 @@ func (m *RenterBandwidthAllocation_Data) Reset()         { *m = RenterBandwidthA
 func (m *RenterBandwidthAllocation_Data) String() string { return proto.CompactTextString(m) }
 func (*RenterBandwidthAllocation_Data) ProtoMessage()    {}
 func (*RenterBandwidthAllocation_Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{1, 0}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{1, 0}
 }
 func (m *RenterBandwidthAllocation_Data) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenterBandwidthAllocation_Data.Unmarshal(m, b)
@@ -264,6 +273,13 @@ func (m *RenterBandwidthAllocation_Data) GetPubKey() []byte {
 	return nil
 }
 
+func (m *RenterBandwidthAllocation_Data) GetChain() [][]byte {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
 type PieceStore struct {
 	BandwidthAllocation  *RenterBandwidthAllocation `protobuf:"bytes,1,opt,name=bandwidth_allocation,json=bandwidthAllocation" json:"bandwidth_allocation,omitempty"`
 	PieceData            *PieceStore_PieceData      `protobuf:"bytes,2,opt,name=piece_data,json=pieceData" json:"piece_data,omitempty"`
@@ -277,7 +293,7 @@ func (m *PieceStore) Reset()         { *m = PieceStore{} }
 func (m *PieceStore) String() string { return proto.CompactTextString(m) }
 func (*PieceStore) ProtoMessage()    {}
 func (*PieceStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{2}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{2}
 }
 func (m *PieceStore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStore.Unmarshal(m, b)
@@ -323,6 +339,8 @@ type PieceStore_PieceData struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ExpirationUnixSec    int64    `protobuf:"varint,2,opt,name=expiration_unix_sec,json=expirationUnixSec,proto3" json:"expiration_unix_sec,omitempty"`
 	Content              []byte   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -332,7 +350,7 @@ func (m *PieceStore_PieceData) Reset()         { *m = PieceStore_PieceData{} }
 func (m *PieceStore_PieceData) String() string { return proto.CompactTextString(m) }
 func (*PieceStore_PieceData) ProtoMessage()    {}
 func (*PieceStore_PieceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{2, 0}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{2, 0}
 }
 func (m *PieceStore_PieceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStore_PieceData.Unmarshal(m, b)
@@ -373,6 +391,20 @@ func (m *PieceStore_PieceData) GetContent() []byte {
 	return nil
 }
 
+func (m *PieceStore_PieceData) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PieceStore_PieceData) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type PieceId struct {
 	// TODO: may want to use customtype and fixed-length byte slice
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -386,7 +418,7 @@ func (m *PieceId) Reset()         { *m = PieceId{} }
 func (m *PieceId) String() string { return proto.CompactTextString(m) }
 func (*PieceId) ProtoMessage()    {}
 func (*PieceId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{3}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{3}
 }
 func (m *PieceId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceId.Unmarshal(m, b)
@@ -424,6 +456,7 @@ type PieceSummary struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	PieceSize            int64    `protobuf:"varint,2,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`
 	ExpirationUnixSec    int64    `protobuf:"varint,3,opt,name=expiration_unix_sec,json=expirationUnixSec,proto3" json:"expiration_unix_sec,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -433,7 +466,7 @@ func (m *PieceSummary) Reset()         { *m = PieceSummary{} }
 func (m *PieceSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceSummary) ProtoMessage()    {}
 func (*PieceSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{4}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{4}
 }
 func (m *PieceSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceSummary.Unmarshal(m, b)
@@ -474,6 +507,13 @@ func (m *PieceSummary) GetExpirationUnixSec() int64 {
 	return 0
 }
 
+func (m *PieceSummary) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type PieceRetrieval struct {
 	BandwidthAllocation  *RenterBandwidthAllocation `protobuf:"bytes,1,opt,name=bandwidth_allocation,json=bandwidthAllocation" json:"bandwidth_allocation,omitempty"`
 	PieceData            *PieceRetrieval_PieceData  `protobuf:"bytes,2,opt,name=piece_data,json=pieceData" json:"piece_data,omitempty"`
@@ -487,7 +527,7 @@ func (m *PieceRetrieval) Reset()         { *m = PieceRetrieval{} }
 func (m *PieceRetrieval) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrieval) ProtoMessage()    {}
 func (*PieceRetrieval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{5}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{5}
 }
 func (m *PieceRetrieval) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrieval.Unmarshal(m, b)
@@ -542,7 +582,7 @@ func (m *PieceRetrieval_PieceData) Reset()         { *m = PieceRetrieval_PieceDa
 func (m *PieceRetrieval_PieceData) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrieval_PieceData) ProtoMessage()    {}
 func (*PieceRetrieval_PieceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{5, 0}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{5, 0}
 }
 func (m *PieceRetrieval_PieceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrieval_PieceData.Unmarshal(m, b)
@@ -595,7 +635,7 @@ func (m *PieceRetrievalStream) Reset()         { *m = PieceRetrievalStream{} }
 func (m *PieceRetrievalStream) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrievalStream) ProtoMessage()    {}
 func (*PieceRetrievalStream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{6}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{6}
 }
 func (m *PieceRetrievalStream) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrievalStream.Unmarshal(m, b)
@@ -642,7 +682,7 @@ func (m *PieceDelete) Reset()         { *m = PieceDelete{} }
 func (m *PieceDelete) String() string { return proto.CompactTextString(m) }
 func (*PieceDelete) ProtoMessage()    {}
 func (*PieceDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{7}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{7}
 }
 func (m *PieceDelete) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceDelete.Unmarshal(m, b)
@@ -687,7 +727,7 @@ func (m *PieceDeleteSummary) Reset()         { *m = PieceDeleteSummary{} }
 func (m *PieceDeleteSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceDeleteSummary) ProtoMessage()    {}
 func (*PieceDeleteSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{8}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{8}
 }
 func (m *PieceDeleteSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceDeleteSummary.Unmarshal(m, b)
@@ -726,7 +766,7 @@ func (m *PieceStoreSummary) Reset()         { *m = PieceStoreSummary{} }
 func (m *PieceStoreSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceStoreSummary) ProtoMessage()    {}
 func (*PieceStoreSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{9}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{9}
 }
 func (m *PieceStoreSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStoreSummary.Unmarshal(m, b)
@@ -760,6 +800,292 @@ func (m *PieceStoreSummary) GetTotalReceived() int64 {
 	return 0
 }
 
+type UploadSessionSummary struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadSessionSummary) Reset()         { *m = UploadSessionSummary{} }
+func (m *UploadSessionSummary) String() string { return proto.CompactTextString(m) }
+func (*UploadSessionSummary) ProtoMessage()    {}
+func (*UploadSessionSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_5427076a287c2352, []int{10}
+}
+func (m *UploadSessionSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadSessionSummary.Unmarshal(m, b)
+}
+func (m *UploadSessionSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadSessionSummary.Marshal(b, m, deterministic)
+}
+func (dst *UploadSessionSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadSessionSummary.Merge(dst, src)
+}
+func (m *UploadSessionSummary) XXX_Size() int {
+	return xxx_messageInfo_UploadSessionSummary.Size(m)
+}
+func (m *UploadSessionSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadSessionSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadSessionSummary proto.InternalMessageInfo
+
+func (m *UploadSessionSummary) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UploadSessionSummary) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type RetainRequest struct {
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainRequest) Reset()         { *m = RetainRequest{} }
+func (m *RetainRequest) String() string { return proto.CompactTextString(m) }
+func (*RetainRequest) ProtoMessage()    {}
+func (*RetainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_5427076a287c2352, []int{11}
+}
+func (m *RetainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainRequest.Unmarshal(m, b)
+}
+func (m *RetainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainRequest.Marshal(b, m, deterministic)
+}
+func (dst *RetainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainRequest.Merge(dst, src)
+}
+func (m *RetainRequest) XXX_Size() int {
+	return xxx_messageInfo_RetainRequest.Size(m)
+}
+func (m *RetainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainRequest proto.InternalMessageInfo
+
+func (m *RetainRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *RetainRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type RetainRequest_Data struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	CreatedUnixSec       int64    `protobuf:"varint,2,opt,name=created_unix_sec,json=createdUnixSec,proto3" json:"created_unix_sec,omitempty"`
+	Filter               []byte   `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainRequest_Data) Reset()         { *m = RetainRequest_Data{} }
+func (m *RetainRequest_Data) String() string { return proto.CompactTextString(m) }
+func (*RetainRequest_Data) ProtoMessage()    {}
+func (*RetainRequest_Data) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_5427076a287c2352, []int{11, 0}
+}
+func (m *RetainRequest_Data) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainRequest_Data.Unmarshal(m, b)
+}
+func (m *RetainRequest_Data) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainRequest_Data.Marshal(b, m, deterministic)
+}
+func (dst *RetainRequest_Data) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainRequest_Data.Merge(dst, src)
+}
+func (m *RetainRequest_Data) XXX_Size() int {
+	return xxx_messageInfo_RetainRequest_Data.Size(m)
+}
+func (m *RetainRequest_Data) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainRequest_Data.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainRequest_Data proto.InternalMessageInfo
+
+func (m *RetainRequest_Data) GetCreatedUnixSec() int64 {
+	if m != nil {
+		return m.CreatedUnixSec
+	}
+	return 0
+}
+
+func (m *RetainRequest_Data) GetFilter() []byte {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type RetainSummary struct {
+	Trashed              int64    `protobuf:"varint,1,opt,name=trashed,proto3" json:"trashed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainSummary) Reset()         { *m = RetainSummary{} }
+func (m *RetainSummary) String() string { return proto.CompactTextString(m) }
+func (*RetainSummary) ProtoMessage()    {}
+func (*RetainSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_5427076a287c2352, []int{12}
+}
+func (m *RetainSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainSummary.Unmarshal(m, b)
+}
+func (m *RetainSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainSummary.Marshal(b, m, deterministic)
+}
+func (dst *RetainSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainSummary.Merge(dst, src)
+}
+func (m *RetainSummary) XXX_Size() int {
+	return xxx_messageInfo_RetainSummary.Size(m)
+}
+func (m *RetainSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainSummary proto.InternalMessageInfo
+
+func (m *RetainSummary) GetTrashed() int64 {
+	if m != nil {
+		return m.Trashed
+	}
+	return 0
+}
+
+type ChallengeRequest struct {
+	// TODO: may want to use customtype and fixed-length byte slice
+	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StripeIndex          int64          `protobuf:"varint,2,opt,name=stripe_index,json=stripeIndex,proto3" json:"stripe_index,omitempty"`
+	ShareSize            int64          `protobuf:"varint,3,opt,name=share_size,json=shareSize,proto3" json:"share_size,omitempty"`
+	Nonce                []byte         `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Authorization        *SignedMessage `protobuf:"bytes,5,opt,name=authorization" json:"authorization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChallengeRequest) Reset()         { *m = ChallengeRequest{} }
+func (m *ChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*ChallengeRequest) ProtoMessage()    {}
+func (*ChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_5427076a287c2352, []int{13}
+}
+func (m *ChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChallengeRequest.Unmarshal(m, b)
+}
+func (m *ChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChallengeRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeRequest.Merge(dst, src)
+}
+func (m *ChallengeRequest) XXX_Size() int {
+	return xxx_messageInfo_ChallengeRequest.Size(m)
+}
+func (m *ChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeRequest proto.InternalMessageInfo
+
+func (m *ChallengeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ChallengeRequest) GetStripeIndex() int64 {
+	if m != nil {
+		return m.StripeIndex
+	}
+	return 0
+}
+
+func (m *ChallengeRequest) GetShareSize() int64 {
+	if m != nil {
+		return m.ShareSize
+	}
+	return 0
+}
+
+func (m *ChallengeRequest) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *ChallengeRequest) GetAuthorization() *SignedMessage {
+	if m != nil {
+		return m.Authorization
+	}
+	return nil
+}
+
+type ChallengeResponse struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChallengeResponse) Reset()         { *m = ChallengeResponse{} }
+func (m *ChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*ChallengeResponse) ProtoMessage()    {}
+func (*ChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_5427076a287c2352, []int{14}
+}
+func (m *ChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChallengeResponse.Unmarshal(m, b)
+}
+func (m *ChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChallengeResponse.Marshal(b, m, deterministic)
+}
+func (dst *ChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeResponse.Merge(dst, src)
+}
+func (m *ChallengeResponse) XXX_Size() int {
+	return xxx_messageInfo_ChallengeResponse.Size(m)
+}
+func (m *ChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeResponse proto.InternalMessageInfo
+
+func (m *ChallengeResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type StatsReq struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -770,7 +1096,7 @@ func (m *StatsReq) Reset()         { *m = StatsReq{} }
 func (m *StatsReq) String() string { return proto.CompactTextString(m) }
 func (*StatsReq) ProtoMessage()    {}
 func (*StatsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{10}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{15}
 }
 func (m *StatsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsReq.Unmarshal(m, b)
@@ -804,7 +1130,7 @@ func (m *StatSummary) Reset()         { *m = StatSummary{} }
 func (m *StatSummary) String() string { return proto.CompactTextString(m) }
 func (*StatSummary) ProtoMessage()    {}
 func (*StatSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{11}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{16}
 }
 func (m *StatSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummary.Unmarshal(m, b)
@@ -865,7 +1191,7 @@ func (m *SignedMessage) Reset()         { *m = SignedMessage{} }
 func (m *SignedMessage) String() string { return proto.CompactTextString(m) }
 func (*SignedMessage) ProtoMessage()    {}
 func (*SignedMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_f6b209c85f045534, []int{12}
+	return fileDescriptor_piecestore_5427076a287c2352, []int{17}
 }
 func (m *SignedMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignedMessage.Unmarshal(m, b)
@@ -921,6 +1247,12 @@ func init() {
 	proto.RegisterType((*PieceDelete)(nil), "piecestoreroutes.PieceDelete")
 	proto.RegisterType((*PieceDeleteSummary)(nil), "piecestoreroutes.PieceDeleteSummary")
 	proto.RegisterType((*PieceStoreSummary)(nil), "piecestoreroutes.PieceStoreSummary")
+	proto.RegisterType((*UploadSessionSummary)(nil), "piecestoreroutes.UploadSessionSummary")
+	proto.RegisterType((*RetainRequest)(nil), "piecestoreroutes.RetainRequest")
+	proto.RegisterType((*RetainRequest_Data)(nil), "piecestoreroutes.RetainRequest.Data")
+	proto.RegisterType((*RetainSummary)(nil), "piecestoreroutes.RetainSummary")
+	proto.RegisterType((*ChallengeRequest)(nil), "piecestoreroutes.ChallengeRequest")
+	proto.RegisterType((*ChallengeResponse)(nil), "piecestoreroutes.ChallengeResponse")
 	proto.RegisterType((*StatsReq)(nil), "piecestoreroutes.StatsReq")
 	proto.RegisterType((*StatSummary)(nil), "piecestoreroutes.StatSummary")
 	proto.RegisterType((*SignedMessage)(nil), "piecestoreroutes.SignedMessage")
@@ -943,6 +1275,9 @@ type PieceStoreRoutesClient interface {
 	Store(ctx context.Context, opts ...grpc.CallOption) (PieceStoreRoutes_StoreClient, error)
 	Delete(ctx context.Context, in *PieceDelete, opts ...grpc.CallOption) (*PieceDeleteSummary, error)
 	Stats(ctx context.Context, in *StatsReq, opts ...grpc.CallOption) (*StatSummary, error)
+	UploadSession(ctx context.Context, in *PieceId, opts ...grpc.CallOption) (*UploadSessionSummary, error)
+	Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainSummary, error)
+	Challenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error)
 }
 
 type pieceStoreRoutesClient struct {
@@ -1045,6 +1380,33 @@ func (c *pieceStoreRoutesClient) Stats(ctx context.Context, in *StatsReq, opts .
 	return out, nil
 }
 
+func (c *pieceStoreRoutesClient) UploadSession(ctx context.Context, in *PieceId, opts ...grpc.CallOption) (*UploadSessionSummary, error) {
+	out := new(UploadSessionSummary)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/UploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreRoutesClient) Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainSummary, error) {
+	out := new(RetainSummary)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/Retain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreRoutesClient) Challenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*ChallengeResponse, error) {
+	out := new(ChallengeResponse)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/Challenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PieceStoreRoutes service
 
 type PieceStoreRoutesServer interface {
@@ -1053,6 +1415,9 @@ type PieceStoreRoutesServer interface {
 	Store(PieceStoreRoutes_StoreServer) error
 	Delete(context.Context, *PieceDelete) (*PieceDeleteSummary, error)
 	Stats(context.Context, *StatsReq) (*StatSummary, error)
+	UploadSession(context.Context, *PieceId) (*UploadSessionSummary, error)
+	Retain(context.Context, *RetainRequest) (*RetainSummary, error)
+	Challenge(context.Context, *ChallengeRequest) (*ChallengeResponse, error)
 }
 
 func RegisterPieceStoreRoutesServer(s *grpc.Server, srv PieceStoreRoutesServer) {
@@ -1165,6 +1530,60 @@ func _PieceStoreRoutes_Stats_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PieceStoreRoutes_UploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PieceId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).UploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/UploadSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).UploadSession(ctx, req.(*PieceId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreRoutes_Retain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).Retain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/Retain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).Retain(ctx, req.(*RetainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreRoutes_Challenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).Challenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/Challenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).Challenge(ctx, req.(*ChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "piecestoreroutes.PieceStoreRoutes",
 	HandlerType: (*PieceStoreRoutesServer)(nil),
@@ -1181,6 +1600,18 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Stats",
 			Handler:    _PieceStoreRoutes_Stats_Handler,
 		},
+		{
+			MethodName: "UploadSession",
+			Handler:    _PieceStoreRoutes_UploadSession_Handler,
+		},
+		{
+			MethodName: "Retain",
+			Handler:    _PieceStoreRoutes_Retain_Handler,
+		},
+		{
+			MethodName: "Challenge",
+			Handler:    _PieceStoreRoutes_Challenge_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1198,68 +1629,84 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 	Metadata: "piecestore.proto",
 }
 
-func init() { proto.RegisterFile("piecestore.proto", fileDescriptor_piecestore_f6b209c85f045534) }
-
-var fileDescriptor_piecestore_f6b209c85f045534 = []byte{
-	// 946 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x36, 0x29, 0x5b, 0xb2, 0x46, 0x7f, 0xac, 0xac, 0x8d, 0xdf, 0x4f, 0x26, 0xe2, 0x5a, 0x60,
-	0x9a, 0x54, 0x48, 0x00, 0xb5, 0x71, 0x81, 0xde, 0x63, 0xd8, 0x28, 0x84, 0xa0, 0x8e, 0xb1, 0xb2,
-	0x2f, 0x39, 0x94, 0x59, 0x92, 0x13, 0x65, 0x11, 0x8a, 0x64, 0xc9, 0xa5, 0x2b, 0xf9, 0x39, 0x7a,
-	0xeb, 0x33, 0xf4, 0xda, 0x27, 0xe8, 0xa1, 0x40, 0xef, 0x3d, 0xf4, 0x10, 0xa0, 0x6f, 0x52, 0x70,
-	0x97, 0x22, 0x2d, 0x4b, 0x94, 0x8a, 0xa0, 0xb9, 0x71, 0x67, 0x66, 0xbf, 0x99, 0xf9, 0xf6, 0x9b,
-	0x5d, 0x42, 0x27, 0xe4, 0xe8, 0x60, 0x2c, 0x82, 0x08, 0x07, 0x61, 0x14, 0x88, 0x80, 0xdc, 0xb1,
-	0x44, 0x41, 0x22, 0x30, 0x36, 0x60, 0x1c, 0x8c, 0x03, 0xe5, 0x35, 0x7f, 0xad, 0x40, 0xf7, 0x92,
-	0xcd, 0x30, 0x3a, 0x65, 0xbe, 0xfb, 0x23, 0x77, 0xc5, 0xbb, 0x17, 0x9e, 0x17, 0x38, 0x4c, 0xf0,
-	0xc0, 0x27, 0x0f, 0xa1, 0x1e, 0xf3, 0xb1, 0xcf, 0x44, 0x12, 0x61, 0x57, 0xeb, 0x69, 0xfd, 0x26,
-	0x2d, 0x0c, 0x84, 0xc0, 0xb6, 0xcb, 0x04, 0xeb, 0xea, 0xd2, 0x21, 0xbf, 0x8d, 0x3f, 0x74, 0xd8,
-	0x3e, 0x63, 0x82, 0x91, 0xe7, 0xd0, 0x8c, 0x99, 0x40, 0xcf, 0xe3, 0x02, 0x2d, 0xee, 0xaa, 0xdd,
-	0xa7, 0xed, 0xdf, 0x3f, 0x1c, 0x6f, 0xfd, 0xf5, 0xe1, 0xb8, 0x7a, 0x11, 0xb8, 0x38, 0x3c, 0xa3,
-	0x8d, 0x3c, 0x66, 0xe8, 0x92, 0x67, 0x50, 0x4f, 0x42, 0x8f, 0xfb, 0xef, 0xd3, 0x78, 0x7d, 0x65,
-	0xfc, 0xae, 0x0a, 0x18, 0xba, 0xe4, 0x10, 0x76, 0x27, 0x6c, 0x6a, 0xc5, 0xfc, 0x16, 0xbb, 0x95,
-	0x9e, 0xd6, 0xaf, 0xd0, 0xda, 0x84, 0x4d, 0x47, 0xfc, 0x16, 0xc9, 0x00, 0xf6, 0x71, 0x1a, 0xf2,
-	0x48, 0xf6, 0x60, 0x25, 0x3e, 0x9f, 0x5a, 0x31, 0x3a, 0xdd, 0x6d, 0x19, 0xf5, 0xa0, 0x70, 0x5d,
-	0xfb, 0x7c, 0x3a, 0x42, 0x87, 0x3c, 0x82, 0x56, 0x8c, 0x11, 0x67, 0x9e, 0xe5, 0x27, 0x13, 0x1b,
-	0xa3, 0xee, 0x4e, 0x4f, 0xeb, 0xd7, 0x69, 0x53, 0x19, 0x2f, 0xa4, 0x8d, 0x0c, 0xa1, 0xca, 0x9c,
-	0x74, 0x57, 0xb7, 0xda, 0xd3, 0xfa, 0xed, 0x93, 0xe7, 0x83, 0xfb, 0xb4, 0x0e, 0xca, 0x68, 0x1c,
-	0xbc, 0x90, 0x1b, 0x69, 0x06, 0x40, 0xfa, 0xd0, 0x71, 0x22, 0x64, 0x02, 0xdd, 0xa2, 0xb8, 0x9a,
-	0x2c, 0xae, 0x9d, 0xd9, 0xb3, 0xca, 0x4c, 0x03, 0xaa, 0x6a, 0x2f, 0xa9, 0x41, 0xe5, 0xf2, 0xfa,
-	0xaa, 0xb3, 0x95, 0x7e, 0x7c, 0x7b, 0x7e, 0xd5, 0xd1, 0xcc, 0x9f, 0x74, 0x38, 0xa4, 0xe8, 0x8b,
-	0xff, 0xea, 0xe4, 0x7e, 0xd3, 0xb2, 0x93, 0xbb, 0x86, 0x4e, 0x98, 0x76, 0x62, 0xb1, 0x1c, 0x4e,
-	0x22, 0x34, 0x4e, 0x9e, 0xfe, 0xfb, 0x9e, 0xe9, 0x9e, 0xc4, 0xb8, 0x53, 0xd1, 0x01, 0xec, 0x88,
-	0x40, 0x30, 0x4f, 0x26, 0xad, 0x50, 0xb5, 0x20, 0xdf, 0xc0, 0x5e, 0x0a, 0xc7, 0xc6, 0x68, 0xf9,
-	0x81, 0x2b, 0x95, 0x52, 0x59, 0x79, 0xf2, 0xad, 0x2c, 0x4c, 0x2e, 0x5d, 0xf2, 0x7f, 0xa8, 0x85,
-	0x89, 0x6d, 0xbd, 0xc7, 0x99, 0x3c, 0xd7, 0x26, 0xad, 0x86, 0x89, 0xfd, 0x12, 0x67, 0xe6, 0xdf,
-	0x3a, 0xc0, 0x65, 0x5a, 0xe5, 0x28, 0xad, 0x92, 0x7c, 0x0f, 0x07, 0xf6, 0xbc, 0xba, 0xe5, 0x86,
-	0x9e, 0x2d, 0x37, 0x54, 0x4a, 0x29, 0xdd, 0xb7, 0x57, 0xf0, 0x7c, 0x0e, 0x20, 0x21, 0xac, 0x9c,
-	0xcf, 0xc6, 0xc9, 0x93, 0x15, 0x34, 0xe5, 0x15, 0xa9, 0xcf, 0x94, 0x68, 0x5a, 0x0f, 0xe7, 0x9f,
-	0xe4, 0x1c, 0x5a, 0x2c, 0x11, 0xef, 0x82, 0x88, 0xdf, 0xaa, 0xfa, 0x2a, 0x12, 0xe9, 0x78, 0x19,
-	0x69, 0xc4, 0xc7, 0x3e, 0xba, 0xdf, 0x61, 0x1c, 0xb3, 0x31, 0xd2, 0xc5, 0x5d, 0x06, 0x42, 0x3d,
-	0x87, 0x27, 0x6d, 0xd0, 0xb3, 0xb9, 0xab, 0x53, 0x9d, 0xbb, 0x65, 0x63, 0xa1, 0x97, 0x8d, 0x45,
-	0x17, 0x6a, 0x4e, 0xe0, 0x0b, 0xf4, 0x85, 0x3a, 0x12, 0x3a, 0x5f, 0x9a, 0x6f, 0xa0, 0x26, 0xd3,
-	0x0c, 0xdd, 0xa5, 0x24, 0x4b, 0x8d, 0xe8, 0x1f, 0xd3, 0x88, 0x39, 0x81, 0xa6, 0xa2, 0x2c, 0x99,
-	0x4c, 0x58, 0x34, 0x5b, 0x4a, 0x73, 0x34, 0xa7, 0x5d, 0xce, 0xbf, 0x6a, 0x41, 0xd1, 0xb9, 0xee,
-	0x06, 0xa8, 0x94, 0xb4, 0x6a, 0xfe, 0xa9, 0x43, 0x5b, 0xe6, 0xa3, 0x28, 0x22, 0x8e, 0x37, 0xcc,
-	0xfb, 0xe4, 0xc2, 0x19, 0xae, 0x10, 0xce, 0xd3, 0x12, 0xe1, 0xe4, 0x55, 0x7d, 0x52, 0xf1, 0xd0,
-	0x75, 0xe2, 0xd9, 0x40, 0xf8, 0xff, 0xa0, 0x1a, 0xbc, 0x7d, 0x1b, 0xa3, 0xc8, 0x38, 0xce, 0x56,
-	0xe6, 0x2b, 0x38, 0x58, 0xec, 0x60, 0x24, 0x22, 0x64, 0x93, 0x7b, 0x70, 0xda, 0x7d, 0xb8, 0x3b,
-	0xd2, 0xd3, 0x17, 0xa5, 0xe7, 0x42, 0x43, 0x15, 0x89, 0x1e, 0x0a, 0xdc, 0x2c, 0xbf, 0x8f, 0xa2,
-	0xc2, 0x1c, 0x00, 0xb9, 0x93, 0x65, 0x2e, 0xc2, 0x2e, 0xd4, 0x26, 0x2a, 0x3e, 0xcb, 0x38, 0x5f,
-	0x9a, 0x57, 0xf0, 0xa0, 0x98, 0xf0, 0x8d, 0xe1, 0xe4, 0x31, 0xb4, 0xe5, 0xed, 0x67, 0x45, 0xe8,
-	0x20, 0xbf, 0x41, 0x37, 0x23, 0xb4, 0x25, 0xad, 0x34, 0x33, 0x9a, 0x00, 0xbb, 0x23, 0xc1, 0x44,
-	0x4c, 0xf1, 0x07, 0xf3, 0x17, 0x0d, 0x1a, 0xe9, 0x62, 0x0e, 0x7e, 0x04, 0x90, 0xc4, 0xe8, 0x5a,
-	0x71, 0xc8, 0x9c, 0x9c, 0xc0, 0xd4, 0x32, 0x4a, 0x0d, 0xe4, 0x0b, 0xd8, 0x63, 0x37, 0x8c, 0x7b,
-	0xcc, 0xf6, 0x30, 0x8b, 0x51, 0x29, 0xda, 0xb9, 0x59, 0x05, 0x3e, 0x86, 0xb6, 0xc4, 0xc9, 0x25,
-	0x9a, 0x1d, 0x60, 0x2b, 0xb5, 0xe6, 0x62, 0x26, 0x5f, 0xc2, 0x7e, 0x81, 0x57, 0xc4, 0xaa, 0x27,
-	0x95, 0xe4, 0xae, 0x7c, 0x83, 0xf9, 0x06, 0x5a, 0x0b, 0x0c, 0xe7, 0x4f, 0x8e, 0x56, 0x3c, 0x39,
-	0x8b, 0x8f, 0x94, 0x7e, 0xff, 0x91, 0x4a, 0x35, 0x92, 0xd8, 0x1e, 0x77, 0xe4, 0x2d, 0xaf, 0xae,
-	0xa0, 0xba, 0xb2, 0xbc, 0xc4, 0xd9, 0xc9, 0xcf, 0x15, 0xe8, 0x14, 0xa4, 0x53, 0x79, 0xaa, 0xe4,
-	0x0c, 0x76, 0xa4, 0x8d, 0x1c, 0x96, 0x8c, 0xd2, 0xd0, 0x35, 0x3e, 0x2b, 0xbb, 0x9e, 0x15, 0xb5,
-	0xe6, 0x16, 0x79, 0x0d, 0xbb, 0x99, 0x60, 0x91, 0xf4, 0x36, 0xcd, 0xa4, 0xf1, 0x64, 0x53, 0x84,
-	0xd2, 0xbc, 0xb9, 0xd5, 0xd7, 0xbe, 0xd2, 0xc8, 0x05, 0xec, 0xa8, 0x97, 0xe9, 0xe1, 0xba, 0x57,
-	0xc2, 0x78, 0xb4, 0xce, 0x9b, 0x57, 0xda, 0xd7, 0xc8, 0x2b, 0xa8, 0x66, 0xb3, 0x70, 0x54, 0xb2,
-	0x45, 0xb9, 0x8d, 0xcf, 0xd7, 0xba, 0x8b, 0xe6, 0xcf, 0xd2, 0x02, 0x99, 0x88, 0x89, 0xb1, 0x62,
-	0x68, 0x32, 0x39, 0x1a, 0x47, 0xab, 0x7d, 0x39, 0xca, 0xe9, 0xf6, 0x6b, 0x3d, 0xb4, 0xed, 0xaa,
-	0xfc, 0xc7, 0xfc, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x64, 0xd1, 0x91, 0x47, 0x95, 0x0a,
-	0x00, 0x00,
+func init() { proto.RegisterFile("piecestore.proto", fileDescriptor_piecestore_5427076a287c2352) }
+
+var fileDescriptor_piecestore_5427076a287c2352 = []byte{
+	// 1203 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x29, 0x4b, 0xb2, 0x46, 0x3f, 0x51, 0x36, 0x46, 0xaa, 0x10, 0x71, 0xad, 0x32, 0x4d,
+	0xa2, 0x26, 0x80, 0xda, 0xb8, 0x40, 0x8f, 0x05, 0x92, 0x3a, 0x28, 0x84, 0xb4, 0x8e, 0x41, 0xd9,
+	0x3d, 0xe4, 0x50, 0x66, 0x45, 0x8e, 0xa5, 0x6d, 0x28, 0x92, 0x21, 0x97, 0xae, 0xec, 0x9e, 0xfa,
+	0x02, 0x05, 0x7a, 0xea, 0x3b, 0x14, 0xe8, 0x2b, 0x14, 0xe8, 0xad, 0x2f, 0xd0, 0x1e, 0x72, 0xc8,
+	0x03, 0xf4, 0x29, 0x0a, 0xee, 0xf2, 0x47, 0xff, 0x2e, 0x8c, 0xe6, 0xc6, 0x99, 0x9d, 0x1d, 0xce,
+	0x7c, 0xfb, 0xcd, 0xc7, 0x25, 0xb4, 0x7c, 0x86, 0x16, 0x86, 0xdc, 0x0b, 0xb0, 0xe7, 0x07, 0x1e,
+	0xf7, 0xc8, 0x8c, 0x27, 0xf0, 0x22, 0x8e, 0xa1, 0x06, 0x23, 0x6f, 0xe4, 0xc9, 0x55, 0xfd, 0xaf,
+	0x22, 0xb4, 0x8f, 0xe8, 0x39, 0x06, 0x4f, 0xa8, 0x6b, 0x7f, 0xcf, 0x6c, 0x3e, 0x7e, 0xec, 0x38,
+	0x9e, 0x45, 0x39, 0xf3, 0x5c, 0x72, 0x1b, 0xaa, 0x21, 0x1b, 0xb9, 0x94, 0x47, 0x01, 0xb6, 0x95,
+	0x8e, 0xd2, 0xad, 0x1b, 0xb9, 0x83, 0x10, 0xd8, 0xb2, 0x29, 0xa7, 0x6d, 0x55, 0x2c, 0x88, 0x67,
+	0xed, 0x1f, 0x15, 0xb6, 0x0e, 0x28, 0xa7, 0xe4, 0x11, 0xd4, 0x43, 0xca, 0xd1, 0x71, 0x18, 0x47,
+	0x93, 0xd9, 0x72, 0xf7, 0x93, 0xe6, 0x9f, 0x6f, 0xf7, 0x0a, 0x6f, 0xde, 0xee, 0x95, 0x0f, 0x3d,
+	0x1b, 0xfb, 0x07, 0x46, 0x2d, 0x8b, 0xe9, 0xdb, 0xe4, 0x21, 0x54, 0x23, 0xdf, 0x61, 0xee, 0xab,
+	0x38, 0x5e, 0x5d, 0x19, 0xbf, 0x2d, 0x03, 0xfa, 0x36, 0xb9, 0x05, 0xdb, 0x13, 0x3a, 0x35, 0x43,
+	0x76, 0x81, 0xed, 0x62, 0x47, 0xe9, 0x16, 0x8d, 0xca, 0x84, 0x4e, 0x07, 0xec, 0x02, 0x49, 0x0f,
+	0x6e, 0xe0, 0xd4, 0x67, 0x81, 0xe8, 0xc1, 0x8c, 0x5c, 0x36, 0x35, 0x43, 0xb4, 0xda, 0x5b, 0x22,
+	0xea, 0x7a, 0xbe, 0x74, 0xe2, 0xb2, 0xe9, 0x00, 0x2d, 0x72, 0x07, 0x1a, 0x21, 0x06, 0x8c, 0x3a,
+	0xa6, 0x1b, 0x4d, 0x86, 0x18, 0xb4, 0x4b, 0x1d, 0xa5, 0x5b, 0x35, 0xea, 0xd2, 0x79, 0x28, 0x7c,
+	0xa4, 0x0f, 0x65, 0x6a, 0xc5, 0xbb, 0xda, 0xe5, 0x8e, 0xd2, 0x6d, 0xee, 0x3f, 0xea, 0x2d, 0xc2,
+	0xda, 0x5b, 0x07, 0x63, 0xef, 0xb1, 0xd8, 0x68, 0x24, 0x09, 0x48, 0x17, 0x5a, 0x56, 0x80, 0x94,
+	0xa3, 0x9d, 0x17, 0x57, 0x11, 0xc5, 0x35, 0x13, 0x7f, 0x5a, 0xd9, 0x2e, 0x80, 0x1f, 0x78, 0xdf,
+	0xa1, 0xc5, 0x63, 0x48, 0xb6, 0xe5, 0x01, 0x24, 0x9e, 0xbe, 0xad, 0x6b, 0x50, 0x96, 0xa9, 0x49,
+	0x05, 0x8a, 0x47, 0x27, 0xc7, 0xad, 0x42, 0xfc, 0xf0, 0xe5, 0xd3, 0xe3, 0x96, 0xa2, 0xff, 0xaa,
+	0xc2, 0x2d, 0x03, 0x5d, 0xfe, 0x7f, 0x1d, 0xec, 0x1b, 0x25, 0x39, 0xd8, 0x13, 0x68, 0xf9, 0x71,
+	0xa3, 0x26, 0xcd, 0xd2, 0x89, 0x0c, 0xb5, 0xfd, 0x07, 0xff, 0x1d, 0x12, 0xe3, 0x9a, 0xc8, 0x31,
+	0x53, 0xd1, 0x0e, 0x94, 0xb8, 0xc7, 0xa9, 0x23, 0x5e, 0x5a, 0x34, 0xa4, 0x41, 0x3e, 0x83, 0x6b,
+	0x71, 0x3a, 0x3a, 0x42, 0xd3, 0xf5, 0x6c, 0x41, 0xa4, 0xe2, 0x4a, 0x62, 0x34, 0x92, 0x30, 0x61,
+	0xda, 0xe4, 0x3d, 0xa8, 0xf8, 0xd1, 0xd0, 0x7c, 0x85, 0xe7, 0xe2, 0xd8, 0xeb, 0x46, 0xd9, 0x8f,
+	0x86, 0xcf, 0xf0, 0x3c, 0x7e, 0x8d, 0x35, 0xa6, 0xcc, 0x6d, 0x97, 0x3a, 0xc5, 0x6e, 0xdd, 0x90,
+	0x86, 0xfe, 0x53, 0x11, 0xe0, 0x28, 0xae, 0x7d, 0x10, 0xd7, 0x4e, 0xbe, 0x85, 0x9d, 0x61, 0x5a,
+	0xf3, 0x72, 0x9b, 0x0f, 0x97, 0xdb, 0x5c, 0x0b, 0xb4, 0x71, 0x63, 0xb8, 0xec, 0x24, 0x4f, 0x01,
+	0x44, 0x0a, 0x33, 0x43, 0xb9, 0xb6, 0x7f, 0x6f, 0x05, 0x78, 0x59, 0x45, 0xf2, 0x31, 0x86, 0xdf,
+	0xa8, 0xfa, 0xe9, 0x23, 0x79, 0x0a, 0x0d, 0x1a, 0xf1, 0xb1, 0x17, 0xb0, 0x0b, 0x59, 0x5f, 0x51,
+	0x64, 0xda, 0x5b, 0xce, 0x34, 0x60, 0x23, 0x17, 0xed, 0xaf, 0x31, 0x0c, 0xe9, 0x08, 0x8d, 0xf9,
+	0x5d, 0xda, 0xcf, 0x0a, 0x54, 0xb3, 0xfc, 0xa4, 0x09, 0x6a, 0x32, 0xad, 0x55, 0x43, 0x65, 0xf6,
+	0xba, 0x61, 0x52, 0xd7, 0x0d, 0x53, 0x1b, 0x2a, 0x96, 0xe7, 0x72, 0x74, 0xb9, 0x3c, 0x29, 0x23,
+	0x35, 0xc9, 0x4d, 0x28, 0x7b, 0xa7, 0xa7, 0x21, 0xf2, 0x64, 0x12, 0x13, 0x2b, 0x66, 0xdb, 0x98,
+	0x86, 0x63, 0x31, 0x75, 0x75, 0x43, 0x3c, 0xeb, 0x2f, 0xa1, 0x22, 0x4a, 0xea, 0xdb, 0x4b, 0x05,
+	0x2d, 0x75, 0xad, 0x5e, 0xa5, 0x6b, 0xfd, 0x47, 0x05, 0xea, 0x12, 0xe0, 0x68, 0x32, 0xa1, 0xc1,
+	0xf9, 0xd2, 0x7b, 0x76, 0xd3, 0x43, 0x12, 0x12, 0x23, 0xfb, 0x95, 0xe0, 0x6f, 0x12, 0x99, 0xe2,
+	0x3a, 0x5c, 0xd2, 0x2e, 0xb7, 0x66, 0xba, 0xfc, 0x5b, 0x85, 0xa6, 0xa8, 0xc1, 0x40, 0x1e, 0x30,
+	0x3c, 0xa3, 0xce, 0x3b, 0xa7, 0x5e, 0x7f, 0x05, 0xf5, 0x1e, 0xac, 0xa1, 0x5e, 0x56, 0xd5, 0x3b,
+	0xa5, 0x9f, 0xb1, 0x89, 0x7d, 0x97, 0x1c, 0x42, 0x4e, 0xa9, 0xe2, 0x2c, 0xa5, 0xf4, 0xe7, 0xb0,
+	0x33, 0xdf, 0xc1, 0x80, 0x07, 0x48, 0x27, 0x0b, 0xe9, 0x94, 0xc5, 0x74, 0x33, 0xdc, 0x55, 0xe7,
+	0xb8, 0xab, 0xdb, 0x50, 0x93, 0x45, 0xa2, 0x83, 0x1c, 0x2f, 0xe7, 0xe4, 0x95, 0xa0, 0xd0, 0x7b,
+	0x40, 0x66, 0xde, 0x92, 0x12, 0xb3, 0x0d, 0x95, 0x89, 0x8c, 0x4f, 0xde, 0x98, 0x9a, 0xfa, 0x31,
+	0x5c, 0xcf, 0x35, 0xe2, 0xd2, 0x70, 0x72, 0x17, 0x9a, 0x42, 0x55, 0xcd, 0x00, 0x2d, 0x64, 0x67,
+	0x68, 0x27, 0x80, 0x36, 0x84, 0xd7, 0x48, 0x9c, 0xfa, 0xe7, 0xb0, 0x73, 0xe2, 0x3b, 0x1e, 0xb5,
+	0x07, 0x18, 0x86, 0xcc, 0x73, 0xd7, 0x0d, 0x48, 0x0e, 0xbe, 0x3a, 0x07, 0xfe, 0xef, 0x0a, 0x34,
+	0x0c, 0xe4, 0x94, 0xb9, 0x06, 0xbe, 0x8e, 0x30, 0xe4, 0x57, 0xf8, 0xda, 0xfc, 0x70, 0xf5, 0x5b,
+	0xc4, 0xaa, 0xaf, 0xab, 0xba, 0xf2, 0xeb, 0x7a, 0x13, 0xca, 0xa7, 0xcc, 0xe1, 0x18, 0x24, 0x4a,
+	0x95, 0x58, 0xfa, 0x47, 0x69, 0xfd, 0x33, 0x90, 0xf2, 0x80, 0x86, 0x63, 0xb4, 0x13, 0xce, 0xa4,
+	0xa6, 0xfe, 0x87, 0x02, 0xad, 0x2f, 0xc6, 0xd4, 0x71, 0xd0, 0x1d, 0x61, 0xda, 0xee, 0x22, 0x50,
+	0x1f, 0x40, 0x3d, 0xe4, 0x01, 0xf3, 0xd1, 0x64, 0xae, 0x8d, 0xd3, 0xa4, 0x9a, 0x9a, 0xf4, 0xf5,
+	0x63, 0x57, 0x4c, 0xcc, 0x70, 0x4c, 0x03, 0x9c, 0xbd, 0xcf, 0x54, 0x85, 0x47, 0x10, 0x73, 0x07,
+	0x4a, 0xae, 0xe7, 0x5a, 0x98, 0xa8, 0x87, 0x34, 0x96, 0x59, 0x57, 0xba, 0x12, 0xeb, 0xee, 0xc3,
+	0xf5, 0x99, 0x16, 0x42, 0xdf, 0x73, 0x43, 0xcc, 0xe4, 0x4a, 0x99, 0x91, 0x2b, 0x80, 0xed, 0x01,
+	0xa7, 0x3c, 0x34, 0xf0, 0xb5, 0xfe, 0x9b, 0x02, 0xb5, 0xd8, 0x48, 0x21, 0xda, 0x05, 0x88, 0x42,
+	0xb4, 0xcd, 0xd0, 0xa7, 0x56, 0x36, 0x59, 0xb1, 0x67, 0x10, 0x3b, 0xc8, 0x7d, 0xb8, 0x46, 0xcf,
+	0x28, 0x73, 0xe8, 0xd0, 0xc1, 0x24, 0x26, 0x39, 0x93, 0xcc, 0x2d, 0x03, 0xef, 0x42, 0x53, 0xe4,
+	0xc9, 0xb4, 0x2b, 0x01, 0xa3, 0x11, 0x7b, 0x33, 0x95, 0x23, 0x1f, 0xc3, 0x8d, 0x3c, 0x5f, 0x1e,
+	0x2b, 0x3f, 0x2c, 0x24, 0x5b, 0xca, 0x36, 0xe8, 0x2f, 0xa1, 0x31, 0x07, 0x42, 0xc6, 0x3a, 0x25,
+	0x67, 0xdd, 0x3c, 0x4f, 0xd5, 0x45, 0x9e, 0xc6, 0xe2, 0x11, 0x0d, 0x1d, 0x66, 0x89, 0x6b, 0x85,
+	0xa4, 0x4c, 0x55, 0x7a, 0x9e, 0xe1, 0xf9, 0xfe, 0x2f, 0x25, 0x68, 0xe5, 0xd3, 0x68, 0x08, 0xe0,
+	0xc9, 0x01, 0x94, 0x84, 0x8f, 0xdc, 0x5a, 0xa3, 0xb1, 0x7d, 0x5b, 0x7b, 0x7f, 0xcd, 0x52, 0x02,
+	0xad, 0x5e, 0x20, 0x2f, 0x60, 0x3b, 0x51, 0x32, 0x24, 0x9d, 0xcb, 0xc4, 0x5a, 0xbb, 0x77, 0x59,
+	0x84, 0x14, 0x43, 0xbd, 0xd0, 0x55, 0x3e, 0x51, 0xc8, 0x21, 0x94, 0xe4, 0xa5, 0xe7, 0xf6, 0xa6,
+	0x0b, 0x88, 0x76, 0x67, 0xd3, 0x6a, 0x56, 0x69, 0x57, 0x21, 0xcf, 0xa1, 0x9c, 0x88, 0xe4, 0xee,
+	0x9a, 0x2d, 0x72, 0x59, 0xfb, 0x70, 0xe3, 0x72, 0xde, 0xfc, 0x41, 0x5c, 0x20, 0xe5, 0x21, 0xd1,
+	0x56, 0xf0, 0x3a, 0xa1, 0xa3, 0xb6, 0xbb, 0x7a, 0x2d, 0xcf, 0x72, 0x0c, 0x8d, 0x39, 0x51, 0xdb,
+	0x74, 0x20, 0x2b, 0x00, 0x5c, 0x25, 0x88, 0x7a, 0x81, 0x7c, 0x05, 0x65, 0xa9, 0x14, 0x64, 0x6f,
+	0xd5, 0x97, 0x79, 0x46, 0x03, 0xb5, 0xb5, 0x01, 0x79, 0xb6, 0x6f, 0xa0, 0x9a, 0x0d, 0x22, 0xd1,
+	0x97, 0xe3, 0x17, 0x85, 0x46, 0xbb, 0xb3, 0x31, 0x46, 0x4e, 0xb2, 0x5e, 0x78, 0xb2, 0xf5, 0x42,
+	0xf5, 0x87, 0xc3, 0xb2, 0xf8, 0xdf, 0xfb, 0xf4, 0xdf, 0x01, 0x00, 0xe5, 0x35, 0xea, 0x8b, 0x21,
+	0x0e, 0x00, 0x00,
 }
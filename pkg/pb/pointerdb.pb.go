@@ -40,7 +40,7 @@ func (x RedundancyScheme_SchemeType) String() string {
 	return proto.EnumName(RedundancyScheme_SchemeType_name, int32(x))
 }
 func (RedundancyScheme_SchemeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{0, 0}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{0, 0}
 }
 
 type Pointer_DataType int32
@@ -63,7 +63,7 @@ func (x Pointer_DataType) String() string {
 	return proto.EnumName(Pointer_DataType_name, int32(x))
 }
 func (Pointer_DataType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{3, 0}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{4, 0}
 }
 
 type RedundancyScheme struct {
@@ -83,7 +83,7 @@ func (m *RedundancyScheme) Reset()         { *m = RedundancyScheme{} }
 func (m *RedundancyScheme) String() string { return proto.CompactTextString(m) }
 func (*RedundancyScheme) ProtoMessage()    {}
 func (*RedundancyScheme) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{0}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{0}
 }
 func (m *RedundancyScheme) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RedundancyScheme.Unmarshal(m, b)
@@ -146,18 +146,20 @@ func (m *RedundancyScheme) GetErasureShareSize() int32 {
 }
 
 type RemotePiece struct {
-	PieceNum             int32    `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
-	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PieceNum             int32             `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	NodeId               NodeID            `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Hash                 []byte            `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Challenges           []*AuditChallenge `protobuf:"bytes,4,rep,name=challenges" json:"challenges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *RemotePiece) Reset()         { *m = RemotePiece{} }
 func (m *RemotePiece) String() string { return proto.CompactTextString(m) }
 func (*RemotePiece) ProtoMessage()    {}
 func (*RemotePiece) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{1}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{1}
 }
 func (m *RemotePiece) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePiece.Unmarshal(m, b)
@@ -184,6 +186,74 @@ func (m *RemotePiece) GetPieceNum() int32 {
 	return 0
 }
 
+func (m *RemotePiece) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *RemotePiece) GetChallenges() []*AuditChallenge {
+	if m != nil {
+		return m.Challenges
+	}
+	return nil
+}
+
+type AuditChallenge struct {
+	StripeIndex          int64    `protobuf:"varint,1,opt,name=stripe_index,json=stripeIndex,proto3" json:"stripe_index,omitempty"`
+	Nonce                []byte   `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditChallenge) Reset()         { *m = AuditChallenge{} }
+func (m *AuditChallenge) String() string { return proto.CompactTextString(m) }
+func (*AuditChallenge) ProtoMessage()    {}
+func (*AuditChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{2}
+}
+func (m *AuditChallenge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditChallenge.Unmarshal(m, b)
+}
+func (m *AuditChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditChallenge.Marshal(b, m, deterministic)
+}
+func (dst *AuditChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditChallenge.Merge(dst, src)
+}
+func (m *AuditChallenge) XXX_Size() int {
+	return xxx_messageInfo_AuditChallenge.Size(m)
+}
+func (m *AuditChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditChallenge proto.InternalMessageInfo
+
+func (m *AuditChallenge) GetStripeIndex() int64 {
+	if m != nil {
+		return m.StripeIndex
+	}
+	return 0
+}
+
+func (m *AuditChallenge) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *AuditChallenge) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type RemoteSegment struct {
 	Redundancy *RedundancyScheme `protobuf:"bytes,1,opt,name=redundancy" json:"redundancy,omitempty"`
 	// TODO: may want to use customtype and fixed-length byte slice
@@ -199,7 +269,7 @@ func (m *RemoteSegment) Reset()         { *m = RemoteSegment{} }
 func (m *RemoteSegment) String() string { return proto.CompactTextString(m) }
 func (*RemoteSegment) ProtoMessage()    {}
 func (*RemoteSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{2}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{3}
 }
 func (m *RemoteSegment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteSegment.Unmarshal(m, b)
@@ -252,20 +322,22 @@ type Pointer struct {
 	InlineSegment []byte           `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
 	Remote        *RemoteSegment   `protobuf:"bytes,4,opt,name=remote" json:"remote,omitempty"`
 	// TODO: rename
-	SegmentSize          int64                `protobuf:"varint,5,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
-	CreationDate         *timestamp.Timestamp `protobuf:"bytes,6,opt,name=creation_date,json=creationDate" json:"creation_date,omitempty"`
-	ExpirationDate       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate" json:"expiration_date,omitempty"`
-	Metadata             []byte               `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	SegmentSize    int64                `protobuf:"varint,5,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	CreationDate   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=creation_date,json=creationDate" json:"creation_date,omitempty"`
+	ExpirationDate *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate" json:"expiration_date,omitempty"`
+	Metadata       []byte               `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// project_id is the project which stored the segment, set by the satellite
+	ProjectId            []byte   `protobuf:"bytes,9,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Pointer) Reset()         { *m = Pointer{} }
 func (m *Pointer) String() string { return proto.CompactTextString(m) }
 func (*Pointer) ProtoMessage()    {}
 func (*Pointer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{3}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{4}
 }
 func (m *Pointer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Pointer.Unmarshal(m, b)
@@ -334,6 +406,13 @@ func (m *Pointer) GetMetadata() []byte {
 	return nil
 }
 
+func (m *Pointer) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
 // PutRequest is a request message for the Put rpc call
 type PutRequest struct {
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -347,7 +426,7 @@ func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{4}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{5}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutRequest.Unmarshal(m, b)
@@ -393,7 +472,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{5}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{6}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
@@ -437,7 +516,7 @@ func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{6}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{7}
 }
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
@@ -510,7 +589,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{7}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{8}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutResponse.Unmarshal(m, b)
@@ -545,7 +624,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{8}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{9}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
@@ -606,7 +685,7 @@ func (m *ListResponse) Reset()         { *m = ListResponse{} }
 func (m *ListResponse) String() string { return proto.CompactTextString(m) }
 func (*ListResponse) ProtoMessage()    {}
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{9}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{10}
 }
 func (m *ListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
@@ -653,7 +732,7 @@ func (m *ListResponse_Item) Reset()         { *m = ListResponse_Item{} }
 func (m *ListResponse_Item) String() string { return proto.CompactTextString(m) }
 func (*ListResponse_Item) ProtoMessage()    {}
 func (*ListResponse_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{9, 0}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{10, 0}
 }
 func (m *ListResponse_Item) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse_Item.Unmarshal(m, b)
@@ -705,7 +784,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{10}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{11}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
@@ -743,7 +822,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{11}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{12}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
@@ -763,6 +842,92 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
+// CompareAndSwapRequest is a request message for the CompareAndSwap rpc call
+type CompareAndSwapRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	OldPointer           *Pointer `protobuf:"bytes,2,opt,name=old_pointer,json=oldPointer" json:"old_pointer,omitempty"`
+	NewPointer           *Pointer `protobuf:"bytes,3,opt,name=new_pointer,json=newPointer" json:"new_pointer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompareAndSwapRequest) Reset()         { *m = CompareAndSwapRequest{} }
+func (m *CompareAndSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*CompareAndSwapRequest) ProtoMessage()    {}
+func (*CompareAndSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{13}
+}
+func (m *CompareAndSwapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareAndSwapRequest.Unmarshal(m, b)
+}
+func (m *CompareAndSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareAndSwapRequest.Marshal(b, m, deterministic)
+}
+func (dst *CompareAndSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareAndSwapRequest.Merge(dst, src)
+}
+func (m *CompareAndSwapRequest) XXX_Size() int {
+	return xxx_messageInfo_CompareAndSwapRequest.Size(m)
+}
+func (m *CompareAndSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareAndSwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareAndSwapRequest proto.InternalMessageInfo
+
+func (m *CompareAndSwapRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CompareAndSwapRequest) GetOldPointer() *Pointer {
+	if m != nil {
+		return m.OldPointer
+	}
+	return nil
+}
+
+func (m *CompareAndSwapRequest) GetNewPointer() *Pointer {
+	if m != nil {
+		return m.NewPointer
+	}
+	return nil
+}
+
+// CompareAndSwapResponse is a response message for the CompareAndSwap rpc call
+type CompareAndSwapResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompareAndSwapResponse) Reset()         { *m = CompareAndSwapResponse{} }
+func (m *CompareAndSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*CompareAndSwapResponse) ProtoMessage()    {}
+func (*CompareAndSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{14}
+}
+func (m *CompareAndSwapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareAndSwapResponse.Unmarshal(m, b)
+}
+func (m *CompareAndSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareAndSwapResponse.Marshal(b, m, deterministic)
+}
+func (dst *CompareAndSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareAndSwapResponse.Merge(dst, src)
+}
+func (m *CompareAndSwapResponse) XXX_Size() int {
+	return xxx_messageInfo_CompareAndSwapResponse.Size(m)
+}
+func (m *CompareAndSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareAndSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareAndSwapResponse proto.InternalMessageInfo
+
 // IterateRequest is a request message for the Iterate rpc call
 type IterateRequest struct {
 	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
@@ -778,7 +943,7 @@ func (m *IterateRequest) Reset()         { *m = IterateRequest{} }
 func (m *IterateRequest) String() string { return proto.CompactTextString(m) }
 func (*IterateRequest) ProtoMessage()    {}
 func (*IterateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{12}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{15}
 }
 func (m *IterateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IterateRequest.Unmarshal(m, b)
@@ -837,7 +1002,7 @@ func (m *PayerBandwidthAllocationRequest) Reset()         { *m = PayerBandwidthA
 func (m *PayerBandwidthAllocationRequest) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocationRequest) ProtoMessage()    {}
 func (*PayerBandwidthAllocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{13}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{16}
 }
 func (m *PayerBandwidthAllocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocationRequest.Unmarshal(m, b)
@@ -875,7 +1040,7 @@ func (m *PayerBandwidthAllocationResponse) Reset()         { *m = PayerBandwidth
 func (m *PayerBandwidthAllocationResponse) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocationResponse) ProtoMessage()    {}
 func (*PayerBandwidthAllocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_b7facd921d32685d, []int{14}
+	return fileDescriptor_pointerdb_c62c35ad5421342c, []int{17}
 }
 func (m *PayerBandwidthAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocationResponse.Unmarshal(m, b)
@@ -905,6 +1070,7 @@ func (m *PayerBandwidthAllocationResponse) GetPba() *PayerBandwidthAllocation {
 func init() {
 	proto.RegisterType((*RedundancyScheme)(nil), "pointerdb.RedundancyScheme")
 	proto.RegisterType((*RemotePiece)(nil), "pointerdb.RemotePiece")
+	proto.RegisterType((*AuditChallenge)(nil), "pointerdb.AuditChallenge")
 	proto.RegisterType((*RemoteSegment)(nil), "pointerdb.RemoteSegment")
 	proto.RegisterType((*Pointer)(nil), "pointerdb.Pointer")
 	proto.RegisterType((*PutRequest)(nil), "pointerdb.PutRequest")
@@ -916,6 +1082,8 @@ func init() {
 	proto.RegisterType((*ListResponse_Item)(nil), "pointerdb.ListResponse.Item")
 	proto.RegisterType((*DeleteRequest)(nil), "pointerdb.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "pointerdb.DeleteResponse")
+	proto.RegisterType((*CompareAndSwapRequest)(nil), "pointerdb.CompareAndSwapRequest")
+	proto.RegisterType((*CompareAndSwapResponse)(nil), "pointerdb.CompareAndSwapResponse")
 	proto.RegisterType((*IterateRequest)(nil), "pointerdb.IterateRequest")
 	proto.RegisterType((*PayerBandwidthAllocationRequest)(nil), "pointerdb.PayerBandwidthAllocationRequest")
 	proto.RegisterType((*PayerBandwidthAllocationResponse)(nil), "pointerdb.PayerBandwidthAllocationResponse")
@@ -942,6 +1110,8 @@ type PointerDBClient interface {
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	// Delete formats and hands off a file path to delete from boltdb
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// CompareAndSwap replaces a pointer only if it still equals the old one
+	CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*CompareAndSwapResponse, error)
 	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
 	PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error)
 }
@@ -990,6 +1160,15 @@ func (c *pointerDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ..
 	return out, nil
 }
 
+func (c *pointerDBClient) CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*CompareAndSwapResponse, error) {
+	out := new(CompareAndSwapResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/CompareAndSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pointerDBClient) PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error) {
 	out := new(PayerBandwidthAllocationResponse)
 	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/PayerBandwidthAllocation", in, out, opts...)
@@ -1010,6 +1189,8 @@ type PointerDBServer interface {
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	// Delete formats and hands off a file path to delete from boltdb
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// CompareAndSwap replaces a pointer only if it still equals the old one
+	CompareAndSwap(context.Context, *CompareAndSwapRequest) (*CompareAndSwapResponse, error)
 	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
 	PayerBandwidthAllocation(context.Context, *PayerBandwidthAllocationRequest) (*PayerBandwidthAllocationResponse, error)
 }
@@ -1090,6 +1271,24 @@ func _PointerDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PointerDB_CompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).CompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/CompareAndSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).CompareAndSwap(ctx, req.(*CompareAndSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PointerDB_PayerBandwidthAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PayerBandwidthAllocationRequest)
 	if err := dec(in); err != nil {
@@ -1128,6 +1327,10 @@ var _PointerDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _PointerDB_Delete_Handler,
 		},
+		{
+			MethodName: "CompareAndSwap",
+			Handler:    _PointerDB_CompareAndSwap_Handler,
+		},
 		{
 			MethodName: "PayerBandwidthAllocation",
 			Handler:    _PointerDB_PayerBandwidthAllocation_Handler,
@@ -1137,77 +1340,87 @@ var _PointerDB_serviceDesc = grpc.ServiceDesc{
 	Metadata: "pointerdb.proto",
 }
 
-func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_pointerdb_b7facd921d32685d) }
-
-var fileDescriptor_pointerdb_b7facd921d32685d = []byte{
-	// 1092 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xae, 0xbf, 0xe3, 0xb3, 0x76, 0xea, 0x77, 0xd4, 0x37, 0xdd, 0xba, 0x45, 0x09, 0x8b, 0x80,
-	0xd2, 0x56, 0x5b, 0x30, 0x95, 0x90, 0x28, 0x08, 0x35, 0x24, 0x54, 0x96, 0xda, 0x10, 0x8d, 0x73,
-	0xc5, 0xcd, 0x32, 0xf1, 0x1e, 0xdb, 0x23, 0xbc, 0x1f, 0x9d, 0x99, 0x2d, 0x4d, 0xff, 0x09, 0xff,
-	0x84, 0x1b, 0x2e, 0x91, 0xf8, 0x0d, 0x5c, 0xf4, 0x02, 0xf1, 0x33, 0xb8, 0x40, 0xf3, 0xb1, 0xf6,
-	0xba, 0x21, 0x69, 0x05, 0x37, 0xc9, 0x9e, 0x73, 0x9e, 0x73, 0xe6, 0x9c, 0xf3, 0x3c, 0x33, 0x86,
-	0xab, 0x79, 0xc6, 0x53, 0x85, 0x22, 0x3e, 0x0d, 0x73, 0x91, 0xa9, 0x8c, 0x74, 0x57, 0x8e, 0xe1,
-	0xee, 0x3c, 0xcb, 0xe6, 0x4b, 0xbc, 0x6f, 0x02, 0xa7, 0xc5, 0xec, 0xbe, 0xe2, 0x09, 0x4a, 0xc5,
-	0x92, 0xdc, 0x62, 0x87, 0x30, 0xcf, 0xe6, 0x59, 0xf9, 0x9d, 0x66, 0x31, 0xba, 0xef, 0x41, 0xce,
-	0x71, 0x8a, 0x52, 0x65, 0xc2, 0x79, 0x82, 0x9f, 0xea, 0x30, 0xa0, 0x18, 0x17, 0x69, 0xcc, 0xd2,
-	0xe9, 0xd9, 0x64, 0xba, 0xc0, 0x04, 0xc9, 0xe7, 0xd0, 0x54, 0x67, 0x39, 0xfa, 0xb5, 0xbd, 0xda,
-	0xed, 0xed, 0xd1, 0x07, 0xe1, 0xba, 0x95, 0xd7, 0xa1, 0xa1, 0xfd, 0x77, 0x72, 0x96, 0x23, 0x35,
-	0x39, 0xe4, 0x3a, 0x74, 0x12, 0x9e, 0x46, 0x02, 0x9f, 0xf9, 0xf5, 0xbd, 0xda, 0xed, 0x16, 0x6d,
-	0x27, 0x3c, 0xa5, 0xf8, 0x8c, 0x5c, 0x83, 0x96, 0xca, 0x14, 0x5b, 0xfa, 0x0d, 0xe3, 0xb6, 0x06,
-	0xf9, 0x08, 0x06, 0x02, 0x73, 0xc6, 0x45, 0xa4, 0x16, 0x02, 0xe5, 0x22, 0x5b, 0xc6, 0x7e, 0xd3,
-	0x00, 0xae, 0x5a, 0xff, 0x49, 0xe9, 0x26, 0x77, 0xe1, 0x7f, 0xb2, 0x98, 0x4e, 0x51, 0xca, 0x0a,
-	0xb6, 0x65, 0xb0, 0x03, 0x17, 0x58, 0x83, 0xef, 0x01, 0x41, 0xc1, 0x64, 0x21, 0x30, 0x92, 0x0b,
-	0xa6, 0xff, 0xf2, 0x97, 0xe8, 0xb7, 0x2d, 0xda, 0x45, 0x26, 0x3a, 0x30, 0xe1, 0x2f, 0x31, 0xb8,
-	0x06, 0xb0, 0x1e, 0x84, 0xb4, 0xa1, 0x4e, 0x27, 0x83, 0x2b, 0xc1, 0x04, 0x3c, 0x8a, 0x49, 0xa6,
-	0xf0, 0x58, 0x6f, 0x8d, 0xdc, 0x84, 0xae, 0x59, 0x5f, 0x94, 0x16, 0x89, 0x59, 0x4d, 0x8b, 0x6e,
-	0x19, 0xc7, 0x51, 0x91, 0x90, 0x0f, 0xa1, 0xa3, 0xf7, 0x1c, 0xf1, 0xd8, 0x8c, 0xdd, 0xdb, 0xdf,
-	0xfe, 0xed, 0xd5, 0xee, 0x95, 0xdf, 0x5f, 0xed, 0xb6, 0x8f, 0xb2, 0x18, 0xc7, 0x07, 0xb4, 0xad,
-	0xc3, 0xe3, 0x38, 0xf8, 0xb5, 0x06, 0x7d, 0x5b, 0x75, 0x82, 0xf3, 0x04, 0x53, 0x45, 0x1e, 0x02,
-	0x88, 0xd5, 0x5a, 0x4d, 0x61, 0x6f, 0x74, 0xf3, 0x92, 0x9d, 0xd3, 0x0a, 0x9c, 0xdc, 0x00, 0xdb,
-	0x43, 0x79, 0x70, 0x97, 0x76, 0x8c, 0x3d, 0x8e, 0xc9, 0x43, 0xe8, 0x0b, 0x73, 0x50, 0x64, 0x59,
-	0xf7, 0x1b, 0x7b, 0x8d, 0xdb, 0xde, 0x68, 0x67, 0xa3, 0xf4, 0x6a, 0x3c, 0xda, 0x13, 0x6b, 0x43,
-	0x92, 0x5d, 0xf0, 0x12, 0x14, 0x3f, 0x2c, 0x31, 0x12, 0x59, 0xa6, 0x0c, 0x25, 0x3d, 0x0a, 0xd6,
-	0x45, 0xb3, 0x4c, 0x05, 0x7f, 0xd5, 0xa1, 0x73, 0x6c, 0x0b, 0x91, 0xfb, 0x1b, 0x7a, 0xa9, 0xf6,
-	0xee, 0x10, 0xe1, 0x01, 0x53, 0xac, 0x22, 0x92, 0xf7, 0x61, 0x9b, 0xa7, 0x4b, 0x9e, 0x62, 0x24,
-	0xed, 0x12, 0x8c, 0x28, 0x7a, 0xb4, 0x6f, 0xbd, 0xe5, 0x66, 0x3e, 0x86, 0xb6, 0x6d, 0xca, 0x9c,
-	0xef, 0x8d, 0xfc, 0x73, 0xad, 0x3b, 0x24, 0x75, 0x38, 0xf2, 0x2e, 0xf4, 0x5c, 0x45, 0x4b, 0xb8,
-	0x96, 0x47, 0x83, 0x7a, 0xce, 0xa7, 0xb9, 0x26, 0x5f, 0x41, 0x7f, 0x2a, 0x90, 0x29, 0x9e, 0xa5,
-	0x51, 0xcc, 0x94, 0x15, 0x85, 0x37, 0x1a, 0x86, 0xf6, 0x52, 0x85, 0xe5, 0xa5, 0x0a, 0x4f, 0xca,
-	0x4b, 0x45, 0x7b, 0x65, 0xc2, 0x01, 0x53, 0x48, 0xbe, 0x86, 0xab, 0xf8, 0x22, 0xe7, 0xa2, 0x52,
-	0xa2, 0xf3, 0xc6, 0x12, 0xdb, 0xeb, 0x14, 0x53, 0x64, 0x08, 0x5b, 0x09, 0x2a, 0x16, 0x33, 0xc5,
-	0xfc, 0x2d, 0x33, 0xfb, 0xca, 0x0e, 0x02, 0xd8, 0x2a, 0xf7, 0x45, 0x00, 0xda, 0xe3, 0xa3, 0x27,
-	0xe3, 0xa3, 0xc3, 0xc1, 0x15, 0xfd, 0x4d, 0x0f, 0x9f, 0x7e, 0x7b, 0x72, 0x38, 0xa8, 0x05, 0x47,
-	0x00, 0xc7, 0x85, 0xa2, 0xf8, 0xac, 0x40, 0xa9, 0x08, 0x81, 0x66, 0xce, 0xd4, 0xc2, 0x10, 0xd0,
-	0xa5, 0xe6, 0x9b, 0xdc, 0x83, 0x8e, 0xdb, 0x96, 0x11, 0x86, 0x37, 0x22, 0xe7, 0x79, 0xa1, 0x25,
-	0x24, 0xd8, 0x03, 0x78, 0x8c, 0x97, 0xd5, 0x0b, 0x7e, 0xae, 0x81, 0xf7, 0x84, 0xcb, 0x15, 0x66,
-	0x07, 0xda, 0xb9, 0xc0, 0x19, 0x7f, 0xe1, 0x50, 0xce, 0xd2, 0xca, 0x91, 0x8a, 0x09, 0x15, 0xb1,
-	0x59, 0x79, 0x76, 0x97, 0x82, 0x71, 0x3d, 0xd2, 0x1e, 0xf2, 0x0e, 0x00, 0xa6, 0x71, 0x74, 0x8a,
-	0xb3, 0x4c, 0xa0, 0x21, 0xbe, 0x4b, 0xbb, 0x98, 0xc6, 0xfb, 0xc6, 0x41, 0x6e, 0x41, 0x57, 0xe0,
-	0xb4, 0x10, 0x92, 0x3f, 0xb7, 0xbc, 0x6f, 0xd1, 0xb5, 0x43, 0xbf, 0x22, 0x4b, 0x9e, 0x70, 0xe5,
-	0x2e, 0xbe, 0x35, 0x74, 0x49, 0xbd, 0xbd, 0x68, 0xb6, 0x64, 0x73, 0x69, 0x08, 0xed, 0xd0, 0xae,
-	0xf6, 0x7c, 0xa3, 0x1d, 0x41, 0x1f, 0x3c, 0xb3, 0x2c, 0x99, 0x67, 0xa9, 0xc4, 0xe0, 0x8f, 0x1a,
-	0x78, 0x66, 0x58, 0x6b, 0x57, 0x37, 0x55, 0x7b, 0xe3, 0xa6, 0xc8, 0x1e, 0xb4, 0xf4, 0x55, 0x96,
-	0x7e, 0xdd, 0x5c, 0x27, 0x08, 0xcd, 0xfb, 0xaa, 0x6f, 0x39, 0xb5, 0x01, 0xf2, 0x05, 0x34, 0xf2,
-	0x53, 0x66, 0x26, 0xf3, 0x46, 0x77, 0xc2, 0xf5, 0x9b, 0x2b, 0xb2, 0x42, 0xa1, 0x0c, 0x8f, 0xd9,
-	0x19, 0x8a, 0x7d, 0x96, 0xc6, 0x3f, 0xf2, 0x58, 0x2d, 0x1e, 0x2d, 0x97, 0xd9, 0xd4, 0x08, 0x83,
-	0xea, 0x34, 0x72, 0x08, 0x7d, 0x56, 0xa8, 0x45, 0x26, 0xf8, 0x4b, 0xe3, 0x75, 0xda, 0xdf, 0x3d,
-	0x5f, 0x67, 0xc2, 0xe7, 0x29, 0xc6, 0x4f, 0x51, 0x4a, 0x36, 0x47, 0xba, 0x99, 0x15, 0xfc, 0x52,
-	0x83, 0x9e, 0xa5, 0xcb, 0x4d, 0x39, 0x82, 0x16, 0x57, 0x98, 0x48, 0xbf, 0x66, 0xfa, 0xbe, 0x55,
-	0x99, 0xb1, 0x8a, 0x0b, 0xc7, 0x0a, 0x13, 0x6a, 0xa1, 0x5a, 0x07, 0x89, 0x26, 0xa9, 0x6e, 0x68,
-	0x30, 0xdf, 0x43, 0x84, 0xa6, 0x86, 0xfc, 0x77, 0xcd, 0xe9, 0x07, 0x95, 0xcb, 0xc8, 0x89, 0xa8,
-	0x61, 0x8e, 0xd8, 0xe2, 0xf2, 0xd8, 0xd8, 0xc1, 0x7b, 0xd0, 0x3f, 0xc0, 0x25, 0x2a, 0xbc, 0x4c,
-	0x93, 0x03, 0xd8, 0x2e, 0x41, 0x8e, 0x5b, 0x01, 0xdb, 0x63, 0x85, 0x82, 0xad, 0xf3, 0x2e, 0xd2,
-	0xe9, 0x35, 0x68, 0xcd, 0xb8, 0x90, 0xca, 0x29, 0xd4, 0x1a, 0xc4, 0x87, 0x8e, 0x15, 0x1b, 0xba,
-	0x8e, 0x4a, 0xd3, 0x46, 0x9e, 0xa3, 0x8e, 0x34, 0xcb, 0x88, 0x31, 0x83, 0x25, 0xec, 0x5e, 0x48,
-	0xa9, 0x6b, 0x62, 0x0c, 0x6d, 0x36, 0x35, 0x6c, 0xda, 0x37, 0xf2, 0x93, 0xb7, 0x57, 0x45, 0xf8,
-	0xc8, 0x24, 0x52, 0x57, 0x20, 0xf8, 0x1e, 0xf6, 0x2e, 0x3e, 0xcd, 0x71, 0xed, 0x14, 0x58, 0xfb,
-	0x57, 0x0a, 0x1c, 0xfd, 0x59, 0x87, 0xae, 0x23, 0xeb, 0x60, 0x9f, 0x3c, 0x80, 0xc6, 0x71, 0xa1,
-	0xc8, 0xff, 0xab, 0x4c, 0xae, 0x5e, 0x9e, 0xe1, 0xce, 0xeb, 0x6e, 0xd7, 0xc1, 0x03, 0x68, 0x3c,
-	0xc6, 0xcd, 0xac, 0xf5, 0xfb, 0xb2, 0x91, 0x55, 0xbd, 0x89, 0x9f, 0x41, 0x53, 0x6b, 0x91, 0xec,
-	0x9c, 0x13, 0xa7, 0xcd, 0xbb, 0x7e, 0x81, 0x68, 0xc9, 0x97, 0xd0, 0xb6, 0x42, 0x20, 0xd5, 0xdf,
-	0x88, 0x0d, 0x01, 0x0d, 0x6f, 0xfc, 0x43, 0xc4, 0xa5, 0x4b, 0xf0, 0x2f, 0x5a, 0x09, 0xb9, 0x53,
-	0x9d, 0xf0, 0x72, 0x9a, 0x87, 0x77, 0xdf, 0x0a, 0x6b, 0x0f, 0xdd, 0x6f, 0x7e, 0x57, 0xcf, 0x4f,
-	0x4f, 0xdb, 0xe6, 0xc7, 0xe2, 0xd3, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x56, 0x48, 0xf9,
-	0xf0, 0x09, 0x00, 0x00,
+func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_pointerdb_c62c35ad5421342c) }
+
+var fileDescriptor_pointerdb_c62c35ad5421342c = []byte{
+	// 1250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x6e, 0x1b, 0xb7,
+	0x12, 0x8e, 0x2c, 0x59, 0xb2, 0x46, 0xb6, 0xa2, 0x43, 0x24, 0xce, 0x46, 0xc9, 0x81, 0x9d, 0x3d,
+	0x38, 0xe7, 0xa4, 0x49, 0xa0, 0xb4, 0x4a, 0x80, 0xa2, 0x4d, 0x8b, 0xc2, 0x8e, 0x5d, 0x43, 0x40,
+	0xe2, 0x1a, 0xb4, 0x7b, 0x53, 0xa0, 0xd8, 0xd2, 0xda, 0xb1, 0xc4, 0x76, 0x77, 0xb9, 0x21, 0xa9,
+	0x38, 0xce, 0x7b, 0xf4, 0xa2, 0xe8, 0x5d, 0x9f, 0xa2, 0x40, 0xd1, 0xcb, 0x02, 0x7d, 0x86, 0x5e,
+	0xe4, 0xa2, 0x4f, 0x52, 0xf0, 0x67, 0xa5, 0x55, 0xfc, 0x17, 0xb4, 0x37, 0x12, 0x67, 0xe6, 0x1b,
+	0x72, 0xf8, 0xcd, 0x37, 0x5c, 0xb8, 0x9a, 0x0b, 0x9e, 0x69, 0x94, 0xf1, 0x61, 0x2f, 0x97, 0x42,
+	0x0b, 0xd2, 0x9c, 0x3a, 0xba, 0x6b, 0x23, 0x21, 0x46, 0x09, 0x3e, 0xb4, 0x81, 0xc3, 0xc9, 0xd1,
+	0x43, 0xcd, 0x53, 0x54, 0x9a, 0xa5, 0xb9, 0xc3, 0x76, 0x61, 0x24, 0x46, 0xa2, 0x58, 0x67, 0x22,
+	0x46, 0xbf, 0xee, 0xe4, 0x1c, 0x87, 0xa8, 0xb4, 0x90, 0xde, 0x13, 0xfe, 0xb0, 0x00, 0x1d, 0x8a,
+	0xf1, 0x24, 0x8b, 0x59, 0x36, 0x3c, 0xd9, 0x1f, 0x8e, 0x31, 0x45, 0xf2, 0x31, 0xd4, 0xf4, 0x49,
+	0x8e, 0x41, 0x65, 0xbd, 0x72, 0xb7, 0xdd, 0xff, 0x5f, 0x6f, 0x56, 0xca, 0xdb, 0xd0, 0x9e, 0xfb,
+	0x3b, 0x38, 0xc9, 0x91, 0xda, 0x1c, 0x72, 0x03, 0x1a, 0x29, 0xcf, 0x22, 0x89, 0x2f, 0x82, 0x85,
+	0xf5, 0xca, 0xdd, 0x45, 0x5a, 0x4f, 0x79, 0x46, 0xf1, 0x05, 0xb9, 0x06, 0x8b, 0x5a, 0x68, 0x96,
+	0x04, 0x55, 0xeb, 0x76, 0x06, 0x79, 0x0f, 0x3a, 0x12, 0x73, 0xc6, 0x65, 0xa4, 0xc7, 0x12, 0xd5,
+	0x58, 0x24, 0x71, 0x50, 0xb3, 0x80, 0xab, 0xce, 0x7f, 0x50, 0xb8, 0xc9, 0x7d, 0xf8, 0x97, 0x9a,
+	0x0c, 0x87, 0xa8, 0x54, 0x09, 0xbb, 0x68, 0xb1, 0x1d, 0x1f, 0x98, 0x81, 0x1f, 0x00, 0x41, 0xc9,
+	0xd4, 0x44, 0x62, 0xa4, 0xc6, 0xcc, 0xfc, 0xf2, 0xd7, 0x18, 0xd4, 0x1d, 0xda, 0x47, 0xf6, 0x4d,
+	0x60, 0x9f, 0xbf, 0xc6, 0xf0, 0x1a, 0xc0, 0xec, 0x22, 0xa4, 0x0e, 0x0b, 0x74, 0xbf, 0x73, 0x25,
+	0xfc, 0xa9, 0x02, 0x2d, 0x8a, 0xa9, 0xd0, 0xb8, 0x67, 0x68, 0x23, 0xb7, 0xa0, 0x69, 0xf9, 0x8b,
+	0xb2, 0x49, 0x6a, 0xb9, 0x59, 0xa4, 0x4b, 0xd6, 0xb1, 0x3b, 0x49, 0xc9, 0xff, 0xa1, 0x61, 0x88,
+	0x8e, 0x78, 0x6c, 0xef, 0xbd, 0xbc, 0xd9, 0xfe, 0xfd, 0xcd, 0xda, 0x95, 0x3f, 0xde, 0xac, 0xd5,
+	0x77, 0x45, 0x8c, 0x83, 0x2d, 0x5a, 0x37, 0xe1, 0x41, 0x4c, 0x08, 0xd4, 0xc6, 0x4c, 0x8d, 0x2d,
+	0x0d, 0xcb, 0xd4, 0xae, 0xc9, 0x47, 0x00, 0xc3, 0x31, 0x4b, 0x12, 0xcc, 0x46, 0xa8, 0x82, 0xda,
+	0x7a, 0xf5, 0x6e, 0xab, 0x7f, 0xb3, 0x44, 0xfb, 0xc6, 0x24, 0xe6, 0xfa, 0x69, 0x81, 0xa0, 0x25,
+	0x70, 0xf8, 0x35, 0xb4, 0xe7, 0xa3, 0xe4, 0x0e, 0x2c, 0x2b, 0x2d, 0x79, 0x8e, 0x11, 0xcf, 0x62,
+	0x7c, 0x65, 0x2b, 0xad, 0xd2, 0x96, 0xf3, 0x0d, 0x8c, 0xcb, 0xf4, 0x22, 0x13, 0xd9, 0x10, 0x5d,
+	0xa9, 0xd4, 0x19, 0x67, 0x55, 0x16, 0xfe, 0x56, 0x81, 0x15, 0xc7, 0xc1, 0x3e, 0x8e, 0x52, 0xcc,
+	0x34, 0x79, 0x02, 0x20, 0xa7, 0x2a, 0xb0, 0x9b, 0xb7, 0xfa, 0xb7, 0x2e, 0x90, 0x08, 0x2d, 0xc1,
+	0xc9, 0x4d, 0x70, 0x8c, 0x15, 0x34, 0x35, 0x69, 0xc3, 0xda, 0x83, 0x98, 0x3c, 0x81, 0x15, 0x69,
+	0x0f, 0x8a, 0xac, 0x47, 0x05, 0x55, 0x4b, 0xc3, 0xea, 0xdc, 0xd6, 0xd3, 0x66, 0xd0, 0x65, 0x39,
+	0x33, 0x14, 0x59, 0x83, 0x56, 0x8a, 0xf2, 0xbb, 0x04, 0x23, 0x29, 0x84, 0xb6, 0x0a, 0x5a, 0xa6,
+	0xe0, 0x5c, 0x54, 0x08, 0x1d, 0xfe, 0x58, 0x85, 0xc6, 0x9e, 0xdb, 0x88, 0x3c, 0x9c, 0x93, 0x77,
+	0xb9, 0x76, 0x8f, 0xe8, 0x6d, 0x31, 0xcd, 0x4a, 0x9a, 0xfe, 0x2f, 0xb4, 0x79, 0x96, 0xf0, 0x0c,
+	0x23, 0xe5, 0x48, 0xf0, 0x14, 0xad, 0x38, 0x6f, 0xc1, 0xcc, 0xfb, 0x50, 0x77, 0x45, 0xd9, 0xf3,
+	0x5b, 0xfd, 0xe0, 0x54, 0xe9, 0x1e, 0x49, 0x3d, 0xce, 0xb6, 0xca, 0xb9, 0x9c, 0x3e, 0x17, 0x7d,
+	0xab, 0x9c, 0xcf, 0x48, 0x93, 0x7c, 0x06, 0x2b, 0x43, 0x89, 0x4c, 0x73, 0x91, 0x45, 0x31, 0xd3,
+	0x4e, 0xc3, 0xad, 0x7e, 0xb7, 0xe7, 0xde, 0x80, 0x5e, 0xf1, 0x06, 0xf4, 0x0e, 0x8a, 0x37, 0x80,
+	0x2e, 0x17, 0x09, 0x5b, 0x4c, 0x23, 0x79, 0x0a, 0x57, 0xf1, 0x55, 0xce, 0x65, 0x69, 0x8b, 0xc6,
+	0xa5, 0x5b, 0xb4, 0x67, 0x29, 0x76, 0x93, 0x2e, 0x2c, 0xa5, 0xa8, 0x59, 0xcc, 0x34, 0x0b, 0x96,
+	0xec, 0xdd, 0xa7, 0x36, 0xf9, 0x37, 0x40, 0x2e, 0xc5, 0xb7, 0x38, 0xd4, 0xa6, 0xab, 0x4d, 0x1b,
+	0x6d, 0x7a, 0xcf, 0x20, 0x0e, 0x43, 0x58, 0x2a, 0xe8, 0x24, 0x00, 0xf5, 0xc1, 0xee, 0xb3, 0xc1,
+	0xee, 0x76, 0xe7, 0x8a, 0x59, 0xd3, 0xed, 0xe7, 0x5f, 0x1c, 0x6c, 0x77, 0x2a, 0xe1, 0x2e, 0xc0,
+	0xde, 0x44, 0x53, 0x7c, 0x31, 0x41, 0xa5, 0x8d, 0x0e, 0x73, 0xa6, 0xc7, 0xb6, 0x3f, 0x4d, 0x6a,
+	0xd7, 0xe4, 0x01, 0x34, 0x3c, 0x99, 0x56, 0x37, 0xad, 0x3e, 0x39, 0xdd, 0x36, 0x5a, 0x40, 0xc2,
+	0x75, 0x80, 0x1d, 0xbc, 0x68, 0xbf, 0xf0, 0xe7, 0x0a, 0xb4, 0x9e, 0x71, 0x35, 0xc5, 0xac, 0x42,
+	0x3d, 0x97, 0x78, 0xc4, 0x5f, 0x79, 0x94, 0xb7, 0x8c, 0xb0, 0x94, 0x66, 0x52, 0x47, 0xec, 0xa8,
+	0x38, 0xbb, 0x49, 0xc1, 0xba, 0x36, 0x8c, 0xc7, 0xdc, 0x1e, 0xb3, 0x38, 0x3a, 0xc4, 0x23, 0x21,
+	0xd1, 0xea, 0xa2, 0x49, 0x9b, 0x98, 0xc5, 0x9b, 0xd6, 0x41, 0x6e, 0x43, 0x53, 0xe2, 0x70, 0x22,
+	0x15, 0x7f, 0xe9, 0x64, 0xb1, 0x44, 0x67, 0x0e, 0x33, 0x87, 0x09, 0x4f, 0xb9, 0xf6, 0xcf, 0x98,
+	0x33, 0xcc, 0x96, 0x86, 0xdc, 0xe8, 0x28, 0x61, 0x23, 0x65, 0xfb, 0xdd, 0xa0, 0x4d, 0xe3, 0xf9,
+	0xdc, 0x38, 0xc2, 0x15, 0x68, 0x59, 0xb2, 0x54, 0x2e, 0x32, 0x85, 0xe1, 0x9f, 0x15, 0x68, 0xed,
+	0xe0, 0xd4, 0x2e, 0x33, 0x55, 0xb9, 0x94, 0x29, 0xb2, 0x6e, 0x5e, 0x82, 0x18, 0x55, 0xb0, 0x60,
+	0xa7, 0x0d, 0x7a, 0xc6, 0xea, 0x99, 0x27, 0x8b, 0xba, 0x00, 0xf9, 0x04, 0xaa, 0xf9, 0x21, 0xb3,
+	0x37, 0x6b, 0xf5, 0xef, 0xf5, 0x66, 0x5f, 0x10, 0x29, 0x26, 0x1a, 0x55, 0x6f, 0x8f, 0x9d, 0xa0,
+	0xdc, 0x64, 0x59, 0x7c, 0xcc, 0x63, 0x3d, 0xde, 0x48, 0x12, 0x31, 0xb4, 0xba, 0xa1, 0x26, 0x8d,
+	0x6c, 0xc3, 0x0a, 0x9b, 0xe8, 0xb1, 0x90, 0xfc, 0xb5, 0xf5, 0xfa, 0xd1, 0x58, 0x3b, 0xbd, 0xcf,
+	0x3e, 0x1f, 0x65, 0x18, 0x3f, 0x47, 0xa5, 0xd8, 0x08, 0xe9, 0x7c, 0x56, 0xf8, 0x6b, 0x05, 0x96,
+	0x5d, 0xbb, 0xfc, 0x2d, 0xfb, 0xb0, 0xc8, 0x35, 0xa6, 0x2a, 0xa8, 0xd8, 0xba, 0x6f, 0x97, 0xee,
+	0x58, 0xc6, 0xf5, 0x06, 0x1a, 0x53, 0xea, 0xa0, 0x46, 0x07, 0xa9, 0x69, 0xd2, 0x82, 0x6d, 0x83,
+	0x5d, 0x77, 0x11, 0x6a, 0x06, 0xf2, 0xcf, 0x35, 0x67, 0xbe, 0x0e, 0x5c, 0x45, 0x5e, 0x44, 0x55,
+	0x7b, 0xc4, 0x12, 0x57, 0x7b, 0xd6, 0x0e, 0xff, 0x03, 0x2b, 0x5b, 0x98, 0xa0, 0xc6, 0x8b, 0x34,
+	0xd9, 0x81, 0x76, 0x01, 0xf2, 0xbd, 0xfd, 0xbe, 0x02, 0xd7, 0x9f, 0x8a, 0x34, 0x67, 0x12, 0x37,
+	0xb2, 0x78, 0xff, 0x98, 0xe5, 0x17, 0xcd, 0xc8, 0x23, 0x68, 0x89, 0x24, 0x8e, 0x2e, 0xaf, 0x19,
+	0x44, 0x12, 0xfb, 0xb5, 0x49, 0xca, 0xf0, 0x78, 0x9a, 0x54, 0x3d, 0x3f, 0x29, 0xc3, 0x63, 0xbf,
+	0x0e, 0x03, 0x58, 0x7d, 0xbb, 0x2c, 0x5f, 0xb1, 0x84, 0xf6, 0x40, 0xa3, 0x64, 0x1a, 0x2f, 0x9b,
+	0xac, 0x6b, 0xb0, 0x78, 0xc4, 0xa5, 0xd2, 0x7e, 0xa6, 0x9c, 0x41, 0x02, 0x68, 0xb8, 0xf1, 0x40,
+	0xcf, 0x61, 0x61, 0xba, 0xc8, 0x4b, 0x34, 0x91, 0x5a, 0x11, 0xb1, 0x66, 0x98, 0xc0, 0xda, 0xb9,
+	0x22, 0xf4, 0x45, 0x0c, 0xa0, 0xce, 0x86, 0x56, 0x7f, 0xee, 0xd1, 0xff, 0xe0, 0xdd, 0x75, 0xdc,
+	0xdb, 0xb0, 0x89, 0xd4, 0x6f, 0x10, 0x7e, 0x03, 0xeb, 0xe7, 0x9f, 0xe6, 0xd5, 0xe9, 0x67, 0xa6,
+	0xf2, 0xb7, 0x66, 0xa6, 0xff, 0x4b, 0x15, 0x9a, 0x9e, 0xe9, 0xad, 0x4d, 0xf2, 0x18, 0xaa, 0x7b,
+	0x13, 0x4d, 0xae, 0x97, 0x5b, 0x32, 0x7d, 0x2b, 0xbb, 0xab, 0x6f, 0xbb, 0x7d, 0x05, 0x8f, 0xa1,
+	0xba, 0x83, 0xf3, 0x59, 0x3b, 0x78, 0x66, 0x56, 0xf9, 0xed, 0xf8, 0x10, 0x6a, 0x66, 0x7a, 0xc8,
+	0xea, 0xa9, 0x71, 0x72, 0x79, 0x37, 0xce, 0x19, 0x33, 0xf2, 0x29, 0xd4, 0x9d, 0x74, 0x49, 0xf9,
+	0xa3, 0x37, 0x27, 0xf9, 0xee, 0xcd, 0x33, 0x22, 0x3e, 0xfd, 0x4b, 0x68, 0xcf, 0xeb, 0x89, 0xac,
+	0x97, 0xc0, 0x67, 0x4e, 0x40, 0xf7, 0xce, 0x05, 0x08, 0xbf, 0xad, 0x82, 0xe0, 0x3c, 0xa6, 0xc9,
+	0xbd, 0x32, 0x71, 0x17, 0xab, 0xa7, 0x7b, 0xff, 0x9d, 0xb0, 0xee, 0xd0, 0xcd, 0xda, 0x57, 0x0b,
+	0xf9, 0xe1, 0x61, 0xdd, 0x7e, 0x54, 0x1f, 0xfd, 0x35, 0x00, 0x26, 0x69, 0x8c, 0x38, 0xc7, 0x0b,
+	0x00, 0x00,
 }
@@ -40,8 +40,9 @@ func CopyNode(src *Node) (dst *Node) {
 	}
 	if src.Metadata != nil {
 		node.Metadata = &NodeMetadata{
-			Email:  src.Metadata.Email,
-			Wallet: src.Metadata.Wallet,
+			Email:   src.Metadata.Email,
+			Wallet:  src.Metadata.Wallet,
+			Version: src.Metadata.Version,
 		}
 	}
 	if src.Restrictions != nil {
@@ -51,6 +52,16 @@ func CopyNode(src *Node) (dst *Node) {
 		}
 	}
 
+	if src.Record != nil {
+		node.Record = &SignedNodeRecord{
+			Record:    append([]byte(nil), src.Record.Record...),
+			Signature: append([]byte(nil), src.Record.Signature...),
+		}
+		for _, cert := range src.Record.Chain {
+			node.Record.Chain = append(node.Record.Chain, append([]byte(nil), cert...))
+		}
+	}
+
 	node.AuditSuccess = src.AuditSuccess
 	node.IsUp = src.IsUp
 	node.LatencyList = src.LatencyList
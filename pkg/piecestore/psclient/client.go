@@ -9,6 +9,7 @@ import (
 	"crypto/ecdsa"
 	"flag"
 	"fmt"
+	"hash"
 	"io"
 	"log"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"czarcoin.org/czarcoin/pkg/ranger"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/pkg/transport"
+	"czarcoin.org/czarcoin/pkg/utils"
 )
 
 // ClientError is any error returned by the client
@@ -34,14 +36,26 @@ var (
 	maxBandwidthMsgSize = flag.Int(
 		"piecestore.rpc.client.max_bandwidth_msg_size", 64*1024,
 		"max bandwidth message size in bytes")
+	maxUploadRetries = flag.Int(
+		"piecestore.rpc.client.max_upload_retries", 3,
+		"max number of times an interrupted upload is resumed")
+	maxResumeBufferSize = flag.Int(
+		"piecestore.rpc.client.max_resume_buffer_size", 1024*1024,
+		"max number of sent bytes kept in memory for resuming an upload")
 )
 
+// resumeDelay is how long Put waits before resuming an interrupted upload,
+// multiplied by the number of attempts
+const resumeDelay = 100 * time.Millisecond
+
 // Client is an interface describing the functions for interacting with piecestore nodes
 type Client interface {
 	Meta(ctx context.Context, id PieceID) (*pb.PieceSummary, error)
-	Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error
+	Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) ([]byte, error)
+	Resume(ctx context.Context, id PieceID, upload *Upload, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) ([]byte, error)
 	Get(ctx context.Context, id PieceID, size int64, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (ranger.Ranger, error)
 	Delete(ctx context.Context, pieceID PieceID, authorization *pb.SignedMessage) error
+	Challenge(ctx context.Context, id PieceID, stripeIndex, shareSize int64, nonce []byte, authorization *pb.SignedMessage) ([]byte, error)
 	Stats(ctx context.Context) (*pb.StatSummary, error)
 	io.Closer
 }
@@ -51,6 +65,7 @@ type PieceStore struct {
 	closeFunc        func() error              // function that closes the transport connection
 	client           pb.PieceStoreRoutesClient // PieceStore for interacting with Storage Node
 	prikey           crypto.PrivateKey         // Uplink private key
+	chain            [][]byte                  // Uplink leaf and CA certificate
 	bandwidthMsgSize int                       // max bandwidth message size in bytes
 	nodeID           czarcoin.NodeID              // Storage node being connected to
 }
@@ -75,6 +90,7 @@ func NewPSClient(ctx context.Context, tc transport.Client, n *pb.Node, bandwidth
 		client:           pb.NewPieceStoreRoutesClient(conn),
 		bandwidthMsgSize: bandwidthMsgSize,
 		prikey:           tc.Identity().Key,
+		chain:            [][]byte{tc.Identity().Leaf.Raw, tc.Identity().CA.Raw},
 		nodeID:           n.Id,
 	}, nil
 }
@@ -111,15 +127,74 @@ func (ps *PieceStore) Meta(ctx context.Context, id PieceID) (*pb.PieceSummary, e
 	return ps.client.Piece(ctx, &pb.PieceId{Id: id.String()})
 }
 
-// Put uploads a Piece to a piece store Server
-func (ps *PieceStore) Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error {
+// Put uploads a Piece to a piece store Server, resuming the upload at the
+// offset reported by the server when the stream breaks. It returns the
+// SHA-256 hash of the piece, which the server verified before committing it.
+func (ps *PieceStore) Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) ([]byte, error) {
+	return ps.Resume(ctx, id, NewUpload(data), ttl, ba, authorization)
+}
+
+// Resume continues an upload of a Piece, which may have been started with
+// another connection, at the offset reported by the server
+func (ps *PieceStore) Resume(ctx context.Context, id PieceID, upload *Upload, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (_ []byte, err error) {
+	var offset int64
+	if upload.started {
+		if offset, err = ps.rewind(ctx, id, upload, authorization); err != nil {
+			return nil, err
+		}
+	}
+	upload.started = true
+
+	for retry := 0; ; retry++ {
+		err = ps.put(ctx, id, upload.buffer, upload.hasher, offset, ttl, ba, authorization)
+		// failures of the source, such as a slow node being cut, are final
+		if err != nil && (!upload.Resumable() || retry >= *maxUploadRetries) {
+			return nil, err
+		}
+		if err == nil {
+			return upload.hasher.Sum(nil), nil
+		}
+
+		zap.S().Infof("Upload of piece %s interrupted, resuming: %v", id, err)
+
+		// give the server time to notice that the previous stream is gone
+		select {
+		case <-time.After(time.Duration(retry+1) * resumeDelay):
+		case <-ctx.Done():
+			return nil, utils.CombineErrors(err, ctx.Err())
+		}
+
+		offset, err = ps.rewind(ctx, id, upload, authorization)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rewind continues upload at the offset received by the server
+func (ps *PieceStore) rewind(ctx context.Context, id PieceID, upload *Upload, authorization *pb.SignedMessage) (int64, error) {
+	session, err := ps.client.UploadSession(ctx, &pb.PieceId{Id: id.String(), Authorization: authorization})
+	if err != nil {
+		return 0, err
+	}
+
+	offset := session.GetOffset()
+	if err := upload.buffer.Rewind(offset); err != nil {
+		return 0, err
+	}
+	return offset, nil
+}
+
+// put sends the data of a Piece starting at offset in a new upload stream,
+// followed by the hash of the whole piece once data is exhausted
+func (ps *PieceStore) put(ctx context.Context, id PieceID, data io.Reader, hasher hash.Hash, offset int64, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error {
 	stream, err := ps.client.Store(ctx)
 	if err != nil {
 		return err
 	}
 
 	msg := &pb.PieceStore{
-		PieceData:     &pb.PieceStore_PieceData{Id: id.String(), ExpirationUnixSec: ttl.Unix()},
+		PieceData:     &pb.PieceStore_PieceData{Id: id.String(), ExpirationUnixSec: ttl.Unix(), Offset: offset},
 		Authorization: authorization,
 	}
 	if err = stream.Send(msg); err != nil {
@@ -130,30 +205,43 @@ func (ps *PieceStore) Put(ctx context.Context, id PieceID, data io.Reader, ttl t
 		return fmt.Errorf("%v.Send() = %v", stream, err)
 	}
 
-	writer := &StreamWriter{signer: ps, stream: stream, pba: ba}
-
-	defer func() {
-		if err := writer.Close(); err != nil && err != io.EOF {
-			log.Printf("failed to close writer: %s\n", err)
-		}
-	}()
-
+	// the allocation covers the bytes received by earlier attempts, so that
+	// the server keeps a single agreement for the whole piece
+	writer := &StreamWriter{signer: ps, stream: stream, pba: ba, totalWritten: offset}
 	bufw := bufio.NewWriterSize(writer, 32*1024)
 
 	_, err = io.Copy(bufw, data)
+	if err == nil {
+		err = bufw.Flush()
+	}
+	if err == nil {
+		err = stream.Send(&pb.PieceStore{
+			PieceData: &pb.PieceStore_PieceData{Hash: hasher.Sum(nil)},
+		})
+	}
+
+	closeErr := writer.Close()
+	if closeErr == io.EOF {
+		closeErr = nil
+	}
+
 	if err == io.ErrUnexpectedEOF {
-		_ = writer.Close()
 		zap.S().Infof("Node cut from upload due to slow connection. Deleting piece %s...", id)
 		deleteErr := ps.Delete(ctx, id, authorization)
 		if deleteErr != nil {
 			return deleteErr
 		}
+		return err
 	}
 	if err != nil {
+		if closeErr != nil {
+			log.Printf("failed to close writer: %s\n", closeErr)
+		}
 		return err
 	}
 
-	return bufw.Flush()
+	// the server reports whether it committed the piece when closing
+	return closeErr
 }
 
 // Get begins downloading a Piece from a piece store Server
@@ -176,6 +264,22 @@ func (ps *PieceStore) Delete(ctx context.Context, id PieceID, authorization *pb.
 	return nil
 }
 
+// Challenge asks the piece store Server for the keyed hash of the erasure
+// share of a Piece at stripeIndex
+func (ps *PieceStore) Challenge(ctx context.Context, id PieceID, stripeIndex, shareSize int64, nonce []byte, authorization *pb.SignedMessage) ([]byte, error) {
+	resp, err := ps.client.Challenge(ctx, &pb.ChallengeRequest{
+		Id:            id.String(),
+		StripeIndex:   stripeIndex,
+		ShareSize:     shareSize,
+		Nonce:         nonce,
+		Authorization: authorization,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetHash(), nil
+}
+
 // Stats will retrieve stats about a piece storage node
 func (ps *PieceStore) Stats(ctx context.Context) (*pb.StatSummary, error) {
 	return ps.client.Stats(ctx, &pb.StatsReq{})
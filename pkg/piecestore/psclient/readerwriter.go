@@ -43,6 +43,7 @@ func (s *StreamWriter) Write(b []byte) (int, error) {
 		Total:           updatedAllocation,
 		StorageNodeId:   s.signer.nodeID,
 		PubKey:          pubbytes, // TODO: Take this out. It will be kept in a database on the satellite
+		Chain:           s.signer.chain,
 	}
 
 	serializedAllocation, err := proto.Marshal(allocationData)
@@ -137,6 +138,7 @@ func NewStreamReader(client *PieceStore, stream pb.PieceStoreRoutes_RetrieveClie
 				Total:           sr.allocated + allocate,
 				StorageNodeId:   sr.client.nodeID,
 				PubKey:          pubbytes, // TODO: Take this out. It will be kept in a database on the satellite
+				Chain:           sr.client.chain,
 			}
 
 			serializedAllocation, err := proto.Marshal(allocationData)
@@ -0,0 +1,103 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"crypto/sha256"
+	"hash"
+	"io"
+)
+
+// resumeBuffer reads from an upload source and keeps the most recently read
+// bytes, so that an interrupted upload can be resumed at an earlier offset
+type resumeBuffer struct {
+	source io.Reader
+	limit  int
+
+	buf  []byte // bytes of the source starting at base
+	base int64
+	pos  int64 // offset of the next byte returned by Read
+	err  error // error returned by source
+}
+
+func newResumeBuffer(source io.Reader, limit int) *resumeBuffer {
+	return &resumeBuffer{source: source, limit: limit}
+}
+
+// Read returns the buffered bytes after a rewind, then continues reading
+// from the source
+func (b *resumeBuffer) Read(p []byte) (n int, err error) {
+	if end := b.base + int64(len(b.buf)); b.pos < end {
+		n = copy(p, b.buf[b.pos-b.base:])
+		b.pos += int64(n)
+		return n, nil
+	}
+
+	if b.err != nil {
+		return 0, b.err
+	}
+
+	n, err = b.source.Read(p)
+	b.keep(p[:n])
+	b.pos += int64(n)
+	b.err = err
+	return n, err
+}
+
+// keep appends data to the buffer and drops the oldest bytes over the limit
+func (b *resumeBuffer) keep(data []byte) {
+	b.buf = append(b.buf, data...)
+	if drop := len(b.buf) - b.limit; drop > 0 {
+		b.buf = b.buf[:copy(b.buf, b.buf[drop:])]
+		b.base += int64(drop)
+	}
+}
+
+// Rewind continues reading at offset, which must still be buffered
+func (b *resumeBuffer) Rewind(offset int64) error {
+	if offset < b.base || offset > b.pos {
+		return ClientError.New("cannot resume upload at offset %d, buffered %d to %d", offset, b.base, b.pos)
+	}
+
+	// bytes before offset are never needed again
+	b.buf = b.buf[:copy(b.buf, b.buf[offset-b.base:])]
+	b.base = offset
+	b.pos = offset
+	return nil
+}
+
+// SourceFailed returns whether reading the source failed, in which case
+// resuming the upload cannot help
+func (b *resumeBuffer) SourceFailed() bool {
+	return b.err != nil && b.err != io.EOF
+}
+
+// Upload is the data of a piece put to a storage node, which can be resumed
+// with a new connection when the previous one broke
+type Upload struct {
+	hasher  hash.Hash
+	buffer  *resumeBuffer
+	started bool
+}
+
+// NewUpload returns an upload of the piece read from data
+func NewUpload(data io.Reader) *Upload {
+	// the source is hashed before buffering, so that resent data is hashed once
+	hasher := sha256.New()
+	return &Upload{
+		hasher: hasher,
+		buffer: newResumeBuffer(io.TeeReader(data, hasher), *maxResumeBufferSize),
+	}
+}
+
+// Read returns the data of the piece at the current offset
+func (u *Upload) Read(p []byte) (n int, err error) {
+	return u.buffer.Read(p)
+}
+
+// Resumable returns whether a failed upload can be resumed, which is not
+// the case when reading the source failed
+func (u *Upload) Resumable() bool {
+	return !u.buffer.SourceFailed()
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumeBuffer(t *testing.T) {
+	data := []byte("0123456789abcdefghij")
+	buffer := newResumeBuffer(bytes.NewReader(data), 8)
+
+	// read the first 15 bytes, keeping the last 8 of them
+	p := make([]byte, 15)
+	_, err := io.ReadFull(buffer, p)
+	assert.NoError(t, err)
+	assert.Equal(t, data[:15], p)
+
+	// offsets which are not buffered cannot be resumed
+	assert.Error(t, buffer.Rewind(6))
+	assert.Error(t, buffer.Rewind(16))
+
+	// the rest of the data is returned after rewinding to a buffered offset
+	assert.NoError(t, buffer.Rewind(10))
+	rest, err := ioutil.ReadAll(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, data[10:], rest)
+	assert.False(t, buffer.SourceFailed())
+
+	// rewinding after the end of the source returns the buffered data again
+	assert.NoError(t, buffer.Rewind(18))
+	rest, err = ioutil.ReadAll(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, data[18:], rest)
+}
+
+func TestResumeBufferSourceFailed(t *testing.T) {
+	failure := errors.New("source failed")
+	source := io.MultiReader(bytes.NewReader([]byte("butts")), &failingReader{err: failure})
+	buffer := newResumeBuffer(source, 8)
+
+	data, err := ioutil.ReadAll(buffer)
+	assert.Equal(t, failure, err)
+	assert.Equal(t, []byte("butts"), data)
+	assert.True(t, buffer.SourceFailed())
+}
+
+type failingReader struct{ err error }
+
+func (r *failingReader) Read(p []byte) (int, error) { return 0, r.err }
@@ -10,19 +10,21 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
-	"google.golang.org/grpc"
 
+	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/pkg/overlay"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/piecestore/psserver/psdb"
 	"czarcoin.org/czarcoin/pkg/provider"
-	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/transport"
 	"czarcoin.org/czarcoin/pkg/utils"
 )
 
 var (
 	defaultCheckInterval = flag.Duration("piecestore.agreementsender.check_interval", time.Hour, "number of seconds to sleep between agreement checks")
 	defaultOverlayAddr   = flag.String("piecestore.agreementsender.overlay_addr", "127.0.0.1:7777", "Overlay Address")
+	defaultBatchSize     = flag.Int("piecestore.agreementsender.batch_size", 1000, "number of agreements settled with a single request")
+	defaultMaxBackoff    = flag.Duration("piecestore.agreementsender.max_backoff", 24*time.Hour, "longest time to wait before retrying a satellite which failed to settle agreements")
 
 	// ASError wraps errors returned from agreementsender package
 	ASError = errs.Class("agreement sender error")
@@ -30,10 +32,10 @@ var (
 
 // AgreementSender maintains variables required for reading bandwidth agreements from a DB and sending them to a Payers
 type AgreementSender struct {
-	DB       *psdb.DB
-	overlay  overlay.Client
-	identity *provider.FullIdentity
-	errs     []error
+	DB        *psdb.DB
+	overlay   overlay.Client
+	transport transport.Client
+	backoff   map[czarcoin.NodeID]*backoff
 }
 
 // Initialize the Agreement Sender
@@ -43,88 +45,144 @@ func Initialize(DB *psdb.DB, identity *provider.FullIdentity) (*AgreementSender,
 		return nil, err
 	}
 
-	return &AgreementSender{DB: DB, identity: identity, overlay: overlay}, nil
+	return &AgreementSender{
+		DB:        DB,
+		overlay:   overlay,
+		transport: transport.NewClient(identity),
+		backoff:   make(map[czarcoin.NodeID]*backoff),
+	}, nil
 }
 
-// Run the afreement sender with a context to cehck for cancel
+// Run the agreement sender with a context to check for cancel
 func (as *AgreementSender) Run(ctx context.Context) error {
 	zap.S().Info("AgreementSender is starting up")
 
-	type agreementGroup struct {
-		satellite  czarcoin.NodeID
-		agreements []*psdb.Agreement
-	}
-
-	c := make(chan *agreementGroup, 1)
-
 	ticker := time.NewTicker(*defaultCheckInterval)
 	defer ticker.Stop()
-	go func() {
-		for range ticker.C {
-			agreementGroups, err := as.DB.GetBandwidthAllocations()
-			if err != nil {
-				zap.S().Error(err)
-				continue
-			}
-
-			// Send agreements in groups by satellite id to open less connections
-			for satellite, agreements := range agreementGroups {
-				c <- &agreementGroup{satellite, agreements}
-			}
-		}
-	}()
 
+	maxSkip := int(*defaultMaxBackoff / *defaultCheckInterval)
 	for {
 		select {
 		case <-ctx.Done():
-			return utils.CombineErrors(as.errs...)
-		case agreementGroup := <-c:
-			go func() {
-				zap.S().Infof("Sending %v agreements to satellite %s\n", len(agreementGroup.agreements), agreementGroup.satellite)
-
-				// Get satellite ip from overlay by Lookup agreementGroup.satellite
-				satellite, err := as.overlay.Lookup(ctx, agreementGroup.satellite)
-				if err != nil {
-					zap.S().Error(err)
-					return
-				}
+			return nil
+		case <-ticker.C:
+			as.sendAgreements(ctx, maxSkip)
+		}
+	}
+}
 
-				// Create client from satellite ip
-				identOpt, err := as.identity.DialOption(czarcoin.NodeID{})
-				if err != nil {
-					zap.S().Error(err)
-					return
-				}
+// sendAgreements settles the agreements of every satellite which isn't backed off
+func (as *AgreementSender) sendAgreements(ctx context.Context, maxSkip int) {
+	agreementGroups, err := as.DB.GetBandwidthAllocations()
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
 
-				conn, err := grpc.Dial(satellite.GetAddress().Address, identOpt)
-				if err != nil {
-					zap.S().Error(err)
-					return
-				}
+	// Send agreements in groups by satellite id to open less connections
+	for satellite, agreements := range agreementGroups {
+		retry := as.backoff[satellite]
+		if retry.wait() {
+			continue
+		}
+
+		zap.S().Infof("Sending %v agreements to satellite %s", len(agreements), satellite)
+		if err := as.sendGroup(ctx, satellite, agreements); err != nil {
+			if retry == nil {
+				retry = &backoff{}
+				as.backoff[satellite] = retry
+			}
+			retry.fail(maxSkip)
+			zap.S().Errorf("Failed to send agreements to satellite %s, retrying in %d checks: %+v", satellite, retry.skip, err)
+			continue
+		}
+		delete(as.backoff, satellite)
+	}
+}
 
-				client := pb.NewBandwidthClient(conn)
+// sendGroup dials the satellite and settles the agreements with it
+func (as *AgreementSender) sendGroup(ctx context.Context, satellite czarcoin.NodeID, agreements []*psdb.Agreement) (err error) {
+	node, err := as.overlay.Lookup(ctx, satellite)
+	if err != nil {
+		return ASError.Wrap(err)
+	}
 
-				for _, agreement := range agreementGroup.agreements {
+	conn, err := as.transport.DialNode(ctx, node)
+	if err != nil {
+		return ASError.Wrap(err)
+	}
+	defer func() { err = utils.CombineErrors(err, conn.Close()) }()
 
-					msg := &pb.RenterBandwidthAllocation{
-						Data:      agreement.Agreement,
-						Signature: agreement.Signature,
-					}
+	return settle(ctx, as.DB, pb.NewBandwidthClient(conn), agreements, *defaultBatchSize)
+}
 
-					// Send agreement to satellite
-					r, err := client.BandwidthAgreements(ctx, msg)
-					if err != nil || r.GetStatus() != pb.AgreementsSummary_OK {
-						zap.S().Errorf("Failed to send agreement to satellite: %+v", err)
-						return
-					}
+// settle sends the agreements in batches and deletes the settled and rejected
+// ones, it fails when any agreement couldn't be settled
+func settle(ctx context.Context, db *psdb.DB, client pb.BandwidthClient, agreements []*psdb.Agreement, batchSize int) error {
+	var failed int
+	for len(agreements) > 0 {
+		batch := agreements
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		agreements = agreements[len(batch):]
+
+		req := &pb.SettleRequest{}
+		for _, agreement := range batch {
+			req.Agreements = append(req.Agreements, &pb.RenterBandwidthAllocation{
+				Data:      agreement.Agreement,
+				Signature: agreement.Signature,
+			})
+		}
+
+		resp, err := client.SettleAgreements(ctx, req)
+		if err != nil {
+			return ASError.Wrap(err)
+		}
+		if len(resp.GetSummaries()) != len(batch) {
+			return ASError.New("expected %d summaries, got %d", len(batch), len(resp.GetSummaries()))
+		}
 
-					// Delete from PSDB by signature
-					if err = as.DB.DeleteBandwidthAllocationBySignature(agreement.Signature); err != nil {
-						zap.S().Error(err)
-						return
-					}
+		for i, summary := range resp.GetSummaries() {
+			if summary.GetStatus() != pb.AgreementsSummary_OK {
+				if summary.GetReason() == pb.AgreementsSummary_NONE {
+					failed++
+					continue
 				}
-			}()
+				// the satellite will never accept the agreement
+				zap.S().Warnf("Satellite rejected agreement: %s", summary.GetReason())
+			}
+			if err := db.DeleteBandwidthAllocationBySignature(batch[i].Signature); err != nil {
+				return ASError.Wrap(err)
+			}
 		}
 	}
+	if failed > 0 {
+		return ASError.New("%d agreements failed to settle", failed)
+	}
+	return nil
+}
+
+// backoff skips the checks of a satellite exponentially after failures
+type backoff struct {
+	failures int
+	skip     int
+}
+
+// wait returns whether this check should be skipped
+func (b *backoff) wait() bool {
+	if b == nil || b.skip <= 0 {
+		return false
+	}
+	b.skip--
+	return true
+}
+
+// fail doubles the number of checks to skip, up to maxSkip
+func (b *backoff) fail(maxSkip int) {
+	b.failures++
+	b.skip = maxSkip
+	if b.failures < 31 && 1<<uint(b.failures)-1 < maxSkip {
+		b.skip = 1<<uint(b.failures) - 1
+	}
 }
@@ -0,0 +1,92 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package agreementsender
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/piecestore/psserver/psdb"
+)
+
+// fakeBandwidthClient answers every agreement with the summary of its signature
+type fakeBandwidthClient struct {
+	pb.BandwidthClient
+	summaries map[string]*pb.AgreementsSummary
+	batches   []int
+}
+
+func (client *fakeBandwidthClient) SettleAgreements(ctx context.Context, req *pb.SettleRequest, opts ...grpc.CallOption) (*pb.SettleResponse, error) {
+	client.batches = append(client.batches, len(req.Agreements))
+	resp := &pb.SettleResponse{}
+	for _, agreement := range req.Agreements {
+		resp.Summaries = append(resp.Summaries, client.summaries[string(agreement.Signature)])
+	}
+	return resp, nil
+}
+
+func TestSettle(t *testing.T) {
+	ctx := context.Background()
+	db, err := psdb.OpenInMemory(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	satellite := testczarcoin.NodeIDFromString("satellite")
+	payerData, err := proto.Marshal(&pb.PayerBandwidthAllocation_Data{SatelliteId: satellite})
+	assert.NoError(t, err)
+	renterData, err := proto.Marshal(&pb.RenterBandwidthAllocation_Data{
+		PayerAllocation: &pb.PayerBandwidthAllocation{Data: payerData},
+	})
+	assert.NoError(t, err)
+
+	client := &fakeBandwidthClient{summaries: map[string]*pb.AgreementsSummary{
+		"settled":  {Status: pb.AgreementsSummary_OK},
+		"rejected": {Status: pb.AgreementsSummary_FAIL, Reason: pb.AgreementsSummary_REPLAYED},
+		"failed":   {Status: pb.AgreementsSummary_FAIL},
+	}}
+	for signature := range client.summaries {
+		err := db.WriteBandwidthAllocToDB(&pb.RenterBandwidthAllocation{Data: renterData, Signature: []byte(signature)})
+		assert.NoError(t, err)
+	}
+
+	groups, err := db.GetBandwidthAllocations()
+	assert.NoError(t, err)
+	assert.Len(t, groups[satellite], 3)
+
+	err = settle(ctx, db, client, groups[satellite], 2)
+	assert.Error(t, err)
+	assert.Equal(t, []int{2, 1}, client.batches)
+
+	// only the agreement which failed is kept for a retry
+	groups, err = db.GetBandwidthAllocations()
+	assert.NoError(t, err)
+	if assert.Len(t, groups[satellite], 1) {
+		assert.Equal(t, []byte("failed"), groups[satellite][0].Signature)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	var retry *backoff
+	assert.False(t, retry.wait())
+
+	retry = &backoff{}
+	var skipped []int
+	for i := 0; i < 5; i++ {
+		retry.fail(10)
+		skip := 0
+		for retry.wait() {
+			skip++
+		}
+		skipped = append(skipped, skip)
+	}
+	assert.Equal(t, []int{1, 3, 7, 10, 10}, skipped)
+}
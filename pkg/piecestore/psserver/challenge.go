@@ -0,0 +1,79 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"database/sql"
+	"io"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/pkg/audit/challenge"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/piecestore"
+	"czarcoin.org/czarcoin/pkg/utils"
+)
+
+// ChallengeError is a type of error for failures in Server.Challenge()
+var ChallengeError = errs.Class("challenge error")
+
+// maxChallengeShareSize limits how much of a piece is read for a challenge
+const maxChallengeShareSize = 1 << 20
+
+// Challenge answers an audit challenge with the keyed hash of an erasure
+// share, proving that the share is stored without sending it
+func (s *Server) Challenge(ctx context.Context, in *pb.ChallengeRequest) (_ *pb.ChallengeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	authorization := in.GetAuthorization()
+	if err := s.verifier(authorization); err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	id, err := pstore.NamespacedID([]byte(in.GetId()), getNamespace(authorization))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkID(id); err != nil {
+		return nil, err
+	}
+
+	shareSize := in.GetShareSize()
+	if shareSize <= 0 || shareSize > maxChallengeShareSize {
+		return nil, pstore.ArgError.New("invalid share size: %v", shareSize)
+	}
+	if len(in.GetNonce()) == 0 {
+		return nil, ChallengeError.New("nonce not specified")
+	}
+
+	ref, size, err := s.DB.GetPiece(id)
+	if err == sql.ErrNoRows {
+		return nil, ChallengeError.New("piece %s not found", in.GetId())
+	}
+	if err != nil {
+		return nil, ChallengeError.Wrap(err)
+	}
+
+	offset := in.GetStripeIndex() * shareSize
+	if in.GetStripeIndex() < 0 || offset+shareSize > size {
+		return nil, pstore.ArgError.New("invalid stripe index: %v", in.GetStripeIndex())
+	}
+
+	blob, err := s.storage.Load(ctx, ref)
+	if err != nil {
+		return nil, ChallengeError.Wrap(err)
+	}
+	defer utils.LogClose(blob)
+
+	share := make([]byte, shareSize)
+	if _, err := io.ReadFull(io.NewSectionReader(blob, offset, shareSize), share); err != nil {
+		return nil, ChallengeError.Wrap(err)
+	}
+
+	zap.S().Debugf("Answered challenge for stripe %d of %s", in.GetStripeIndex(), in.GetId())
+	return &pb.ChallengeResponse{Hash: challenge.Hash(in.GetNonce(), share)}, nil
+}
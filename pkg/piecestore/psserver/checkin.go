@@ -0,0 +1,108 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"czarcoin.org/czarcoin/pkg/auth"
+	"czarcoin.org/czarcoin/pkg/dht"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
+	"czarcoin.org/czarcoin/pkg/transport"
+	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/pkg/version"
+)
+
+// checkInRetryInterval is how long to wait before retrying a failed check-in
+const checkInRetryInterval = time.Minute
+
+// CheckInConfig is a configuration struct for reporting the node to the satellite
+type CheckInConfig struct {
+	SatelliteAddr string        `help:"address of the satellite to check in with, check-ins are disabled when empty" default:""`
+	Interval      time.Duration `help:"how often to check in with the satellite" default:"1h"`
+}
+
+// checkIn signs the current capacity of the node and sends it to the satellite
+func (s *Server) checkIn(ctx context.Context, client pb.CheckInClient, identity *provider.FullIdentity, self pb.Node) (resp *pb.CheckInResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats, err := s.Stats(ctx, &pb.StatsReq{})
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	info, err := proto.Marshal(&pb.CheckInInfo{
+		Node: &self,
+		Capacity: &pb.NodeRestrictions{
+			FreeBandwidth: stats.AvailableBandwidth,
+			FreeDisk:      stats.AvailableSpace,
+		},
+		Version:          version.Version,
+		TimestampUnixSec: time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+	signature, err := auth.GenerateSignature(info, identity)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	return client.CheckIn(ctx, &pb.CheckInRequest{Info: info, Signature: signature})
+}
+
+// runCheckIns checks in with the satellite every interval until ctx is
+// canceled, failed dials and check-ins are retried sooner. The node is read
+// from the routing table on every check-in so address changes are reported.
+func (s *Server) runCheckIns(ctx context.Context, config CheckInConfig, identity *provider.FullIdentity, rt dht.RoutingTable) {
+	retryInterval := checkInRetryInterval
+	if config.Interval < retryInterval {
+		retryInterval = config.Interval
+	}
+
+	var conn *grpc.ClientConn
+	defer func() {
+		if conn != nil {
+			utils.LogClose(conn)
+		}
+	}()
+
+	for {
+		wait := retryInterval
+		if conn == nil {
+			var err error
+			conn, err = transport.NewClient(identity).DialAddress(ctx, config.SatelliteAddr)
+			if err != nil {
+				zap.S().Errorf("Failed dialing satellite %s for check-ins: %v", config.SatelliteAddr, err)
+				conn = nil
+			}
+		}
+
+		if conn != nil {
+			self := rt.Local()
+			resp, err := s.checkIn(ctx, pb.NewCheckInClient(conn), identity, self)
+			switch {
+			case err != nil:
+				zap.S().Errorf("Failed checking in with satellite: %v", err)
+			case !resp.PingbackSuccess:
+				zap.S().Warnf("Satellite can't reach this node at %s: %s", self.GetAddress().GetAddress(), resp.PingbackError)
+			default:
+				zap.S().Info("Checked in with satellite")
+				wait = config.Interval
+			}
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/pkg/piecestore"
+	"czarcoin.org/czarcoin/pkg/piecestore/psserver/psdb"
+	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/storage"
+)
+
+// migrateLegacyPieces moves pieces stored by pstore under dir into blobs
+// and indexes them in db. Every piece is removed from dir only after it
+// has been committed, so an interrupted migration can be resumed.
+func migrateLegacyPieces(ctx context.Context, dir string, blobs storage.Blobs, db *psdb.DB) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+
+	zap.S().Infof("Migrating pieces from %s...", dir)
+
+	var migrated int
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		// pstore.PathByID splits the id into two folders and the file name
+		id := strings.Replace(filepath.ToSlash(rel), "/", "", -1)
+		if len(id) < pstore.IDLength {
+			zap.S().Warnf("Skipping unknown file %s", path)
+			return nil
+		}
+
+		if err := migratePiece(ctx, id, path, info.Size(), blobs, db); err != nil {
+			return err
+		}
+
+		migrated++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	zap.S().Infof("Migrated %d pieces.", migrated)
+
+	return os.RemoveAll(dir)
+}
+
+// migratePiece stores the piece at path in blobs and indexes it as id
+func migratePiece(ctx context.Context, id, path string, size int64, blobs storage.Blobs, db *psdb.DB) error {
+	// the piece was committed before the migration got interrupted
+	if _, _, err := db.GetPiece(id); err == nil {
+		return os.Remove(path)
+	} else if err != sql.ErrNoRows {
+		return err
+	}
+
+	expiration, err := db.GetTTLByID(id)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	hasher := sha256.New()
+	ref, err := blobs.Store(ctx, io.TeeReader(file, hasher), size)
+	if err != nil {
+		return utils.CombineErrors(err, file.Close())
+	}
+
+	if err := file.Close(); err != nil {
+		return utils.CombineErrors(err, blobs.Delete(ctx, ref))
+	}
+
+	// the satellite of legacy pieces is unknown, so they are never trashed
+	if err := db.AddPiece(id, nil, ref, hasher.Sum(nil), expiration, size); err != nil {
+		return utils.CombineErrors(err, blobs.Delete(ctx, ref))
+	}
+
+	return os.Remove(path)
+}
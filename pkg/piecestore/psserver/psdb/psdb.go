@@ -20,10 +20,10 @@ import (
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
-	"czarcoin.org/czarcoin/pkg/pb"
-	pstore "czarcoin.org/czarcoin/pkg/piecestore"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/storage"
 )
 
 var (
@@ -32,14 +32,15 @@ var (
 	Error = errs.Class("psdb")
 
 	defaultCheckInterval = flag.Duration("piecestore.ttl.check_interval", time.Hour, "number of seconds to sleep between ttl checks")
+	trashExpiration      = flag.Duration("piecestore.trash.expiration", 7*24*time.Hour, "how long trashed pieces are kept before they are deleted")
 )
 
 // DB is a piece store database
 type DB struct {
-	dataPath string
-	mu       sync.Mutex
-	DB       *sql.DB // TODO: hide
-	check    *time.Ticker
+	blobs storage.Blobs
+	mu    sync.Mutex
+	DB    *sql.DB // TODO: hide
+	check *time.Ticker
 }
 
 // Agreement is a struct that contains a bandwidth agreement and the associated signature
@@ -48,8 +49,8 @@ type Agreement struct {
 	Signature []byte
 }
 
-// Open opens DB at DBPath
-func Open(ctx context.Context, dataPath, DBPath string) (db *DB, err error) {
+// Open opens DB at DBPath, blobs is used to delete the data of expired pieces
+func Open(ctx context.Context, blobs storage.Blobs, DBPath string) (db *DB, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err = os.MkdirAll(filepath.Dir(DBPath), 0700); err != nil {
@@ -61,9 +62,9 @@ func Open(ctx context.Context, dataPath, DBPath string) (db *DB, err error) {
 		return nil, Error.Wrap(err)
 	}
 	db = &DB{
-		DB:       sqlite,
-		dataPath: dataPath,
-		check:    time.NewTicker(*defaultCheckInterval),
+		DB:    sqlite,
+		blobs: blobs,
+		check: time.NewTicker(*defaultCheckInterval),
 	}
 	if err := db.init(); err != nil {
 		return nil, utils.CombineErrors(err, db.DB.Close())
@@ -75,7 +76,7 @@ func Open(ctx context.Context, dataPath, DBPath string) (db *DB, err error) {
 }
 
 // OpenInMemory opens sqlite DB inmemory
-func OpenInMemory(ctx context.Context, dataPath string) (db *DB, err error) {
+func OpenInMemory(ctx context.Context, blobs storage.Blobs) (db *DB, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	sqlite, err := sql.Open("sqlite3", ":memory:")
@@ -84,9 +85,9 @@ func OpenInMemory(ctx context.Context, dataPath string) (db *DB, err error) {
 	}
 
 	db = &DB{
-		DB:       sqlite,
-		dataPath: dataPath,
-		check:    time.NewTicker(*defaultCheckInterval),
+		DB:    sqlite,
+		blobs: blobs,
+		check: time.NewTicker(*defaultCheckInterval),
 	}
 	if err := db.init(); err != nil {
 		return nil, utils.CombineErrors(err, db.DB.Close())
@@ -125,6 +126,28 @@ func (db *DB) init() (err error) {
 		return err
 	}
 
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `pieces` (`id` BLOB UNIQUE, `satellite` BLOB, `blobref` BLOB, `hash` BLOB, `size` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	// trash holds pieces which the satellite no longer references until they are deleted
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `trash` (`id` BLOB, `blobref` BLOB, `size` INT(10), `trashed` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	// usedspace holds a single row with the running total of piece sizes
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `usedspace` (`id` INT PRIMARY KEY, `total` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("INSERT OR IGNORE INTO `usedspace` (`id`, `total`) VALUES (0, 0);")
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -150,7 +173,7 @@ func (db *DB) locked() func() {
 func (db *DB) DeleteExpired(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var expired []string
+	var expired []storage.BlobRef
 	err = func() error {
 		defer db.locked()()
 
@@ -162,33 +185,47 @@ func (db *DB) DeleteExpired(ctx context.Context) (err error) {
 
 		now := time.Now().Unix()
 
-		rows, err := tx.Query("SELECT id FROM ttl WHERE 0 < expires AND ? < expires", now)
+		rows, err := tx.Query("SELECT id FROM ttl WHERE 0 < expires AND expires < ?", now)
 		if err != nil {
 			return err
 		}
 
+		var ids []string
 		for rows.Next() {
 			var id string
 			if err := rows.Scan(&id); err != nil {
-				return err
+				return utils.CombineErrors(err, rows.Close())
 			}
-			expired = append(expired, id)
+			ids = append(ids, id)
 		}
 		if err := rows.Close(); err != nil {
 			return err
 		}
 
-		_, err = tx.Exec(`DELETE FROM ttl WHERE 0 < expires AND ? < expires`, now)
+		for _, id := range ids {
+			ref, found, err := deletePiece(tx, id)
+			if err != nil {
+				return err
+			}
+			if found {
+				expired = append(expired, ref)
+			}
+		}
+
+		_, err = tx.Exec(`DELETE FROM ttl WHERE 0 < expires AND expires < ?`, now)
 		if err != nil {
 			return err
 		}
 
 		return tx.Commit()
 	}()
+	if err != nil {
+		return Error.Wrap(err)
+	}
 
 	var errs []error
-	for _, id := range expired {
-		err := pstore.Delete(id, db.dataPath)
+	for _, ref := range expired {
+		err := db.blobs.Delete(ctx, ref)
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -201,13 +238,18 @@ func (db *DB) DeleteExpired(ctx context.Context) (err error) {
 	return nil
 }
 
-// garbageCollect will periodically run DeleteExpired
+// garbageCollect will periodically run DeleteExpired and EmptyTrash
 func (db *DB) garbageCollect(ctx context.Context) {
 	for range db.check.C {
 		err := db.DeleteExpired(ctx)
 		if err != nil {
 			zap.S().Errorf("failed checking entries: %+v", err)
 		}
+
+		err = db.EmptyTrash(ctx, time.Now().Add(-*trashExpiration).Unix())
+		if err != nil {
+			zap.S().Errorf("failed emptying trash: %+v", err)
+		}
 	}
 }
 
@@ -305,6 +347,226 @@ func (db *DB) GetBandwidthAllocations() (map[czarcoin.NodeID][]*Agreement, error
 	return agreements, nil
 }
 
+// AddPiece atomically records the blob holding piece id of satellite together
+// with its hash and expiration and adds its size to the used space
+func (db *DB) AddPiece(id string, satellite []byte, ref storage.BlobRef, hash []byte, expiration, size int64) error {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.Exec("INSERT INTO pieces (id, satellite, blobref, hash, size) VALUES (?, ?, ?, ?, ?)", id, satellite, ref[:], hash, size)
+	if err != nil {
+		return err
+	}
+
+	created := time.Now().Unix()
+	_, err = tx.Exec("INSERT OR REPLACE INTO ttl (id, created, expires, size) VALUES (?, ?, ?, ?)", id, created, expiration, size)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE usedspace SET total = total + ? WHERE id = 0", size)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetPiece finds the blob reference and size of piece id
+func (db *DB) GetPiece(id string) (ref storage.BlobRef, size int64, err error) {
+	defer db.locked()()
+
+	var blobref []byte
+	err = db.DB.QueryRow(`SELECT blobref, size FROM pieces WHERE id=?`, id).Scan(&blobref, &size)
+	if err != nil {
+		return ref, 0, err
+	}
+	if len(blobref) != len(ref) {
+		return ref, 0, Error.New("invalid blob reference for %q", id)
+	}
+	copy(ref[:], blobref)
+	return ref, size, nil
+}
+
+// GetPieceHash finds the hash of piece id, which was verified when it was stored
+func (db *DB) GetPieceHash(id string) (hash []byte, err error) {
+	defer db.locked()()
+
+	err = db.DB.QueryRow(`SELECT hash FROM pieces WHERE id=?`, id).Scan(&hash)
+	return hash, err
+}
+
+// DeletePiece removes piece id from the index and its TTL, returning the
+// reference to the blob which should be deleted
+func (db *DB) DeletePiece(id string) (ref storage.BlobRef, found bool, err error) {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return ref, false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	ref, found, err = deletePiece(tx, id)
+	if err != nil {
+		return ref, false, err
+	}
+
+	_, err = tx.Exec(`DELETE FROM ttl WHERE id=?`, id)
+	if err != nil {
+		return ref, false, err
+	}
+
+	return ref, found, tx.Commit()
+}
+
+// deletePiece removes piece id from the index inside tx and subtracts its size from the used space
+func deletePiece(tx *sql.Tx, id string) (ref storage.BlobRef, found bool, err error) {
+	var blobref []byte
+	var size int64
+	err = tx.QueryRow(`SELECT blobref, size FROM pieces WHERE id=?`, id).Scan(&blobref, &size)
+	if err == sql.ErrNoRows {
+		return ref, false, nil
+	}
+	if err != nil {
+		return ref, false, err
+	}
+	copy(ref[:], blobref)
+
+	if _, err = tx.Exec(`DELETE FROM pieces WHERE id=?`, id); err != nil {
+		return ref, false, err
+	}
+
+	if _, err = tx.Exec("UPDATE usedspace SET total = total - ? WHERE id = 0", size); err != nil {
+		return ref, false, err
+	}
+
+	return ref, true, nil
+}
+
+// TrashPieces moves the pieces of satellite which were created before the
+// given unix time and are not retained to the trash, their data is kept
+// until EmptyTrash deletes it
+func (db *DB) TrashPieces(ctx context.Context, satellite []byte, createdBefore int64, retain func(id string) bool) (trashed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer db.locked()()
+
+	tx, err := db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.Query(`SELECT pieces.id FROM pieces JOIN ttl ON pieces.id = ttl.id WHERE pieces.satellite = ? AND ttl.created < ?`, satellite, createdBefore)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return 0, Error.Wrap(utils.CombineErrors(err, rows.Close()))
+		}
+		if !retain(id) {
+			ids = append(ids, id)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	now := time.Now().Unix()
+	for _, id := range ids {
+		_, err = tx.Exec(`INSERT INTO trash (id, blobref, size, trashed) SELECT id, blobref, size, ? FROM pieces WHERE id=?`, now, id)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		if _, err = tx.Exec(`DELETE FROM pieces WHERE id=?`, id); err != nil {
+			return 0, Error.Wrap(err)
+		}
+		if _, err = tx.Exec(`DELETE FROM ttl WHERE id=?`, id); err != nil {
+			return 0, Error.Wrap(err)
+		}
+	}
+
+	return int64(len(ids)), Error.Wrap(tx.Commit())
+}
+
+// EmptyTrash deletes the data of pieces trashed before the given unix time
+func (db *DB) EmptyTrash(ctx context.Context, trashedBefore int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var trashed []storage.BlobRef
+	err = func() error {
+		defer db.locked()()
+
+		tx, err := db.DB.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		rows, err := tx.Query(`SELECT blobref, size FROM trash WHERE trashed < ?`, trashedBefore)
+		if err != nil {
+			return err
+		}
+
+		var total int64
+		for rows.Next() {
+			var blobref []byte
+			var size int64
+			if err := rows.Scan(&blobref, &size); err != nil {
+				return utils.CombineErrors(err, rows.Close())
+			}
+
+			var ref storage.BlobRef
+			copy(ref[:], blobref)
+			trashed = append(trashed, ref)
+			total += size
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+
+		if _, err = tx.Exec(`DELETE FROM trash WHERE trashed < ?`, trashedBefore); err != nil {
+			return err
+		}
+
+		if _, err = tx.Exec("UPDATE usedspace SET total = total - ? WHERE id = 0", total); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	}()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var errs []error
+	for _, ref := range trashed {
+		err := db.blobs.Delete(ctx, ref)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return utils.CombineErrors(errs...)
+}
+
+// UsedSpace returns the total size of all stored pieces
+func (db *DB) UsedSpace() (total int64, err error) {
+	defer db.locked()()
+
+	err = db.DB.QueryRow(`SELECT total FROM usedspace WHERE id = 0`).Scan(&total)
+	return total, err
+}
+
 // AddTTL adds TTL into database by id
 func (db *DB) AddTTL(id string, expiration, size int64) error {
 	defer db.locked()()
@@ -6,6 +6,7 @@ package psdb
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -17,8 +18,10 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 
 	"czarcoin.org/czarcoin/internal/testczarcoin"
-	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/storage"
+	"czarcoin.org/czarcoin/storage/filestore"
 )
 
 var ctx = context.Background()
@@ -32,7 +35,7 @@ func newDB(t testing.TB) (*DB, func()) {
 	}
 	dbpath := filepath.Join(tmpdir, "psdb.db")
 
-	db, err := Open(ctx, "", dbpath)
+	db, err := Open(ctx, nil, dbpath)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -51,7 +54,7 @@ func newDB(t testing.TB) (*DB, func()) {
 }
 
 func TestNewInmemory(t *testing.T) {
-	db, err := OpenInMemory(context.Background(), "")
+	db, err := OpenInMemory(context.Background(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -231,6 +234,74 @@ func TestHappyPath(t *testing.T) {
 	})
 }
 
+func TestPieces(t *testing.T) {
+	db, cleanup := newDB(t)
+	defer cleanup()
+
+	pieces := []struct {
+		ID   string
+		Ref  storage.BlobRef
+		Size int64
+	}{
+		{ID: "11111111111111111111", Ref: storage.BlobRef{1}, Size: 5},
+		{ID: "22222222222222222222", Ref: storage.BlobRef{2}, Size: 1024},
+	}
+
+	var total int64
+	for _, piece := range pieces {
+		if err := db.AddPiece(piece.ID, nil, piece.Ref, nil, 0, piece.Size); err != nil {
+			t.Fatal(err)
+		}
+		total += piece.Size
+	}
+
+	if err := db.AddPiece(pieces[0].ID, nil, storage.BlobRef{3}, nil, 0, 10); err == nil {
+		t.Fatal("expected adding a duplicate piece to fail")
+	}
+
+	used, err := db.UsedSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used != total {
+		t.Fatalf("expected used space %d got %d", total, used)
+	}
+
+	for _, piece := range pieces {
+		ref, size, err := db.GetPiece(piece.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ref != piece.Ref || size != piece.Size {
+			t.Fatalf("expected %x/%d got %x/%d", piece.Ref, piece.Size, ref, size)
+		}
+	}
+
+	ref, found, err := db.DeletePiece(pieces[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || ref != pieces[0].Ref {
+		t.Fatalf("expected to delete %x got %x", pieces[0].Ref, ref)
+	}
+
+	_, found, err = db.DeletePiece(pieces[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Fatal("expected piece to be already deleted")
+	}
+
+	used, err = db.UsedSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used != pieces[1].Size {
+		t.Fatalf("expected used space %d got %d", pieces[1].Size, used)
+	}
+}
+
 func TestBandwidthUsage(t *testing.T) {
 	db, cleanup := newDB(t)
 	defer cleanup()
@@ -325,3 +396,107 @@ func serialize(t testing.TB, v proto.Message) []byte {
 	}
 	return data
 }
+
+func TestTrash(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "czarcoin-psdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(tmpdir) }()
+
+	blobs, err := filestore.NewAt(filepath.Join(tmpdir, "blobs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := Open(ctx, blobs, filepath.Join(tmpdir, "psdb.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	satellite := []byte("satellite")
+	pieces := []struct {
+		ID        string
+		Satellite []byte
+		Retain    bool
+		Trashed   bool
+	}{
+		{ID: "11111111111111111111", Satellite: satellite, Retain: true},
+		{ID: "22222222222222222222", Satellite: satellite, Trashed: true},
+		{ID: "33333333333333333333", Satellite: []byte("other")},
+		{ID: "44444444444444444444", Satellite: nil},
+	}
+
+	refs := map[string]storage.BlobRef{}
+	for _, piece := range pieces {
+		ref, err := blobs.Store(ctx, bytes.NewReader([]byte("butts")), 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddPiece(piece.ID, piece.Satellite, ref, nil, 0, 5); err != nil {
+			t.Fatal(err)
+		}
+		refs[piece.ID] = ref
+	}
+
+	// pieces created after the filter are always kept
+	trashed, err := db.TrashPieces(ctx, satellite, time.Now().Add(-time.Hour).Unix(), func(string) bool { return false })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trashed != 0 {
+		t.Fatalf("expected no trashed pieces got %d", trashed)
+	}
+
+	trashed, err = db.TrashPieces(ctx, satellite, time.Now().Add(time.Hour).Unix(), func(id string) bool {
+		return id == pieces[0].ID
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trashed != 1 {
+		t.Fatalf("expected 1 trashed piece got %d", trashed)
+	}
+
+	for _, piece := range pieces {
+		_, _, err := db.GetPiece(piece.ID)
+		if piece.Trashed && err != sql.ErrNoRows {
+			t.Fatalf("expected %s to be trashed got %v", piece.ID, err)
+		}
+		if !piece.Trashed && err != nil {
+			t.Fatalf("expected %s to be kept got %v", piece.ID, err)
+		}
+	}
+
+	// the data of trashed pieces is kept until the trash is emptied
+	if err := db.EmptyTrash(ctx, time.Now().Add(-time.Hour).Unix()); err != nil {
+		t.Fatal(err)
+	}
+	reader, err := blobs.Load(ctx, refs[pieces[1].ID])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reader.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.EmptyTrash(ctx, time.Now().Add(time.Hour).Unix()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := blobs.Load(ctx, refs[pieces[1].ID]); err == nil {
+		t.Fatal("expected trashed piece to be deleted")
+	}
+
+	used, err := db.UsedSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used != 15 {
+		t.Fatalf("expected used space 15 got %d", used)
+	}
+}
@@ -36,6 +36,7 @@ type StreamReader struct {
 	src                 *utils.ReaderSource
 	bandwidthAllocation *pb.RenterBandwidthAllocation
 	currentTotal        int64
+	hash                []byte
 }
 
 // NewStreamReader returns a new StreamReader for Server.Store
@@ -51,6 +52,11 @@ func NewStreamReader(s *Server, stream pb.PieceStoreRoutes_StoreServer) *StreamR
 		pd := recv.GetPieceData()
 		ba := recv.GetBandwidthAllocation()
 
+		// the hash of the whole piece is sent after the content
+		if hash := pd.GetHash(); len(hash) > 0 {
+			sr.hash = hash
+		}
+
 		if ba != nil {
 			if err = s.verifySignature(stream.Context(), ba); err != nil {
 				return nil, err
@@ -0,0 +1,80 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gtank/cryptopasta"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/pkg/bloomfilter"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/peertls"
+	"czarcoin.org/czarcoin/pkg/provider"
+)
+
+// RetainError is a type of error for failures in Server.Retain()
+var RetainError = errs.Class("retain error")
+
+// Retain moves the pieces of the calling satellite which are older than its
+// filter and not contained in it to the trash
+func (s *Server) Retain(ctx context.Context, in *pb.RetainRequest) (_ *pb.RetainSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := s.verifyRetain(ctx, in)
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+
+	filter, err := bloomfilter.NewFromBytes(data.GetFilter())
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+
+	zap.S().Infof("Retaining pieces of %s created before %d...", data.SatelliteId, data.GetCreatedUnixSec())
+
+	trashed, err := s.DB.TrashPieces(ctx, data.SatelliteId.Bytes(), data.GetCreatedUnixSec(), func(id string) bool {
+		return filter.Contains([]byte(id))
+	})
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+
+	zap.S().Infof("Moved %d pieces of %s to the trash.", trashed, data.SatelliteId)
+	return &pb.RetainSummary{Trashed: trashed}, nil
+}
+
+// verifyRetain checks that the retain request was signed by the satellite
+// which sent it and returns its data
+func (s *Server) verifyRetain(ctx context.Context, in *pb.RetainRequest) (*pb.RetainRequest_Data, error) {
+	pi, err := provider.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	k, ok := pi.Leaf.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, peertls.ErrUnsupportedKey.New("%T", pi.Leaf.PublicKey)
+	}
+
+	if ok := cryptopasta.Verify(in.GetData(), in.GetSignature(), k); !ok {
+		return nil, RetainError.New("failed to verify signature")
+	}
+
+	data := &pb.RetainRequest_Data{}
+	if err := proto.Unmarshal(in.GetData(), data); err != nil {
+		return nil, err
+	}
+
+	// pieces are only trashed for the satellite which stored them
+	if data.SatelliteId != pi.ID {
+		return nil, RetainError.New("filter of %s sent by %s", data.SatelliteId, pi.ID)
+	}
+
+	return data, nil
+}
@@ -5,10 +5,10 @@ package psserver
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"log"
-	"os"
 	"sync/atomic"
 
 	"github.com/gogo/protobuf/proto"
@@ -19,6 +19,7 @@ import (
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/piecestore"
 	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/storage"
 )
 
 // RetrieveError is a type of error for failures in Server.Retrieve()
@@ -50,33 +51,33 @@ func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error)
 
 	zap.S().Infof("Retrieving %s...", pd.GetId())
 
-	id, err := getNamespacedPieceID([]byte(pd.GetId()), getNamespace(authorization))
+	id, err := pstore.NamespacedID([]byte(pd.GetId()), getNamespace(authorization))
 	if err != nil {
 		return err
 	}
 
-	// Get path to data being retrieved
-	path, err := pstore.PathByID(id, s.DataDir)
-	if err != nil {
+	if err := checkID(id); err != nil {
 		return err
 	}
 
-	// Verify that the path exists
-	fileInfo, err := os.Stat(path)
+	// Find the blob holding the data being retrieved
+	ref, fileSize, err := s.DB.GetPiece(id)
+	if err == sql.ErrNoRows {
+		return RetrieveError.New("piece %s not found", pd.GetId())
+	}
 	if err != nil {
 		return RetrieveError.Wrap(err)
 	}
 
 	// Read the size specified
 	totalToRead := pd.GetPieceSize()
-	fileSize := fileInfo.Size()
 
 	// Read the entire file if specified -1 but make sure we do it from the correct offset
 	if pd.GetPieceSize() <= -1 || totalToRead+pd.GetOffset() > fileSize {
 		totalToRead = fileSize - pd.GetOffset()
 	}
 
-	retrieved, allocated, err := s.retrieveData(ctx, stream, id, pd.GetOffset(), totalToRead)
+	retrieved, allocated, err := s.retrieveData(ctx, stream, ref, pd.GetOffset(), totalToRead)
 	if err != nil {
 		return err
 	}
@@ -85,15 +86,22 @@ func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error)
 	return nil
 }
 
-func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_RetrieveServer, id string, offset, length int64) (retrieved, allocated int64, err error) {
+func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_RetrieveServer, ref storage.BlobRef, offset, length int64) (retrieved, allocated int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	storeFile, err := pstore.RetrieveReader(ctx, id, offset, length, s.DataDir)
+	blob, err := s.storage.Load(ctx, ref)
 	if err != nil {
 		return 0, 0, err
 	}
 
-	defer utils.LogClose(storeFile)
+	defer utils.LogClose(blob)
+
+	// If offset is greater than blob size return
+	if offset >= blob.Size() || offset < 0 {
+		return 0, 0, pstore.ArgError.New("invalid offset: %v", offset)
+	}
+
+	storeFile := io.NewSectionReader(blob, offset, length)
 
 	writer := NewStreamWriter(s, stream)
 	allocationTracking := sync2.NewThrottle()
@@ -6,17 +6,13 @@ package psserver
 import (
 	"crypto"
 	"crypto/ecdsa"
-	"crypto/hmac"
-	"crypto/sha512"
-	"errors"
+	"database/sql"
 	"log"
-	"os"
 	"path/filepath"
 	"regexp"
 	"time"
 
 	"github.com/gtank/cryptopasta"
-	"github.com/mr-tron/base58/base58"
 	"github.com/shirou/gopsutil/disk"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -24,12 +20,16 @@ import (
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"czarcoin.org/czarcoin/pkg/auth"
+	"czarcoin.org/czarcoin/pkg/kademlia"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/peertls"
 	"czarcoin.org/czarcoin/pkg/piecestore"
 	as "czarcoin.org/czarcoin/pkg/piecestore/psserver/agreementsender"
 	"czarcoin.org/czarcoin/pkg/piecestore/psserver/psdb"
 	"czarcoin.org/czarcoin/pkg/provider"
+	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/storage"
+	"czarcoin.org/czarcoin/storage/filestore"
 )
 
 var (
@@ -44,6 +44,10 @@ type Config struct {
 	Path               string `help:"path to store data in" default:"$CONFDIR"`
 	AllocatedDiskSpace int64  `help:"total allocated disk space, default(1GB)" default:"1073741824"`
 	AllocatedBandwidth int64  `help:"total allocated bandwidth, default(100GB)" default:"107374182400"`
+
+	UploadExpiration time.Duration `help:"how long the data of interrupted uploads is kept for resuming" default:"24h"`
+
+	CheckIn CheckInConfig
 }
 
 // Run implements provider.Responsibility
@@ -70,6 +74,20 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 		}
 	}()
 
+	go s.collectUploads(ctx, c.UploadExpiration)
+
+	if c.CheckIn.SatelliteAddr != "" {
+		kad := kademlia.LoadFromContext(ctx)
+		if kad == nil {
+			return ServerError.New("programmer error: kademlia responsibility unstarted")
+		}
+		rt, err := kad.GetRoutingTable(ctx)
+		if err != nil {
+			return ServerError.Wrap(err)
+		}
+		go s.runCheckIns(ctx, c.CheckIn, server.Identity(), rt)
+	}
+
 	defer func() {
 		log.Fatal(s.Stop(ctx))
 	}()
@@ -77,27 +95,10 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 	return server.Run(ctx)
 }
 
-//DirSize returns the total size of the files in that directory
-func DirSize(path string) (int64, error) {
-	var size int64
-	_, err := os.Stat(path)
-	if err != nil {
-		return 0, errors.New("path doesn't exists")
-	}
-	adjSize := func(_ string, info os.FileInfo, err error) error {
-		if !info.IsDir() {
-			size += info.Size()
-		}
-		return err
-	}
-	err = filepath.Walk(path, adjSize)
-
-	return size, err
-}
-
 // Server -- GRPC server meta data used in route calls
 type Server struct {
-	DataDir          string
+	storage          storage.Blobs
+	uploads          *uploads
 	DB               *psdb.DB
 	pkey             crypto.PrivateKey
 	totalAllocated   int64
@@ -108,7 +109,10 @@ type Server struct {
 // Initialize -- initializes a server struct
 func Initialize(ctx context.Context, config Config, pkey crypto.PrivateKey) (*Server, error) {
 	dbPath := filepath.Join(config.Path, "piecestore.db")
-	dataDir := filepath.Join(config.Path, "piece-store-data")
+	blobDir := filepath.Join(config.Path, "piece-store-blobs")
+	// legacyDir contains pieces stored by id, before the blob store was used
+	legacyDir := filepath.Join(config.Path, "piece-store-data")
+	uploadDir := filepath.Join(config.Path, "piece-store-uploads")
 
 	// read the allocated disk space from the config file
 	allocatedDiskSpace := config.AllocatedDiskSpace
@@ -123,17 +127,33 @@ func Initialize(ctx context.Context, config Config, pkey crypto.PrivateKey) (*Se
 	}
 	freeDiskSpace := int64(diskSpace.Free)
 
-	db, err := psdb.Open(ctx, dataDir, dbPath)
+	blobs, err := filestore.NewAt(blobDir)
 	if err != nil {
 		return nil, ServerError.Wrap(err)
 	}
 
+	db, err := psdb.Open(ctx, blobs, dbPath)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	if err := migrateLegacyPieces(ctx, legacyDir, blobs, db); err != nil {
+		return nil, ServerError.Wrap(utils.CombineErrors(err, db.Close()))
+	}
+
 	// get how much is currently used, if for the first time totalUsed = 0
-	totalUsed, err := db.SumTTLSizes()
+	totalUsed, err := db.UsedSpace()
+	if err != nil {
+		return nil, ServerError.Wrap(utils.CombineErrors(err, db.Close()))
+	}
+
+	// uploads which are not committed yet take space too
+	uploads := newUploads(uploadDir)
+	partialUsed, err := uploads.size()
 	if err != nil {
-		//first time setup
-		totalUsed = 0x00
+		return nil, ServerError.Wrap(utils.CombineErrors(err, db.Close()))
 	}
+	totalUsed += partialUsed
 
 	// get used bandwidth from the beginning of the month to till date
 	usedBandwidth, err := db.GetTotalBandwidthBetween(getBeginningOfMonth(), time.Now())
@@ -169,7 +189,8 @@ func Initialize(ctx context.Context, config Config, pkey crypto.PrivateKey) (*Se
 	}
 
 	return &Server{
-		DataDir:          dataDir,
+		storage:          blobs,
+		uploads:          uploads,
 		DB:               db,
 		pkey:             pkey,
 		totalAllocated:   allocatedDiskSpace,
@@ -178,10 +199,11 @@ func Initialize(ctx context.Context, config Config, pkey crypto.PrivateKey) (*Se
 	}, nil
 }
 
-// New creates a Server with custom db
-func New(dataDir string, db *psdb.DB, config Config, pkey crypto.PrivateKey) *Server {
+// New creates a Server with custom blob storage and db
+func New(blobs storage.Blobs, db *psdb.DB, config Config, pkey crypto.PrivateKey) *Server {
 	return &Server{
-		DataDir:          dataDir,
+		storage:          blobs,
+		uploads:          newUploads(filepath.Join(config.Path, "piece-store-uploads")),
 		DB:               db,
 		pkey:             pkey,
 		totalAllocated:   config.AllocatedDiskSpace,
@@ -204,13 +226,12 @@ func (s *Server) Piece(ctx context.Context, in *pb.PieceId) (*pb.PieceSummary, e
 		return nil, ServerError.Wrap(err)
 	}
 
-	id, err := getNamespacedPieceID([]byte(in.GetId()), getNamespace(authorization))
+	id, err := pstore.NamespacedID([]byte(in.GetId()), getNamespace(authorization))
 	if err != nil {
 		return nil, err
 	}
 
-	path, err := pstore.PathByID(id, s.DataDir)
-	if err != nil {
+	if err := checkID(id); err != nil {
 		return nil, err
 	}
 
@@ -223,7 +244,10 @@ func (s *Server) Piece(ctx context.Context, in *pb.PieceId) (*pb.PieceSummary, e
 		return nil, ServerError.New("invalid ID")
 	}
 
-	fileInfo, err := os.Stat(path)
+	_, size, err := s.DB.GetPiece(id)
+	if err == sql.ErrNoRows {
+		return nil, ServerError.New("piece %s not found", in.GetId())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -234,15 +258,20 @@ func (s *Server) Piece(ctx context.Context, in *pb.PieceId) (*pb.PieceSummary, e
 		return nil, err
 	}
 
+	hash, err := s.DB.GetPieceHash(id)
+	if err != nil {
+		return nil, err
+	}
+
 	zap.S().Infof("Successfully retrieved meta for %s.", in.GetId())
-	return &pb.PieceSummary{Id: in.GetId(), PieceSize: fileInfo.Size(), ExpirationUnixSec: ttl}, nil
+	return &pb.PieceSummary{Id: in.GetId(), PieceSize: size, ExpirationUnixSec: ttl, Hash: hash}, nil
 }
 
 // Stats will return statistics about the Server
 func (s *Server) Stats(ctx context.Context, in *pb.StatsReq) (*pb.StatSummary, error) {
 	zap.S().Infof("Getting Stats...\n")
 
-	totalUsed, err := s.DB.SumTTLSizes()
+	totalUsed, err := s.usedSpace()
 	if err != nil {
 		return nil, err
 	}
@@ -264,11 +293,11 @@ func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (*pb.PieceDelet
 		return nil, ServerError.Wrap(err)
 	}
 
-	id, err := getNamespacedPieceID([]byte(in.GetId()), getNamespace(authorization))
+	id, err := pstore.NamespacedID([]byte(in.GetId()), getNamespace(authorization))
 	if err != nil {
 		return nil, err
 	}
-	if err := s.deleteByID(id); err != nil {
+	if err := s.deleteByID(ctx, id); err != nil {
 		return nil, err
 	}
 
@@ -276,20 +305,35 @@ func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (*pb.PieceDelet
 	return &pb.PieceDeleteSummary{Message: OK}, nil
 }
 
-func (s *Server) deleteByID(id string) error {
-	if err := pstore.Delete(id, s.DataDir); err != nil {
+func (s *Server) deleteByID(ctx context.Context, id string) error {
+	if err := checkID(id); err != nil {
 		return err
 	}
 
-	if err := s.DB.DeleteTTLByID(id); err != nil {
+	ref, found, err := s.DB.DeletePiece(id)
+	if err != nil {
 		return err
 	}
 
+	if found {
+		if err := s.storage.Delete(ctx, ref); err != nil {
+			return err
+		}
+	}
+
 	zap.S().Infof("Deleted data of id (%s)\n", id)
 
 	return nil
 }
 
+// checkID verifies that id is long enough to be a piece id
+func checkID(id string) error {
+	if len(id) < pstore.IDLength {
+		return pstore.ArgError.New("invalid id length")
+	}
+	return nil
+}
+
 func (s *Server) verifySignature(ctx context.Context, ba *pb.RenterBandwidthAllocation) error {
 	// TODO(security): detect replay attacks
 	pi, err := provider.PeerIdentityFromContext(ctx)
@@ -314,20 +358,6 @@ func getBeginningOfMonth() time.Time {
 	return time.Date(y, m, 1, 0, 0, 0, 0, time.Now().Location())
 }
 
-func getNamespacedPieceID(pieceID, namespace []byte) (string, error) {
-	if namespace == nil {
-		return string(pieceID), nil
-	}
-
-	mac := hmac.New(sha512.New, namespace)
-	_, err := mac.Write(pieceID)
-	if err != nil {
-		return "", err
-	}
-	h := mac.Sum(nil)
-	return base58.Encode(h), nil
-}
-
 func getNamespace(signedMessage *pb.SignedMessage) []byte {
 	return signedMessage.GetData()
 }
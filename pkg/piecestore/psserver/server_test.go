@@ -7,17 +7,17 @@ import (
 	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/sha256"
+	"database/sql"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"net"
 	"os"
-	"path"
 	"path/filepath"
-	"runtime"
-	"strings"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gtank/cryptopasta"
@@ -27,39 +27,40 @@ import (
 	"google.golang.org/grpc"
 
 	"czarcoin.org/czarcoin/internal/identity"
+	"czarcoin.org/czarcoin/internal/memory"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/audit/challenge"
+	"czarcoin.org/czarcoin/pkg/bloomfilter"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/piecestore"
 	"czarcoin.org/czarcoin/pkg/piecestore/psserver/psdb"
-	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/provider"
+	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/storage/filestore"
 )
 
 var ctx = context.Background()
 
-func writeFileToDir(name, dir string) error {
-	file, err := pstore.StoreWriter(name, dir)
-	if err != nil {
-		return err
-	}
-
-	// Close when finished
-	_, err = io.Copy(file, bytes.NewReader([]byte("butts")))
+func writePiece(s *Server, id string, expiration int64) error {
+	content := []byte("butts")
+	ref, err := s.storage.Store(ctx, bytes.NewReader(content), int64(len(content)))
 	if err != nil {
-		_ = file.Close()
 		return err
 	}
-	return file.Close()
+	return s.DB.AddPiece(id, nil, ref, nil, expiration, int64(len(content)))
 }
 
 func TestPiece(t *testing.T) {
 	TS := NewTestServer(t)
 	defer TS.Stop()
 
-	if err := writeFileToDir("11111111111111111111", TS.s.DataDir); err != nil {
+	if err := writePiece(TS.s, "11111111111111111111", 9999999999); err != nil {
 		t.Errorf("Error: %v\nCould not create test piece", err)
 		return
 	}
 
-	defer func() { _ = pstore.Delete("11111111111111111111", TS.s.DataDir) }()
+	defer func() { _ = TS.s.deleteByID(ctx, "11111111111111111111") }()
 
 	// set up test cases
 	tests := []struct {
@@ -84,7 +85,7 @@ func TestPiece(t *testing.T) {
 			id:         "22222222222222222222",
 			size:       5,
 			expiration: 9999999999,
-			err:        fmt.Sprintf("rpc error: code = Unknown desc = stat %s: no such file or directory", path.Join(TS.s.DataDir, "/22/22/2222222222222222")),
+			err:        "rpc error: code = Unknown desc = PSServer error: piece 22222222222222222222 not found",
 		},
 		{ // server should err with invalid TTL
 			id:         "22222222222222222222;DELETE*FROM TTL;;;;",
@@ -98,24 +99,11 @@ func TestPiece(t *testing.T) {
 		t.Run("should return expected PieceSummary values", func(t *testing.T) {
 			assert := assert.New(t)
 
-			// simulate piece TTL entry
-			_, err := TS.s.DB.DB.Exec(fmt.Sprintf(`INSERT INTO ttl (id, created, expires) VALUES ("%s", "%d", "%d")`, tt.id, 1234567890, tt.expiration))
-			assert.NoError(err)
-
-			defer func() {
-				_, err := TS.s.DB.DB.Exec(fmt.Sprintf(`DELETE FROM ttl WHERE id="%s"`, tt.id))
-				assert.NoError(err)
-			}()
-
 			req := &pb.PieceId{Id: tt.id}
 			resp, err := TS.c.Piece(ctx, req)
 
 			if tt.err != "" {
 				assert.NotNil(err)
-				if runtime.GOOS == "windows" && strings.Contains(tt.err, "no such file or directory") {
-					//TODO (windows): ignoring for windows due to different underlying error
-					return
-				}
 				assert.Equal(tt.err, err.Error())
 				return
 			}
@@ -136,12 +124,12 @@ func TestRetrieve(t *testing.T) {
 	defer TS.Stop()
 
 	// simulate piece stored with storagenode
-	if err := writeFileToDir("11111111111111111111", TS.s.DataDir); err != nil {
+	if err := writePiece(TS.s, "11111111111111111111", 0); err != nil {
 		t.Errorf("Error: %v\nCould not create test piece", err)
 		return
 	}
 
-	defer func() { _ = pstore.Delete("11111111111111111111", TS.s.DataDir) }()
+	defer func() { _ = TS.s.deleteByID(ctx, "11111111111111111111") }()
 
 	// set up test cases
 	tests := []struct {
@@ -205,7 +193,7 @@ func TestRetrieve(t *testing.T) {
 			allocSize: 5,
 			offset:    0,
 			content:   []byte("butts"),
-			err:       fmt.Sprintf("rpc error: code = Unknown desc = retrieve error: stat %s: no such file or directory", path.Join(TS.s.DataDir, "/22/22/2222222222222222")),
+			err:       "rpc error: code = Unknown desc = retrieve error: piece 22222222222222222222 not found",
 		},
 		{ // server should return expected content and respSize with offset and excess reqSize
 			id:        "11111111111111111111",
@@ -266,10 +254,6 @@ func TestRetrieve(t *testing.T) {
 				resp, err = stream.Recv()
 				if tt.err != "" {
 					assert.NotNil(err)
-					if runtime.GOOS == "windows" && strings.Contains(tt.err, "no such file or directory") {
-						//TODO (windows): ignoring for windows due to different underlying error
-						return
-					}
 					assert.Equal(tt.err, err.Error())
 					return
 				}
@@ -298,6 +282,7 @@ func TestStore(t *testing.T) {
 		id            string
 		ttl           int64
 		content       []byte
+		hash          []byte
 		message       string
 		totalReceived int64
 		err           string
@@ -310,6 +295,24 @@ func TestStore(t *testing.T) {
 			totalReceived: 5,
 			err:           "",
 		},
+		{ // should successfully store data with a matching hash
+			id:            "99999999999999999999",
+			ttl:           9999999999,
+			content:       []byte("butts"),
+			hash:          sha256Sum([]byte("butts")),
+			message:       "OK",
+			totalReceived: 5,
+			err:           "",
+		},
+		{ // should err with a hash not matching the content
+			id:            "99999999999999999999",
+			ttl:           9999999999,
+			content:       []byte("butts"),
+			hash:          sha256Sum([]byte("nope")),
+			message:       "",
+			totalReceived: 0,
+			err:           "rpc error: code = Unknown desc = store error: hash mismatch for piece 99999999999999999999",
+		},
 		{ // should err with invalid id length
 			id:            "butts",
 			ttl:           9999999999,
@@ -359,20 +362,44 @@ func TestStore(t *testing.T) {
 				assert.NoError(err)
 			}
 
+			if tt.hash != nil {
+				err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Hash: tt.hash}})
+				if err != io.EOF && err != nil {
+					assert.NoError(err)
+				}
+			}
+
 			resp, err := stream.CloseAndRecv()
 			if tt.err != "" {
 				assert.NotNil(err)
 				assert.Equal(tt.err, err.Error())
+
+				// nothing is committed or kept for a failed upload
+				_, _, err = TS.s.DB.GetPiece(tt.id)
+				assert.Equal(sql.ErrNoRows, err)
 				return
 			}
 
 			assert.NoError(err)
 
 			defer func() {
-				_, err := db.Exec(fmt.Sprintf(`DELETE FROM ttl WHERE id="%s"`, tt.id))
+				assert.NoError(TS.s.deleteByID(ctx, tt.id))
+
+				// agreements are checked against the allocation of each case
+				_, err := db.Exec(`DELETE FROM bandwidth_agreements`)
 				assert.NoError(err)
 			}()
 
+			// check the piece index to make sure the piece was committed
+			_, size, err := TS.s.DB.GetPiece(tt.id)
+			assert.NoError(err)
+			assert.Equal(tt.totalReceived, size)
+
+			// the node keeps the hash of the stored data
+			hash, err := TS.s.DB.GetPieceHash(tt.id)
+			assert.NoError(err)
+			assert.Equal(sha256Sum(tt.content), hash)
+
 			// check db to make sure agreement and signature were stored correctly
 			rows, err := db.Query(`SELECT agreement, signature FROM bandwidth_agreements`)
 			assert.NoError(err)
@@ -405,11 +432,276 @@ func TestStore(t *testing.T) {
 	}
 }
 
-func TestDelete(t *testing.T) {
+func TestStoreResume(t *testing.T) {
 	TS := NewTestServer(t)
 	defer TS.Stop()
 
-	db := TS.s.DB.DB
+	id := "88888888888888888888"
+
+	// sendContent sends content as part of an upload with a signed allocation
+	// of total bytes and returns its signature
+	sendContent := func(stream pb.PieceStoreRoutes_StoreClient, content []byte, total int64) ([]byte, error) {
+		msg := &pb.PieceStore{
+			PieceData: &pb.PieceStore_PieceData{Content: content},
+			BandwidthAllocation: &pb.RenterBandwidthAllocation{
+				Data: serializeData(&pb.RenterBandwidthAllocation_Data{
+					PayerAllocation: &pb.PayerBandwidthAllocation{},
+					Total:           total,
+				}),
+			},
+		}
+
+		signature, err := cryptopasta.Sign(msg.BandwidthAllocation.Data, TS.k.(*ecdsa.PrivateKey))
+		if err != nil {
+			return nil, err
+		}
+		msg.BandwidthAllocation.Signature = signature
+
+		return signature, stream.Send(msg)
+	}
+
+	// break the first upload after sending part of the piece
+	uploadCtx, cancel := context.WithCancel(ctx)
+	stream, err := TS.c.Store(uploadCtx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: id, ExpirationUnixSec: 9999999999}}))
+	firstSignature, err := sendContent(stream, []byte("butts"), 5)
+	assert.NoError(t, err)
+
+	// wait until the server has received the data
+	var session *pb.UploadSessionSummary
+	for i := 0; i < 100; i++ {
+		session, err = TS.c.UploadSession(ctx, &pb.PieceId{Id: id})
+		assert.NoError(t, err)
+		if session.GetOffset() == 5 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, int64(5), session.GetOffset())
+
+	cancel()
+
+	// wait until the server has noticed the broken stream
+	for i := 0; i < 100; i++ {
+		TS.s.uploads.mu.Lock()
+		_, active := TS.s.uploads.active[id]
+		TS.s.uploads.mu.Unlock()
+		if !active {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the piece is not visible before the upload is complete
+	_, _, err = TS.s.DB.GetPiece(id)
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	// but the received data counts as used space
+	stats, err := TS.c.Stats(ctx, &pb.StatsReq{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), stats.GetUsedSpace())
+
+	agreements, err := TS.s.DB.GetBandwidthAllocationBySignature(firstSignature)
+	assert.NoError(t, err)
+	assert.Len(t, agreements, 1)
+
+	// resuming past the received data fails
+	stream, err = TS.c.Store(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: id, ExpirationUnixSec: 9999999999, Offset: 6}}))
+	_, err = stream.CloseAndRecv()
+	assert.Error(t, err)
+
+	// resume the upload at the reported offset
+	stream, err = TS.c.Store(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: id, ExpirationUnixSec: 9999999999, Offset: session.GetOffset()}}))
+	// the allocation total is carried over from the interrupted attempt
+	secondSignature, err := sendContent(stream, []byte(" and more"), int64(len("butts and more")))
+	assert.NoError(t, err)
+	resp, err := stream.CloseAndRecv()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(" and more")), resp.GetTotalReceived())
+
+	// only the allocation of the whole piece is kept for settlement
+	agreements, err = TS.s.DB.GetBandwidthAllocationBySignature(firstSignature)
+	assert.NoError(t, err)
+	assert.Len(t, agreements, 0)
+	agreements, err = TS.s.DB.GetBandwidthAllocationBySignature(secondSignature)
+	assert.NoError(t, err)
+	assert.Len(t, agreements, 1)
+
+	ref, size, err := TS.s.DB.GetPiece(id)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len("butts and more")), size)
+
+	reader, err := TS.s.storage.Load(ctx, ref)
+	assert.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.NoError(t, reader.Close())
+	assert.Equal(t, "butts and more", string(data))
+
+	// the upload data is removed once the piece is committed
+	offset, err := TS.s.uploads.offset(id)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), offset)
+
+	// a committed piece is reported as fully received
+	session, err = TS.c.UploadSession(ctx, &pb.PieceId{Id: id})
+	assert.NoError(t, err)
+	assert.Equal(t, size, session.GetOffset())
+
+	// resuming a committed piece at its end succeeds without changes
+	stream, err = TS.c.Store(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: id, ExpirationUnixSec: 9999999999, Offset: size}}))
+	resp, err = stream.CloseAndRecv()
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", resp.GetMessage())
+
+	assert.NoError(t, TS.s.deleteByID(ctx, id))
+}
+
+func TestRetain(t *testing.T) {
+	TS := NewTestServer(t)
+	defer TS.Stop()
+
+	satellite := TS.identity.ID
+	for _, id := range []string{"11111111111111111111", "22222222222222222222"} {
+		ref, err := TS.s.storage.Store(ctx, bytes.NewReader([]byte("butts")), 5)
+		assert.NoError(t, err)
+		assert.NoError(t, TS.s.DB.AddPiece(id, satellite.Bytes(), ref, nil, 0, 5))
+	}
+
+	// pieces of other satellites are never trashed
+	assert.NoError(t, writePiece(TS.s, "33333333333333333333", 0))
+
+	filter := bloomfilter.NewOptimal(10, 0.01, 0)
+	filter.Add([]byte("11111111111111111111"))
+
+	newRequest := func(satelliteID czarcoin.NodeID) *pb.RetainRequest {
+		data, err := proto.Marshal(&pb.RetainRequest_Data{
+			SatelliteId:    satelliteID,
+			CreatedUnixSec: time.Now().Add(time.Hour).Unix(),
+			Filter:         filter.Bytes(),
+		})
+		assert.NoError(t, err)
+
+		signature, err := cryptopasta.Sign(data, TS.k.(*ecdsa.PrivateKey))
+		assert.NoError(t, err)
+
+		return &pb.RetainRequest{Data: data, Signature: signature}
+	}
+
+	// the filter must be signed by the satellite which sends it
+	_, err := TS.c.Retain(ctx, newRequest(testczarcoin.NodeIDFromString("other")))
+	assert.Error(t, err)
+
+	invalid := newRequest(satellite)
+	invalid.Signature[0]++
+	_, err = TS.c.Retain(ctx, invalid)
+	assert.Error(t, err)
+
+	summary, err := TS.c.Retain(ctx, newRequest(satellite))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), summary.GetTrashed())
+
+	_, _, err = TS.s.DB.GetPiece("11111111111111111111")
+	assert.NoError(t, err)
+	_, _, err = TS.s.DB.GetPiece("22222222222222222222")
+	assert.Equal(t, sql.ErrNoRows, err)
+	_, _, err = TS.s.DB.GetPiece("33333333333333333333")
+	assert.NoError(t, err)
+}
+
+func TestChallenge(t *testing.T) {
+	TS := NewTestServer(t)
+	defer TS.Stop()
+
+	if err := writePiece(TS.s, "11111111111111111111", 9999999999); err != nil {
+		t.Errorf("Error: %v\nCould not create test piece", err)
+		return
+	}
+
+	defer func() { _ = TS.s.deleteByID(ctx, "11111111111111111111") }()
+
+	nonce := []byte("nonce")
+
+	tests := []struct {
+		id          string
+		stripeIndex int64
+		shareSize   int64
+		nonce       []byte
+		share       string
+		err         string
+	}{
+		{ // should answer with the keyed hash of the first share
+			id:          "11111111111111111111",
+			stripeIndex: 0,
+			shareSize:   2,
+			nonce:       nonce,
+			share:       "bu",
+		},
+		{ // should answer with the keyed hash of the second share
+			id:          "11111111111111111111",
+			stripeIndex: 1,
+			shareSize:   2,
+			nonce:       nonce,
+			share:       "tt",
+		},
+		{ // should err with a share past the end of the piece
+			id:          "11111111111111111111",
+			stripeIndex: 2,
+			shareSize:   2,
+			nonce:       nonce,
+			err:         "rpc error: code = Unknown desc = argError: invalid stripe index: 2",
+		},
+		{ // should err without a nonce
+			id:          "11111111111111111111",
+			stripeIndex: 0,
+			shareSize:   2,
+			err:         "rpc error: code = Unknown desc = challenge error: nonce not specified",
+		},
+		{ // should err with invalid share size
+			id:          "11111111111111111111",
+			stripeIndex: 0,
+			shareSize:   0,
+			nonce:       nonce,
+			err:         "rpc error: code = Unknown desc = argError: invalid share size: 0",
+		},
+		{ // should err with nonexistent piece
+			id:          "22222222222222222222",
+			stripeIndex: 0,
+			shareSize:   2,
+			nonce:       nonce,
+			err:         "rpc error: code = Unknown desc = challenge error: piece 22222222222222222222 not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("should return expected ChallengeResponse values", func(t *testing.T) {
+			resp, err := TS.c.Challenge(ctx, &pb.ChallengeRequest{
+				Id:          tt.id,
+				StripeIndex: tt.stripeIndex,
+				ShareSize:   tt.shareSize,
+				Nonce:       tt.nonce,
+			})
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, challenge.Hash(tt.nonce, []byte(tt.share)), resp.GetHash())
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	TS := NewTestServer(t)
+	defer TS.Stop()
 
 	// set up test cases
 	tests := []struct {
@@ -439,22 +731,13 @@ func TestDelete(t *testing.T) {
 			assert := assert.New(t)
 
 			// simulate piece stored with storagenode
-			if err := writeFileToDir("11111111111111111111", TS.s.DataDir); err != nil {
+			if err := writePiece(TS.s, "11111111111111111111", 1234567890); err != nil {
 				t.Errorf("Error: %v\nCould not create test piece", err)
 				return
 			}
 
-			// simulate piece TTL entry
-			_, err := db.Exec(fmt.Sprintf(`INSERT INTO ttl (id, created, expires) VALUES ("%s", "%d", "%d")`, tt.id, 1234567890, 1234567890))
-			assert.NoError(err)
-
-			defer func() {
-				_, err := db.Exec(fmt.Sprintf(`DELETE FROM ttl WHERE id="%s"`, tt.id))
-				assert.NoError(err)
-			}()
-
 			defer func() {
-				assert.NoError(pstore.Delete("11111111111111111111", TS.s.DataDir))
+				assert.NoError(TS.s.deleteByID(ctx, "11111111111111111111"))
 			}()
 
 			req := &pb.PieceDelete{Id: tt.id}
@@ -468,17 +751,64 @@ func TestDelete(t *testing.T) {
 			assert.NoError(err)
 			assert.Equal(tt.message, resp.GetMessage())
 
-			// if test passes, check if file was indeed deleted
-			filePath, err := pstore.PathByID(tt.id, TS.s.DataDir)
-			assert.NoError(err)
-			if _, err = os.Stat(filePath); os.IsExist(err) {
-				t.Errorf("File not deleted")
+			// if test passes, check if piece was indeed deleted
+			if _, _, err = TS.s.DB.GetPiece(tt.id); err == nil {
+				t.Errorf("Piece not deleted")
 				return
 			}
 		})
 	}
 }
 
+func TestMigrateLegacyPieces(t *testing.T) {
+	server, cleanup := newTestServerStruct(t)
+	defer cleanup()
+
+	legacyDir, err := ioutil.TempDir("", "czarcoin-pstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(legacyDir) }()
+
+	ids := []string{"11111111111111111111", "33333333333333333333"}
+	for _, id := range ids {
+		file, err := pstore.StoreWriter(id, legacyDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = io.Copy(file, bytes.NewReader([]byte(id)))
+		assert.NoError(t, utils.CombineErrors(err, file.Close()))
+	}
+	assert.NoError(t, server.DB.AddTTL(ids[0], 9999999999, int64(len(ids[0]))))
+
+	// simulate a migration that was interrupted after committing the first piece
+	assert.NoError(t, writePiece(server, ids[0], 9999999999))
+
+	err = migrateLegacyPieces(ctx, legacyDir, server.storage, server.DB)
+	assert.NoError(t, err)
+
+	_, err = os.Stat(legacyDir)
+	assert.True(t, os.IsNotExist(err))
+
+	ref, size, err := server.DB.GetPiece(ids[1])
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(ids[1])), size)
+
+	blob, err := server.storage.Load(ctx, ref)
+	assert.NoError(t, err)
+	data, err := ioutil.ReadAll(blob)
+	assert.NoError(t, utils.CombineErrors(err, blob.Close()))
+	assert.Equal(t, ids[1], string(data))
+
+	expiration, err := server.DB.GetTTLByID(ids[0])
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9999999999), expiration)
+
+	used, err := server.DB.UsedSpace()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len("butts")+len(ids[1])), used)
+}
+
 func newTestServerStruct(t *testing.T) (*Server, func()) {
 	tmp, err := ioutil.TempDir("", "czarcoin-piecestore")
 	if err != nil {
@@ -488,7 +818,12 @@ func newTestServerStruct(t *testing.T) (*Server, func()) {
 	tempDBPath := filepath.Join(tmp, "test.db")
 	tempDir := filepath.Join(tmp, "test-data", "3000")
 
-	psDB, err := psdb.Open(ctx, tempDir, tempDBPath)
+	blobs, err := filestore.NewAt(tempDir)
+	if err != nil {
+		t.Fatalf("failed open blob store: %v", err)
+	}
+
+	psDB, err := psdb.Open(ctx, blobs, tempDBPath)
 	if err != nil {
 		t.Fatalf("failed open psdb: %v", err)
 	}
@@ -496,7 +831,8 @@ func newTestServerStruct(t *testing.T) (*Server, func()) {
 	verifier := func(authorization *pb.SignedMessage) error {
 		return nil
 	}
-	server := &Server{DataDir: tempDir, DB: psDB, verifier: verifier}
+	uploads := newUploads(filepath.Join(tmp, "test-uploads"))
+	server := &Server{storage: blobs, uploads: uploads, DB: psDB, totalAllocated: memory.GB.Int64(), verifier: verifier}
 	return server, func() {
 		if serr := server.Stop(ctx); serr != nil {
 			t.Fatal(serr)
@@ -527,6 +863,7 @@ type TestServer struct {
 	conn     *grpc.ClientConn
 	c        pb.PieceStoreRoutesClient
 	k        crypto.PrivateKey
+	identity *provider.FullIdentity
 }
 
 func NewTestServer(t *testing.T) *TestServer {
@@ -555,7 +892,7 @@ func NewTestServer(t *testing.T) *TestServer {
 
 	k, ok := fiC.Key.(*ecdsa.PrivateKey)
 	assert.True(t, ok)
-	ts := &TestServer{s: s, scleanup: cleanup, grpcs: grpcs, k: k}
+	ts := &TestServer{s: s, scleanup: cleanup, grpcs: grpcs, k: k, identity: fiC}
 	addr := ts.start()
 	ts.c, ts.conn = connect(addr, co)
 
@@ -589,3 +926,8 @@ func serializeData(ba *pb.RenterBandwidthAllocation_Data) []byte {
 	data, _ := proto.Marshal(ba)
 	return data
 }
+
+func sha256Sum(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
@@ -4,8 +4,13 @@
 package psserver
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"database/sql"
 	"io"
+	"io/ioutil"
+	"os"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -13,6 +18,7 @@ import (
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/piecestore"
 	"czarcoin.org/czarcoin/pkg/utils"
+	"czarcoin.org/czarcoin/storage"
 )
 
 // OK - Success!
@@ -50,21 +56,75 @@ func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 		return StoreError.New("piece ID not specified")
 	}
 
-	id, err := getNamespacedPieceID([]byte(pd.GetId()), getNamespace(authorization))
+	id, err := pstore.NamespacedID([]byte(pd.GetId()), getNamespace(authorization))
 	if err != nil {
 		return err
 	}
-	total, err := s.storeData(ctx, reqStream, id)
+	if err := checkID(id); err != nil {
+		return err
+	}
+
+	offset := pd.GetOffset()
+
+	// the previous attempt may have been committed before the client
+	// received the summary
+	if _, size, err := s.DB.GetPiece(id); err == nil {
+		if offset != size {
+			return StoreError.New("piece %s already stored", pd.GetId())
+		}
+		return s.confirmStored(ctx, reqStream, id)
+	} else if err != sql.ErrNoRows {
+		return StoreError.Wrap(err)
+	}
+
+	// the data of uploads which are not committed yet takes space too
+	used, err := s.usedSpace()
+	if err != nil {
+		return StoreError.Wrap(err)
+	}
+	if used >= s.totalAllocated {
+		return StoreError.New("not enough allocated disk space for piece %s", pd.GetId())
+	}
+
+	file, err := s.uploads.open(id, offset)
 	if err != nil {
 		return err
 	}
+	defer s.uploads.release(id)
 
-	if err = s.DB.AddTTL(id, pd.GetExpirationUnixSec(), total); err != nil {
-		deleteErr := s.deleteByID(id)
+	total, expectedHash, ba, err := s.storeData(ctx, reqStream, file)
+	if baErr := s.saveUploadAllocation(id, ba); baErr != nil {
+		zap.S().Errorf("Error while writing Bandwidth Alloc to DB: %s\n", baErr.Error())
+	}
+	if err != nil {
+		// the received data is kept, so that the client can resume the upload
+		return utils.CombineErrors(err, file.Close())
+	}
+
+	ref, hash, err := s.commitUpload(ctx, id, file, offset+total)
+	if err != nil {
+		return StoreError.Wrap(err)
+	}
+
+	// clients which don't send a hash get the one computed by the node
+	if expectedHash != nil && !bytes.Equal(expectedHash, hash) {
+		deleteErr := s.storage.Delete(ctx, ref)
+		removeErr := s.uploads.remove(id)
+		return utils.CombineErrors(StoreError.New("hash mismatch for piece %s", pd.GetId()), deleteErr, removeErr)
+	}
+
+	// the piece becomes visible only once the index has been committed
+	if err = s.DB.AddPiece(id, getNamespace(authorization), ref, hash, pd.GetExpirationUnixSec(), offset+total); err != nil {
+		deleteErr := s.storage.Delete(ctx, ref)
 		return StoreError.New("failed to write piece meta data to database: %v", utils.CombineErrors(err, deleteErr))
 	}
 
-	if err = s.DB.AddBandwidthUsed(total); err != nil {
+	if err = s.uploads.remove(id); err != nil {
+		zap.S().Errorf("Error while removing upload data of %s: %s\n", pd.GetId(), err.Error())
+	}
+
+	// bytes received by interrupted attempts are only accounted now
+	if err = s.DB.AddBandwidthUsed(offset + total); err != nil {
 		return StoreError.New("failed to write bandwidth info to database: %v", err)
 	}
 	zap.S().Infof("Successfully stored %s.", pd.GetId())
@@ -72,40 +132,104 @@ func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 	return reqStream.SendAndClose(&pb.PieceStoreSummary{Message: OK, TotalReceived: total})
 }
 
-func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_StoreServer, id string) (total int64, err error) {
+// confirmStored answers an upload of piece id which has already been committed
+func (s *Server) confirmStored(ctx context.Context, stream pb.PieceStoreRoutes_StoreServer, id string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	// Delete data if we error
-	defer func() {
-		if err != nil && err != io.EOF {
-			if deleteErr := s.deleteByID(id); deleteErr != nil {
-				zap.S().Errorf("Failed on deleteByID in Store: %s", deleteErr.Error())
-			}
+	total, expectedHash, ba, err := s.storeData(ctx, stream, ioutil.Discard)
+	if ba != nil {
+		if baErr := s.DB.WriteBandwidthAllocToDB(ba); baErr != nil {
+			zap.S().Errorf("Error while writing Bandwidth Alloc to DB: %s\n", baErr.Error())
 		}
-	}()
-
-	// Initialize file for storing data
-	storeFile, err := pstore.StoreWriter(id, s.DataDir)
+	}
 	if err != nil {
-		return 0, err
+		return err
+	}
+	if total > 0 {
+		return StoreError.New("received %d bytes past the end of the piece", total)
+	}
+
+	if expectedHash != nil {
+		hash, err := s.DB.GetPieceHash(id)
+		if err != nil {
+			return StoreError.Wrap(err)
+		}
+		if !bytes.Equal(expectedHash, hash) {
+			return StoreError.New("hash mismatch for stored piece")
+		}
 	}
 
-	defer utils.LogClose(storeFile)
+	return stream.SendAndClose(&pb.PieceStoreSummary{Message: OK})
+}
+
+// storeData writes the piece data received from stream to w and returns the
+// hash of the whole piece if the client sent one, along with the latest
+// bandwidth allocation received
+func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_StoreServer, w io.Writer) (total int64, hash []byte, ba *pb.RenterBandwidthAllocation, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	reader := NewStreamReader(s, stream)
+	total, err = io.Copy(w, reader)
+	return total, reader.hash, reader.bandwidthAllocation, err
+}
+
+// saveUploadAllocation writes the bandwidth allocation received for the
+// upload of id and deletes the one of a previous attempt, since its total
+// is carried over and the satellite settles a serial once per node
+func (s *Server) saveUploadAllocation(id string, ba *pb.RenterBandwidthAllocation) error {
+	if ba == nil {
+		return nil
+	}
+
+	previous, err := s.uploads.allocation(id)
+	if err != nil {
+		return err
+	}
 
-	defer func() {
-		baWriteErr := s.DB.WriteBandwidthAllocToDB(reader.bandwidthAllocation)
-		if baWriteErr != nil {
-			zap.S().Errorf("Error while writing Bandwidth Alloc to DB: %s\n", baWriteErr.Error())
+	if err := s.DB.WriteBandwidthAllocToDB(ba); err != nil {
+		return err
+	}
+	if previous != nil {
+		if err := s.DB.DeleteBandwidthAllocationBySignature(previous); err != nil {
+			return err
 		}
-	}()
+	}
 
-	total, err = io.Copy(storeFile, reader)
+	return s.uploads.setAllocation(id, ba.GetSignature())
+}
 
-	if err != nil && err != io.EOF {
+// usedSpace returns the size of the stored pieces and of the data received
+// for uploads which are not committed yet
+func (s *Server) usedSpace() (int64, error) {
+	stored, err := s.DB.UsedSpace()
+	if err != nil {
+		return 0, err
+	}
+	partial, err := s.uploads.size()
+	if err != nil {
 		return 0, err
 	}
+	return stored + partial, nil
+}
+
+// commitUpload moves the size bytes of upload data in file into the blob
+// store and returns their hash
+func (s *Server) commitUpload(ctx context.Context, id string, file *os.File, size int64) (ref storage.BlobRef, hash []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return ref, nil, utils.CombineErrors(err, file.Close())
+	}
+
+	hasher := sha256.New()
+	ref, err = s.storage.Store(ctx, io.TeeReader(file, hasher), size)
+	if err != nil {
+		return ref, nil, utils.CombineErrors(err, file.Close())
+	}
+
+	if err := file.Close(); err != nil {
+		return ref, nil, utils.CombineErrors(err, s.storage.Delete(ctx, ref))
+	}
 
-	return total, nil
+	return ref, hasher.Sum(nil), nil
 }
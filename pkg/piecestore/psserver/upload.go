@@ -0,0 +1,262 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/piecestore"
+	"czarcoin.org/czarcoin/pkg/utils"
+)
+
+// UploadError is a type of error for failures in upload sessions
+var UploadError = errs.Class("upload error")
+
+// UploadSession returns how much of a piece the server has received, so that
+// an interrupted upload can continue at that offset
+func (s *Server) UploadSession(ctx context.Context, in *pb.PieceId) (*pb.UploadSessionSummary, error) {
+	authorization := in.GetAuthorization()
+	if err := s.verifier(authorization); err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	id, err := pstore.NamespacedID([]byte(in.GetId()), getNamespace(authorization))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkID(id); err != nil {
+		return nil, err
+	}
+
+	// the piece may have been committed before the client got the summary
+	_, size, err := s.DB.GetPiece(id)
+	if err == nil {
+		return &pb.UploadSessionSummary{Id: in.GetId(), Offset: size}, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	offset, err := s.uploads.offset(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UploadSessionSummary{Id: in.GetId(), Offset: offset}, nil
+}
+
+// collectUploads periodically deletes the data of uploads which have not
+// been resumed within expiration
+func (s *Server) collectUploads(ctx context.Context, expiration time.Duration) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		if err := s.uploads.removeStale(time.Now().Add(-expiration)); err != nil {
+			zap.S().Errorf("Error while removing stale uploads: %s\n", err.Error())
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// allocationExt is the extension of the files keeping the signature of the
+// bandwidth allocation of an upload
+const allocationExt = ".alloc"
+
+// uploads keeps the data received for pieces which are not committed yet,
+// so that an interrupted upload can continue from where it stopped
+type uploads struct {
+	dir string
+
+	mu     sync.Mutex
+	active map[string]struct{}
+}
+
+func newUploads(dir string) *uploads {
+	return &uploads{
+		dir:    dir,
+		active: map[string]struct{}{},
+	}
+}
+
+// path returns the location of the upload data for id
+func (u *uploads) path(id string) string {
+	hash := sha256.Sum256([]byte(id))
+	return filepath.Join(u.dir, hex.EncodeToString(hash[:]))
+}
+
+// allocationPath returns the location of the signature of the bandwidth
+// allocation last written for the upload of id
+func (u *uploads) allocationPath(id string) string {
+	return u.path(id) + allocationExt
+}
+
+// open starts the upload of id or continues it at offset, only one upload
+// of the same piece can be active at a time
+func (u *uploads) open(id string, offset int64) (*os.File, error) {
+	if offset < 0 {
+		return nil, UploadError.New("invalid offset: %v", offset)
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if _, ok := u.active[id]; ok {
+		return nil, UploadError.New("upload of piece already in progress")
+	}
+
+	if err := os.MkdirAll(u.dir, 0700); err != nil {
+		return nil, UploadError.Wrap(err)
+	}
+
+	file, err := os.OpenFile(u.path(id), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, UploadError.Wrap(err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, UploadError.Wrap(utils.CombineErrors(err, file.Close()))
+	}
+
+	// the client can only continue from data we already have
+	if offset > info.Size() {
+		return nil, UploadError.Wrap(utils.CombineErrors(
+			UploadError.New("invalid offset %v, received %v bytes", offset, info.Size()),
+			file.Close(),
+		))
+	}
+
+	if err := file.Truncate(offset); err != nil {
+		return nil, UploadError.Wrap(utils.CombineErrors(err, file.Close()))
+	}
+
+	if _, err := file.Seek(offset, 0); err != nil {
+		return nil, UploadError.Wrap(utils.CombineErrors(err, file.Close()))
+	}
+
+	u.active[id] = struct{}{}
+	return file, nil
+}
+
+// release marks the upload of id as no longer active
+func (u *uploads) release(id string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.active, id)
+}
+
+// offset returns the number of bytes received for id
+func (u *uploads) offset(id string) (int64, error) {
+	info, err := os.Stat(u.path(id))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, UploadError.Wrap(err)
+	}
+	return info.Size(), nil
+}
+
+// allocation returns the signature of the bandwidth allocation last written
+// for id, or nil if there is none
+func (u *uploads) allocation(id string) ([]byte, error) {
+	signature, err := ioutil.ReadFile(u.allocationPath(id))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, UploadError.Wrap(err)
+	}
+	return signature, nil
+}
+
+// setAllocation keeps the signature of the bandwidth allocation written for id
+func (u *uploads) setAllocation(id string, signature []byte) error {
+	return UploadError.Wrap(ioutil.WriteFile(u.allocationPath(id), signature, 0600))
+}
+
+// size returns the number of bytes received for all uploads
+func (u *uploads) size() (total int64, err error) {
+	infos, err := ioutil.ReadDir(u.dir)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, UploadError.Wrap(err)
+	}
+
+	for _, info := range infos {
+		if info.IsDir() || filepath.Ext(info.Name()) == allocationExt {
+			continue
+		}
+		total += info.Size()
+	}
+	return total, nil
+}
+
+// remove deletes the data received for id
+func (u *uploads) remove(id string) error {
+	var errs []error
+	for _, path := range []string{u.path(id), u.allocationPath(id)} {
+		err := os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	return UploadError.Wrap(utils.CombineErrors(errs...))
+}
+
+// removeStale deletes the data of inactive uploads which were last written
+// before the given time
+func (u *uploads) removeStale(before time.Time) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	active := map[string]bool{}
+	for id := range u.active {
+		active[u.path(id)] = true
+		active[u.allocationPath(id)] = true
+	}
+
+	infos, err := ioutil.ReadDir(u.dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return UploadError.Wrap(err)
+	}
+
+	var errs []error
+	for _, info := range infos {
+		path := filepath.Join(u.dir, info.Name())
+		if info.IsDir() || active[path] || !info.ModTime().Before(before) {
+			continue
+		}
+		err := os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return UploadError.Wrap(utils.CombineErrors(errs...))
+}
@@ -5,11 +5,14 @@ package pstore
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha512"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
 
+	"github.com/mr-tron/base58/base58"
 	"github.com/zeebo/errs"
 
 	"czarcoin.org/czarcoin/pkg/ranger"
@@ -24,6 +27,22 @@ var (
 	FSError  = errs.Class("fsError")
 )
 
+// NamespacedID returns the id under which a storage node keeps pieceID when
+// it was uploaded with the given namespace
+func NamespacedID(pieceID, namespace []byte) (string, error) {
+	if namespace == nil {
+		return string(pieceID), nil
+	}
+
+	mac := hmac.New(sha512.New, namespace)
+	_, err := mac.Write(pieceID)
+	if err != nil {
+		return "", err
+	}
+	h := mac.Sum(nil)
+	return base58.Encode(h), nil
+}
+
 // PathByID creates datapath from id and dir
 func PathByID(id, dir string) (string, error) {
 	if len(id) < IDLength {
@@ -5,12 +5,16 @@ package pointerdb
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 
+	"czarcoin.org/czarcoin/pkg/accounting/projectusage"
 	"czarcoin.org/czarcoin/pkg/overlay"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/provider"
+	"czarcoin.org/czarcoin/pkg/satellite"
+	"czarcoin.org/czarcoin/pkg/satellite/satellitedb"
 	"czarcoin.org/czarcoin/pkg/utils"
 	"czarcoin.org/czarcoin/storage"
 	"czarcoin.org/czarcoin/storage/boltdb"
@@ -30,10 +34,13 @@ const (
 // Config is a configuration struct that is everything you need to start a
 // PointerDB responsibility
 type Config struct {
-	DatabaseURL          string `help:"the database connection string to use" default:"bolt://$CONFDIR/pointerdb.db"`
-	MinRemoteSegmentSize int    `default:"1240" help:"minimum remote segment size"`
-	MaxInlineSegmentSize int    `default:"8000" help:"maximum inline segment size"`
-	Overlay              bool   `default:"false" help:"toggle flag if overlay is enabled"`
+	DatabaseURL          string        `help:"the database connection string to use" default:"bolt://$CONFDIR/pointerdb.db"`
+	MinRemoteSegmentSize int           `default:"1240" help:"minimum remote segment size"`
+	MaxInlineSegmentSize int           `default:"8000" help:"maximum inline segment size"`
+	Overlay              bool          `default:"false" help:"toggle flag if overlay is enabled"`
+	AllocationExpiration time.Duration `default:"168h" help:"how long the bandwidth allocations given to uplinks are valid"`
+	AllocationMaxSize    int64         `default:"1073741824" help:"the maximum number of bytes a bandwidth allocation pays for across all storage nodes"`
+	ConsoleDatabaseURL   string        `default:"" help:"the console database with the projects of API keys and their limits, projects aren't accounted when empty"`
 }
 
 func newKeyValueStore(dbURLString string) (db storage.KeyValueStore, err error) {
@@ -62,12 +69,53 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) error {
 	cache := overlay.LoadFromContext(ctx)
 	dblogged := storelogger.New(zap.L(), db)
 	s := NewServer(dblogged, cache, zap.L(), c, server.Identity())
+
+	if c.ConsoleDatabaseURL != "" {
+		usage, err := c.openProjectUsage(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = usage.console.Close() }()
+		s.SetProjectUsage(usage.service)
+	}
+
 	pb.RegisterPointerDBServer(server.GRPC(), s)
 	// add the server to the context
 	ctx = context.WithValue(ctx, ctxKey, s)
 	return server.Run(ctx)
 }
 
+type projectUsage struct {
+	console satellite.DB
+	service *projectusage.Service
+}
+
+// openProjectUsage opens the console database and accounts the projects in the satellite master db
+func (c Config) openProjectUsage(ctx context.Context) (*projectUsage, error) {
+	db, ok := ctx.Value("masterdb").(interface{ ProjectUsage() projectusage.DB })
+	if !ok {
+		return nil, Error.New("unable to get satellite master db instance")
+	}
+
+	dbURL, err := utils.ParseURL(c.ConsoleDatabaseURL)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	console, err := satellitedb.New(dbURL.Scheme, dbURL.Path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if err = console.CreateTables(); err != nil {
+		_ = console.Close()
+		return nil, Error.Wrap(err)
+	}
+
+	return &projectUsage{
+		console: console,
+		service: projectusage.NewService(db.ProjectUsage(), console),
+	}, nil
+}
+
 // LoadFromContext gives access to the pointerdb server from the context, or returns nil
 func LoadFromContext(ctx context.Context) *Server {
 	if v, ok := ctx.Value(ctxKey).(*Server); ok {
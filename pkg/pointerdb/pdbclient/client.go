@@ -52,6 +52,7 @@ type Client interface {
 	Get(ctx context.Context, path czarcoin.Path) (*pb.Pointer, []*pb.Node, *pb.PayerBandwidthAllocation, error)
 	List(ctx context.Context, prefix, startAfter, endBefore czarcoin.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 	Delete(ctx context.Context, path czarcoin.Path) error
+	CompareAndSwap(ctx context.Context, path czarcoin.Path, oldPointer, newPointer *pb.Pointer) error
 
 	SignedMessage() *pb.SignedMessage
 	PayerBandwidthAllocation(context.Context, pb.PayerBandwidthAllocation_Action) (*pb.PayerBandwidthAllocation, error)
@@ -84,7 +85,7 @@ func (pdb *PointerDB) Put(ctx context.Context, path czarcoin.Path, pointer *pb.P
 
 	_, err = pdb.client.Put(ctx, &pb.PutRequest{Path: path, Pointer: pointer})
 
-	return err
+	return wrapQuotaExceeded(err)
 }
 
 // Get is the interface to make a GET request, needs PATH and APIKey
@@ -96,6 +97,9 @@ func (pdb *PointerDB) Get(ctx context.Context, path czarcoin.Path) (pointer *pb.
 		if status.Code(err) == codes.NotFound {
 			return nil, nil, nil, storage.ErrKeyNotFound.Wrap(err)
 		}
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, nil, nil, ErrQuotaExceeded.Wrap(err)
+		}
 		return nil, nil, nil, Error.Wrap(err)
 	}
 
@@ -142,17 +146,47 @@ func (pdb *PointerDB) Delete(ctx context.Context, path czarcoin.Path) (err error
 	return err
 }
 
+// CompareAndSwap replaces the pointer of path with newPointer only if it
+// still equals oldPointer, otherwise it returns ErrPointerChanged
+func (pdb *PointerDB) CompareAndSwap(ctx context.Context, path czarcoin.Path, oldPointer, newPointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.client.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{
+		Path:       path,
+		OldPointer: oldPointer,
+		NewPointer: newPointer,
+	})
+	if err != nil {
+		if status.Code(err) == codes.Aborted {
+			return ErrPointerChanged.Wrap(err)
+		}
+		if status.Code(err) == codes.FailedPrecondition {
+			return ErrQuotaExceeded.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
 // PayerBandwidthAllocation gets payer bandwidth allocation message
 func (pdb *PointerDB) PayerBandwidthAllocation(ctx context.Context, action pb.PayerBandwidthAllocation_Action) (resp *pb.PayerBandwidthAllocation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	response, err := pdb.client.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: action})
 	if err != nil {
-		return nil, err
+		return nil, wrapQuotaExceeded(err)
 	}
 	return response.GetPba(), nil
 }
 
+// wrapQuotaExceeded wraps the status the satellite returns when the project exceeded its limits
+func wrapQuotaExceeded(err error) error {
+	if status.Code(err) == codes.FailedPrecondition {
+		return ErrQuotaExceeded.Wrap(err)
+	}
+	return err
+}
+
 // SignedMessage gets signed message from last request
 func (pdb *PointerDB) SignedMessage() *pb.SignedMessage {
 	return (*pb.SignedMessage)(atomic.LoadPointer(&pdb.authorization))
@@ -15,6 +15,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"czarcoin.org/czarcoin/internal/testczarcoin"
 
@@ -294,3 +296,27 @@ func TestDelete(t *testing.T) {
 		}
 	}
 }
+
+func TestQuotaExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for i, tt := range []struct {
+		err   error
+		quota bool
+	}{
+		{status.Error(codes.FailedPrecondition, "quota exceeded"), true},
+		{status.Error(codes.ResourceExhausted, "not enough nodes"), false},
+		{ErrUnauthenticated, false},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+		gc := NewMockPointerDBClient(ctrl)
+		pdb := PointerDB{client: gc}
+
+		gc.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+
+		_, err := pdb.PayerBandwidthAllocation(context.Background(), pb.PayerBandwidthAllocation_GET)
+		assert.Error(t, err, errTag)
+		assert.Equal(t, tt.quota, ErrQuotaExceeded.Has(err), errTag)
+	}
+}
@@ -9,3 +9,9 @@ import (
 
 // Error is the pdbclient error class
 var Error = errs.Class("pointerdb client error")
+
+// ErrPointerChanged is returned when a compare and swap finds a different pointer
+var ErrPointerChanged = errs.Class("pointer changed")
+
+// ErrQuotaExceeded is returned when the project of the API key exceeded its storage or egress limit
+var ErrQuotaExceeded = errs.Class("project limit")
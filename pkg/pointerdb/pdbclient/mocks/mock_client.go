@@ -49,6 +49,18 @@ func (mr *MockClientMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClient)(nil).Delete), arg0, arg1)
 }
 
+// CompareAndSwap mocks base method
+func (m *MockClient) CompareAndSwap(arg0 context.Context, arg1 string, arg2, arg3 *pb.Pointer) error {
+	ret := m.ctrl.Call(m, "CompareAndSwap", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompareAndSwap indicates an expected call of CompareAndSwap
+func (mr *MockClientMockRecorder) CompareAndSwap(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSwap", reflect.TypeOf((*MockClient)(nil).CompareAndSwap), arg0, arg1, arg2, arg3)
+}
+
 // Get mocks base method
 func (m *MockClient) Get(arg0 context.Context, arg1 string) (*pb.Pointer, []*pb.Node, *pb.PayerBandwidthAllocation, error) {
 	ret := m.ctrl.Call(m, "Get", arg0, arg1)
@@ -58,6 +58,24 @@ func (mr *MockPointerDBClientMockRecorder) Delete(arg0, arg1 interface{}, arg2 .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPointerDBClient)(nil).Delete), varargs...)
 }
 
+// CompareAndSwap mocks base method
+func (m *MockPointerDBClient) CompareAndSwap(arg0 context.Context, arg1 *pb.CompareAndSwapRequest, arg2 ...grpc.CallOption) (*pb.CompareAndSwapResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompareAndSwap", varargs...)
+	ret0, _ := ret[0].(*pb.CompareAndSwapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareAndSwap indicates an expected call of CompareAndSwap
+func (mr *MockPointerDBClientMockRecorder) CompareAndSwap(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSwap", reflect.TypeOf((*MockPointerDBClient)(nil).CompareAndSwap), varargs...)
+}
+
 // Get mocks base method
 func (m *MockPointerDBClient) Get(arg0 context.Context, arg1 *pb.GetRequest, arg2 ...grpc.CallOption) (*pb.GetResponse, error) {
 	varargs := []interface{}{arg0, arg1}
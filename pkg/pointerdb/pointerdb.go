@@ -4,17 +4,22 @@
 package pointerdb
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/mr-tron/base58/base58"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"czarcoin.org/czarcoin/pkg/accounting/projectusage"
 	"czarcoin.org/czarcoin/pkg/auth"
 	"czarcoin.org/czarcoin/pkg/overlay"
 	"czarcoin.org/czarcoin/pkg/pb"
@@ -36,6 +41,7 @@ type Server struct {
 	config   Config
 	cache    *overlay.Cache
 	identity *provider.FullIdentity
+	usage    *projectusage.Service // nil when projects aren't accounted
 }
 
 // NewServer creates instance of Server
@@ -49,13 +55,30 @@ func NewServer(db storage.KeyValueStore, cache *overlay.Cache, logger *zap.Logge
 	}
 }
 
-func (s *Server) validateAuth(ctx context.Context) error {
+// SetProjectUsage enables the accounting and limits of the projects of API keys
+func (s *Server) SetProjectUsage(usage *projectusage.Service) {
+	s.usage = usage
+}
+
+// validateAuth validates the API key of the request and returns its project,
+// the project is nil for keys which don't belong to a project
+func (s *Server) validateAuth(ctx context.Context) (*uuid.UUID, error) {
 	APIKey, ok := auth.GetAPIKey(ctx)
+	if ok && s.usage != nil {
+		projectID, err := s.usage.Project(ctx, APIKey)
+		if err != nil {
+			s.logger.Error("err looking up project of api key", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if projectID != nil {
+			return projectID, nil
+		}
+	}
 	if !ok || !pointerdbAuth.ValidateAPIKey(string(APIKey)) {
 		s.logger.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "Invalid API credential")))
-		return status.Errorf(codes.Unauthenticated, "Invalid API credential")
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
 	}
-	return nil
+	return nil, nil
 }
 
 func (s *Server) validateSegment(req *pb.PutRequest) error {
@@ -86,35 +109,122 @@ func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (resp *pb.PutRespo
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	if err = s.validateAuth(ctx); err != nil {
+	projectID, err := s.validateAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	// Update the pointer with the creation date
-	req.GetPointer().CreationDate = ptypes.TimestampNow()
+	// Update the pointer with the creation date and the project which stores it
+	pointer := req.GetPointer()
+	pointer.CreationDate = ptypes.TimestampNow()
+	pointer.ProjectId = nil
+	if projectID != nil {
+		pointer.ProjectId = projectID[:]
+	}
 
-	pointerBytes, err := proto.Marshal(req.GetPointer())
+	pointerBytes, err := proto.Marshal(pointer)
 	if err != nil {
 		s.logger.Error("err marshaling pointer", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	// TODO(kaloyan): make sure that we know we are overwriting the pointer!
-	// In such case we should delete the pieces of the old segment if it was
-	// a remote one.
-	if err = s.DB.Put([]byte(req.GetPath()), pointerBytes); err != nil {
-		s.logger.Error("err putting pointer", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+	// the pointer is only replaced if it didn't change since it was
+	// accounted, otherwise it is accounted again against the newer one
+	for {
+		old, oldBytes, err := s.getPointer(req.GetPath())
+		if err != nil {
+			return nil, err
+		}
+
+		if projectID != nil {
+			delta := segmentSize(pointer)
+			if old != nil && bytes.Equal(old.GetProjectId(), pointer.ProjectId) {
+				delta -= segmentSize(old)
+			}
+			if delta > 0 {
+				if err = s.usage.CheckStorage(ctx, *projectID, delta); err != nil {
+					return nil, s.usageStatus(err)
+				}
+			}
+		}
+
+		// TODO(kaloyan): make sure that we know we are overwriting the pointer!
+		// In such case we should delete the pieces of the old segment if it was
+		// a remote one.
+		err = s.DB.CompareAndSwap([]byte(req.GetPath()), oldBytes, pointerBytes)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			s.logger.Error("err putting pointer", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		s.addStorage(ctx, old, -1)
+		s.addStorage(ctx, pointer, 1)
+
+		return &pb.PutResponse{}, nil
+	}
+}
+
+// getPointer returns the pointer at path and its stored bytes, or nils when there is none
+func (s *Server) getPointer(path string) (*pb.Pointer, storage.Value, error) {
+	pointerBytes, err := s.DB.Get([]byte(path))
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, nil, nil
+		}
+		s.logger.Error("err getting pointer", zap.Error(err))
+		return nil, nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.PutResponse{}, nil
+	pointer := &pb.Pointer{}
+	if err = proto.Unmarshal(pointerBytes, pointer); err != nil {
+		s.logger.Error("Error unmarshaling pointer")
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return pointer, pointerBytes, nil
+}
+
+// addStorage adds the size of the segment, multiplied by sign, to the storage
+// of the project which stores it, failures are only logged as the pointer is
+// already changed
+func (s *Server) addStorage(ctx context.Context, pointer *pb.Pointer, sign int64) {
+	if s.usage == nil || pointer == nil || len(pointer.GetProjectId()) == 0 {
+		return
+	}
+	projectID, err := projectusage.ParseProjectID(pointer.GetProjectId())
+	if err != nil {
+		s.logger.Error("invalid project id of pointer", zap.Error(err))
+		return
+	}
+	if err = s.usage.AddStorage(ctx, projectID, sign*segmentSize(pointer)); err != nil {
+		s.logger.Error("err accounting project storage", zap.Error(err))
+	}
+}
+
+// usageStatus passes quota exceeded statuses through and turns other errors into internal ones
+func (s *Server) usageStatus(err error) error {
+	if projectusage.IsQuotaExceeded(err) {
+		return errs.Unwrap(err)
+	}
+	s.logger.Error("err checking project usage", zap.Error(err))
+	return status.Error(codes.Internal, err.Error())
+}
+
+// segmentSize returns the number of bytes of the segment of the pointer
+func segmentSize(pointer *pb.Pointer) int64 {
+	if pointer.GetType() == pb.Pointer_INLINE {
+		return int64(len(pointer.GetInlineSegment()))
+	}
+	return pointer.GetSegmentSize()
 }
 
 // Get formats and hands off a file path to get from boltdb
 func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err = s.validateAuth(ctx); err != nil {
+	if _, err = s.validateAuth(ctx); err != nil {
 		return nil, err
 	}
 
@@ -134,8 +244,16 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 		return nil, err
 	}
 
+	// the audit challenges of the pieces are kept secret from uplinks, list
+	// items never contain the remote pieces
+	if !s.fromSatellite(ctx) {
+		stripChallenges(pointer)
+	}
+
+	// projects over their egress limit get no download allocation, but can
+	// still look the metadata of their segments up and delete them
 	pba, err := s.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: pb.PayerBandwidthAllocation_GET})
-	if err != nil {
+	if err != nil && !projectusage.IsQuotaExceeded(err) {
 		s.logger.Error("err getting payer bandwidth allocation", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -164,6 +282,10 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 		if err != nil {
 			s.logger.Error("Error getting node from cache")
 		}
+		// outdated nodes are treated as unavailable
+		if node != nil && !s.cache.MinVersions.Allowed(node) {
+			node = nil
+		}
 		nodes = append(nodes, node)
 	}
 
@@ -177,11 +299,31 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 	return r, nil
 }
 
+// fromSatellite returns whether the request was made with the identity of
+// the satellite, as its services do
+func (s *Server) fromSatellite(ctx context.Context) bool {
+	if s.identity == nil {
+		return false
+	}
+	peerIdentity, err := provider.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return false
+	}
+	return peerIdentity.ID == s.identity.ID
+}
+
+// stripChallenges removes the audit challenges from the pieces of pointer
+func stripChallenges(pointer *pb.Pointer) {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		piece.Challenges = nil
+	}
+}
+
 // List returns all Path keys in the Pointers bucket
 func (s *Server) List(ctx context.Context, req *pb.ListRequest) (resp *pb.ListResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err = s.validateAuth(ctx); err != nil {
+	if _, err = s.validateAuth(ctx); err != nil {
 		return nil, err
 	}
 
@@ -267,17 +409,111 @@ func (s *Server) setMetadata(item *pb.ListResponse_Item, data []byte, metaFlags
 func (s *Server) Delete(ctx context.Context, req *pb.DeleteRequest) (resp *pb.DeleteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err = s.validateAuth(ctx); err != nil {
+	if _, err = s.validateAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	// without accounting there's no need to know which pointer is deleted
+	if s.usage == nil {
+		err = s.DB.Delete([]byte(req.GetPath()))
+		if err != nil {
+			s.logger.Error("err deleting path and pointer", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		return &pb.DeleteResponse{}, nil
+	}
+
+	for {
+		old, oldBytes, err := s.getPointer(req.GetPath())
+		if err != nil {
+			return nil, err
+		}
+
+		if old == nil {
+			err = s.DB.Delete([]byte(req.GetPath()))
+		} else {
+			err = s.DB.CompareAndSwap([]byte(req.GetPath()), oldBytes, nil)
+		}
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			s.logger.Error("err deleting path and pointer", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		s.addStorage(ctx, old, -1)
+
+		return &pb.DeleteResponse{}, nil
+	}
+}
+
+// CompareAndSwap replaces a pointer only if it still equals the old pointer
+// of the request, the creation date of the new pointer is kept as is
+func (s *Server) CompareAndSwap(ctx context.Context, req *pb.CompareAndSwapRequest) (resp *pb.CompareAndSwapResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.validateSegment(&pb.PutRequest{Path: req.GetPath(), Pointer: req.GetNewPointer()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err = s.validateAuth(ctx); err != nil {
 		return nil, err
 	}
 
-	err = s.DB.Delete([]byte(req.GetPath()))
+	oldBytes, err := s.DB.Get([]byte(req.GetPath()))
 	if err != nil {
-		s.logger.Error("err deleting path and pointer", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.Aborted, "pointer %s was deleted", req.GetPath())
+		}
+		s.logger.Error("err getting pointer", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	current := &pb.Pointer{}
+	if err = proto.Unmarshal(oldBytes, current); err != nil {
+		s.logger.Error("Error unmarshaling pointer")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !proto.Equal(current, req.GetOldPointer()) {
+		return nil, status.Errorf(codes.Aborted, "pointer %s was changed", req.GetPath())
+	}
+
+	// the segment stays with the project which stored it
+	pointer := req.GetNewPointer()
+	pointer.ProjectId = current.GetProjectId()
+
+	if delta := segmentSize(pointer) - segmentSize(current); delta > 0 && s.usage != nil && len(current.GetProjectId()) > 0 {
+		projectID, err := projectusage.ParseProjectID(current.GetProjectId())
+		if err != nil {
+			s.logger.Error("invalid project id of pointer", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if err = s.usage.CheckStorage(ctx, projectID, delta); err != nil {
+			return nil, s.usageStatus(err)
+		}
 	}
 
-	return &pb.DeleteResponse{}, nil
+	pointerBytes, err := proto.Marshal(pointer)
+	if err != nil {
+		s.logger.Error("err marshaling pointer", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// the store only swaps if the pointer still is the compared one
+	err = s.DB.CompareAndSwap([]byte(req.GetPath()), oldBytes, pointerBytes)
+	if storage.ErrValueChanged.Has(err) {
+		return nil, status.Errorf(codes.Aborted, "pointer %s was changed", req.GetPath())
+	}
+	if err != nil {
+		s.logger.Error("err putting pointer", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.addStorage(ctx, current, -1)
+	s.addStorage(ctx, pointer, 1)
+
+	return &pb.CompareAndSwapResponse{}, nil
 }
 
 // Iterate iterates over items based on IterateRequest
@@ -291,20 +527,37 @@ func (s *Server) Iterate(ctx context.Context, req *pb.IterateRequest, f func(it
 	return s.DB.Iterate(opts, f)
 }
 
-// PayerBandwidthAllocation returns PayerBandwidthAllocation struct, signed and with given action type
+// PayerBandwidthAllocation returns PayerBandwidthAllocation struct, signed and with given action type,
+// the allocation is attributed to the project of the API key and refused once the project exceeds its limits
 func (s *Server) PayerBandwidthAllocation(ctx context.Context, req *pb.PayerBandwidthAllocationRequest) (*pb.PayerBandwidthAllocationResponse, error) {
 	payer := s.identity.ID
 
+	projectID, err := s.allocationProject(ctx, req.GetAction())
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO(michal) should be replaced with renter id when available
 	peerIdentity, err := provider.PeerIdentityFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	created := time.Now()
 	pbad := &pb.PayerBandwidthAllocation_Data{
-		SatelliteId:    payer,
-		UplinkId:       peerIdentity.ID,
-		CreatedUnixSec: time.Now().Unix(),
-		Action:         req.GetAction(),
+		SatelliteId:       payer,
+		UplinkId:          peerIdentity.ID,
+		MaxSize:           s.config.AllocationMaxSize,
+		ExpirationUnixSec: created.Add(s.config.AllocationExpiration).Unix(),
+		SerialNumber:      serialNumber,
+		CreatedUnixSec:    created.Unix(),
+		Action:            req.GetAction(),
+	}
+	if projectID != nil {
+		pbad.ProjectId = projectID[:]
 	}
 
 	data, err := proto.Marshal(pbad)
@@ -318,6 +571,46 @@ func (s *Server) PayerBandwidthAllocation(ctx context.Context, req *pb.PayerBand
 	return &pb.PayerBandwidthAllocationResponse{Pba: &pb.PayerBandwidthAllocation{Signature: signature, Data: data}}, nil
 }
 
+// allocationProject returns the project of the API key of the request, or nil
+// when there is none, and checks the limit of the project for the action
+func (s *Server) allocationProject(ctx context.Context, action pb.PayerBandwidthAllocation_Action) (*uuid.UUID, error) {
+	if s.usage == nil {
+		return nil, nil
+	}
+	APIKey, ok := auth.GetAPIKey(ctx)
+	if !ok {
+		return nil, nil
+	}
+	projectID, err := s.usage.Project(ctx, APIKey)
+	if err != nil {
+		s.logger.Error("err looking up project of api key", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if projectID == nil {
+		return nil, nil
+	}
+
+	switch action {
+	case pb.PayerBandwidthAllocation_GET:
+		err = s.usage.CheckEgress(ctx, *projectID, time.Now())
+	case pb.PayerBandwidthAllocation_PUT:
+		err = s.usage.CheckStorage(ctx, *projectID, 0)
+	}
+	if err != nil {
+		return nil, s.usageStatus(err)
+	}
+	return projectID, nil
+}
+
+// newSerialNumber returns a random serial number for a bandwidth allocation
+func newSerialNumber() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base58.Encode(b), nil
+}
+
 func (s *Server) getSignedMessage() (*pb.SignedMessage, error) {
 	signature, err := auth.GenerateSignature(s.identity.ID.Bytes(), s.identity)
 	if err != nil {
@@ -10,10 +10,12 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -22,8 +24,13 @@ import (
 	"google.golang.org/grpc/status"
 
 	"czarcoin.org/czarcoin/internal/identity"
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/pkg/accounting/projectusage"
 	"czarcoin.org/czarcoin/pkg/auth"
 	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/provider"
+	"czarcoin.org/czarcoin/pkg/satellite"
+	"czarcoin.org/czarcoin/pkg/satellite/satellitedb"
 	"czarcoin.org/czarcoin/pkg/storage/meta"
 	"czarcoin.org/czarcoin/storage"
 	"czarcoin.org/czarcoin/storage/teststore"
@@ -123,6 +130,76 @@ func TestServiceGet(t *testing.T) {
 	}
 }
 
+func TestServiceGetChallenges(t *testing.T) {
+	ctx := auth.WithAPIKey(context.Background(), nil)
+	// node ids are derived from the CA
+	satellite, err := testidentity.NewTestIdentity()
+	assert.NoError(t, err)
+	uplink, err := testidentity.NewTestIdentity()
+	assert.NoError(t, err)
+
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop(), identity: satellite}
+
+	pr := &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			RemotePieces: []*pb.RemotePiece{
+				{PieceNum: 0, Challenges: []*pb.AuditChallenge{{Nonce: []byte("nonce")}}},
+			},
+		},
+	}
+	prBytes, err := proto.Marshal(pr)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Put(storage.Key("a/b/c"), storage.Value(prBytes)))
+
+	for _, tt := range []struct {
+		identity   *provider.FullIdentity
+		challenges int
+	}{
+		{satellite, 1},
+		{uplink, 0},
+	} {
+		info := credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{tt.identity.Leaf, tt.identity.CA}}}
+
+		resp, err := s.Get(peer.NewContext(ctx, &peer.Peer{AuthInfo: info}), &pb.GetRequest{Path: "a/b/c"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, resp.GetPointer().GetRemote().GetRemotePieces()[0].GetChallenges(), tt.challenges)
+	}
+}
+
+func TestPayerBandwidthAllocation(t *testing.T) {
+	ctx := context.Background()
+	ca, err := testidentity.NewTestCA(ctx)
+	assert.NoError(t, err)
+	identity, err := ca.NewIdentity()
+	assert.NoError(t, err)
+
+	info := credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{identity.Leaf, identity.CA}}}
+	ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: info})
+
+	config := Config{AllocationExpiration: time.Hour, AllocationMaxSize: 1000}
+	s := NewServer(teststore.New(), nil, zap.NewNop(), config, identity)
+
+	serials := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		resp, err := s.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: pb.PayerBandwidthAllocation_PUT})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pbad := &pb.PayerBandwidthAllocation_Data{}
+		assert.NoError(t, proto.Unmarshal(resp.GetPba().GetData(), pbad))
+		assert.EqualValues(t, 1000, pbad.GetMaxSize())
+		assert.InDelta(t, time.Now().Add(time.Hour).Unix(), pbad.GetExpirationUnixSec(), 5)
+		assert.NotEmpty(t, pbad.GetSerialNumber())
+		assert.False(t, serials[pbad.GetSerialNumber()], "serial number reused")
+		serials[pbad.GetSerialNumber()] = true
+	}
+}
+
 func TestServiceDelete(t *testing.T) {
 	for i, tt := range []struct {
 		apiKey    []byte
@@ -159,6 +236,40 @@ func TestServiceDelete(t *testing.T) {
 	}
 }
 
+func TestServiceCompareAndSwap(t *testing.T) {
+	ctx := auth.WithAPIKey(context.Background(), nil)
+	path := "a/b/c"
+
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop(), config: Config{MaxInlineSegmentSize: 8000}}
+
+	stored := func() *pb.Pointer {
+		pointerBytes, err := db.Get(storage.Key(path))
+		assert.NoError(t, err)
+		pointer := &pb.Pointer{}
+		assert.NoError(t, proto.Unmarshal(pointerBytes, pointer))
+		return pointer
+	}
+
+	old := &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("old")}
+	_, err := s.Put(ctx, &pb.PutRequest{Path: path, Pointer: old})
+	assert.NoError(t, err)
+	current := stored()
+
+	swapped := &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("new"), CreationDate: current.CreationDate}
+	_, err = s.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Path: path, OldPointer: current, NewPointer: swapped})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(swapped, stored()))
+
+	// the pointer isn't the old one anymore
+	_, err = s.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Path: path, OldPointer: current, NewPointer: current})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.True(t, proto.Equal(swapped, stored()))
+
+	_, err = s.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Path: "missing", OldPointer: current, NewPointer: current})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
 func TestServiceList(t *testing.T) {
 	db := teststore.New()
 	server := Server{DB: db, logger: zap.NewNop()}
@@ -342,3 +453,151 @@ func TestServiceList(t *testing.T) {
 		}
 	}
 }
+
+type fakeProjectUsage struct {
+	storage map[uuid.UUID]int64
+	egress  map[uuid.UUID]int64
+}
+
+func (usage *fakeProjectUsage) AddStorage(ctx context.Context, projectID uuid.UUID, delta int64) error {
+	usage.storage[projectID] += delta
+	return nil
+}
+
+func (usage *fakeProjectUsage) GetStorage(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	return usage.storage[projectID], nil
+}
+
+func (usage *fakeProjectUsage) AddEgress(ctx context.Context, projectID uuid.UUID, month time.Time, bytes int64) error {
+	usage.egress[projectID] += bytes
+	return nil
+}
+
+func (usage *fakeProjectUsage) GetEgress(ctx context.Context, projectID uuid.UUID, month time.Time) (int64, error) {
+	return usage.egress[projectID], nil
+}
+
+func TestProjectUsage(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	ca, err := testidentity.NewTestCA(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := ca.NewIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{identity.Leaf, identity.CA}}}
+
+	console, err := satellitedb.New("sqlite3", "file:pointerdbusage?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(console.Close)
+	if err = console.CreateTables(); err != nil {
+		t.Fatal(err)
+	}
+
+	project, err := console.Projects().Insert(ctx, &satellite.Project{Name: "Project"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := []byte("project key")
+	if _, err = console.APIKeys().Insert(ctx, project.ID, key, "key"); err != nil {
+		t.Fatal(err)
+	}
+	err = console.ProjectLimits().Set(ctx, &satellite.ProjectLimit{ProjectID: project.ID, StorageLimit: 100, EgressLimit: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	usage := &fakeProjectUsage{storage: map[uuid.UUID]int64{}, egress: map[uuid.UUID]int64{}}
+	s := NewServer(teststore.New(), nil, zap.NewNop(), Config{MaxInlineSegmentSize: 8000}, identity)
+	s.SetProjectUsage(projectusage.NewService(usage, console))
+
+	projectCtx := peer.NewContext(auth.WithAPIKey(ctx, key), &peer.Peer{AuthInfo: info})
+	put := func(path string, size int) error {
+		pointer := &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: make([]byte, size)}
+		_, err := s.Put(projectCtx, &pb.PutRequest{Path: path, Pointer: pointer})
+		return err
+	}
+
+	// segments are attributed to the project of the API key
+	assert.NoError(t, put("a", 60))
+	assert.EqualValues(t, 60, usage.storage[project.ID])
+	resp, err := s.Get(projectCtx, &pb.GetRequest{Path: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, project.ID[:], resp.GetPointer().GetProjectId())
+
+	// overwriting a segment only accounts the difference
+	assert.NoError(t, put("a", 80))
+	assert.EqualValues(t, 80, usage.storage[project.ID])
+
+	// uploads over the storage limit are rejected
+	err = put("b", 30)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.True(t, projectusage.IsQuotaExceeded(err))
+	assert.EqualValues(t, 80, usage.storage[project.ID])
+
+	// deleting a segment frees its storage
+	_, err = s.Delete(projectCtx, &pb.DeleteRequest{Path: "a"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, usage.storage[project.ID])
+	assert.NoError(t, put("b", 30))
+
+	// segments swapped by the satellite stay with their project and account the difference
+	get := func(path string) *pb.Pointer {
+		resp, err := s.Get(projectCtx, &pb.GetRequest{Path: path})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.GetPointer()
+	}
+	swap := func(path string, size int) error {
+		current := get(path)
+		swapped := &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: make([]byte, size), CreationDate: current.CreationDate, ProjectId: []byte("other project")}
+		_, err := s.CompareAndSwap(auth.WithAPIKey(ctx, nil), &pb.CompareAndSwapRequest{Path: path, OldPointer: current, NewPointer: swapped})
+		return err
+	}
+	assert.NoError(t, swap("b", 20))
+	assert.EqualValues(t, 20, usage.storage[project.ID])
+	assert.Equal(t, project.ID[:], get("b").GetProjectId())
+	err = swap("b", 120)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.EqualValues(t, 20, usage.storage[project.ID])
+
+	// allocations carry the project and are refused once the egress limit is used up
+	pba, err := s.PayerBandwidthAllocation(projectCtx, &pb.PayerBandwidthAllocationRequest{Action: pb.PayerBandwidthAllocation_GET})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pbad := &pb.PayerBandwidthAllocation_Data{}
+	assert.NoError(t, proto.Unmarshal(pba.GetPba().GetData(), pbad))
+	assert.Equal(t, project.ID[:], pbad.GetProjectId())
+
+	usage.egress[project.ID] = 50
+	_, err = s.PayerBandwidthAllocation(projectCtx, &pb.PayerBandwidthAllocationRequest{Action: pb.PayerBandwidthAllocation_GET})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.True(t, projectusage.IsQuotaExceeded(err))
+	// other exhausted resources, like too few nodes, aren't the limits of the project
+	assert.False(t, projectusage.IsQuotaExceeded(status.Error(codes.ResourceExhausted, "not enough nodes")))
+
+	// segments of projects over their egress limit can still be looked up and deleted
+	resp, err = s.Get(projectCtx, &pb.GetRequest{Path: "b"})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp.GetPointer())
+	assert.Nil(t, resp.GetPba())
+	_, err = s.Delete(projectCtx, &pb.DeleteRequest{Path: "b"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, usage.storage[project.ID])
+
+	// keys without a project still use the configured API key and aren't accounted
+	_, err = s.Put(auth.WithAPIKey(ctx, nil), &pb.PutRequest{Path: "c", Pointer: &pb.Pointer{}})
+	assert.NoError(t, err)
+	_, err = s.Put(auth.WithAPIKey(ctx, []byte("wrong key")), &pb.PutRequest{Path: "c", Pointer: &pb.Pointer{}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
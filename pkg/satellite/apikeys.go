@@ -0,0 +1,33 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellite
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// APIKeys exposes methods to manage APIKeys table in database.
+type APIKeys interface {
+	// GetByKey is a method for querying the api key info from the database by key, it returns nil for unknown keys.
+	GetByKey(ctx context.Context, key []byte) (*APIKeyInfo, error)
+	// GetByProjectID is a method for querying the api keys of a project from the database.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]APIKeyInfo, error)
+	// Insert is a method for inserting an api key of a project into the database.
+	Insert(ctx context.Context, projectID uuid.UUID, key []byte, name string) (*APIKeyInfo, error)
+	// Delete is a method for deleting api key by key from the database.
+	Delete(ctx context.Context, key []byte) error
+}
+
+// APIKeyInfo is a database object that describes the API key of a project
+type APIKeyInfo struct {
+	Key []byte
+	// FK on Projects table.
+	ProjectID uuid.UUID
+	Name      string
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellite
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ProjectLimits exposes methods to manage ProjectLimits table in database.
+type ProjectLimits interface {
+	// Get is a method for querying the limits of a project, projects without limits get zero limits.
+	Get(ctx context.Context, projectID uuid.UUID) (*ProjectLimit, error)
+	// Set is a method for inserting or updating the limits of a project.
+	Set(ctx context.Context, limit *ProjectLimit) error
+}
+
+// ProjectLimit is a database object that describes the monthly usage limits of a project
+type ProjectLimit struct {
+	// FK on Projects table.
+	ProjectID uuid.UUID
+
+	// StorageLimit is the maximum number of bytes the project can store, zero is unlimited
+	StorageLimit int64
+	// EgressLimit is the maximum number of bytes the project can download within a month, zero is unlimited
+	EgressLimit int64
+}
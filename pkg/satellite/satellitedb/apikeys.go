@@ -0,0 +1,92 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"czarcoin.org/czarcoin/pkg/satellite"
+	"czarcoin.org/czarcoin/pkg/satellite/satellitedb/dbx"
+	"czarcoin.org/czarcoin/pkg/utils"
+)
+
+// implementation of APIKeys interface repository using spacemonkeygo/dbx orm
+type apiKeys struct {
+	db *dbx.DB
+}
+
+// GetByKey is a method for querying the api key info from the database by key, it returns nil for unknown keys.
+func (keys *apiKeys) GetByKey(ctx context.Context, key []byte) (*satellite.APIKeyInfo, error) {
+	apiKey, err := keys.db.Get_ApiKey_By_Key(ctx, dbx.ApiKey_Key(key))
+	if isNoRows(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeyFromDBX(apiKey)
+}
+
+// GetByProjectID is a method for querying the api keys of a project from the database.
+func (keys *apiKeys) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]satellite.APIKeyInfo, error) {
+	apiKeysDbx, err := keys.db.All_ApiKey_By_ProjectId_OrderBy_Asc_CreatedAt(ctx, dbx.ApiKey_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []satellite.APIKeyInfo
+	var errors []error
+	for _, apiKeyDbx := range apiKeysDbx {
+		info, err := apiKeyFromDBX(apiKeyDbx)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+		infos = append(infos, *info)
+	}
+
+	return infos, utils.CombineErrors(errors...)
+}
+
+// Insert is a method for inserting an api key of a project into the database.
+func (keys *apiKeys) Insert(ctx context.Context, projectID uuid.UUID, key []byte, name string) (*satellite.APIKeyInfo, error) {
+	createdAPIKey, err := keys.db.Create_ApiKey(ctx,
+		dbx.ApiKey_Key(key),
+		dbx.ApiKey_ProjectId(projectID[:]),
+		dbx.ApiKey_Name(name))
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeyFromDBX(createdAPIKey)
+}
+
+// Delete is a method for deleting api key by key from the database.
+func (keys *apiKeys) Delete(ctx context.Context, key []byte) error {
+	_, err := keys.db.Delete_ApiKey_By_Key(ctx, dbx.ApiKey_Key(key))
+	return err
+}
+
+// apiKeyFromDBX is used for creating APIKeyInfo entity from autogenerated dbx.ApiKey struct
+func apiKeyFromDBX(apiKey *dbx.ApiKey) (*satellite.APIKeyInfo, error) {
+	if apiKey == nil {
+		return nil, errs.New("apiKey parameter is nil")
+	}
+
+	projectID, err := bytesToUUID(apiKey.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &satellite.APIKeyInfo{
+		Key:       apiKey.Key,
+		ProjectID: projectID,
+		Name:      apiKey.Name,
+		CreatedAt: apiKey.CreatedAt,
+	}, nil
+}
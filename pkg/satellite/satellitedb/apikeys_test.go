@@ -0,0 +1,80 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/pkg/satellite"
+)
+
+func TestAPIKeysRepository(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := New("sqlite3", "file:apikeys?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(db.Close)
+
+	err = db.CreateTables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	project, err := db.Projects().Insert(ctx, &satellite.Project{Name: "Project"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiKeys := db.APIKeys()
+	key := []byte("project key")
+
+	t.Run("Insert api key success", func(t *testing.T) {
+		info, err := apiKeys.Insert(ctx, project.ID, key, "key name")
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, info) {
+			assert.Equal(t, key, info.Key)
+			assert.Equal(t, project.ID, info.ProjectID)
+			assert.Equal(t, "key name", info.Name)
+		}
+	})
+
+	t.Run("Get api key success", func(t *testing.T) {
+		info, err := apiKeys.GetByKey(ctx, key)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, info) {
+			assert.Equal(t, project.ID, info.ProjectID)
+		}
+
+		infos, err := apiKeys.GetByProjectID(ctx, project.ID)
+
+		assert.NoError(t, err)
+		if assert.Len(t, infos, 1) {
+			assert.Equal(t, key, infos[0].Key)
+		}
+	})
+
+	t.Run("Get unknown api key", func(t *testing.T) {
+		info, err := apiKeys.GetByKey(ctx, []byte("unknown key"))
+
+		assert.NoError(t, err)
+		assert.Nil(t, info)
+	})
+
+	t.Run("Delete api key success", func(t *testing.T) {
+		err := apiKeys.Delete(ctx, key)
+		assert.NoError(t, err)
+
+		info, err := apiKeys.GetByKey(ctx, key)
+		assert.NoError(t, err)
+		assert.Nil(t, info)
+	})
+}
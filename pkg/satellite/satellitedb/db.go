@@ -56,9 +56,19 @@ func (db *Database) ProjectMembers() satellite.ProjectMembers {
 	return &projectMembers{db.db}
 }
 
+// APIKeys is a getter for APIKeys repository
+func (db *Database) APIKeys() satellite.APIKeys {
+	return &apiKeys{db.db}
+}
+
+// ProjectLimits is a getter for ProjectLimits repository
+func (db *Database) ProjectLimits() satellite.ProjectLimits {
+	return &projectLimits{db.db}
+}
+
 // CreateTables is a method for creating all tables for satellitedb
 func (db *Database) CreateTables() error {
-	return migrate.Create("satellitedb", db.db)
+	return migrate.CreateWithMigrations("satellitedb", db.db, migrations...)
 }
 
 // Close is used to close db connection
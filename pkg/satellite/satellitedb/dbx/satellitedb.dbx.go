@@ -303,6 +303,19 @@ CREATE TABLE project_members (
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
+);
+CREATE TABLE api_keys (
+	key BLOB NOT NULL,
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( key )
+);
+CREATE TABLE project_limits (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_limit INTEGER NOT NULL,
+	egress_limit INTEGER NOT NULL,
+	PRIMARY KEY ( project_id )
 );`
 }
 
@@ -904,6 +917,157 @@ func (f ProjectMember_CreatedAt_Field) value() interface{} {
 
 func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
 
+type ApiKey struct {
+	Key       []byte
+	ProjectId []byte
+	Name      string
+	CreatedAt time.Time
+}
+
+func (ApiKey) _Table() string { return "api_keys" }
+
+type ApiKey_Update_Fields struct {
+}
+
+type ApiKey_Key_Field struct {
+	_set   bool
+	_value []byte
+}
+
+func ApiKey_Key(v []byte) ApiKey_Key_Field {
+	return ApiKey_Key_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Key_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Key_Field) _Column() string { return "key" }
+
+type ApiKey_ProjectId_Field struct {
+	_set   bool
+	_value []byte
+}
+
+func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
+	return ApiKey_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_ProjectId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+
+type ApiKey_Name_Field struct {
+	_set   bool
+	_value string
+}
+
+func ApiKey_Name(v string) ApiKey_Name_Field {
+	return ApiKey_Name_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Name_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Name_Field) _Column() string { return "name" }
+
+type ApiKey_CreatedAt_Field struct {
+	_set   bool
+	_value time.Time
+}
+
+func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
+	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_CreatedAt_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectLimit struct {
+	ProjectId    []byte
+	StorageLimit int64
+	EgressLimit  int64
+}
+
+func (ProjectLimit) _Table() string { return "project_limits" }
+
+type ProjectLimit_Update_Fields struct {
+	StorageLimit ProjectLimit_StorageLimit_Field
+	EgressLimit  ProjectLimit_EgressLimit_Field
+}
+
+type ProjectLimit_ProjectId_Field struct {
+	_set   bool
+	_value []byte
+}
+
+func ProjectLimit_ProjectId(v []byte) ProjectLimit_ProjectId_Field {
+	return ProjectLimit_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectLimit_ProjectId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectLimit_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectLimit_StorageLimit_Field struct {
+	_set   bool
+	_value int64
+}
+
+func ProjectLimit_StorageLimit(v int64) ProjectLimit_StorageLimit_Field {
+	return ProjectLimit_StorageLimit_Field{_set: true, _value: v}
+}
+
+func (f ProjectLimit_StorageLimit_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectLimit_StorageLimit_Field) _Column() string { return "storage_limit" }
+
+type ProjectLimit_EgressLimit_Field struct {
+	_set   bool
+	_value int64
+}
+
+func ProjectLimit_EgressLimit(v int64) ProjectLimit_EgressLimit_Field {
+	return ProjectLimit_EgressLimit_Field{_set: true, _value: v}
+}
+
+func (f ProjectLimit_EgressLimit_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectLimit_EgressLimit_Field) _Column() string { return "egress_limit" }
+
 func toUTC(t time.Time) time.Time {
 	return t.UTC()
 }
@@ -1951,6 +2115,280 @@ func (obj *sqlite3Impl) getLastProjectMember(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Create_ApiKey(ctx context.Context,
+	api_key_key ApiKey_Key_Field,
+	api_key_project_id ApiKey_ProjectId_Field,
+	api_key_name ApiKey_Name_Field) (
+	api_key *ApiKey, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__key_val := api_key_key.value()
+	__project_id_val := api_key_project_id.value()
+	__name_val := api_key_name.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( key, project_id, name, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __key_val, __project_id_val, __name_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __key_val, __project_id_val, __name_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastApiKey(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_ApiKey_By_Key(ctx context.Context,
+	api_key_key ApiKey_Key_Field) (
+	api_key *ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.key, api_keys.project_id, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_key.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Key, &api_key.ProjectId, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	api_key_project_id ApiKey_ProjectId_Field) (
+	rows []*ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.key, api_keys.project_id, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.created_at")
+
+	var __values []interface{}
+	__values = append(__values, api_key_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		api_key := &ApiKey{}
+		err = __rows.Scan(&api_key.Key, &api_key.ProjectId, &api_key.Name, &api_key.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, api_key)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_ApiKey_By_Key(ctx context.Context,
+	api_key_key ApiKey_Key_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.key = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_key.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastApiKey(ctx context.Context,
+	pk int64) (
+	api_key *ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.key, api_keys.project_id, api_keys.name, api_keys.created_at FROM api_keys WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key.Key, &api_key.ProjectId, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *sqlite3Impl) Create_ProjectLimit(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	project_limit_storage_limit ProjectLimit_StorageLimit_Field,
+	project_limit_egress_limit ProjectLimit_EgressLimit_Field) (
+	project_limit *ProjectLimit, err error) {
+
+	__project_id_val := project_limit_project_id.value()
+	__storage_limit_val := project_limit_storage_limit.value()
+	__egress_limit_val := project_limit_egress_limit.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_limits ( project_id, storage_limit, egress_limit ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __storage_limit_val, __egress_limit_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __storage_limit_val, __egress_limit_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectLimit(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field) (
+	project_limit *ProjectLimit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_limits.project_id, project_limits.storage_limit, project_limits.egress_limit FROM project_limits WHERE project_limits.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_limit_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_limit = &ProjectLimit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_limit.ProjectId, &project_limit.StorageLimit, &project_limit.EgressLimit)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_limit, nil
+
+}
+
+func (obj *sqlite3Impl) Update_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	update ProjectLimit_Update_Fields) (
+	project_limit *ProjectLimit, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_limits SET "), __sets, __sqlbundle_Literal(" WHERE project_limits.project_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.EgressLimit._set {
+		__values = append(__values, update.EgressLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_limit = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_limit_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_limit = &ProjectLimit{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_limits.project_id, project_limits.storage_limit, project_limits.egress_limit FROM project_limits WHERE project_limits.project_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_limit.ProjectId, &project_limit.StorageLimit, &project_limit.EgressLimit)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_limit, nil
+}
+
+func (obj *sqlite3Impl) Delete_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_limits WHERE project_limits.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_limit_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastProjectLimit(ctx context.Context,
+	pk int64) (
+	project_limit *ProjectLimit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_limits.project_id, project_limits.storage_limit, project_limits.egress_limit FROM project_limits WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	project_limit = &ProjectLimit{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_limit.ProjectId, &project_limit.StorageLimit, &project_limit.EgressLimit)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_limit, nil
+
+}
+
 func (impl sqlite3Impl) isConstraintError(err error) (
 	constraint string, ok bool) {
 	if e, ok := err.(sqlite3.Error); ok {
@@ -1969,6 +2407,26 @@ func (impl sqlite3Impl) isConstraintError(err error) (
 func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM project_limits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.Exec("DELETE FROM project_members;")
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -2310,6 +2768,91 @@ func (rx *Rx) Update_User_By_Id(ctx context.Context,
 	return tx.Update_User_By_Id(ctx, user_id, update)
 }
 
+func (rx *Rx) Create_ApiKey(ctx context.Context,
+	api_key_key ApiKey_Key_Field,
+	api_key_project_id ApiKey_ProjectId_Field,
+	api_key_name ApiKey_Name_Field) (
+	api_key *ApiKey, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_ApiKey(ctx, api_key_key, api_key_project_id, api_key_name)
+}
+
+func (rx *Rx) Get_ApiKey_By_Key(ctx context.Context,
+	api_key_key ApiKey_Key_Field) (
+	api_key *ApiKey, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ApiKey_By_Key(ctx, api_key_key)
+}
+
+func (rx *Rx) Delete_ApiKey_By_Key(ctx context.Context,
+	api_key_key ApiKey_Key_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_ApiKey_By_Key(ctx, api_key_key)
+}
+
+func (rx *Rx) All_ApiKey_By_ProjectId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	api_key_project_id ApiKey_ProjectId_Field) (
+	rows []*ApiKey, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ApiKey_By_ProjectId_OrderBy_Asc_CreatedAt(ctx, api_key_project_id)
+}
+
+func (rx *Rx) Create_ProjectLimit(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	project_limit_storage_limit ProjectLimit_StorageLimit_Field,
+	project_limit_egress_limit ProjectLimit_EgressLimit_Field) (
+	project_limit *ProjectLimit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_ProjectLimit(ctx, project_limit_project_id, project_limit_storage_limit, project_limit_egress_limit)
+}
+
+func (rx *Rx) Get_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field) (
+	project_limit *ProjectLimit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ProjectLimit_By_ProjectId(ctx, project_limit_project_id)
+}
+
+func (rx *Rx) Update_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	update ProjectLimit_Update_Fields) (
+	project_limit *ProjectLimit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectLimit_By_ProjectId(ctx, project_limit_project_id, update)
+}
+
+func (rx *Rx) Delete_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_ProjectLimit_By_ProjectId(ctx, project_limit_project_id)
+}
+
 type Methods interface {
 	All_Project(ctx context.Context) (
 		rows []*Project, err error)
@@ -2422,6 +2965,43 @@ type Methods interface {
 		user_id User_Id_Field,
 		update User_Update_Fields) (
 		user *User, err error)
+
+	Create_ApiKey(ctx context.Context,
+		api_key_key ApiKey_Key_Field,
+		api_key_project_id ApiKey_ProjectId_Field,
+		api_key_name ApiKey_Name_Field) (
+		api_key *ApiKey, err error)
+
+	Get_ApiKey_By_Key(ctx context.Context,
+		api_key_key ApiKey_Key_Field) (
+		api_key *ApiKey, err error)
+
+	Delete_ApiKey_By_Key(ctx context.Context,
+		api_key_key ApiKey_Key_Field) (
+		deleted bool, err error)
+
+	All_ApiKey_By_ProjectId_OrderBy_Asc_CreatedAt(ctx context.Context,
+		api_key_project_id ApiKey_ProjectId_Field) (
+		rows []*ApiKey, err error)
+
+	Create_ProjectLimit(ctx context.Context,
+		project_limit_project_id ProjectLimit_ProjectId_Field,
+		project_limit_storage_limit ProjectLimit_StorageLimit_Field,
+		project_limit_egress_limit ProjectLimit_EgressLimit_Field) (
+		project_limit *ProjectLimit, err error)
+
+	Get_ProjectLimit_By_ProjectId(ctx context.Context,
+		project_limit_project_id ProjectLimit_ProjectId_Field) (
+		project_limit *ProjectLimit, err error)
+
+	Update_ProjectLimit_By_ProjectId(ctx context.Context,
+		project_limit_project_id ProjectLimit_ProjectId_Field,
+		update ProjectLimit_Update_Fields) (
+		project_limit *ProjectLimit, err error)
+
+	Delete_ProjectLimit_By_ProjectId(ctx context.Context,
+		project_limit_project_id ProjectLimit_ProjectId_Field) (
+		deleted bool, err error)
 }
 
 type TxMethods interface {
@@ -38,3 +38,16 @@ CREATE TABLE project_members (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE api_keys (
+	key BLOB NOT NULL,
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( key )
+);
+CREATE TABLE project_limits (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_limit INTEGER NOT NULL,
+	egress_limit INTEGER NOT NULL,
+	PRIMARY KEY ( project_id )
+);
@@ -0,0 +1,67 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"czarcoin.org/czarcoin/internal/migrate"
+)
+
+// migrations upgrade satellite databases created before api keys and project limits
+var migrations = []migrate.Migration{
+	{
+		From: `CREATE TABLE users (
+	id BLOB NOT NULL,
+	first_name TEXT NOT NULL,
+	last_name TEXT NOT NULL,
+	email TEXT NOT NULL,
+	password_hash BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( email )
+);
+CREATE TABLE companies (
+	user_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	address TEXT NOT NULL,
+	country TEXT NOT NULL,
+	city TEXT NOT NULL,
+	state TEXT NOT NULL,
+	postal_code TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( user_id )
+);
+CREATE TABLE projects (
+	id BLOB NOT NULL,
+	owner_id BLOB REFERENCES users( id ) ON DELETE SET NULL,
+	name TEXT NOT NULL,
+	company_name TEXT NOT NULL,
+	description TEXT NOT NULL,
+	terms_accepted INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_members (
+	id BLOB NOT NULL,
+	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);`,
+		Steps: []string{
+			`CREATE TABLE api_keys (
+	key BLOB NOT NULL,
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( key )
+);`,
+			`CREATE TABLE project_limits (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_limit INTEGER NOT NULL,
+	egress_limit INTEGER NOT NULL,
+	PRIMARY KEY ( project_id )
+);`,
+		},
+	},
+}
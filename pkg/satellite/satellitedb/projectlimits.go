@@ -0,0 +1,76 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"czarcoin.org/czarcoin/pkg/satellite"
+	"czarcoin.org/czarcoin/pkg/satellite/satellitedb/dbx"
+	"czarcoin.org/czarcoin/pkg/utils"
+)
+
+// implementation of ProjectLimits interface repository using spacemonkeygo/dbx orm
+type projectLimits struct {
+	db *dbx.DB
+}
+
+// Get is a method for querying the limits of a project, projects without limits get zero limits.
+func (limits *projectLimits) Get(ctx context.Context, projectID uuid.UUID) (*satellite.ProjectLimit, error) {
+	limit, err := limits.db.Get_ProjectLimit_By_ProjectId(ctx, dbx.ProjectLimit_ProjectId(projectID[:]))
+	if isNoRows(err) {
+		return &satellite.ProjectLimit{ProjectID: projectID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &satellite.ProjectLimit{
+		ProjectID:    projectID,
+		StorageLimit: limit.StorageLimit,
+		EgressLimit:  limit.EgressLimit,
+	}, nil
+}
+
+// Set is a method for inserting or updating the limits of a project.
+func (limits *projectLimits) Set(ctx context.Context, limit *satellite.ProjectLimit) (err error) {
+	tx, err := limits.db.Open(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = utils.CombineErrors(err, tx.Rollback())
+		}
+	}()
+
+	projectID := dbx.ProjectLimit_ProjectId(limit.ProjectID[:])
+	storageLimit := dbx.ProjectLimit_StorageLimit(limit.StorageLimit)
+	egressLimit := dbx.ProjectLimit_EgressLimit(limit.EgressLimit)
+
+	_, err = tx.Get_ProjectLimit_By_ProjectId(ctx, projectID)
+	if isNoRows(err) {
+		_, err = tx.Create_ProjectLimit(ctx, projectID, storageLimit, egressLimit)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	_, err = tx.Update_ProjectLimit_By_ProjectId(ctx, projectID, dbx.ProjectLimit_Update_Fields{
+		StorageLimit: storageLimit,
+		EgressLimit:  egressLimit,
+	})
+	return err
+}
+
+// isNoRows returns whether err is a dbx error for a missing row
+func isNoRows(err error) bool {
+	dbxErr, ok := errs.Unwrap(err).(*dbx.Error)
+	return ok && dbxErr.Code == dbx.ErrorCode_NoRows
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/pkg/satellite"
+)
+
+func TestProjectLimitsRepository(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := New("sqlite3", "file:projectlimits?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(db.Close)
+
+	err = db.CreateTables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	project, err := db.Projects().Insert(ctx, &satellite.Project{Name: "Project"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limits := db.ProjectLimits()
+
+	t.Run("Projects without limits are unlimited", func(t *testing.T) {
+		limit, err := limits.Get(ctx, project.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &satellite.ProjectLimit{ProjectID: project.ID}, limit)
+	})
+
+	t.Run("Set limits success", func(t *testing.T) {
+		for _, expected := range []*satellite.ProjectLimit{
+			{ProjectID: project.ID, StorageLimit: 100, EgressLimit: 200},
+			{ProjectID: project.ID, StorageLimit: 300},
+		} {
+			err := limits.Set(ctx, expected)
+			assert.NoError(t, err)
+
+			limit, err := limits.Get(ctx, project.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, limit)
+		}
+	})
+}
@@ -23,11 +23,12 @@ const (
 type Config struct {
 	DatabaseURL    string `help:"the database connection string to use" default:"$CONFDIR/stats.db"`
 	DatabaseDriver string `help:"the database driver to use" default:"sqlite3"`
+	Reputation     ReputationConfig
 }
 
 // Run implements the provider.Responsibility interface
 func (c Config) Run(ctx context.Context, server *provider.Provider) error {
-	ns, err := NewStatDB(c.DatabaseDriver, c.DatabaseURL, zap.L())
+	ns, err := NewStatDBWithReputation(c.DatabaseDriver, c.DatabaseURL, zap.L(), c.Reputation)
 	if err != nil {
 		return err
 	}
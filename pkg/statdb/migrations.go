@@ -0,0 +1,101 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb
+
+import (
+	"fmt"
+
+	"czarcoin.org/czarcoin/internal/migrate"
+)
+
+// schemas of the baseline statdb tables
+const (
+	postgresNodes = `CREATE TABLE nodes (
+	id bytea NOT NULL,
+	audit_success_count bigint NOT NULL,
+	total_audit_count bigint NOT NULL,
+	audit_success_ratio double precision NOT NULL,
+	uptime_success_count bigint NOT NULL,
+	total_uptime_count bigint NOT NULL,
+	uptime_ratio double precision NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);`
+
+	sqliteNodes = `CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	audit_success_count INTEGER NOT NULL,
+	total_audit_count INTEGER NOT NULL,
+	audit_success_ratio REAL NOT NULL,
+	uptime_success_count INTEGER NOT NULL,
+	total_uptime_count INTEGER NOT NULL,
+	uptime_ratio REAL NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);`
+)
+
+// auditedAtUpdate treats nodes as audited when they were created, so the
+// audit selection doesn't prefer or skip nodes which existed before
+const auditedAtUpdate = `UPDATE nodes SET audited_at = created_at`
+
+// reputationUpdate starts the reputation of nodes with results from their
+// lifetime counts, so it keeps their ratios until new results decay them.
+// Nodes with as many audits as the vetting audit count are vetted.
+func reputationUpdate(vettingAuditCount int64) string {
+	return fmt.Sprintf(`UPDATE nodes SET
+	audit_reputation_alpha = CASE WHEN total_audit_count > 0 THEN audit_success_count ELSE 1 END,
+	audit_reputation_beta = CASE WHEN total_audit_count > 0 THEN total_audit_count - audit_success_count ELSE 0 END,
+	uptime_reputation_alpha = CASE WHEN total_uptime_count > 0 THEN uptime_success_count ELSE 1 END,
+	uptime_reputation_beta = CASE WHEN total_uptime_count > 0 THEN total_uptime_count - uptime_success_count ELSE 0 END,
+	vetted = (total_audit_count >= %d)`, vettingAuditCount)
+}
+
+var (
+	postgresAuditedAt = []string{
+		`ALTER TABLE nodes ADD COLUMN audited_at timestamp with time zone NOT NULL DEFAULT 'epoch'`,
+		auditedAtUpdate,
+	}
+	postgresReputation = []string{
+		`ALTER TABLE nodes ADD COLUMN audit_reputation_alpha double precision NOT NULL DEFAULT 1`,
+		`ALTER TABLE nodes ADD COLUMN audit_reputation_beta double precision NOT NULL DEFAULT 0`,
+		`ALTER TABLE nodes ADD COLUMN uptime_reputation_alpha double precision NOT NULL DEFAULT 1`,
+		`ALTER TABLE nodes ADD COLUMN uptime_reputation_beta double precision NOT NULL DEFAULT 0`,
+		`ALTER TABLE nodes ADD COLUMN vetted boolean NOT NULL DEFAULT false`,
+		`ALTER TABLE nodes ADD COLUMN disqualified boolean NOT NULL DEFAULT false`,
+	}
+
+	sqliteAuditedAt = []string{
+		`ALTER TABLE nodes ADD COLUMN audited_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00'`,
+		auditedAtUpdate,
+	}
+	sqliteReputation = []string{
+		`ALTER TABLE nodes ADD COLUMN audit_reputation_alpha REAL NOT NULL DEFAULT 1`,
+		`ALTER TABLE nodes ADD COLUMN audit_reputation_beta REAL NOT NULL DEFAULT 0`,
+		`ALTER TABLE nodes ADD COLUMN uptime_reputation_alpha REAL NOT NULL DEFAULT 1`,
+		`ALTER TABLE nodes ADD COLUMN uptime_reputation_beta REAL NOT NULL DEFAULT 0`,
+		`ALTER TABLE nodes ADD COLUMN vetted INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE nodes ADD COLUMN disqualified INTEGER NOT NULL DEFAULT 0`,
+	}
+)
+
+// migrations upgrade statdb tables created before nodes had an audit time or
+// a reputation, vetting the nodes with the configured vetting audit count
+func migrations(reputation ReputationConfig) []migrate.Migration {
+	update := reputationUpdate(reputation.VettingAuditCount)
+	return []migrate.Migration{
+		{From: postgresNodes, Steps: join(postgresAuditedAt, postgresReputation, []string{update})},
+		{From: sqliteNodes, Steps: join(sqliteAuditedAt, sqliteReputation, []string{update})},
+	}
+}
+
+// join concatenates the steps into a new slice
+func join(steps ...[]string) (all []string) {
+	for _, s := range steps {
+		all = append(all, s...)
+	}
+	return all
+}
@@ -35,7 +35,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{0}
+	return fileDescriptor_statdb_7094477357e9b355, []int{0}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
@@ -81,7 +81,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{1}
+	return fileDescriptor_statdb_7094477357e9b355, []int{1}
 }
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateResponse.Unmarshal(m, b)
@@ -120,7 +120,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{2}
+	return fileDescriptor_statdb_7094477357e9b355, []int{2}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
@@ -152,7 +152,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{3}
+	return fileDescriptor_statdb_7094477357e9b355, []int{3}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
@@ -179,6 +179,77 @@ func (m *GetResponse) GetStats() *NodeStats {
 	return nil
 }
 
+// GetBatchRequest is a request message for the GetBatch rpc call
+type GetBatchRequest struct {
+	NodeIds              []NodeID `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds,customtype=NodeID" json:"node_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBatchRequest) Reset()         { *m = GetBatchRequest{} }
+func (m *GetBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBatchRequest) ProtoMessage()    {}
+func (*GetBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_statdb_7094477357e9b355, []int{4}
+}
+func (m *GetBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBatchRequest.Unmarshal(m, b)
+}
+func (m *GetBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBatchRequest.Merge(dst, src)
+}
+func (m *GetBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBatchRequest.Size(m)
+}
+func (m *GetBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBatchRequest proto.InternalMessageInfo
+
+// GetBatchResponse is a response message for the GetBatch rpc call
+type GetBatchResponse struct {
+	StatsList            []*NodeStats `protobuf:"bytes,1,rep,name=stats_list,json=statsList" json:"stats_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetBatchResponse) Reset()         { *m = GetBatchResponse{} }
+func (m *GetBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBatchResponse) ProtoMessage()    {}
+func (*GetBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_statdb_7094477357e9b355, []int{5}
+}
+func (m *GetBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBatchResponse.Unmarshal(m, b)
+}
+func (m *GetBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBatchResponse.Merge(dst, src)
+}
+func (m *GetBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBatchResponse.Size(m)
+}
+func (m *GetBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBatchResponse proto.InternalMessageInfo
+
+func (m *GetBatchResponse) GetStatsList() []*NodeStats {
+	if m != nil {
+		return m.StatsList
+	}
+	return nil
+}
+
 // FindInvalidNodesRequest is a request message for the FindInvalidNodes rpc call
 type FindInvalidNodesRequest struct {
 	NodeIds              []NodeID   `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds,customtype=NodeID" json:"node_ids,omitempty"`
@@ -192,7 +263,7 @@ func (m *FindInvalidNodesRequest) Reset()         { *m = FindInvalidNodesRequest
 func (m *FindInvalidNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*FindInvalidNodesRequest) ProtoMessage()    {}
 func (*FindInvalidNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{4}
+	return fileDescriptor_statdb_7094477357e9b355, []int{6}
 }
 func (m *FindInvalidNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindInvalidNodesRequest.Unmarshal(m, b)
@@ -231,7 +302,7 @@ func (m *FindInvalidNodesResponse) Reset()         { *m = FindInvalidNodesRespon
 func (m *FindInvalidNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*FindInvalidNodesResponse) ProtoMessage()    {}
 func (*FindInvalidNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{5}
+	return fileDescriptor_statdb_7094477357e9b355, []int{7}
 }
 func (m *FindInvalidNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindInvalidNodesResponse.Unmarshal(m, b)
@@ -263,7 +334,7 @@ func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()    {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{6}
+	return fileDescriptor_statdb_7094477357e9b355, []int{8}
 }
 func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRequest.Unmarshal(m, b)
@@ -302,7 +373,7 @@ func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()    {}
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{7}
+	return fileDescriptor_statdb_7094477357e9b355, []int{9}
 }
 func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateResponse.Unmarshal(m, b)
@@ -341,7 +412,7 @@ func (m *UpdateUptimeRequest) Reset()         { *m = UpdateUptimeRequest{} }
 func (m *UpdateUptimeRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateUptimeRequest) ProtoMessage()    {}
 func (*UpdateUptimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{8}
+	return fileDescriptor_statdb_7094477357e9b355, []int{10}
 }
 func (m *UpdateUptimeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateUptimeRequest.Unmarshal(m, b)
@@ -380,7 +451,7 @@ func (m *UpdateUptimeResponse) Reset()         { *m = UpdateUptimeResponse{} }
 func (m *UpdateUptimeResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateUptimeResponse) ProtoMessage()    {}
 func (*UpdateUptimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{9}
+	return fileDescriptor_statdb_7094477357e9b355, []int{11}
 }
 func (m *UpdateUptimeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateUptimeResponse.Unmarshal(m, b)
@@ -419,7 +490,7 @@ func (m *UpdateAuditSuccessRequest) Reset()         { *m = UpdateAuditSuccessReq
 func (m *UpdateAuditSuccessRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateAuditSuccessRequest) ProtoMessage()    {}
 func (*UpdateAuditSuccessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{10}
+	return fileDescriptor_statdb_7094477357e9b355, []int{12}
 }
 func (m *UpdateAuditSuccessRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateAuditSuccessRequest.Unmarshal(m, b)
@@ -458,7 +529,7 @@ func (m *UpdateAuditSuccessResponse) Reset()         { *m = UpdateAuditSuccessRe
 func (m *UpdateAuditSuccessResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateAuditSuccessResponse) ProtoMessage()    {}
 func (*UpdateAuditSuccessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{11}
+	return fileDescriptor_statdb_7094477357e9b355, []int{13}
 }
 func (m *UpdateAuditSuccessResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateAuditSuccessResponse.Unmarshal(m, b)
@@ -497,7 +568,7 @@ func (m *UpdateBatchRequest) Reset()         { *m = UpdateBatchRequest{} }
 func (m *UpdateBatchRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateBatchRequest) ProtoMessage()    {}
 func (*UpdateBatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{12}
+	return fileDescriptor_statdb_7094477357e9b355, []int{14}
 }
 func (m *UpdateBatchRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateBatchRequest.Unmarshal(m, b)
@@ -537,7 +608,7 @@ func (m *UpdateBatchResponse) Reset()         { *m = UpdateBatchResponse{} }
 func (m *UpdateBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateBatchResponse) ProtoMessage()    {}
 func (*UpdateBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{13}
+	return fileDescriptor_statdb_7094477357e9b355, []int{15}
 }
 func (m *UpdateBatchResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateBatchResponse.Unmarshal(m, b)
@@ -583,7 +654,7 @@ func (m *CreateEntryIfNotExistsRequest) Reset()         { *m = CreateEntryIfNotE
 func (m *CreateEntryIfNotExistsRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateEntryIfNotExistsRequest) ProtoMessage()    {}
 func (*CreateEntryIfNotExistsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{14}
+	return fileDescriptor_statdb_7094477357e9b355, []int{16}
 }
 func (m *CreateEntryIfNotExistsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEntryIfNotExistsRequest.Unmarshal(m, b)
@@ -622,7 +693,7 @@ func (m *CreateEntryIfNotExistsResponse) Reset()         { *m = CreateEntryIfNot
 func (m *CreateEntryIfNotExistsResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateEntryIfNotExistsResponse) ProtoMessage()    {}
 func (*CreateEntryIfNotExistsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_statdb_c60cfd63f3ff5762, []int{15}
+	return fileDescriptor_statdb_7094477357e9b355, []int{17}
 }
 func (m *CreateEntryIfNotExistsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEntryIfNotExistsResponse.Unmarshal(m, b)
@@ -654,6 +725,8 @@ func init() {
 	proto.RegisterType((*CreateResponse)(nil), "statdb.CreateResponse")
 	proto.RegisterType((*GetRequest)(nil), "statdb.GetRequest")
 	proto.RegisterType((*GetResponse)(nil), "statdb.GetResponse")
+	proto.RegisterType((*GetBatchRequest)(nil), "statdb.GetBatchRequest")
+	proto.RegisterType((*GetBatchResponse)(nil), "statdb.GetBatchResponse")
 	proto.RegisterType((*FindInvalidNodesRequest)(nil), "statdb.FindInvalidNodesRequest")
 	proto.RegisterType((*FindInvalidNodesResponse)(nil), "statdb.FindInvalidNodesResponse")
 	proto.RegisterType((*UpdateRequest)(nil), "statdb.UpdateRequest")
@@ -683,6 +756,8 @@ type StatDBClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	// Get uses a storagenode ID to get that storagenode's stats
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// GetBatch gets the stats of the storagenodes which have an entry
+	GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchResponse, error)
 	// FindInvalidNodes gets a subset of storagenodes that fail to meet minimum reputation args
 	FindInvalidNodes(ctx context.Context, in *FindInvalidNodesRequest, opts ...grpc.CallOption) (*FindInvalidNodesResponse, error)
 	// Update updates all stats for a single storagenode
@@ -723,6 +798,15 @@ func (c *statDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *statDBClient) GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchResponse, error) {
+	out := new(GetBatchResponse)
+	err := c.cc.Invoke(ctx, "/statdb.StatDB/GetBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *statDBClient) FindInvalidNodes(ctx context.Context, in *FindInvalidNodesRequest, opts ...grpc.CallOption) (*FindInvalidNodesResponse, error) {
 	out := new(FindInvalidNodesResponse)
 	err := c.cc.Invoke(ctx, "/statdb.StatDB/FindInvalidNodes", in, out, opts...)
@@ -784,6 +868,8 @@ type StatDBServer interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	// Get uses a storagenode ID to get that storagenode's stats
 	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// GetBatch gets the stats of the storagenodes which have an entry
+	GetBatch(context.Context, *GetBatchRequest) (*GetBatchResponse, error)
 	// FindInvalidNodes gets a subset of storagenodes that fail to meet minimum reputation args
 	FindInvalidNodes(context.Context, *FindInvalidNodesRequest) (*FindInvalidNodesResponse, error)
 	// Update updates all stats for a single storagenode
@@ -838,6 +924,24 @@ func _StatDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatDB_GetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatDBServer).GetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statdb.StatDB/GetBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatDBServer).GetBatch(ctx, req.(*GetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StatDB_FindInvalidNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindInvalidNodesRequest)
 	if err := dec(in); err != nil {
@@ -958,6 +1062,10 @@ var _StatDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _StatDB_Get_Handler,
 		},
+		{
+			MethodName: "GetBatch",
+			Handler:    _StatDB_GetBatch_Handler,
+		},
 		{
 			MethodName: "FindInvalidNodes",
 			Handler:    _StatDB_FindInvalidNodes_Handler,
@@ -987,43 +1095,45 @@ var _StatDB_serviceDesc = grpc.ServiceDesc{
 	Metadata: "statdb.proto",
 }
 
-func init() { proto.RegisterFile("statdb.proto", fileDescriptor_statdb_c60cfd63f3ff5762) }
-
-var fileDescriptor_statdb_c60cfd63f3ff5762 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x6f, 0xd3, 0x3c,
-	0x14, 0x7e, 0xfb, 0x6e, 0x64, 0xeb, 0x49, 0x36, 0x90, 0x07, 0xa3, 0x78, 0xb0, 0x8e, 0x48, 0x85,
-	0x49, 0x40, 0x91, 0x06, 0xa8, 0x17, 0x68, 0x42, 0x74, 0x1f, 0x55, 0x24, 0xb4, 0x8b, 0x4e, 0xe5,
-	0x86, 0x8b, 0x2a, 0xab, 0xbd, 0x62, 0xa9, 0x4d, 0x4a, 0xed, 0xa2, 0xf2, 0x0f, 0xf9, 0x0d, 0xbb,
-	0xd8, 0x6f, 0x41, 0xfe, 0x08, 0x89, 0xbb, 0xa4, 0x34, 0x77, 0xe9, 0x39, 0xcf, 0x87, 0xe5, 0xf3,
-	0x1c, 0x17, 0x3c, 0x2e, 0x42, 0x41, 0xae, 0x9a, 0x93, 0x69, 0x2c, 0x62, 0xe4, 0xe8, 0x5f, 0x18,
-	0x86, 0xf1, 0x30, 0xd6, 0x35, 0x0c, 0x51, 0x4c, 0xa8, 0xfe, 0xf6, 0xbf, 0xc2, 0xd6, 0xc9, 0x94,
-	0x86, 0x82, 0x76, 0xe9, 0x8f, 0x19, 0xe5, 0x02, 0xed, 0xc3, 0xba, 0x6c, 0xd7, 0x2a, 0x07, 0x95,
-	0x43, 0xf7, 0x08, 0x9a, 0x0a, 0x7b, 0x11, 0x13, 0xda, 0x55, 0x75, 0xd4, 0x80, 0x7b, 0x52, 0x92,
-	0xd7, 0xfe, 0x57, 0x80, 0xfb, 0x29, 0xe0, 0x52, 0x96, 0xbb, 0xba, 0xeb, 0xb7, 0x60, 0x3b, 0xd1,
-	0xe5, 0x93, 0x38, 0xe2, 0x19, 0x62, 0x65, 0x29, 0xf1, 0x03, 0x40, 0x87, 0x8a, 0xe4, 0x34, 0x2f,
-	0x61, 0x43, 0xc2, 0xfa, 0x8c, 0x28, 0x9a, 0xd7, 0xde, 0xfe, 0x7d, 0x5b, 0xff, 0xef, 0xe6, 0xb6,
-	0xee, 0x48, 0x62, 0x70, 0xda, 0x75, 0x64, 0x3b, 0x20, 0xfe, 0x7b, 0x70, 0x15, 0xad, 0x9c, 0x59,
-	0x04, 0x8f, 0xcf, 0x59, 0x44, 0x82, 0xe8, 0x67, 0x38, 0x62, 0x44, 0xb6, 0x79, 0xe2, 0xdc, 0x80,
-	0x4d, 0xe3, 0x2c, 0x45, 0xd6, 0x0e, 0xbd, 0x36, 0x64, 0x6c, 0x37, 0xb4, 0x2d, 0x47, 0xaf, 0xa1,
-	0x3a, 0x0e, 0xe7, 0xfd, 0xa5, 0x57, 0xb2, 0x39, 0x0e, 0xe7, 0xea, 0xcb, 0xef, 0x40, 0xed, 0xae,
-	0x9f, 0x39, 0xf2, 0x2b, 0x70, 0x99, 0xae, 0x17, 0x78, 0x82, 0x69, 0x07, 0x84, 0xfb, 0x6f, 0x61,
-	0xab, 0x37, 0x21, 0xab, 0x8f, 0x4d, 0xce, 0x23, 0x21, 0x94, 0x9d, 0xc7, 0x8e, 0x26, 0xf6, 0x26,
-	0x82, 0x8d, 0x57, 0xf6, 0x3b, 0x86, 0x87, 0x36, 0xad, 0x9c, 0xeb, 0x47, 0x78, 0xa2, 0xe9, 0x9f,
-	0x67, 0x84, 0x89, 0xcb, 0xd9, 0x60, 0x40, 0x39, 0x5f, 0xd5, 0xfb, 0x04, 0x70, 0x1e, 0xb9, 0xdc,
-	0x09, 0x8e, 0x01, 0x69, 0x91, 0x76, 0x28, 0x06, 0xdf, 0xd3, 0x3c, 0x56, 0x55, 0x2a, 0x46, 0x8c,
-	0x0b, 0x35, 0x22, 0xdb, 0x5f, 0x45, 0xe6, 0x0b, 0xe3, 0xc2, 0x17, 0xc9, 0xb5, 0x19, 0xba, 0x31,
-	0x6f, 0x02, 0x28, 0xf9, 0xac, 0xc0, 0x9d, 0x13, 0x54, 0x15, 0x44, 0xca, 0xa0, 0x37, 0xe0, 0x5d,
-	0x87, 0x6c, 0x44, 0x49, 0x5f, 0x62, 0x64, 0xc2, 0x16, 0x2d, 0x5d, 0xdd, 0x57, 0x59, 0xf2, 0x3f,
-	0xc1, 0x33, 0xbd, 0x75, 0x67, 0x91, 0x98, 0xfe, 0x0a, 0xae, 0x2f, 0x62, 0x71, 0x36, 0x67, 0x5c,
-	0xac, 0x7c, 0x75, 0x1d, 0xd8, 0x2f, 0x12, 0x28, 0x75, 0x7d, 0x47, 0x37, 0xeb, 0xe0, 0xc8, 0xc2,
-	0x69, 0x1b, 0xb5, 0xc0, 0xd1, 0x9a, 0xe8, 0x51, 0xd3, 0xbc, 0x4d, 0xd6, 0x93, 0x83, 0x77, 0x17,
-	0xcb, 0x7f, 0x2f, 0x6b, 0xad, 0x43, 0x05, 0x42, 0x49, 0x3b, 0x7d, 0x17, 0xf0, 0x8e, 0x55, 0x33,
-	0xf8, 0x1e, 0x3c, 0x58, 0xdc, 0x2e, 0x54, 0x4f, 0x80, 0x05, 0x7b, 0x8e, 0x0f, 0x8a, 0x01, 0x46,
-	0xb6, 0x05, 0x8e, 0x1e, 0x65, 0x7a, 0x7e, 0x6b, 0xf7, 0xd2, 0xf3, 0x2f, 0x6c, 0x58, 0x00, 0x5e,
-	0x76, 0x07, 0xd0, 0x9e, 0x8d, 0xb3, 0x16, 0x0a, 0x3f, 0xcd, 0x6f, 0x1a, 0xa9, 0x6f, 0x49, 0x1a,
-	0xb3, 0x91, 0x46, 0xcf, 0x6d, 0x4e, 0xce, 0xae, 0x60, 0x7f, 0x19, 0xc4, 0x88, 0x9f, 0x83, 0x9b,
-	0xc9, 0x2a, 0xc2, 0x36, 0x25, 0x9b, 0x7f, 0xbc, 0x97, 0xdb, 0x33, 0x3a, 0x43, 0xd8, 0xcd, 0x0f,
-	0x0f, 0x6a, 0xd8, 0x13, 0x2e, 0x48, 0x27, 0x7e, 0xf1, 0x2f, 0x98, 0x36, 0xba, 0x72, 0xd4, 0x7f,
-	0xd7, 0xbb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xcd, 0x56, 0x62, 0xeb, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("statdb.proto", fileDescriptor_statdb_7094477357e9b355) }
+
+var fileDescriptor_statdb_7094477357e9b355 = []byte{
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x12, 0x4d,
+	0x18, 0xfe, 0xfa, 0xd5, 0x6e, 0xcb, 0x03, 0xfd, 0xc9, 0xa0, 0x14, 0xa7, 0x5a, 0xea, 0x26, 0xd8,
+	0x26, 0x2a, 0x26, 0x55, 0x83, 0x89, 0x21, 0x46, 0xfa, 0x43, 0x48, 0x4c, 0x0f, 0x68, 0xf0, 0xc4,
+	0x03, 0xb2, 0x65, 0xb7, 0xb8, 0x09, 0xec, 0x22, 0x33, 0x18, 0xbc, 0x14, 0xef, 0xc8, 0x6b, 0xf0,
+	0xa0, 0xd7, 0x62, 0xe6, 0x67, 0x65, 0x07, 0x16, 0x64, 0x3d, 0x5b, 0xde, 0xf7, 0xf9, 0x99, 0x9d,
+	0x7d, 0xde, 0x17, 0xe4, 0x18, 0x77, 0xb8, 0x7b, 0x53, 0x19, 0x8e, 0x42, 0x1e, 0x12, 0x4b, 0xfd,
+	0xa2, 0xe8, 0x85, 0xbd, 0x50, 0xd5, 0x28, 0x82, 0xd0, 0xf5, 0xd4, 0xb3, 0xfd, 0x09, 0xdb, 0x67,
+	0x23, 0xcf, 0xe1, 0x5e, 0xcb, 0xfb, 0x3a, 0xf6, 0x18, 0x27, 0x87, 0xb8, 0x27, 0xda, 0xc5, 0xb5,
+	0xa3, 0xb5, 0x93, 0xec, 0x29, 0x2a, 0x12, 0x7b, 0x15, 0xba, 0x5e, 0x4b, 0xd6, 0x49, 0x19, 0x1b,
+	0x42, 0x92, 0x15, 0xff, 0x97, 0x80, 0xdd, 0x29, 0xe0, 0x5a, 0x94, 0x5b, 0xaa, 0x6b, 0x57, 0xb1,
+	0x13, 0xe9, 0xb2, 0x61, 0x18, 0xb0, 0x18, 0x71, 0x6d, 0x29, 0xf1, 0x0d, 0xd0, 0xf0, 0x78, 0x74,
+	0x9a, 0x63, 0x6c, 0x0a, 0x58, 0xc7, 0x77, 0x25, 0x2d, 0x57, 0xdf, 0xf9, 0x79, 0x57, 0xfa, 0xef,
+	0xd7, 0x5d, 0xc9, 0x12, 0xc4, 0xe6, 0x79, 0xcb, 0x12, 0xed, 0xa6, 0x6b, 0xbf, 0x46, 0x56, 0xd2,
+	0xd2, 0x99, 0xbd, 0xc5, 0x6e, 0xc3, 0xe3, 0x75, 0x87, 0x77, 0xbf, 0x44, 0x8e, 0x65, 0x6c, 0x69,
+	0x47, 0x41, 0x5e, 0x3f, 0xc9, 0xd5, 0x11, 0xb3, 0xdb, 0x54, 0x76, 0xcc, 0xae, 0x63, 0x6f, 0xca,
+	0xd4, 0xa6, 0x15, 0x40, 0xca, 0x76, 0xfa, 0x3e, 0xe3, 0x92, 0x9c, 0xe0, 0x9c, 0x91, 0x90, 0x8f,
+	0x3e, 0xe3, 0x76, 0x80, 0xfd, 0x4b, 0x3f, 0x70, 0x9b, 0xc1, 0x37, 0xa7, 0xef, 0xbb, 0x02, 0xc2,
+	0xd2, 0x9d, 0x82, 0x3c, 0x47, 0x66, 0xe0, 0x4c, 0x3a, 0x4b, 0x3f, 0xc8, 0xd6, 0xc0, 0x99, 0xc8,
+	0x27, 0xbb, 0x81, 0xe2, 0xbc, 0x9f, 0x3e, 0xfb, 0x33, 0x64, 0x7d, 0x55, 0x5f, 0xe0, 0x09, 0xdd,
+	0x16, 0x2f, 0xff, 0x12, 0xdb, 0xed, 0xa1, 0xbb, 0x7a, 0x68, 0x44, 0x1a, 0x22, 0x42, 0xda, 0x34,
+	0xe4, 0x15, 0xb1, 0x3d, 0xe4, 0xfe, 0x60, 0x65, 0xbf, 0x1a, 0xee, 0x9b, 0xb4, 0x74, 0xae, 0xef,
+	0xf0, 0x50, 0xd1, 0x3f, 0x8c, 0x5d, 0x9f, 0x5f, 0x8f, 0xbb, 0x5d, 0x8f, 0xb1, 0x55, 0xbd, 0xcf,
+	0x40, 0x93, 0xc8, 0xe9, 0x4e, 0x50, 0x03, 0x51, 0x22, 0x46, 0x36, 0x8f, 0x91, 0x91, 0xa9, 0x88,
+	0xe5, 0x2b, 0xee, 0x2f, 0x23, 0x23, 0x93, 0xc5, 0x91, 0x37, 0xe8, 0xff, 0x16, 0x50, 0xf2, 0x02,
+	0xb9, 0x5b, 0xc7, 0xef, 0x7b, 0x6e, 0x47, 0x60, 0x44, 0xc2, 0x66, 0x2d, 0xb3, 0xaa, 0x2f, 0x9e,
+	0x99, 0xfd, 0x1e, 0x8f, 0xd5, 0xcc, 0x5f, 0x04, 0x7c, 0xf4, 0xbd, 0x79, 0x7b, 0x15, 0xf2, 0x8b,
+	0x89, 0xcf, 0xf8, 0xca, 0x57, 0xd7, 0xc0, 0xe1, 0x22, 0x81, 0x54, 0xd7, 0x77, 0xfa, 0x63, 0x03,
+	0x96, 0x28, 0x9c, 0xd7, 0x49, 0x15, 0x96, 0xd2, 0x24, 0x0f, 0x2a, 0x7a, 0x33, 0x1a, 0x0b, 0x8f,
+	0x16, 0x66, 0xcb, 0x7f, 0x2e, 0x6b, 0xbd, 0xe1, 0x71, 0x42, 0xa2, 0xf6, 0x74, 0x2b, 0xd1, 0xbc,
+	0x51, 0xd3, 0xf8, 0x1a, 0xb6, 0xa2, 0x8d, 0x40, 0xf6, 0x63, 0x80, 0xf8, 0x17, 0xa4, 0xc5, 0xf9,
+	0x86, 0xa6, 0xb7, 0xb1, 0x37, 0x3b, 0x9c, 0xa4, 0x14, 0xa1, 0x17, 0xac, 0x09, 0x7a, 0xb4, 0x18,
+	0xa0, 0x65, 0xab, 0xb0, 0x54, 0x12, 0xa6, 0xaf, 0x6f, 0x8c, 0x2e, 0x2d, 0xcc, 0x96, 0x35, 0xb1,
+	0x89, 0x5c, 0x7c, 0x84, 0xc8, 0x81, 0x89, 0x33, 0xe6, 0x91, 0x3e, 0x4a, 0x6e, 0x6a, 0xa9, 0xcf,
+	0x20, 0xf3, 0x13, 0x41, 0x9e, 0x98, 0x9c, 0x84, 0x51, 0xa3, 0xf6, 0x32, 0x88, 0x16, 0xbf, 0x44,
+	0x36, 0x16, 0x75, 0x42, 0x4d, 0x8a, 0x71, 0xf9, 0x07, 0x89, 0x3d, 0xad, 0xd3, 0x43, 0x21, 0x39,
+	0x7b, 0xa4, 0x6c, 0x06, 0x64, 0x41, 0xb8, 0xe9, 0xd3, 0xbf, 0xc1, 0x94, 0xd1, 0x8d, 0x25, 0xff,
+	0x78, 0x5f, 0xfd, 0x1e, 0x00, 0x5d, 0x25, 0x4c, 0x8a, 0xa8, 0x07, 0x00, 0x00,
 }
@@ -0,0 +1,100 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb
+
+// Reputation is the alpha and beta of the beta distribution estimating how
+// likely the next result of a node is a success
+type Reputation struct {
+	Alpha float64
+	Beta  float64
+}
+
+// Score returns the expected chance of a successful result, from 0 to 1
+func (reputation Reputation) Score() float64 {
+	if reputation.Alpha+reputation.Beta <= 0 {
+		return 0
+	}
+	return reputation.Alpha / (reputation.Alpha + reputation.Beta)
+}
+
+// ReputationModel computes the reputation of nodes from their results
+type ReputationModel interface {
+	// Initial returns the reputation of a node without results
+	Initial() Reputation
+	// Update returns the reputation after a new result
+	Update(reputation Reputation, success bool) Reputation
+}
+
+// ReputationConfig configures the reputation model, vetting and disqualification of nodes
+type ReputationConfig struct {
+	Lambda                 float64 `help:"how much of the previous audit reputation is kept with every audit result, lower values forget old results faster" default:"0.95"`
+	UptimeLambda           float64 `help:"how much of the previous uptime reputation is kept with every uptime result, nodes check in and are pinged far more often than audited" default:"0.999"`
+	Weight                 float64 `help:"how much a single result changes the reputation" default:"1"`
+	InitialAlpha           float64 `help:"the alpha of nodes without results" default:"1"`
+	InitialBeta            float64 `help:"the beta of nodes without results" default:"0"`
+	VettingAuditCount      int64   `help:"the number of audits a node needs to finish vetting" default:"100"`
+	AuditDisqualification  float64 `help:"the audit score below which vetted nodes are disqualified permanently" default:"0.6"`
+	UptimeDisqualification float64 `help:"the uptime score below which vetted nodes are disqualified permanently, 0 never disqualifies nodes for downtime" default:"0"`
+}
+
+// DefaultReputationConfig matches the defaults of the reputation flags
+var DefaultReputationConfig = ReputationConfig{
+	Lambda:                 0.95,
+	UptimeLambda:           0.999,
+	Weight:                 1,
+	InitialAlpha:           1,
+	InitialBeta:            0,
+	VettingAuditCount:      100,
+	AuditDisqualification:  0.6,
+	UptimeDisqualification: 0,
+}
+
+// Model returns the decaying reputation model of audits
+func (config ReputationConfig) Model() ReputationModel {
+	return config.decayModel(config.Lambda)
+}
+
+// UptimeModel returns the decaying reputation model of uptime, which
+// forgets results slower so a short outage doesn't ruin the score
+func (config ReputationConfig) UptimeModel() ReputationModel {
+	return config.decayModel(config.UptimeLambda)
+}
+
+// decayModel returns a decaying reputation model keeping lambda of the
+// previous reputation with every result
+func (config ReputationConfig) decayModel(lambda float64) ReputationModel {
+	return DecayModel{
+		Lambda: lambda,
+		Weight: config.Weight,
+		Start:  Reputation{Alpha: config.InitialAlpha, Beta: config.InitialBeta},
+	}
+}
+
+// disqualifies returns whether a vetted node with the scores is disqualified
+func (config ReputationConfig) disqualifies(audit, uptime Reputation) bool {
+	return audit.Score() < config.AuditDisqualification || uptime.Score() < config.UptimeDisqualification
+}
+
+// DecayModel multiplies alpha and beta by lambda with every result, so old
+// results matter less and less
+type DecayModel struct {
+	Lambda float64
+	Weight float64
+	Start  Reputation
+}
+
+// Initial returns the reputation of a node without results
+func (model DecayModel) Initial() Reputation { return model.Start }
+
+// Update returns the reputation after a new result
+func (model DecayModel) Update(reputation Reputation, success bool) Reputation {
+	reputation.Alpha *= model.Lambda
+	reputation.Beta *= model.Lambda
+	if success {
+		reputation.Alpha += model.Weight
+	} else {
+		reputation.Beta += model.Weight
+	}
+	return reputation
+}
@@ -0,0 +1,206 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb_test
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/statdb"
+	pb "czarcoin.org/czarcoin/pkg/statdb/proto"
+)
+
+func TestDecayModel(t *testing.T) {
+	model := statdb.DefaultReputationConfig.Model()
+	assert.Equal(t, 1.0, model.Initial().Score())
+
+	// a long history of successes doesn't hide recent failures
+	reputation := statdb.Reputation{Alpha: 1000}
+	for i := 0; i < 100; i++ {
+		reputation = model.Update(reputation, false)
+	}
+	assert.True(t, reputation.Score() < 0.5, reputation.Score())
+
+	// and recent successes are recovered from
+	for i := 0; i < 100; i++ {
+		reputation = model.Update(reputation, true)
+	}
+	assert.True(t, reputation.Score() > 0.9, reputation.Score())
+}
+
+func TestVettingAndDisqualification(t *testing.T) {
+	sdb, _, err := getServerAndDB(getDBPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdb.Reputation.VettingAuditCount = 3
+
+	nodeID := testczarcoin.NodeIDFromString("testnodeid")
+	audit := func(success bool) *pb.NodeStats {
+		resp, err := sdb.UpdateAuditSuccess(ctx, &pb.UpdateAuditSuccessRequest{
+			Node: &pb.Node{Id: nodeID, AuditSuccess: success},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Stats
+	}
+	invalid := func() czarcoin.NodeIDList {
+		resp, err := sdb.FindInvalidNodes(ctx, &pb.FindInvalidNodesRequest{
+			NodeIds:  czarcoin.NodeIDList{nodeID},
+			MaxStats: &pb.NodeStats{},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.InvalidIds
+	}
+
+	_, err = sdb.Create(ctx, &pb.CreateRequest{Node: &pb.Node{Id: nodeID}})
+	assert.NoError(t, err)
+
+	// failures while vetting don't disqualify
+	audit(false)
+	stats := audit(false)
+	assert.False(t, stats.Vetted)
+	assert.False(t, stats.Disqualified)
+	assert.True(t, stats.AuditReputation < statdb.DefaultReputationConfig.AuditDisqualification)
+	assert.Empty(t, invalid())
+
+	// once vetted the low score disqualifies
+	stats = audit(true)
+	assert.True(t, stats.Vetted)
+	assert.True(t, stats.Disqualified)
+	assert.Equal(t, czarcoin.NodeIDList{nodeID}, invalid())
+
+	// permanently
+	for i := 0; i < 20; i++ {
+		stats = audit(true)
+	}
+	assert.True(t, stats.AuditReputation > statdb.DefaultReputationConfig.AuditDisqualification)
+	assert.True(t, stats.Disqualified)
+	assert.Equal(t, czarcoin.NodeIDList{nodeID}, invalid())
+}
+
+func TestShortOutage(t *testing.T) {
+	for _, disqualification := range []float64{statdb.DefaultReputationConfig.UptimeDisqualification, 0.6} {
+		sdb, _, err := getServerAndDB(getDBPath())
+		if err != nil {
+			t.Fatal(err)
+		}
+		sdb.Reputation.VettingAuditCount = 0
+		sdb.Reputation.UptimeDisqualification = disqualification
+
+		nodeID := testczarcoin.NodeIDFromString("testnodeid")
+		_, err = sdb.Create(ctx, &pb.CreateRequest{Node: &pb.Node{Id: nodeID}})
+		assert.NoError(t, err)
+
+		uptime := func(up bool) *pb.NodeStats {
+			resp, err := sdb.UpdateUptime(ctx, &pb.UpdateUptimeRequest{
+				Node: &pb.Node{Id: nodeID, IsUp: up},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return resp.Stats
+		}
+
+		// a month of hourly check-ins
+		var stats *pb.NodeStats
+		for i := 0; i < 30*24; i++ {
+			stats = uptime(true)
+		}
+		assert.True(t, stats.Vetted)
+
+		// an hour of failed check-ins retried every minute
+		for i := 0; i < 60; i++ {
+			stats = uptime(false)
+		}
+		assert.False(t, stats.Disqualified)
+		assert.True(t, stats.UptimeReputation > 0.8, "uptime score %v", stats.UptimeReputation)
+	}
+}
+
+func TestMigrateReputation(t *testing.T) {
+	t.Run("default vetting", func(t *testing.T) {
+		testMigrateReputation(t, statdb.DefaultReputationConfig, true)
+	})
+	t.Run("longer vetting", func(t *testing.T) {
+		reputation := statdb.DefaultReputationConfig
+		reputation.VettingAuditCount = 500
+		testMigrateReputation(t, reputation, false)
+	})
+}
+
+func testMigrateReputation(t *testing.T, reputation statdb.ReputationConfig, veteranVetted bool) {
+	// a statdb created before nodes had an audit time or a reputation
+	schema := `CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	audit_success_count INTEGER NOT NULL,
+	total_audit_count INTEGER NOT NULL,
+	audit_success_ratio REAL NOT NULL,
+	uptime_success_count INTEGER NOT NULL,
+	total_uptime_count INTEGER NOT NULL,
+	uptime_ratio REAL NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);`
+
+	path := getDBPath()
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	for _, query := range []string{
+		`CREATE TABLE table_schemas (id text, schemaText text);`,
+		schema,
+	} {
+		_, err := db.Exec(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = db.Exec(`INSERT INTO table_schemas(id, schemaText) VALUES (?, ?);`, "statdb", schema)
+	assert.NoError(t, err)
+
+	veteran := testczarcoin.NodeIDFromString("veteran")
+	newcomer := testczarcoin.NodeIDFromString("newcomer")
+	created := time.Now().Add(-time.Hour).UTC()
+	insert := func(values ...interface{}) {
+		values = append(values, created, created)
+		_, err := db.Exec(`INSERT INTO nodes VALUES (?`+strings.Repeat(", ?", len(values)-1)+`)`, values...)
+		assert.NoError(t, err)
+	}
+	insert(veteran.Bytes(), 150, 200, 0.75, 90, 100, 0.9)
+	insert(newcomer.Bytes(), 0, 0, 0, 0, 0, 0)
+
+	sdb, err := statdb.NewStatDBWithReputation("sqlite3", path, zap.NewNop(), reputation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := sdb.Get(ctx, &pb.GetRequest{NodeId: veteran})
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.75, resp.Stats.AuditReputation, 1e-9)
+	assert.InDelta(t, 0.9, resp.Stats.UptimeReputation, 1e-9)
+	assert.Equal(t, veteranVetted, resp.Stats.Vetted)
+	assert.False(t, resp.Stats.Disqualified)
+	assert.Equal(t, created.Unix(), resp.Stats.AuditedUnixSec)
+
+	resp, err = sdb.Get(ctx, &pb.GetRequest{NodeId: newcomer})
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, resp.Stats.AuditReputation)
+	assert.Equal(t, 1.0, resp.Stats.UptimeReputation)
+	assert.False(t, resp.Stats.Vetted)
+}
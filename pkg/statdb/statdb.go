@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -17,6 +18,7 @@ import (
 	"czarcoin.org/czarcoin/internal/migrate"
 	dbx "czarcoin.org/czarcoin/pkg/statdb/dbx"
 	pb "czarcoin.org/czarcoin/pkg/statdb/proto"
+	"czarcoin.org/czarcoin/pkg/utils"
 	"czarcoin.org/czarcoin/pkg/czarcoin"
 )
 
@@ -30,24 +32,40 @@ var (
 type StatDB struct {
 	log *zap.Logger
 	DB  *dbx.DB
+
+	// Reputation configures vetting and disqualification
+	Reputation ReputationConfig
+	// Model computes the audit reputation of nodes
+	Model ReputationModel
+	// UptimeModel computes the uptime reputation of nodes
+	UptimeModel ReputationModel
 }
 
-// NewStatDB creates instance of StatDB
+// NewStatDB creates instance of StatDB with the default reputation config
 func NewStatDB(driver, source string, log *zap.Logger) (*StatDB, error) {
+	return NewStatDBWithReputation(driver, source, log, DefaultReputationConfig)
+}
+
+// NewStatDBWithReputation creates instance of StatDB which vets, scores and
+// disqualifies nodes as configured by reputation
+func NewStatDBWithReputation(driver, source string, log *zap.Logger, reputation ReputationConfig) (*StatDB, error) {
 	db, err := dbx.Open(driver, source)
 	if err != nil {
 		return nil, Error.New("failed opening database %q, %q: %v",
 			driver, source, err)
 	}
 
-	err = migrate.Create("statdb", db)
+	err = migrate.CreateWithMigrations("statdb", db, migrations(reputation)...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &StatDB{
-		DB:  db,
-		log: log,
+		DB:         db,
+		log:        log,
+		Reputation:  reputation,
+		Model:       reputation.Model(),
+		UptimeModel: reputation.UptimeModel(),
 	}, nil
 }
 
@@ -62,6 +80,8 @@ func (s *StatDB) Create(ctx context.Context, createReq *pb.CreateRequest) (resp
 		totalUptimeCount   int64
 		uptimeSuccessCount int64
 		uptimeRatio        float64
+		auditReputation    = s.Model.Initial()
+		uptimeReputation   = s.UptimeModel.Initial()
 	)
 
 	stats := createReq.Stats
@@ -79,6 +99,13 @@ func (s *StatDB) Create(ctx context.Context, createReq *pb.CreateRequest) (resp
 		if err != nil {
 			return nil, errUptime.Wrap(err)
 		}
+
+		if totalAuditCount > 0 {
+			auditReputation = reputationFromCounts(auditSuccessCount, totalAuditCount)
+		}
+		if totalUptimeCount > 0 {
+			uptimeReputation = reputationFromCounts(uptimeSuccessCount, totalUptimeCount)
+		}
 	}
 
 	node := createReq.Node
@@ -92,18 +119,18 @@ func (s *StatDB) Create(ctx context.Context, createReq *pb.CreateRequest) (resp
 		dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
 		dbx.Node_TotalUptimeCount(totalUptimeCount),
 		dbx.Node_UptimeRatio(uptimeRatio),
+		dbx.Node_AuditReputationAlpha(auditReputation.Alpha),
+		dbx.Node_AuditReputationBeta(auditReputation.Beta),
+		dbx.Node_UptimeReputationAlpha(uptimeReputation.Alpha),
+		dbx.Node_UptimeReputationBeta(uptimeReputation.Beta),
+		dbx.Node_Vetted(totalAuditCount >= s.Reputation.VettingAuditCount),
+		dbx.Node_Disqualified(false),
 	)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	nodeStats := &pb.NodeStats{
-		NodeId:            node.Id,
-		AuditSuccessRatio: dbNode.AuditSuccessRatio,
-		AuditCount:        dbNode.TotalAuditCount,
-		UptimeRatio:       dbNode.UptimeRatio,
-		UptimeCount:       dbNode.TotalUptimeCount,
-	}
+	nodeStats := convertDBNode(node.Id, dbNode)
 	return &pb.CreateResponse{
 		Stats: nodeStats,
 	}, nil
@@ -118,18 +145,62 @@ func (s *StatDB) Get(ctx context.Context, getReq *pb.GetRequest) (resp *pb.GetRe
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	nodeStats := &pb.NodeStats{
-		NodeId:            getReq.NodeId,
-		AuditSuccessRatio: dbNode.AuditSuccessRatio,
-		AuditCount:        dbNode.TotalAuditCount,
-		UptimeRatio:       dbNode.UptimeRatio,
-		UptimeCount:       dbNode.TotalUptimeCount,
-	}
+	nodeStats := convertDBNode(getReq.NodeId, dbNode)
 	return &pb.GetResponse{
 		Stats: nodeStats,
 	}, nil
 }
 
+// GetBatch gets the stats of the storagenodes in the request which have an entry in the db
+func (s *StatDB) GetBatch(ctx context.Context, getBatchReq *pb.GetBatchRequest) (resp *pb.GetBatchResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeIds := getBatchReq.NodeIds
+	if len(nodeIds) == 0 {
+		return &pb.GetBatchResponse{}, nil
+	}
+
+	args := make([]interface{}, len(nodeIds))
+	for i, id := range nodeIds {
+		args[i] = id.Bytes()
+	}
+
+	rows, err := s.DB.Query(s.DB.Rebind(`SELECT nodes.id, nodes.total_audit_count,
+		nodes.audit_success_ratio, nodes.total_uptime_count,
+		nodes.uptime_ratio, nodes.audited_at,
+		nodes.audit_reputation_alpha, nodes.audit_reputation_beta,
+		nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta,
+		nodes.vetted, nodes.disqualified
+		FROM nodes
+		WHERE nodes.id IN (?`+strings.Repeat(", ?", len(nodeIds)-1)+`)`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = utils.CombineErrors(err, rows.Close())
+	}()
+
+	var statsList []*pb.NodeStats
+	for rows.Next() {
+		node := &dbx.Node{}
+		err = rows.Scan(&node.Id, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditedAt,
+			&node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta,
+			&node.Vetted, &node.Disqualified)
+		if err != nil {
+			return nil, err
+		}
+		id, err := czarcoin.NodeIDFromBytes(node.Id)
+		if err != nil {
+			return nil, err
+		}
+		statsList = append(statsList, convertDBNode(id, node))
+	}
+
+	return &pb.GetBatchResponse{
+		StatsList: statsList,
+	}, rows.Err()
+}
+
 // FindInvalidNodes finds a subset of storagenodes that fail to meet minimum reputation requirements
 func (s *StatDB) FindInvalidNodes(ctx context.Context, getReq *pb.FindInvalidNodesRequest) (resp *pb.FindInvalidNodesResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -137,10 +208,10 @@ func (s *StatDB) FindInvalidNodes(ctx context.Context, getReq *pb.FindInvalidNod
 	var invalidIds czarcoin.NodeIDList
 
 	nodeIds := getReq.NodeIds
-	maxAuditSuccess := getReq.MaxStats.AuditSuccessRatio
-	maxUptime := getReq.MaxStats.UptimeRatio
+	maxAuditReputation := getReq.GetMaxStats().GetAuditReputation()
+	maxUptimeReputation := getReq.GetMaxStats().GetUptimeReputation()
 
-	rows, err := s.findInvalidNodesQuery(nodeIds, maxAuditSuccess, maxUptime)
+	rows, err := s.findInvalidNodesQuery(nodeIds, maxAuditReputation, maxUptimeReputation)
 
 	if err != nil {
 		return nil, err
@@ -154,7 +225,7 @@ func (s *StatDB) FindInvalidNodes(ctx context.Context, getReq *pb.FindInvalidNod
 
 	for rows.Next() {
 		node := &dbx.Node{}
-		err = rows.Scan(&node.Id, &node.TotalAuditCount, &node.TotalUptimeCount, &node.AuditSuccessRatio, &node.UptimeRatio)
+		err = rows.Scan(&node.Id)
 		if err != nil {
 			return nil, err
 		}
@@ -170,24 +241,30 @@ func (s *StatDB) FindInvalidNodes(ctx context.Context, getReq *pb.FindInvalidNod
 	}, nil
 }
 
-func (s *StatDB) findInvalidNodesQuery(nodeIds czarcoin.NodeIDList, auditSuccess, uptime float64) (*sql.Rows, error) {
+// findInvalidNodesQuery selects the disqualified nodes and the nodes with
+// results whose audit or uptime score is below the given ones
+func (s *StatDB) findInvalidNodesQuery(nodeIds czarcoin.NodeIDList, auditReputation, uptimeReputation float64) (*sql.Rows, error) {
 	args := make([]interface{}, len(nodeIds))
 	for i, id := range nodeIds {
 		args[i] = id.Bytes()
 	}
-	args = append(args, auditSuccess, uptime)
+	args = append(args, true, auditReputation, uptimeReputation)
 
-	rows, err := s.DB.Query(`SELECT nodes.id, nodes.total_audit_count,
-		nodes.total_uptime_count, nodes.audit_success_ratio,
-		nodes.uptime_ratio
+	// alpha / (alpha + beta) < score is compared without dividing, as both can be 0
+	rows, err := s.DB.Query(s.DB.Rebind(`SELECT nodes.id
 		FROM nodes
 		WHERE nodes.id IN (?`+strings.Repeat(", ?", len(nodeIds)-1)+`)
-		AND nodes.total_audit_count > 0
-		AND nodes.total_uptime_count > 0
 		AND (
-			nodes.audit_success_ratio < ?
-			OR nodes.uptime_ratio < ?
-		)`, args...)
+			nodes.disqualified = ?
+			OR (
+				nodes.total_audit_count > 0
+				AND nodes.total_uptime_count > 0
+				AND (
+					nodes.audit_reputation_alpha < ? * (nodes.audit_reputation_alpha + nodes.audit_reputation_beta)
+					OR nodes.uptime_reputation_alpha < ? * (nodes.uptime_reputation_alpha + nodes.uptime_reputation_beta)
+				)
+			)
+		)`), args...)
 
 	return rows, err
 }
@@ -231,6 +308,7 @@ func (s *StatDB) Update(ctx context.Context, updateReq *pb.UpdateRequest) (resp
 		updateFields.AuditSuccessCount = dbx.Node_AuditSuccessCount(auditSuccessCount)
 		updateFields.TotalAuditCount = dbx.Node_TotalAuditCount(totalAuditCount)
 		updateFields.AuditSuccessRatio = dbx.Node_AuditSuccessRatio(auditSuccessRatio)
+		updateFields.AuditedAt = dbx.Node_AuditedAt(time.Now())
 	}
 	if node.UpdateUptime {
 		uptimeSuccessCount, totalUptimeCount, uptimeRatio = updateRatioVars(
@@ -244,18 +322,14 @@ func (s *StatDB) Update(ctx context.Context, updateReq *pb.UpdateRequest) (resp
 		updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
 	}
 
+	s.updateReputation(dbNode, node, totalAuditCount, &updateFields)
+
 	dbNode, err = s.DB.Update_Node_By_Id(ctx, dbx.Node_Id(node.Id.Bytes()), updateFields)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	nodeStats := &pb.NodeStats{
-		NodeId:            node.Id,
-		AuditSuccessRatio: dbNode.AuditSuccessRatio,
-		AuditCount:        dbNode.TotalAuditCount,
-		UptimeRatio:       dbNode.UptimeRatio,
-		UptimeCount:       dbNode.TotalUptimeCount,
-	}
+	nodeStats := convertDBNode(node.Id, dbNode)
 	return &pb.UpdateResponse{
 		Stats: nodeStats,
 	}, nil
@@ -288,18 +362,14 @@ func (s *StatDB) UpdateUptime(ctx context.Context, updateReq *pb.UpdateUptimeReq
 	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
 	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
 
+	s.updateReputation(dbNode, &pb.Node{Id: node.Id, UpdateUptime: true, IsUp: node.IsUp}, dbNode.TotalAuditCount, &updateFields)
+
 	dbNode, err = s.DB.Update_Node_By_Id(ctx, dbx.Node_Id(node.Id.Bytes()), updateFields)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	nodeStats := &pb.NodeStats{
-		NodeId:            node.Id,
-		AuditSuccessRatio: dbNode.AuditSuccessRatio,
-		AuditCount:        dbNode.TotalAuditCount,
-		UptimeRatio:       dbNode.UptimeRatio,
-		UptimeCount:       dbNode.TotalUptimeCount,
-	}
+	nodeStats := convertDBNode(node.Id, dbNode)
 	return &pb.UpdateUptimeResponse{
 		Stats: nodeStats,
 	}, nil
@@ -331,19 +401,16 @@ func (s *StatDB) UpdateAuditSuccess(ctx context.Context, updateReq *pb.UpdateAud
 	updateFields.AuditSuccessCount = dbx.Node_AuditSuccessCount(auditSuccessCount)
 	updateFields.TotalAuditCount = dbx.Node_TotalAuditCount(totalAuditCount)
 	updateFields.AuditSuccessRatio = dbx.Node_AuditSuccessRatio(auditRatio)
+	updateFields.AuditedAt = dbx.Node_AuditedAt(time.Now())
+
+	s.updateReputation(dbNode, &pb.Node{Id: node.Id, UpdateAuditSuccess: true, AuditSuccess: node.AuditSuccess}, totalAuditCount, &updateFields)
 
 	dbNode, err = s.DB.Update_Node_By_Id(ctx, dbx.Node_Id(node.Id.Bytes()), updateFields)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	nodeStats := &pb.NodeStats{
-		NodeId:            node.Id,
-		AuditSuccessRatio: dbNode.AuditSuccessRatio,
-		AuditCount:        dbNode.TotalAuditCount,
-		UptimeRatio:       dbNode.UptimeRatio,
-		UptimeCount:       dbNode.TotalUptimeCount,
-	}
+	nodeStats := convertDBNode(node.Id, dbNode)
 	return &pb.UpdateAuditSuccessResponse{
 		Stats: nodeStats,
 	}, nil
@@ -430,3 +497,63 @@ func checkRatioVars(successCount, totalCount int64) (ratio float64, err error) {
 	ratio = float64(successCount) / float64(totalCount)
 	return ratio, nil
 }
+
+// updateReputation adds the reputation of the node after its new results to
+// fields, and whether it finished vetting or got disqualified with them
+func (s *StatDB) updateReputation(dbNode *dbx.Node, node *pb.Node, totalAuditCount int64, fields *dbx.Node_Update_Fields) {
+	audit := Reputation{Alpha: dbNode.AuditReputationAlpha, Beta: dbNode.AuditReputationBeta}
+	if node.UpdateAuditSuccess {
+		audit = s.Model.Update(audit, node.AuditSuccess)
+		fields.AuditReputationAlpha = dbx.Node_AuditReputationAlpha(audit.Alpha)
+		fields.AuditReputationBeta = dbx.Node_AuditReputationBeta(audit.Beta)
+	}
+
+	uptime := Reputation{Alpha: dbNode.UptimeReputationAlpha, Beta: dbNode.UptimeReputationBeta}
+	if node.UpdateUptime {
+		uptime = s.UptimeModel.Update(uptime, node.IsUp)
+		fields.UptimeReputationAlpha = dbx.Node_UptimeReputationAlpha(uptime.Alpha)
+		fields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(uptime.Beta)
+	}
+
+	// disqualification is permanent
+	if dbNode.Disqualified {
+		return
+	}
+
+	// the scores of nodes in vetting swing too much to disqualify them
+	vetted := dbNode.Vetted || totalAuditCount >= s.Reputation.VettingAuditCount
+	if !vetted {
+		return
+	}
+	if !dbNode.Vetted {
+		fields.Vetted = dbx.Node_Vetted(true)
+	}
+	if s.Reputation.disqualifies(audit, uptime) {
+		s.log.Info("node disqualified", zap.String("nodeID", node.Id.String()),
+			zap.Float64("audit score", audit.Score()), zap.Float64("uptime score", uptime.Score()))
+		fields.Disqualified = dbx.Node_Disqualified(true)
+	}
+}
+
+// reputationFromCounts returns a reputation with the ratio of the counts
+func reputationFromCounts(successCount, totalCount int64) Reputation {
+	return Reputation{Alpha: float64(successCount), Beta: float64(totalCount - successCount)}
+}
+
+// convertDBNode returns the stats of a node in the db
+func convertDBNode(id czarcoin.NodeID, dbNode *dbx.Node) *pb.NodeStats {
+	audit := Reputation{Alpha: dbNode.AuditReputationAlpha, Beta: dbNode.AuditReputationBeta}
+	uptime := Reputation{Alpha: dbNode.UptimeReputationAlpha, Beta: dbNode.UptimeReputationBeta}
+	return &pb.NodeStats{
+		NodeId:            id,
+		AuditSuccessRatio: dbNode.AuditSuccessRatio,
+		AuditCount:        dbNode.TotalAuditCount,
+		UptimeRatio:       dbNode.UptimeRatio,
+		UptimeCount:       dbNode.TotalUptimeCount,
+		AuditedUnixSec:    dbNode.AuditedAt.Unix(),
+		AuditReputation:   audit.Score(),
+		UptimeReputation:  uptime.Score(),
+		Vetted:            dbNode.Vetted,
+		Disqualified:      dbNode.Disqualified,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
@@ -139,6 +140,61 @@ func TestGetDoesNotExist(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGetBatch(t *testing.T) {
+	dbPath := getDBPath()
+	statdb, db, err := getServerAndDB(dbPath)
+	assert.NoError(t, err)
+
+	nodeIDs := testczarcoin.NodeIDsFromStrings("id1", "id2", "id3")
+
+	err = createNode(ctx, db, nodeIDs[0], 4, 10, 0.4, 8, 25, 0.32)
+	assert.NoError(t, err)
+	err = createNode(ctx, db, nodeIDs[1], 1, 2, 0.5, 1, 1, 1)
+	assert.NoError(t, err)
+
+	resp, err := statdb.GetBatch(ctx, &pb.GetBatchRequest{NodeIds: nodeIDs})
+	assert.NoError(t, err)
+
+	counts := map[czarcoin.NodeID]int64{}
+	for _, stats := range resp.StatsList {
+		counts[stats.NodeId] = stats.AuditCount
+		assert.NotZero(t, stats.AuditedUnixSec)
+	}
+	assert.Equal(t, map[czarcoin.NodeID]int64{nodeIDs[0]: 10, nodeIDs[1]: 2}, counts)
+
+	resp, err = statdb.GetBatch(ctx, &pb.GetBatchRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.StatsList)
+}
+
+func TestUpdateAuditedAt(t *testing.T) {
+	dbPath := getDBPath()
+	statdb, db, err := getServerAndDB(dbPath)
+	assert.NoError(t, err)
+
+	nodeID := testczarcoin.NodeIDFromString("testnodeid")
+	err = createNode(ctx, db, nodeID, 4, 10, 0.4, 8, 25, 0.32)
+	assert.NoError(t, err)
+
+	audited := time.Now().Add(-time.Hour)
+	_, err = db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		AuditedAt: dbx.Node_AuditedAt(audited),
+	})
+	assert.NoError(t, err)
+
+	resp, err := statdb.Update(ctx, &pb.UpdateRequest{
+		Node: &pb.Node{Id: nodeID, IsUp: true, UpdateUptime: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, audited.Unix(), resp.Stats.AuditedUnixSec)
+
+	resp, err = statdb.Update(ctx, &pb.UpdateRequest{
+		Node: &pb.Node{Id: nodeID, AuditSuccess: true, UpdateAuditSuccess: true},
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Stats.AuditedUnixSec > audited.Unix())
+}
+
 func TestFindInvalidNodes(t *testing.T) {
 	NodeIDs := testczarcoin.NodeIDsFromStrings("id1", "id2", "id3", "id4", "id5", "id6", "id7")
 	dbPath := getDBPath()
@@ -174,8 +230,8 @@ func TestFindInvalidNodes(t *testing.T) {
 			NodeIDs[4], NodeIDs[5],
 		},
 		MaxStats: &pb.NodeStats{
-			AuditSuccessRatio: 0.5,
-			UptimeRatio:       0.5,
+			AuditReputation:  0.5,
+			UptimeReputation: 0.5,
 		},
 	}
 
@@ -456,6 +512,12 @@ func createNode(ctx context.Context, db *dbx.DB, nodeID czarcoin.NodeID,
 		dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
 		dbx.Node_TotalUptimeCount(totalUptimeCount),
 		dbx.Node_UptimeRatio(uptimeRatio),
+		dbx.Node_AuditReputationAlpha(float64(auditSuccessCount)),
+		dbx.Node_AuditReputationBeta(float64(totalAuditCount-auditSuccessCount)),
+		dbx.Node_UptimeReputationAlpha(float64(uptimeSuccessCount)),
+		dbx.Node_UptimeReputationBeta(float64(totalUptimeCount-uptimeSuccessCount)),
+		dbx.Node_Vetted(false),
+		dbx.Node_Disqualified(false),
 	)
 	return err
 }
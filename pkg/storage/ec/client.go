@@ -4,7 +4,10 @@
 package ecclient
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"hash"
 	"io"
 	"io/ioutil"
 	"sort"
@@ -13,6 +16,7 @@ import (
 	"go.uber.org/zap"
 	"gopkg.in/spacemonkeygo/monkit.v2"
 
+	"czarcoin.org/czarcoin/pkg/audit/challenge"
 	"czarcoin.org/czarcoin/pkg/eestream"
 	"czarcoin.org/czarcoin/pkg/pb"
 	"czarcoin.org/czarcoin/pkg/piecestore/psclient"
@@ -25,12 +29,19 @@ import (
 
 var mon = monkit.Package()
 
+// auditChallenges is the number of audit challenges created for each piece
+const auditChallenges = 4
+
+// maxPutRedials is the number of times a node is dialed again to resume an
+// interrupted piece upload
+const maxPutRedials = 2
+
 // Client defines an interface for storing erasure coded data to piece store nodes
 type Client interface {
 	Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy,
-		pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, err error)
+		pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, successfulPieces []*pb.RemotePiece, err error)
 	Get(ctx context.Context, nodes []*pb.Node, es eestream.ErasureScheme,
-		pieceID psclient.PieceID, size int64, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (ranger.Ranger, error)
+		pieceID psclient.PieceID, size int64, hashes [][]byte, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (ranger.Ranger, error)
 	Delete(ctx context.Context, nodes []*pb.Node, pieceID psclient.PieceID, authorization *pb.SignedMessage) error
 }
 
@@ -58,25 +69,29 @@ func (ec *ecClient) newPSClient(ctx context.Context, n *pb.Node) (psclient.Clien
 }
 
 func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy,
-	pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, err error) {
+	pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, successfulPieces []*pb.RemotePiece, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if len(nodes) != rs.TotalCount() {
-		return nil, Error.New("number of nodes (%d) do not match total count (%d) of erasure scheme", len(nodes), rs.TotalCount())
+		return nil, nil, Error.New("number of nodes (%d) do not match total count (%d) of erasure scheme", len(nodes), rs.TotalCount())
 	}
 	if !unique(nodes) {
-		return nil, Error.New("duplicated nodes are not allowed")
+		return nil, nil, Error.New("duplicated nodes are not allowed")
+	}
+	if validCount(nodes) == 0 {
+		return nil, nil, Error.New("no nodes to put pieces to")
 	}
 
 	padded := eestream.PadReader(ioutil.NopCloser(data), rs.StripeSize())
 	readers, err := eestream.EncodeReader(ctx, padded, rs, ec.memoryLimit)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	type info struct {
-		i   int
-		err error
+		i     int
+		piece *pb.RemotePiece
+		err   error
 	}
 	infos := make(chan info, len(nodes))
 
@@ -95,33 +110,37 @@ func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.Redun
 				infos <- info{i: i, err: err}
 				return
 			}
-			ps, err := ec.newPSClient(ctx, n)
+			// challenges for auditing the piece are sampled while it is sent
+			sampler := challenge.NewSampler(auditChallenges, rs.ErasureShareSize())
+			upload := psclient.NewUpload(io.TeeReader(readers[i], sampler))
+			hash, err := ec.putPiece(ctx, n, derivedPieceID, upload, expiration, pba, authorization)
+			// io.ErrUnexpectedEOF means the piece upload was interrupted due to slow connection.
+			// No error logging for this case.
 			if err != nil {
-				zap.S().Errorf("Failed dialing for putting piece %s -> %s to node %s: %v",
-					pieceID, derivedPieceID, n.Id, err)
+				if err != io.ErrUnexpectedEOF {
+					zap.S().Errorf("Failed putting piece %s -> %s to node %s: %v",
+						pieceID, derivedPieceID, n.Id, err)
+				}
 				infos <- info{i: i, err: err}
 				return
 			}
-			err = ps.Put(ctx, derivedPieceID, readers[i], expiration, pba, authorization)
-			// normally the bellow call should be deferred, but doing so fails
-			// randomly the unit tests
-			utils.LogClose(ps)
-			// io.ErrUnexpectedEOF means the piece upload was interrupted due to slow connection.
-			// No error logging for this case.
-			if err != nil && err != io.ErrUnexpectedEOF {
-				zap.S().Errorf("Failed putting piece %s -> %s to node %s: %v",
-					pieceID, derivedPieceID, n.Id, err)
-			}
-			infos <- info{i: i, err: err}
+			infos <- info{i: i, piece: &pb.RemotePiece{
+				PieceNum:   int32(i),
+				NodeId:     n.Id,
+				Hash:       hash,
+				Challenges: sampler.Challenges(),
+			}}
 		}(i, n)
 	}
 
 	successfulNodes = make([]*pb.Node, len(nodes))
+	successfulPieces = make([]*pb.RemotePiece, len(nodes))
 	var successfulCount int
 	for range nodes {
 		info := <-infos
-		if info.err == nil {
+		if info.err == nil && info.piece != nil {
 			successfulNodes[info.i] = nodes[info.i]
+			successfulPieces[info.i] = info.piece
 			successfulCount++
 		}
 	}
@@ -138,15 +157,23 @@ func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.Redun
 		}
 	}()
 
-	if successfulCount < rs.RepairThreshold() {
-		return nil, Error.New("successful puts (%d) less than repair threshold (%d)", successfulCount, rs.RepairThreshold())
+	// nil nodes only discard their pieces, so they don't count as successes,
+	// when only some of the pieces are put all of them have to succeed
+	threshold := rs.RepairThreshold()
+	if valid := validCount(nodes); valid < threshold {
+		threshold = valid
+	}
+	if successfulCount < threshold {
+		return nil, nil, Error.New("successful puts (%d) less than repair threshold (%d)", successfulCount, threshold)
 	}
 
-	return successfulNodes, nil
+	return successfulNodes, successfulPieces, nil
 }
 
+// Get downloads the segment from the nodes, the pieces which are downloaded
+// whole are verified against hashes, which are indexed like nodes
 func (ec *ecClient) Get(ctx context.Context, nodes []*pb.Node, es eestream.ErasureScheme,
-	pieceID psclient.PieceID, size int64, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (rr ranger.Ranger, err error) {
+	pieceID psclient.PieceID, size int64, hashes [][]byte, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (rr ranger.Ranger, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	validNodeCount := validCount(nodes)
@@ -179,11 +206,17 @@ func (ec *ecClient) Get(ctx context.Context, nodes []*pb.Node, es eestream.Erasu
 				return
 			}
 
+			var hash []byte
+			if i < len(hashes) {
+				hash = hashes[i]
+			}
+
 			rr := &lazyPieceRanger{
 				newPSClientHelper: ec.newPSClient,
 				node:              n,
 				id:                derivedPieceID,
 				size:              pieceSize,
+				hash:              hash,
 				pba:               pba,
 				authorization:     authorization,
 			}
@@ -264,6 +297,32 @@ func collectErrors(errs <-chan error, size int) []error {
 	return result
 }
 
+// putPiece puts the piece to node n, dialing the node again to resume the
+// upload when the connection breaks
+func (ec *ecClient) putPiece(ctx context.Context, n *pb.Node, id psclient.PieceID, upload *psclient.Upload,
+	expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (hash []byte, err error) {
+	for redial := 0; ; redial++ {
+		var ps psclient.Client
+		ps, err = ec.newPSClient(ctx, n)
+		if err != nil {
+			zap.S().Errorf("Failed dialing for putting piece %s to node %s: %v", id, n.Id, err)
+		} else {
+			hash, err = ps.Resume(ctx, id, upload, expiration, pba, authorization)
+			// normally the bellow call should be deferred, but doing so fails
+			// randomly the unit tests
+			utils.LogClose(ps)
+			if err == nil {
+				return hash, nil
+			}
+		}
+
+		// a node which was cut for being slow is not dialed again
+		if err == io.ErrUnexpectedEOF || !upload.Resumable() || redial >= maxPutRedials || ctx.Err() != nil {
+			return nil, err
+		}
+	}
+}
+
 func unique(nodes []*pb.Node) bool {
 	if len(nodes) < 2 {
 		return true
@@ -302,6 +361,7 @@ type lazyPieceRanger struct {
 	node              *pb.Node
 	id                psclient.PieceID
 	size              int64
+	hash              []byte
 	pba               *pb.PayerBandwidthAllocation
 	authorization     *pb.SignedMessage
 }
@@ -324,7 +384,37 @@ func (lr *lazyPieceRanger) Range(ctx context.Context, offset, length int64) (io.
 		}
 		lr.ranger = ranger
 	}
-	return lr.ranger.Range(ctx, offset, length)
+
+	rc, err := lr.ranger.Range(ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	// only whole pieces can be verified against their hash
+	if len(lr.hash) == 0 || offset != 0 || length != lr.size {
+		return rc, nil
+	}
+	return &verifiedReader{ReadCloser: rc, hasher: sha256.New(), remaining: length, hash: lr.hash}, nil
+}
+
+// verifiedReader fails the read of the last byte of a piece when the piece
+// doesn't match its hash
+type verifiedReader struct {
+	io.ReadCloser
+	hasher    hash.Hash
+	remaining int64
+	hash      []byte
+}
+
+// Read implements io.Reader
+func (r *verifiedReader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	_, _ = r.hasher.Write(p[:n])
+	r.remaining -= int64(n)
+	if n > 0 && r.remaining == 0 && !bytes.Equal(r.hasher.Sum(nil), r.hash) {
+		return n, Error.New("piece hash mismatch")
+	}
+	return n, err
 }
 
 func validCount(nodes []*pb.Node) int {
@@ -8,6 +8,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
@@ -111,6 +112,12 @@ TestLoop:
 			"ecclient error: successful puts (1) less than repair threshold (2)"},
 		{[]*pb.Node{nil, nil, node2, node3}, 0, 0, false,
 			[]error{nil, nil, nil, nil}, ""},
+		{[]*pb.Node{nil, nil, node2, node3}, 0, 0, false,
+			[]error{nil, nil, ErrOpFailed, nil},
+			"ecclient error: successful puts (1) less than repair threshold (2)"},
+		{[]*pb.Node{nil, nil, nil, nil}, 0, 0, true,
+			[]error{nil, nil, nil, nil},
+			"ecclient error: no nodes to put pieces to"},
 	} {
 		errTag := fmt.Sprintf("Test case #%d", i)
 
@@ -118,8 +125,12 @@ TestLoop:
 		ttl := time.Now()
 
 		errs := make(map[*pb.Node]error, len(tt.nodes))
+		hashes := make(map[*pb.Node][]byte, len(tt.nodes))
 		for i, n := range tt.nodes {
 			errs[n] = tt.errs[i]
+			if n != nil && tt.errs[i] == nil {
+				hashes[n] = []byte{byte(i + 1)}
+			}
 		}
 
 		clients := make(map[*pb.Node]psclient.Client, len(tt.nodes))
@@ -131,16 +142,19 @@ TestLoop:
 			if !assert.NoError(t, err, errTag) {
 				continue TestLoop
 			}
+			// failed uploads are resumed with a new connection
+			calls := 1
+			if errs[n] != nil {
+				calls += maxPutRedials
+			}
 			ps := NewMockPSClient(ctrl)
-			gomock.InOrder(
-				ps.EXPECT().Put(gomock.Any(), derivedID, gomock.Any(), ttl, gomock.Any(), gomock.Any()).Return(errs[n]).
-					Do(func(ctx context.Context, id psclient.PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) {
-						// simulate that the mocked piece store client is reading the data
-						_, err := io.Copy(ioutil.Discard, data)
-						assert.NoError(t, err, errTag)
-					}),
-				ps.EXPECT().Close().Return(nil),
-			)
+			ps.EXPECT().Resume(gomock.Any(), derivedID, gomock.Any(), ttl, gomock.Any(), gomock.Any()).Return(hashes[n], errs[n]).
+				Do(func(ctx context.Context, id psclient.PieceID, upload *psclient.Upload, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) {
+					// simulate that the mocked piece store client is reading the data
+					_, err := io.Copy(ioutil.Discard, upload)
+					assert.NoError(t, err, errTag)
+				}).Times(calls)
+			ps.EXPECT().Close().Return(nil).Times(calls)
 			clients[n] = ps
 		}
 		rs, err := eestream.NewRedundancyStrategy(es, tt.min, 0)
@@ -150,18 +164,24 @@ TestLoop:
 		r := io.LimitReader(rand.Reader, int64(size))
 		ec := ecClient{newPSClientFunc: mockNewPSClient(clients), memoryLimit: tt.mbm}
 
-		successfulNodes, err := ec.Put(ctx, tt.nodes, rs, id, r, ttl, nil, nil)
+		successfulNodes, successfulPieces, err := ec.Put(ctx, tt.nodes, rs, id, r, ttl, nil, nil)
 
 		if tt.errString != "" {
 			assert.EqualError(t, err, tt.errString, errTag)
 		} else {
 			assert.NoError(t, err, errTag)
 			assert.Equal(t, len(tt.nodes), len(successfulNodes), errTag)
+			assert.Equal(t, len(tt.nodes), len(successfulPieces), errTag)
 			for i := range tt.nodes {
-				if tt.errs[i] != nil {
+				if tt.errs[i] != nil || tt.nodes[i] == nil {
 					assert.Nil(t, successfulNodes[i], errTag)
+					assert.Nil(t, successfulPieces[i], errTag)
 				} else {
 					assert.Equal(t, tt.nodes[i], successfulNodes[i], errTag)
+					assert.Equal(t, int32(i), successfulPieces[i].GetPieceNum(), errTag)
+					assert.Equal(t, tt.nodes[i].Id, successfulPieces[i].NodeId, errTag)
+					assert.Equal(t, hashes[tt.nodes[i]], successfulPieces[i].GetHash(), errTag)
+					assert.NotEmpty(t, successfulPieces[i].GetChallenges(), errTag)
 				}
 			}
 		}
@@ -223,8 +243,12 @@ TestLoop:
 		id := psclient.NewPieceID()
 
 		errs := make(map[*pb.Node]error, len(tt.nodes))
+		hashes := make(map[*pb.Node][]byte, len(tt.nodes))
 		for i, n := range tt.nodes {
 			errs[n] = tt.errs[i]
+			if n != nil && tt.errs[i] == nil {
+				hashes[n] = []byte{byte(i + 1)}
+			}
 		}
 
 		clients := make(map[*pb.Node]psclient.Client, len(tt.nodes))
@@ -240,7 +264,7 @@ TestLoop:
 			}
 		}
 		ec := ecClient{newPSClientFunc: mockNewPSClient(clients), memoryLimit: tt.mbm}
-		rr, err := ec.Get(ctx, tt.nodes, es, id, int64(size), nil, nil)
+		rr, err := ec.Get(ctx, tt.nodes, es, id, int64(size), nil, nil, nil)
 		if err == nil {
 			_, err := rr.Range(ctx, 0, 0)
 			assert.NoError(t, err, errTag)
@@ -254,6 +278,42 @@ TestLoop:
 	}
 }
 
+func TestGetVerifiesHash(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("some piece data")
+	hash := sha256.Sum256(data)
+
+	for i, tt := range []struct {
+		hash   []byte
+		offset int64
+		length int64
+		ok     bool
+	}{
+		{hash[:], 0, int64(len(data)), true},
+		{[]byte("other hash"), 0, int64(len(data)), false},
+		// partially downloaded pieces can't be verified
+		{[]byte("other hash"), 1, 4, true},
+		{nil, 0, int64(len(data)), true},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		rr := &lazyPieceRanger{ranger: ranger.ByteRanger(data), size: int64(len(data)), hash: tt.hash}
+		rc, err := rr.Range(ctx, tt.offset, tt.length)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+
+		read, err := ioutil.ReadAll(rc)
+		assert.NoError(t, rc.Close(), errTag)
+		if tt.ok {
+			assert.NoError(t, err, errTag)
+			assert.Equal(t, data[tt.offset:tt.offset+tt.length], read, errTag)
+		} else {
+			assert.Error(t, err, errTag)
+		}
+	}
+}
+
 func TestDelete(t *testing.T) {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
@@ -282,8 +342,12 @@ TestLoop:
 		id := psclient.NewPieceID()
 
 		errs := make(map[*pb.Node]error, len(tt.nodes))
+		hashes := make(map[*pb.Node][]byte, len(tt.nodes))
 		for i, n := range tt.nodes {
 			errs[n] = tt.errs[i]
+			if n != nil && tt.errs[i] == nil {
+				hashes[n] = []byte{byte(i + 1)}
+			}
 		}
 
 		clients := make(map[*pb.Node]psclient.Client, len(tt.nodes))
@@ -0,0 +1,63 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segments
+
+import (
+	"crypto/sha256"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+// missingLeaf is the leaf of pieces which are missing or have no hash, so
+// that the position of every leaf is its piece number
+var missingLeaf = make([]byte, sha256.Size)
+
+// merkleRoot returns the root of the SHA-256 merkle tree over the piece
+// hashes, which are indexed by piece number. An odd hash at the end of a
+// level is carried to the next level. It returns nil when no piece has a
+// hash, as the root would not cover any piece.
+func merkleRoot(hashes [][]byte) []byte {
+	level := make([][]byte, 0, len(hashes))
+	covered := false
+	for _, hash := range hashes {
+		if len(hash) == 0 {
+			level = append(level, missingLeaf)
+			continue
+		}
+		level = append(level, hash)
+		covered = true
+	}
+	if !covered {
+		return nil
+	}
+
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			hasher := sha256.New()
+			_, _ = hasher.Write(level[i])
+			_, _ = hasher.Write(level[i+1])
+			next = append(next, hasher.Sum(nil))
+		}
+		level = next
+	}
+
+	return level[0]
+}
+
+// pieceHashes returns the hashes of pieces indexed by piece number, which is
+// nil for the pieces of the total that are missing
+func pieceHashes(pieces []*pb.RemotePiece, total int) [][]byte {
+	hashes := make([][]byte, total)
+	for _, piece := range pieces {
+		if num := int(piece.GetPieceNum()); num >= 0 && num < total {
+			hashes[num] = piece.GetHash()
+		}
+	}
+	return hashes
+}
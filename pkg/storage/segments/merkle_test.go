@@ -0,0 +1,41 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segments
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+func TestMerkleRoot(t *testing.T) {
+	hash := func(data ...[]byte) []byte {
+		hasher := sha256.New()
+		for _, d := range data {
+			_, _ = hasher.Write(d)
+		}
+		return hasher.Sum(nil)
+	}
+
+	a, b, c := hash([]byte("a")), hash([]byte("b")), hash([]byte("c"))
+
+	assert.Nil(t, merkleRoot(nil))
+	assert.Equal(t, [][]byte{nil, a, b}, pieceHashes([]*pb.RemotePiece{{PieceNum: 2, Hash: b}, {PieceNum: 1, Hash: a}}, 3))
+	assert.Equal(t, a, merkleRoot([][]byte{a}))
+	assert.Equal(t, hash(a, b), merkleRoot([][]byte{a, b}))
+	assert.Equal(t, hash(hash(a, b), c), merkleRoot([][]byte{a, b, c}))
+
+	// the root depends on the order of the pieces
+	assert.NotEqual(t, merkleRoot([][]byte{a, b}), merkleRoot([][]byte{b, a}))
+
+	// missing pieces keep the position of the other leaves
+	assert.Equal(t, hash(hash(a, missingLeaf), c), merkleRoot([][]byte{a, nil, c}))
+	assert.NotEqual(t, merkleRoot([][]byte{a, nil, c}), merkleRoot([][]byte{a, c, nil}))
+
+	// a root covers at least one piece
+	assert.Nil(t, merkleRoot([][]byte{nil, nil}))
+}
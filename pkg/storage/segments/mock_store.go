@@ -67,10 +67,11 @@ func (mr *MockStoreMockRecorder) Get(ctx, path interface{}) *gomock.Call {
 }
 
 // Repair mocks base method
-func (m *MockStore) Repair(ctx context.Context, path czarcoin.Path, lostPieces []int32) error {
+func (m *MockStore) Repair(ctx context.Context, path czarcoin.Path, lostPieces []int32) (RepairReport, error) {
 	ret := m.ctrl.Call(m, "Repair", ctx, path, lostPieces)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(RepairReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Repair indicates an expected call of Repair
@@ -48,7 +48,7 @@ type ListItem struct {
 type Store interface {
 	Meta(ctx context.Context, path czarcoin.Path) (meta Meta, err error)
 	Get(ctx context.Context, path czarcoin.Path) (rr ranger.Ranger, meta Meta, err error)
-	Repair(ctx context.Context, path czarcoin.Path, lostPieces []int32) (err error)
+	Repair(ctx context.Context, path czarcoin.Path, lostPieces []int32) (report RepairReport, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (czarcoin.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path czarcoin.Path) (err error)
 	List(ctx context.Context, prefix, startAfter, endBefore czarcoin.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
@@ -125,7 +125,7 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 			return Meta{}, Error.Wrap(err)
 		}
 		// puts file to ecclient
-		successfulNodes, err := s.ec.Put(ctx, nodes, s.rs, pieceID, sizedReader, expiration, pba, authorization)
+		_, successfulPieces, err := s.ec.Put(ctx, nodes, s.rs, pieceID, sizedReader, expiration, pba, authorization)
 		if err != nil {
 			return Meta{}, Error.Wrap(err)
 		}
@@ -136,7 +136,7 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 		}
 		path = p
 
-		pointer, err = s.makeRemotePointer(successfulNodes, pieceID, sizedReader.Size(), exp, metadata)
+		pointer, err = s.makeRemotePointer(successfulPieces, pieceID, sizedReader.Size(), exp, metadata)
 		if err != nil {
 			return Meta{}, err
 		}
@@ -156,17 +156,15 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 	return m, nil
 }
 
-// makeRemotePointer creates a pointer of type remote
-func (s *segmentStore) makeRemotePointer(nodes []*pb.Node, pieceID psclient.PieceID, readerSize int64, exp *timestamp.Timestamp, metadata []byte) (pointer *pb.Pointer, err error) {
+// makeRemotePointer creates a pointer of type remote, pieces are indexed by
+// piece number and nil for the pieces which were not stored
+func (s *segmentStore) makeRemotePointer(pieces []*pb.RemotePiece, pieceID psclient.PieceID, readerSize int64, exp *timestamp.Timestamp, metadata []byte) (pointer *pb.Pointer, err error) {
 	var remotePieces []*pb.RemotePiece
-	for i := range nodes {
-		if nodes[i] == nil {
+	for _, piece := range pieces {
+		if piece == nil {
 			continue
 		}
-		remotePieces = append(remotePieces, &pb.RemotePiece{
-			PieceNum: int32(i),
-			NodeId:   nodes[i].Id,
-		})
+		remotePieces = append(remotePieces, piece)
 	}
 
 	pointer = &pb.Pointer{
@@ -182,6 +180,7 @@ func (s *segmentStore) makeRemotePointer(nodes []*pb.Node, pieceID psclient.Piec
 			},
 			PieceId:      string(pieceID),
 			RemotePieces: remotePieces,
+			MerkleRoot:   merkleRoot(pieceHashes(remotePieces, len(pieces))),
 		},
 		SegmentSize:    readerSize,
 		ExpirationDate: exp,
@@ -203,6 +202,15 @@ func (s *segmentStore) Get(ctx context.Context, path czarcoin.Path) (rr ranger.R
 		seg := pr.GetRemote()
 		pid := psclient.PieceID(seg.GetPieceId())
 
+		// pointers come without an allocation once the project exceeded its
+		// egress limit, asking for one returns why
+		if pba == nil {
+			pba, err = s.pdb.PayerBandwidthAllocation(ctx, pb.PayerBandwidthAllocation_GET)
+			if err != nil {
+				return nil, Meta{}, Error.Wrap(err)
+			}
+		}
+
 		// fall back if nodes are not available
 		if nodes == nil {
 			nodes, err = s.lookupNodes(ctx, seg)
@@ -216,9 +224,7 @@ func (s *segmentStore) Get(ctx context.Context, path czarcoin.Path) (rr ranger.R
 			return nil, Meta{}, err
 		}
 
-		// calculate how many minimum nodes needed based on t = k + (n-o)k/o
-		rs := pr.GetRemote().GetRedundancy()
-		needed := rs.GetMinReq() + ((rs.GetTotal()-rs.GetSuccessThreshold())*rs.GetMinReq())/rs.GetSuccessThreshold()
+		needed := downloadCount(pr.GetRemote().GetRedundancy())
 
 		for i, v := range nodes {
 			if v != nil {
@@ -231,7 +237,8 @@ func (s *segmentStore) Get(ctx context.Context, path czarcoin.Path) (rr ranger.R
 		}
 
 		authorization := s.pdb.SignedMessage()
-		rr, err = s.ec.Get(ctx, nodes, es, pid, pr.GetSegmentSize(), pba, authorization)
+		hashes := pieceHashes(seg.GetRemotePieces(), len(nodes))
+		rr, err = s.ec.Get(ctx, nodes, es, pid, pr.GetSegmentSize(), hashes, pba, authorization)
 		if err != nil {
 			return nil, Meta{}, Error.Wrap(err)
 		}
@@ -284,29 +291,44 @@ func (s *segmentStore) Delete(ctx context.Context, path czarcoin.Path) (err erro
 	return s.pdb.Delete(ctx, path)
 }
 
-// Repair retrieves an at-risk segment and repairs and stores lost pieces on new nodes
-func (s *segmentStore) Repair(ctx context.Context, path czarcoin.Path, lostPieces []int32) (err error) {
+// RepairReport describes what a repair did to a segment
+type RepairReport struct {
+	// HealthyPieces is the number of pieces which didn't need a repair
+	HealthyPieces int
+	// RebuiltPieces is the number of pieces uploaded to new nodes
+	RebuiltPieces int
+	// NewNodes are the nodes chosen to store the rebuilt pieces
+	NewNodes czarcoin.NodeIDList
+}
+
+// Repair retrieves an at-risk segment from its healthy nodes, stores its lost
+// pieces on new nodes and swaps the pointer only if it didn't change in the
+// meantime. Like Get it downloads from more nodes than the minimum needed to
+// decode, as downloading exactly the minimum would fail the repair on any
+// single corrupt piece or unresponsive node.
+func (s *segmentStore) Repair(ctx context.Context, path czarcoin.Path, lostPieces []int32) (report RepairReport, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	//Read the segment's pointer's info from the PointerDB
 	pr, originalNodes, pba, err := s.pdb.Get(ctx, path)
 	if err != nil {
-		return Error.Wrap(err)
+		return report, Error.Wrap(err)
 	}
 
 	if pr.GetType() != pb.Pointer_REMOTE {
-		return Error.New("cannot repair inline segment %s", psclient.PieceID(pr.GetInlineSegment()))
+		return report, Error.New("cannot repair inline segment %s", psclient.PieceID(pr.GetInlineSegment()))
 	}
 
 	seg := pr.GetRemote()
 	pid := psclient.PieceID(seg.GetPieceId())
+	redundancy := seg.GetRedundancy()
 
 	// fall back if nodes are not available
 	if originalNodes == nil {
 		// Get the list of remote pieces from the pointer
 		originalNodes, err = s.lookupNodes(ctx, seg)
 		if err != nil {
-			return Error.Wrap(err)
+			return report, Error.Wrap(err)
 		}
 	}
 
@@ -334,16 +356,28 @@ func (s *segmentStore) Repair(ctx context.Context, path czarcoin.Path, lostPiece
 			healthyNodes[i] = v
 		}
 	}
+	report.HealthyPieces = len(originalNodes) - totalNilNodes
+
+	// download with the same margin as Get, so the decoder can correct
+	// corrupt pieces and slow nodes don't fail the repair
+	downloadNodes := make([]*pb.Node, len(healthyNodes))
+	needed := downloadCount(redundancy)
+	for i, v := range healthyNodes {
+		if v != nil && needed > 0 {
+			downloadNodes[i] = v
+			needed--
+		}
+	}
 
 	//Request Overlay for n-h new storage nodes
 	op := overlay.Options{Amount: totalNilNodes, Space: 0, Excluded: excludeNodeIDs}
 	newNodes, err := s.oc.Choose(ctx, op)
 	if err != nil {
-		return err
+		return report, err
 	}
 
 	if totalNilNodes != len(newNodes) {
-		return Error.New("Number of new nodes from overlay (%d) does not equal total nil nodes (%d)", len(newNodes), totalNilNodes)
+		return report, Error.New("Number of new nodes from overlay (%d) does not equal total nil nodes (%d)", len(newNodes), totalNilNodes)
 	}
 
 	totalRepairCount := len(newNodes)
@@ -353,7 +387,7 @@ func (s *segmentStore) Repair(ctx context.Context, path czarcoin.Path, lostPiece
 	for j, vr := range healthyNodes {
 		// check that totalRepairCount is non-negative
 		if totalRepairCount < 0 {
-			return Error.New("Total repair count (%d) less than zero", totalRepairCount)
+			return report, Error.New("Total repair count (%d) less than zero", totalRepairCount)
 		}
 
 		// find the nil in the node list
@@ -366,53 +400,79 @@ func (s *segmentStore) Repair(ctx context.Context, path czarcoin.Path, lostPiece
 
 	// check that all nil nodes have a replacement prepared
 	if totalRepairCount != 0 {
-		return Error.New("Failed to replace all nil nodes (%d). (%d) new nodes not inserted", len(newNodes), totalRepairCount)
+		return report, Error.New("Failed to replace all nil nodes (%d). (%d) new nodes not inserted", len(newNodes), totalRepairCount)
 	}
 
-	es, err := makeErasureScheme(pr.GetRemote().GetRedundancy())
+	es, err := makeErasureScheme(redundancy)
 	if err != nil {
-		return Error.Wrap(err)
+		return report, Error.Wrap(err)
+	}
+
+	// the pieces are rebuilt with the redundancy of the segment, which may
+	// differ from the one new segments are stored with
+	rs, err := eestream.NewRedundancyStrategy(es, int(redundancy.GetRepairThreshold()), int(redundancy.GetSuccessThreshold()))
+	if err != nil {
+		return report, Error.Wrap(err)
 	}
 
 	signedMessage := s.pdb.SignedMessage()
 
 	// download the segment using the nodes just with healthy nodes
-	rr, err := s.ec.Get(ctx, healthyNodes, es, pid, pr.GetSegmentSize(), pba, signedMessage)
+	hashes := pieceHashes(seg.GetRemotePieces(), len(downloadNodes))
+	rr, err := s.ec.Get(ctx, downloadNodes, es, pid, pr.GetSegmentSize(), hashes, pba, signedMessage)
 	if err != nil {
-		return Error.Wrap(err)
+		return report, Error.Wrap(err)
 	}
 
 	// get io.Reader from ranger
 	r, err := rr.Range(ctx, 0, rr.Size())
 	if err != nil {
-		return err
+		return report, err
 	}
 	defer utils.LogClose(r)
 
-	// puts file to ecclient
+	// puts file to ecclient, only the pieces of the repair nodes are uploaded
 	exp := pr.GetExpirationDate()
 
-	successfulNodes, err := s.ec.Put(ctx, repairNodesList, s.rs, pid, r, time.Unix(exp.GetSeconds(), 0), pba, signedMessage)
+	_, successfulPieces, err := s.ec.Put(ctx, repairNodesList, rs, pid, r, time.Unix(exp.GetSeconds(), 0), pba, signedMessage)
 	if err != nil {
-		return Error.Wrap(err)
+		return report, Error.Wrap(err)
 	}
 
-	// merge the successful nodes list into the healthy nodes list
+	// healthy pieces keep the hashes and challenges committed when they were uploaded
+	pieces := make([]*pb.RemotePiece, len(healthyNodes))
+	for _, piece := range seg.GetRemotePieces() {
+		num := int(piece.GetPieceNum())
+		if num < len(pieces) && healthyNodes[num] != nil {
+			pieces[num] = piece
+		}
+	}
+
+	// merge the successfully repaired pieces into the healthy pieces
 	for i, v := range healthyNodes {
-		if v == nil {
-			// copy the successfuNode info
-			healthyNodes[i] = successfulNodes[i]
+		if v == nil && successfulPieces[i] != nil {
+			pieces[i] = successfulPieces[i]
+			report.RebuiltPieces++
+			report.NewNodes = append(report.NewNodes, successfulPieces[i].NodeId)
 		}
 	}
 
-	metadata := pr.GetMetadata()
-	pointer, err := s.makeRemotePointer(healthyNodes, pid, rr.Size(), exp, metadata)
-	if err != nil {
-		return err
+	var remotePieces []*pb.RemotePiece
+	for _, piece := range pieces {
+		if piece == nil {
+			continue
+		}
+		remotePieces = append(remotePieces, piece)
 	}
 
-	// update the segment info in the pointerDB
-	return s.pdb.Put(ctx, path, pointer)
+	remote := *seg
+	remote.RemotePieces = remotePieces
+	remote.MerkleRoot = merkleRoot(pieceHashes(remotePieces, int(redundancy.GetTotal())))
+	pointer := *pr
+	pointer.Remote = &remote
+
+	// update the segment info in the pointerDB unless it was changed meanwhile
+	return report, s.pdb.CompareAndSwap(ctx, path, pr, &pointer)
 }
 
 // lookupNodes calls Lookup to get node addresses from the overlay
@@ -488,3 +548,9 @@ func convertTime(ts *timestamp.Timestamp) time.Time {
 	}
 	return t
 }
+
+// downloadCount calculates how many nodes to download from based on
+// t = k + (n-o)k/o
+func downloadCount(rs *pb.RedundancyScheme) int32 {
+	return rs.GetMinReq() + ((rs.GetTotal()-rs.GetSuccessThreshold())*rs.GetMinReq())/rs.GetSuccessThreshold()
+}
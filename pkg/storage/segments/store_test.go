@@ -305,22 +305,25 @@ func TestSegmentStoreRepairRemote(t *testing.T) {
 			mockOC.EXPECT().Choose(gomock.Any(), gomock.Any()).Return(tt.newNodes, nil),
 			mockPDB.EXPECT().SignedMessage(),
 			mockEC.EXPECT().Get(
-				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 			).Return(ranger.ByteRanger([]byte(tt.data)), nil),
 			mockEC.EXPECT().Put(
 				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-			).Return(tt.newNodes, nil),
-			mockES.EXPECT().RequiredCount().Return(1),
-			mockES.EXPECT().TotalCount().Return(1),
-			mockES.EXPECT().ErasureShareSize().Return(1),
-			mockPDB.EXPECT().Put(
-				gomock.Any(), gomock.Any(), gomock.Any(),
+			).Return(tt.newNodes, []*pb.RemotePiece{
+				{PieceNum: 0, NodeId: tt.newNodes[1].Id},
+				{PieceNum: 1, NodeId: tt.newNodes[0].Id},
+			}, nil),
+			mockPDB.EXPECT().CompareAndSwap(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 			).Return(nil),
 		}
 		gomock.InOrder(calls...)
 
-		err := ss.Repair(ctx, tt.pathInput, tt.lostPieces)
+		report, err := ss.Repair(ctx, tt.pathInput, tt.lostPieces)
 		assert.NoError(t, err)
+		assert.Equal(t, 0, report.HealthyPieces)
+		assert.Equal(t, 2, report.RebuiltPieces)
+		assert.Equal(t, czarcoin.NodeIDList{tt.newNodes[1].Id, tt.newNodes[0].Id}, report.NewNodes)
 	}
 }
 
@@ -373,10 +376,11 @@ func TestSegmentStoreGetRemote(t *testing.T) {
 				SegmentSize:    tt.size,
 				Metadata:       tt.metadata,
 			}, nil, nil, nil),
+			mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), pb.PayerBandwidthAllocation_GET),
 			mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()),
 			mockPDB.EXPECT().SignedMessage(),
 			mockEC.EXPECT().Get(
-				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 			),
 		}
 		gomock.InOrder(calls...)
@@ -6,6 +6,7 @@ package utils
 import (
 	"bytes"
 	"encoding/gob"
+	"errors"
 	"net/url"
 	"strings"
 	"time"
@@ -48,6 +49,19 @@ func ParseURL(s string) (*url.URL, error) {
 	return url.Parse(s)
 }
 
+// ParseTime parses an RFC3339 time or a UTC date
+//   2019-01-02T15:04:05+01:00
+//   2019-01-02
+func ParseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, errors.New("missing time")
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", s)
+}
+
 // CombineErrors combines multiple errors to a single error
 func CombineErrors(errs ...error) error {
 	var errlist combinedError
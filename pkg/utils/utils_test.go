@@ -40,3 +40,18 @@ func TestCollecMultipleError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "error1\nerror2\nerror3")
 }
+
+func TestParseTime(t *testing.T) {
+	date, err := utils.ParseTime("2019-01-02")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), date)
+
+	moment, err := utils.ParseTime("2019-01-02T15:04:05+01:00")
+	assert.NoError(t, err)
+	assert.True(t, moment.Equal(time.Date(2019, 1, 2, 14, 4, 5, 0, time.UTC)))
+
+	for _, invalid := range []string{"", "yesterday", "2019-13-01"} {
+		_, err := utils.ParseTime(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
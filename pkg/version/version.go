@@ -0,0 +1,111 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/zeebo/errs"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+// Version is the software version of this build, it is set at build time with
+// -ldflags "-X czarcoin.org/czarcoin/pkg/version.Version=v0.1.0"
+var Version = "v0.0.0"
+
+// Error is the default version errs class
+var Error = errs.Class("version error")
+
+var semVerRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)`)
+
+// SemVer is a semantic version
+type SemVer struct {
+	Major int64
+	Minor int64
+	Patch int64
+}
+
+// NewSemVer parses a version of the form v1.2.3, anything after the patch
+// number, such as -rc1, is ignored
+func NewSemVer(version string) (SemVer, error) {
+	m := semVerRegex.FindStringSubmatch(version)
+	if m == nil {
+		return SemVer{}, Error.New("invalid version %q", version)
+	}
+
+	var parts [3]int64
+	for i := range parts {
+		n, err := strconv.ParseInt(m[i+1], 10, 64)
+		if err != nil {
+			return SemVer{}, Error.Wrap(err)
+		}
+		parts[i] = n
+	}
+	return SemVer{Major: parts[0], Minor: parts[1], Patch: parts[2]}, nil
+}
+
+// Compare returns -1, 0 or 1 when v is older than, the same as or newer than other
+func (v SemVer) Compare(other SemVer) int {
+	for _, d := range []int64{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		switch {
+		case d < 0:
+			return -1
+		case d > 0:
+			return 1
+		}
+	}
+	return 0
+}
+
+// String returns the version in the form v1.2.3
+func (v SemVer) String() string {
+	return fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// MinimumConfig is the minimum version nodes of each type need to run to be used
+type MinimumConfig struct {
+	Storage   string `help:"minimum version of storage nodes, older nodes are not selected for storing or serving data" default:""`
+	Satellite string `help:"minimum version of satellites" default:""`
+}
+
+// Parse parses the configured minimum versions, types without a minimum are
+// left out
+func (c MinimumConfig) Parse() (Minimums, error) {
+	minimums := Minimums{}
+	for nodeType, version := range map[pb.NodeType]string{
+		pb.NodeType_STORAGE: c.Storage,
+		pb.NodeType_ADMIN:   c.Satellite,
+	} {
+		if version == "" {
+			continue
+		}
+		min, err := NewSemVer(version)
+		if err != nil {
+			return nil, err
+		}
+		minimums[nodeType] = min
+	}
+	return minimums, nil
+}
+
+// Minimums are the minimum versions per node type
+type Minimums map[pb.NodeType]SemVer
+
+// Allowed returns whether the node runs at least the minimum version for its
+// type, nodes which don't report a valid version are only allowed when there
+// is no minimum
+func (minimums Minimums) Allowed(node *pb.Node) bool {
+	min, ok := minimums[node.GetType()]
+	if !ok {
+		return true
+	}
+	version, err := NewSemVer(node.GetMetadata().GetVersion())
+	if err != nil {
+		return false
+	}
+	return version.Compare(min) >= 0
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+)
+
+func TestSemVer(t *testing.T) {
+	for _, tt := range []struct {
+		version string
+		parsed  SemVer
+		valid   bool
+	}{
+		{"v1.2.3", SemVer{1, 2, 3}, true},
+		{"1.2.3", SemVer{1, 2, 3}, true},
+		{"v0.10.0-rc1", SemVer{0, 10, 0}, true},
+		{"", SemVer{}, false},
+		{"v1.2", SemVer{}, false},
+		{"latest", SemVer{}, false},
+	} {
+		parsed, err := NewSemVer(tt.version)
+		if !tt.valid {
+			assert.Error(t, err, tt.version)
+			continue
+		}
+		if assert.NoError(t, err, tt.version) {
+			assert.Equal(t, tt.parsed, parsed)
+		}
+	}
+
+	older, newer := SemVer{0, 9, 12}, SemVer{0, 10, 0}
+	assert.Equal(t, -1, older.Compare(newer))
+	assert.Equal(t, 1, newer.Compare(older))
+	assert.Equal(t, 0, newer.Compare(newer))
+	assert.Equal(t, "v0.10.0", newer.String())
+}
+
+func TestMinimums(t *testing.T) {
+	_, err := MinimumConfig{Storage: "invalid"}.Parse()
+	assert.Error(t, err)
+
+	minimums, err := MinimumConfig{Storage: "v0.2.0"}.Parse()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	node := func(nodeType pb.NodeType, version string) *pb.Node {
+		return &pb.Node{Type: nodeType, Metadata: &pb.NodeMetadata{Version: version}}
+	}
+	assert.True(t, minimums.Allowed(node(pb.NodeType_STORAGE, "v0.2.0")))
+	assert.True(t, minimums.Allowed(node(pb.NodeType_STORAGE, "v1.0.0")))
+	assert.False(t, minimums.Allowed(node(pb.NodeType_STORAGE, "v0.1.9")))
+	assert.False(t, minimums.Allowed(node(pb.NodeType_STORAGE, "")))
+	assert.False(t, minimums.Allowed(&pb.Node{Type: pb.NodeType_STORAGE}))
+	// satellites have no minimum
+	assert.True(t, minimums.Allowed(node(pb.NodeType_ADMIN, "")))
+
+	// without minimums every node is allowed
+	assert.True(t, Minimums(nil).Allowed(node(pb.NodeType_STORAGE, "")))
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	dbx "czarcoin.org/czarcoin/satellite/satellitedb/dbx"
+)
+
+type auditCursor struct {
+	db   *dbx.DB
+	name string
+}
+
+// LastPath returns the last path listed by the audit cursor
+func (cursor *auditCursor) LastPath(ctx context.Context) (czarcoin.Path, error) {
+	row, err := cursor.db.Get_AuditCursor_By_Name(ctx, dbx.AuditCursor_Name(cursor.name))
+	if err != nil {
+		if dbxErr, ok := errs.Unwrap(err).(*dbx.Error); ok && dbxErr.Code == dbx.ErrorCode_NoRows {
+			return "", nil
+		}
+		return "", Error.Wrap(err)
+	}
+	return czarcoin.Path(row.LastPath), nil
+}
+
+// SetLastPath saves the last path listed by the audit cursor
+func (cursor *auditCursor) SetLastPath(ctx context.Context, path czarcoin.Path) error {
+	_, err := cursor.db.ExecContext(ctx, cursor.db.Rebind(
+		`INSERT INTO audit_cursors (name, last_path) VALUES (?, ?)
+		ON CONFLICT (name) DO UPDATE SET last_path = excluded.last_path`),
+		cursor.name, []byte(path))
+	return Error.Wrap(err)
+}
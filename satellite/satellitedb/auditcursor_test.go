@@ -0,0 +1,34 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+)
+
+func TestAuditCursor(t *testing.T) {
+	testDrivers(t, func(ctx *testcontext.Context, t *testing.T, db *DB) {
+		if !assert.NoError(t, db.CreateTables()) {
+			return
+		}
+		cursor := db.AuditCursor()
+
+		path, err := cursor.LastPath(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, czarcoin.Path(""), path)
+
+		for _, saved := range []czarcoin.Path{"a/b", "c/d", ""} {
+			assert.NoError(t, cursor.SetLastPath(ctx, saved))
+
+			path, err = db.AuditCursor().LastPath(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, saved, path)
+		}
+	})
+}
@@ -7,7 +7,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/zeebo/errs"
+
 	"czarcoin.org/czarcoin/pkg/bwagreement"
+	"czarcoin.org/czarcoin/pkg/utils"
 	dbx "czarcoin.org/czarcoin/satellite/satellitedb/dbx"
 )
 
@@ -15,13 +18,63 @@ type bandwidthagreement struct {
 	db *dbx.DB
 }
 
-func (b *bandwidthagreement) CreateAgreement(ctx context.Context, agreement bwagreement.Agreement) error {
-	_, err := b.db.Create_Bwagreement(
+func (b *bandwidthagreement) CreateAgreement(ctx context.Context, serial bwagreement.Serial, agreement bwagreement.Agreement) (err error) {
+	// the allocation is created before the transaction, so settlements of the
+	// same serial number by other storage nodes lock the same row
+	_, err = b.db.Create_BwagreementAllocation(ctx,
+		dbx.BwagreementAllocation_SerialNumber(serial.SerialNumber),
+		dbx.BwagreementAllocation_Total(0),
+	)
+	if err != nil && !isConstraintViolation(err) {
+		return Error.Wrap(err)
+	}
+
+	tx, err := b.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = utils.CombineErrors(err, tx.Rollback())
+		}
+	}()
+
+	_, err = tx.Create_BwagreementSerial(ctx,
+		dbx.BwagreementSerial_SerialNumber(serial.SerialNumber),
+		dbx.BwagreementSerial_StorageNodeId(serial.StorageNodeID.Bytes()),
+		dbx.BwagreementSerial_Total(serial.Total),
+	)
+	if err != nil {
+		if isConstraintViolation(err) {
+			return bwagreement.ErrReplayed.New("%s by %s", serial.SerialNumber, serial.StorageNodeID)
+		}
+		return Error.Wrap(err)
+	}
+
+	// the update locks the allocation until the transaction ends and is
+	// evaluated again against the total of concurrent settlements
+	result, err := tx.Tx.ExecContext(ctx, b.db.Rebind(
+		`UPDATE bwagreement_allocations SET total = total + ? WHERE serial_number = ? AND total + ? <= ?`),
+		serial.Total, serial.SerialNumber, serial.Total, serial.MaxSize)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated == 0 {
+		return bwagreement.ErrMaxSizeExceeded.New("%s allocates more than %d bytes", serial.SerialNumber, serial.MaxSize)
+	}
+
+	_, err = tx.Create_Bwagreement(
 		ctx,
 		dbx.Bwagreement_Signature(agreement.Signature),
 		dbx.Bwagreement_Data(agreement.Agreement),
 	)
-	return err
+	return Error.Wrap(err)
 }
 
 func (b *bandwidthagreement) GetAgreements(ctx context.Context) ([]bwagreement.Agreement, error) {
@@ -41,7 +94,7 @@ func (b *bandwidthagreement) GetAgreements(ctx context.Context) ([]bwagreement.A
 }
 
 func (b *bandwidthagreement) GetAgreementsSince(ctx context.Context, since time.Time) ([]bwagreement.Agreement, error) {
-	rows, err := b.db.All_Bwagreement_By_CreatedAt_Greater(ctx, dbx.Bwagreement_CreatedAt(since))
+	rows, err := b.db.All_Bwagreement_By_CreatedAt_GreaterOrEqual(ctx, dbx.Bwagreement_CreatedAt(since))
 	if err != nil {
 		return nil, err
 	}
@@ -55,3 +108,9 @@ func (b *bandwidthagreement) GetAgreementsSince(ctx context.Context, since time.
 	}
 	return agreements, nil
 }
+
+// isConstraintViolation checks whether err is caused by a unique constraint
+func isConstraintViolation(err error) bool {
+	dbxErr, ok := errs.Unwrap(err).(*dbx.Error)
+	return ok && dbxErr.Code == dbx.ErrorCode_ConstraintViolation
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"czarcoin.org/czarcoin/pkg/audit"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/piecestore/psclient"
+	dbx "czarcoin.org/czarcoin/satellite/satellitedb/dbx"
+)
+
+type containment struct {
+	db *dbx.DB
+}
+
+// Get returns the pending audit of a node
+func (containment *containment) Get(ctx context.Context, nodeID czarcoin.NodeID) (*audit.PendingAudit, error) {
+	pending, err := containment.db.Get_PendingAudit_By_NodeId(ctx, dbx.PendingAudit_NodeId(nodeID.Bytes()))
+	if err != nil {
+		if dbxErr, ok := errs.Unwrap(err).(*dbx.Error); ok && dbxErr.Code == dbx.ErrorCode_NoRows {
+			return nil, audit.ErrContainedNotFound.New("%s", nodeID)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return convertPendingAudit(pending)
+}
+
+// IncrementPending creates the pending audit of a node or increments the
+// reverify count of the existing one
+func (containment *containment) IncrementPending(ctx context.Context, pending *audit.PendingAudit) error {
+	existing, err := containment.Get(ctx, pending.NodeID)
+	if err != nil {
+		if !audit.ErrContainedNotFound.Has(err) {
+			return err
+		}
+
+		_, err = containment.db.Create_PendingAudit(ctx,
+			dbx.PendingAudit_NodeId(pending.NodeID.Bytes()),
+			dbx.PendingAudit_Path([]byte(pending.Path)),
+			dbx.PendingAudit_PieceId([]byte(pending.PieceID)),
+			dbx.PendingAudit_StripeIndex(pending.StripeIndex),
+			dbx.PendingAudit_ShareSize(pending.ShareSize),
+			dbx.PendingAudit_ExpectedShareHash(pending.ExpectedShareHash),
+			dbx.PendingAudit_ReverifyCount(0),
+		)
+		return Error.Wrap(err)
+	}
+
+	_, err = containment.db.Update_PendingAudit_By_NodeId(ctx,
+		dbx.PendingAudit_NodeId(pending.NodeID.Bytes()),
+		dbx.PendingAudit_Update_Fields{
+			ReverifyCount: dbx.PendingAudit_ReverifyCount(existing.ReverifyCount + 1),
+		},
+	)
+	return Error.Wrap(err)
+}
+
+// Delete removes the pending audit of a node
+func (containment *containment) Delete(ctx context.Context, nodeID czarcoin.NodeID) (bool, error) {
+	deleted, err := containment.db.Delete_PendingAudit_By_NodeId(ctx, dbx.PendingAudit_NodeId(nodeID.Bytes()))
+	return deleted, Error.Wrap(err)
+}
+
+func convertPendingAudit(pending *dbx.PendingAudit) (*audit.PendingAudit, error) {
+	nodeID, err := czarcoin.NodeIDFromBytes(pending.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &audit.PendingAudit{
+		NodeID:            nodeID,
+		Path:              czarcoin.Path(pending.Path),
+		PieceID:           psclient.PieceID(pending.PieceId),
+		StripeIndex:       pending.StripeIndex,
+		ShareSize:         pending.ShareSize,
+		ExpectedShareHash: pending.ExpectedShareHash,
+		ReverifyCount:     pending.ReverifyCount,
+	}, nil
+}
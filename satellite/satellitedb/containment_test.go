@@ -0,0 +1,54 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/audit"
+)
+
+func TestContainment(t *testing.T) {
+	testDrivers(t, func(ctx *testcontext.Context, t *testing.T, db *DB) {
+		if !assert.NoError(t, db.CreateTables()) {
+			return
+		}
+		containment := db.Containment()
+
+		nodeID := testczarcoin.NodeIDFromString("contained")
+		_, err := containment.Get(ctx, nodeID)
+		assert.True(t, audit.ErrContainedNotFound.Has(err))
+
+		pending := &audit.PendingAudit{
+			NodeID:            nodeID,
+			Path:              "a/b/c",
+			PieceID:           "pieceid",
+			StripeIndex:       3,
+			ShareSize:         256,
+			ExpectedShareHash: []byte("hash"),
+		}
+		assert.NoError(t, containment.IncrementPending(ctx, pending))
+
+		got, err := containment.Get(ctx, nodeID)
+		assert.NoError(t, err)
+		assert.Equal(t, pending, got)
+
+		// failing again only increments the reverify count
+		assert.NoError(t, containment.IncrementPending(ctx, got))
+		got, err = containment.Get(ctx, nodeID)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, got.ReverifyCount)
+
+		deleted, err := containment.Delete(ctx, nodeID)
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+
+		_, err = containment.Get(ctx, nodeID)
+		assert.True(t, audit.ErrContainedNotFound.Has(err))
+	})
+}
@@ -7,7 +7,12 @@ import (
 	"github.com/zeebo/errs"
 
 	"czarcoin.org/czarcoin/internal/migrate"
+	"czarcoin.org/czarcoin/pkg/accounting/projectusage"
+	"czarcoin.org/czarcoin/pkg/audit"
 	"czarcoin.org/czarcoin/pkg/bwagreement"
+	"czarcoin.org/czarcoin/pkg/datarepair/queue"
+	"czarcoin.org/czarcoin/pkg/datarepair/repairer"
+	"czarcoin.org/czarcoin/pkg/overlay"
 	"czarcoin.org/czarcoin/pkg/utils"
 	dbx "czarcoin.org/czarcoin/satellite/satellitedb/dbx"
 )
@@ -46,6 +51,41 @@ func (db *DB) BandwidthAgreement() bwagreement.DB {
 	return &bandwidthagreement{db: db.db}
 }
 
+// Containment is a getter for the pending audits repository
+func (db *DB) Containment() audit.Containment {
+	return &containment{db: db.db}
+}
+
+// AuditCursor is a getter for the audit cursor repository
+func (db *DB) AuditCursor() audit.CursorDB {
+	return &auditCursor{db: db.db, name: "audit"}
+}
+
+// RepairQueueDB is a getter for the injured segments repository
+func (db *DB) RepairQueueDB() queue.DB {
+	return &repairQueueDB{db: db.db}
+}
+
+// RepairHistory is a getter for the repair history repository
+func (db *DB) RepairHistory() repairer.History {
+	return &repairHistory{db: db.db}
+}
+
+// OverlayCacheDB is a getter for the overlay cache repository
+func (db *DB) OverlayCacheDB() overlay.DB {
+	return &overlayCacheDB{db: db.db}
+}
+
+// NodeWallets is a getter for the node wallets repository
+func (db *DB) NodeWallets() overlay.WalletDB {
+	return &nodeWallets{db: db.db}
+}
+
+// ProjectUsage is a getter for the project usage repository
+func (db *DB) ProjectUsage() projectusage.DB {
+	return &projectUsage{db: db.db}
+}
+
 // // PointerDB is a getter for PointerDB repository
 // func (db *DB) PointerDB() pointerdb.DB {
 // 	return &pointerDB{db: db.db}
@@ -56,16 +96,6 @@ func (db *DB) BandwidthAgreement() bwagreement.DB {
 // 	return &statDB{db: db.db}
 // }
 
-// // OverlayCacheDB is a getter for OverlayCacheDB repository
-// func (db *DB) OverlayCacheDB() overlay.DB {
-// 	return &overlayCacheDB{db: db.db}
-// }
-
-// // RepairQueueDB is a getter for RepairQueueDB repository
-// func (db *DB) RepairQueueDB() queue.DB {
-// 	return &repairQueueDB{db: db.db}
-// }
-
 // // AccountingDB is a getter for AccountingDB repository
 // func (db *DB) AccountingDB() accounting.DB {
 // 	return &accountingDB{db: db.db}
@@ -73,7 +103,7 @@ func (db *DB) BandwidthAgreement() bwagreement.DB {
 
 // CreateTables is a method for creating all tables for database
 func (db *DB) CreateTables() error {
-	return migrate.Create("database", db.db)
+	return migrate.CreateWithMigrations("database", db.db, migrations...)
 }
 
 // Close is used to close db connection
@@ -277,6 +277,94 @@ func (obj *postgresDB) Schema() string {
 	data bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( signature )
+);
+CREATE TABLE pending_audits (
+	node_id bytea NOT NULL,
+	path bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	stripe_index bigint NOT NULL,
+	share_size bigint NOT NULL,
+	expected_share_hash bytea NOT NULL,
+	reverify_count bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE injuredsegments (
+	path bytea NOT NULL,
+	data bytea NOT NULL,
+	num_healthy bigint NOT NULL,
+	lease_unix_sec bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( path )
+);
+CREATE TABLE repairs (
+	id bytea NOT NULL,
+	path bytea NOT NULL,
+	healthy_pieces bigint NOT NULL,
+	rebuilt_pieces bigint NOT NULL,
+	new_nodes bytea NOT NULL,
+	failure text NOT NULL,
+	repaired_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE overlay_cache_nodes (
+	node_id bytea NOT NULL,
+	node_type integer NOT NULL,
+	address text NOT NULL,
+	protocol integer NOT NULL,
+	operator_email text NOT NULL,
+	operator_wallet text NOT NULL,
+	version text NOT NULL,
+	free_bandwidth bigint NOT NULL,
+	free_disk bigint NOT NULL,
+	latency_90 bigint NOT NULL,
+	audit_success_ratio double precision NOT NULL,
+	audit_uptime_ratio double precision NOT NULL,
+	audit_count bigint NOT NULL,
+	audit_success_count bigint NOT NULL,
+	uptime_count bigint NOT NULL,
+	uptime_success_count bigint NOT NULL,
+	audited_unix_sec bigint NOT NULL,
+	audit_reputation double precision NOT NULL,
+	uptime_reputation double precision NOT NULL,
+	vetted boolean NOT NULL,
+	disqualified boolean NOT NULL,
+	first_seen_unix_sec bigint NOT NULL,
+	last_contact_unix_sec bigint NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE INDEX overlay_cache_nodes_selection_index ON overlay_cache_nodes ( node_type, free_disk, free_bandwidth, audit_count );
+CREATE TABLE bwagreement_serials (
+	serial_number text NOT NULL,
+	storage_node_id bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( serial_number, storage_node_id )
+);
+CREATE TABLE project_storages (
+	project_id bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_egresses (
+	project_id bytea NOT NULL,
+	month timestamp with time zone NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id, month )
+);
+CREATE TABLE bwagreement_allocations (
+	serial_number text NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
+CREATE TABLE audit_cursors (
+	name text NOT NULL,
+	last_path bytea NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE node_wallets (
+	node_id bytea NOT NULL,
+	wallet text NOT NULL,
+	PRIMARY KEY ( node_id )
 );`
 }
 
@@ -346,6 +434,94 @@ func (obj *sqlite3DB) Schema() string {
 	data BLOB NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( signature )
+);
+CREATE TABLE pending_audits (
+	node_id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	piece_id BLOB NOT NULL,
+	stripe_index INTEGER NOT NULL,
+	share_size INTEGER NOT NULL,
+	expected_share_hash BLOB NOT NULL,
+	reverify_count INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE injuredsegments (
+	path BLOB NOT NULL,
+	data BLOB NOT NULL,
+	num_healthy INTEGER NOT NULL,
+	lease_unix_sec INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( path )
+);
+CREATE TABLE repairs (
+	id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	healthy_pieces INTEGER NOT NULL,
+	rebuilt_pieces INTEGER NOT NULL,
+	new_nodes BLOB NOT NULL,
+	failure TEXT NOT NULL,
+	repaired_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE overlay_cache_nodes (
+	node_id BLOB NOT NULL,
+	node_type INTEGER NOT NULL,
+	address TEXT NOT NULL,
+	protocol INTEGER NOT NULL,
+	operator_email TEXT NOT NULL,
+	operator_wallet TEXT NOT NULL,
+	version TEXT NOT NULL,
+	free_bandwidth INTEGER NOT NULL,
+	free_disk INTEGER NOT NULL,
+	latency_90 INTEGER NOT NULL,
+	audit_success_ratio REAL NOT NULL,
+	audit_uptime_ratio REAL NOT NULL,
+	audit_count INTEGER NOT NULL,
+	audit_success_count INTEGER NOT NULL,
+	uptime_count INTEGER NOT NULL,
+	uptime_success_count INTEGER NOT NULL,
+	audited_unix_sec INTEGER NOT NULL,
+	audit_reputation REAL NOT NULL,
+	uptime_reputation REAL NOT NULL,
+	vetted INTEGER NOT NULL,
+	disqualified INTEGER NOT NULL,
+	first_seen_unix_sec INTEGER NOT NULL,
+	last_contact_unix_sec INTEGER NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE INDEX overlay_cache_nodes_selection_index ON overlay_cache_nodes ( node_type, free_disk, free_bandwidth, audit_count );
+CREATE TABLE bwagreement_serials (
+	serial_number TEXT NOT NULL,
+	storage_node_id BLOB NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( serial_number, storage_node_id )
+);
+CREATE TABLE project_storages (
+	project_id BLOB NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_egresses (
+	project_id BLOB NOT NULL,
+	month TIMESTAMP NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( project_id, month )
+);
+CREATE TABLE bwagreement_allocations (
+	serial_number TEXT NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
+CREATE TABLE audit_cursors (
+	name TEXT NOT NULL,
+	last_path BLOB NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE node_wallets (
+	node_id BLOB NOT NULL,
+	wallet TEXT NOT NULL,
+	PRIMARY KEY ( node_id )
 );`
 }
 
@@ -474,707 +650,5247 @@ func (f Bwagreement_CreatedAt_Field) value() interface{} {
 
 func (Bwagreement_CreatedAt_Field) _Column() string { return "created_at" }
 
-func toUTC(t time.Time) time.Time {
-	return t.UTC()
+type PendingAudit struct {
+	NodeId            []byte
+	Path              []byte
+	PieceId           []byte
+	StripeIndex       int64
+	ShareSize         int64
+	ExpectedShareHash []byte
+	ReverifyCount     int64
+	CreatedAt         time.Time
 }
 
-func toDate(t time.Time) time.Time {
-	// keep up the minute portion so that translations between timezones will
-	// continue to reflect properly.
-	return t.Truncate(time.Minute)
-}
+func (PendingAudit) _Table() string { return "pending_audits" }
 
-//
-// runtime support for building sql statements
-//
+type PendingAudit_Update_Fields struct {
+	ReverifyCount PendingAudit_ReverifyCount_Field
+}
 
-type __sqlbundle_SQL interface {
-	Render() string
+type PendingAudit_NodeId_Field struct {
+	_set   bool
+	_value []byte
+}
 
-	private()
+func PendingAudit_NodeId(v []byte) PendingAudit_NodeId_Field {
+	return PendingAudit_NodeId_Field{_set: true, _value: v}
 }
 
-type __sqlbundle_Dialect interface {
-	Rebind(sql string) string
+func (f PendingAudit_NodeId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
 }
 
-type __sqlbundle_RenderOp int
+func (PendingAudit_NodeId_Field) _Column() string { return "node_id" }
 
-const (
-	__sqlbundle_NoFlatten __sqlbundle_RenderOp = iota
-	__sqlbundle_NoTerminate
-)
+type PendingAudit_Path_Field struct {
+	_set   bool
+	_value []byte
+}
 
-func __sqlbundle_Render(dialect __sqlbundle_Dialect, sql __sqlbundle_SQL, ops ...__sqlbundle_RenderOp) string {
-	out := sql.Render()
+func PendingAudit_Path(v []byte) PendingAudit_Path_Field {
+	return PendingAudit_Path_Field{_set: true, _value: v}
+}
 
-	flatten := true
-	terminate := true
-	for _, op := range ops {
-		switch op {
-		case __sqlbundle_NoFlatten:
-			flatten = false
-		case __sqlbundle_NoTerminate:
-			terminate = false
-		}
+func (f PendingAudit_Path_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
+	return f._value
+}
 
-	if flatten {
-		out = __sqlbundle_flattenSQL(out)
-	}
-	if terminate {
-		out += ";"
-	}
+func (PendingAudit_Path_Field) _Column() string { return "path" }
 
-	return dialect.Rebind(out)
+type PendingAudit_PieceId_Field struct {
+	_set   bool
+	_value []byte
 }
 
-var __sqlbundle_reSpace = regexp.MustCompile(`\s+`)
+func PendingAudit_PieceId(v []byte) PendingAudit_PieceId_Field {
+	return PendingAudit_PieceId_Field{_set: true, _value: v}
+}
 
-func __sqlbundle_flattenSQL(s string) string {
-	return strings.TrimSpace(__sqlbundle_reSpace.ReplaceAllString(s, " "))
+func (f PendingAudit_PieceId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
 }
 
-// this type is specially named to match up with the name returned by the
-// dialect impl in the sql package.
-type __sqlbundle_postgres struct{}
+func (PendingAudit_PieceId_Field) _Column() string { return "piece_id" }
 
-func (p __sqlbundle_postgres) Rebind(sql string) string {
-	out := make([]byte, 0, len(sql)+10)
+type PendingAudit_StripeIndex_Field struct {
+	_set   bool
+	_value int64
+}
 
-	j := 1
-	for i := 0; i < len(sql); i++ {
-		ch := sql[i]
-		if ch != '?' {
-			out = append(out, ch)
-			continue
-		}
+func PendingAudit_StripeIndex(v int64) PendingAudit_StripeIndex_Field {
+	return PendingAudit_StripeIndex_Field{_set: true, _value: v}
+}
 
-		out = append(out, '$')
-		out = append(out, strconv.Itoa(j)...)
-		j++
+func (f PendingAudit_StripeIndex_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
-
-	return string(out)
+	return f._value
 }
 
-// this type is specially named to match up with the name returned by the
-// dialect impl in the sql package.
-type __sqlbundle_sqlite3 struct{}
+func (PendingAudit_StripeIndex_Field) _Column() string { return "stripe_index" }
 
-func (s __sqlbundle_sqlite3) Rebind(sql string) string {
-	return sql
+type PendingAudit_ShareSize_Field struct {
+	_set   bool
+	_value int64
 }
 
-type __sqlbundle_Literal string
+func PendingAudit_ShareSize(v int64) PendingAudit_ShareSize_Field {
+	return PendingAudit_ShareSize_Field{_set: true, _value: v}
+}
 
-func (__sqlbundle_Literal) private() {}
+func (f PendingAudit_ShareSize_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-func (l __sqlbundle_Literal) Render() string { return string(l) }
+func (PendingAudit_ShareSize_Field) _Column() string { return "share_size" }
 
-type __sqlbundle_Literals struct {
-	Join string
-	SQLs []__sqlbundle_SQL
+type PendingAudit_ExpectedShareHash_Field struct {
+	_set   bool
+	_value []byte
 }
 
-func (__sqlbundle_Literals) private() {}
-
-func (l __sqlbundle_Literals) Render() string {
-	var out bytes.Buffer
+func PendingAudit_ExpectedShareHash(v []byte) PendingAudit_ExpectedShareHash_Field {
+	return PendingAudit_ExpectedShareHash_Field{_set: true, _value: v}
+}
 
-	first := true
-	for _, sql := range l.SQLs {
-		if sql == nil {
-			continue
-		}
-		if !first {
-			out.WriteString(l.Join)
-		}
-		first = false
-		out.WriteString(sql.Render())
+func (f PendingAudit_ExpectedShareHash_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
-
-	return out.String()
+	return f._value
 }
 
-type __sqlbundle_Condition struct {
-	// set at compile/embed time
-	Name  string
-	Left  string
-	Equal bool
-	Right string
+func (PendingAudit_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
 
-	// set at runtime
-	Null bool
+type PendingAudit_ReverifyCount_Field struct {
+	_set   bool
+	_value int64
 }
 
-func (*__sqlbundle_Condition) private() {}
-
-func (c *__sqlbundle_Condition) Render() string {
+func PendingAudit_ReverifyCount(v int64) PendingAudit_ReverifyCount_Field {
+	return PendingAudit_ReverifyCount_Field{_set: true, _value: v}
+}
 
-	switch {
-	case c.Equal && c.Null:
-		return c.Left + " is null"
-	case c.Equal && !c.Null:
-		return c.Left + " = " + c.Right
-	case !c.Equal && c.Null:
-		return c.Left + " is not null"
-	case !c.Equal && !c.Null:
-		return c.Left + " != " + c.Right
-	default:
-		panic("unhandled case")
+func (f PendingAudit_ReverifyCount_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
+	return f._value
 }
 
-type __sqlbundle_Hole struct {
-	// set at compiile/embed time
-	Name string
+func (PendingAudit_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
-	// set at runtime
-	SQL __sqlbundle_SQL
+type PendingAudit_CreatedAt_Field struct {
+	_set   bool
+	_value time.Time
 }
 
-func (*__sqlbundle_Hole) private() {}
-
-func (h *__sqlbundle_Hole) Render() string { return h.SQL.Render() }
+func PendingAudit_CreatedAt(v time.Time) PendingAudit_CreatedAt_Field {
+	return PendingAudit_CreatedAt_Field{_set: true, _value: v}
+}
 
-//
-// end runtime support for building sql statements
-//
+func (f PendingAudit_CreatedAt_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-func (obj *postgresImpl) Create_Bwagreement(ctx context.Context,
-	bwagreement_signature Bwagreement_Signature_Field,
-	bwagreement_data Bwagreement_Data_Field) (
-	bwagreement *Bwagreement, err error) {
+func (PendingAudit_CreatedAt_Field) _Column() string { return "created_at" }
 
-	__now := obj.db.Hooks.Now().UTC()
-	__signature_val := bwagreement_signature.value()
-	__data_val := bwagreement_data.value()
-	__created_at_val := __now
+type Injuredsegment struct {
+	Path         []byte
+	Data         []byte
+	NumHealthy   int64
+	LeaseUnixSec int64
+	CreatedAt    time.Time
+}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreements ( signature, data, created_at ) VALUES ( ?, ?, ? ) RETURNING bwagreements.signature, bwagreements.data, bwagreements.created_at")
+func (Injuredsegment) _Table() string { return "injuredsegments" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __signature_val, __data_val, __created_at_val)
+type Injuredsegment_Update_Fields struct {
+	Data         Injuredsegment_Data_Field
+	NumHealthy   Injuredsegment_NumHealthy_Field
+	LeaseUnixSec Injuredsegment_LeaseUnixSec_Field
+}
 
-	bwagreement = &Bwagreement{}
-	err = obj.driver.QueryRow(__stmt, __signature_val, __data_val, __created_at_val).Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return bwagreement, nil
+type Injuredsegment_Path_Field struct {
+	_set   bool
+	_value []byte
+}
 
+func Injuredsegment_Path(v []byte) Injuredsegment_Path_Field {
+	return Injuredsegment_Path_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Get_Bwagreement_By_Signature(ctx context.Context,
-	bwagreement_signature Bwagreement_Signature_Field) (
-	bwagreement *Bwagreement, err error) {
+func (f Injuredsegment_Path_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE bwagreements.signature = ?")
+func (Injuredsegment_Path_Field) _Column() string { return "path" }
 
-	var __values []interface{}
-	__values = append(__values, bwagreement_signature.value())
+type Injuredsegment_Data_Field struct {
+	_set   bool
+	_value []byte
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Injuredsegment_Data(v []byte) Injuredsegment_Data_Field {
+	return Injuredsegment_Data_Field{_set: true, _value: v}
+}
 
-	bwagreement = &Bwagreement{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Injuredsegment_Data_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
-	return bwagreement, nil
+	return f._value
+}
+
+func (Injuredsegment_Data_Field) _Column() string { return "data" }
 
+type Injuredsegment_NumHealthy_Field struct {
+	_set   bool
+	_value int64
 }
 
-func (obj *postgresImpl) Limited_Bwagreement(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Bwagreement, err error) {
+func Injuredsegment_NumHealthy(v int64) Injuredsegment_NumHealthy_Field {
+	return Injuredsegment_NumHealthy_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements LIMIT ? OFFSET ?")
+func (f Injuredsegment_NumHealthy_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values)
+func (Injuredsegment_NumHealthy_Field) _Column() string { return "num_healthy" }
 
-	__values = append(__values, limit, offset)
+type Injuredsegment_LeaseUnixSec_Field struct {
+	_set   bool
+	_value int64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Injuredsegment_LeaseUnixSec(v int64) Injuredsegment_LeaseUnixSec_Field {
+	return Injuredsegment_LeaseUnixSec_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Injuredsegment_LeaseUnixSec_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (Injuredsegment_LeaseUnixSec_Field) _Column() string { return "lease_unix_sec" }
 
+type Injuredsegment_CreatedAt_Field struct {
+	_set   bool
+	_value time.Time
 }
 
-func (obj *postgresImpl) All_Bwagreement(ctx context.Context) (
-	rows []*Bwagreement, err error) {
+func Injuredsegment_CreatedAt(v time.Time) Injuredsegment_CreatedAt_Field {
+	return Injuredsegment_CreatedAt_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements")
+func (f Injuredsegment_CreatedAt_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values)
+func (Injuredsegment_CreatedAt_Field) _Column() string { return "created_at" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type Repair struct {
+	Id            []byte
+	Path          []byte
+	HealthyPieces int64
+	RebuiltPieces int64
+	NewNodes      []byte
+	Failure       string
+	RepairedAt    time.Time
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func (Repair) _Table() string { return "repairs" }
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+type Repair_Update_Fields struct {
+}
 
+type Repair_Id_Field struct {
+	_set   bool
+	_value []byte
 }
 
-func (obj *postgresImpl) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
-	bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
-	rows []*Bwagreement, err error) {
+func Repair_Id(v []byte) Repair_Id_Field {
+	return Repair_Id_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE bwagreements.created_at > ?")
+func (f Repair_Id_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values, bwagreement_created_at_greater.value())
+func (Repair_Id_Field) _Column() string { return "id" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type Repair_Path_Field struct {
+	_set   bool
+	_value []byte
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func Repair_Path(v []byte) Repair_Path_Field {
+	return Repair_Path_Field{_set: true, _value: v}
+}
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+func (f Repair_Path_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
-	return rows, nil
+	return f._value
+}
+
+func (Repair_Path_Field) _Column() string { return "path" }
 
+type Repair_HealthyPieces_Field struct {
+	_set   bool
+	_value int64
 }
 
-func (obj *postgresImpl) Delete_Bwagreement_By_Signature(ctx context.Context,
-	bwagreement_signature Bwagreement_Signature_Field) (
-	deleted bool, err error) {
+func Repair_HealthyPieces(v int64) Repair_HealthyPieces_Field {
+	return Repair_HealthyPieces_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bwagreements WHERE bwagreements.signature = ?")
+func (f Repair_HealthyPieces_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values, bwagreement_signature.value())
+func (Repair_HealthyPieces_Field) _Column() string { return "healthy_pieces" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type Repair_RebuiltPieces_Field struct {
+	_set   bool
+	_value int64
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+func Repair_RebuiltPieces(v int64) Repair_RebuiltPieces_Field {
+	return Repair_RebuiltPieces_Field{_set: true, _value: v}
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
+func (f Repair_RebuiltPieces_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
+	return f._value
+}
 
-	return __count > 0, nil
+func (Repair_RebuiltPieces_Field) _Column() string { return "rebuilt_pieces" }
 
+type Repair_NewNodes_Field struct {
+	_set   bool
+	_value []byte
 }
 
-func (impl postgresImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(*pq.Error); ok {
-		if e.Code.Class() == "23" {
-			return e.Constraint, true
-		}
-	}
-	return "", false
+func Repair_NewNodes(v []byte) Repair_NewNodes_Field {
+	return Repair_NewNodes_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error) {
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (f Repair_NewNodes_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
+	return f._value
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
+func (Repair_NewNodes_Field) _Column() string { return "new_nodes" }
 
-	return count, nil
+type Repair_Failure_Field struct {
+	_set   bool
+	_value string
+}
 
+func Repair_Failure(v string) Repair_Failure_Field {
+	return Repair_Failure_Field{_set: true, _value: v}
 }
 
-func (obj *sqlite3Impl) Create_Bwagreement(ctx context.Context,
-	bwagreement_signature Bwagreement_Signature_Field,
-	bwagreement_data Bwagreement_Data_Field) (
-	bwagreement *Bwagreement, err error) {
+func (f Repair_Failure_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-	__now := obj.db.Hooks.Now().UTC()
-	__signature_val := bwagreement_signature.value()
-	__data_val := bwagreement_data.value()
-	__created_at_val := __now
+func (Repair_Failure_Field) _Column() string { return "failure" }
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreements ( signature, data, created_at ) VALUES ( ?, ?, ? )")
+type Repair_RepairedAt_Field struct {
+	_set   bool
+	_value time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __signature_val, __data_val, __created_at_val)
+func Repair_RepairedAt(v time.Time) Repair_RepairedAt_Field {
+	return Repair_RepairedAt_Field{_set: true, _value: v}
+}
 
-	__res, err := obj.driver.Exec(__stmt, __signature_val, __data_val, __created_at_val)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Repair_RepairedAt_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
-	return obj.getLastBwagreement(ctx, __pk)
-
+	return f._value
 }
 
-func (obj *sqlite3Impl) Get_Bwagreement_By_Signature(ctx context.Context,
-	bwagreement_signature Bwagreement_Signature_Field) (
-	bwagreement *Bwagreement, err error) {
+func (Repair_RepairedAt_Field) _Column() string { return "repaired_at" }
+
+type OverlayCacheNode struct {
+	NodeId             []byte
+	NodeType           int
+	Address            string
+	Protocol           int
+	OperatorEmail      string
+	OperatorWallet     string
+	Version            string
+	FreeBandwidth      int64
+	FreeDisk           int64
+	Latency90          int64
+	AuditSuccessRatio  float64
+	AuditUptimeRatio   float64
+	AuditCount         int64
+	AuditSuccessCount  int64
+	UptimeCount        int64
+	UptimeSuccessCount int64
+	AuditedUnixSec     int64
+	AuditReputation    float64
+	UptimeReputation   float64
+	Vetted             bool
+	Disqualified       bool
+	FirstSeenUnixSec   int64
+	LastContactUnixSec int64
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE bwagreements.signature = ?")
+func (OverlayCacheNode) _Table() string { return "overlay_cache_nodes" }
+
+type OverlayCacheNode_Update_Fields struct {
+	NodeType           OverlayCacheNode_NodeType_Field
+	Address            OverlayCacheNode_Address_Field
+	Protocol           OverlayCacheNode_Protocol_Field
+	OperatorEmail      OverlayCacheNode_OperatorEmail_Field
+	OperatorWallet     OverlayCacheNode_OperatorWallet_Field
+	Version            OverlayCacheNode_Version_Field
+	FreeBandwidth      OverlayCacheNode_FreeBandwidth_Field
+	FreeDisk           OverlayCacheNode_FreeDisk_Field
+	Latency90          OverlayCacheNode_Latency90_Field
+	AuditSuccessRatio  OverlayCacheNode_AuditSuccessRatio_Field
+	AuditUptimeRatio   OverlayCacheNode_AuditUptimeRatio_Field
+	AuditCount         OverlayCacheNode_AuditCount_Field
+	AuditSuccessCount  OverlayCacheNode_AuditSuccessCount_Field
+	UptimeCount        OverlayCacheNode_UptimeCount_Field
+	UptimeSuccessCount OverlayCacheNode_UptimeSuccessCount_Field
+	AuditedUnixSec     OverlayCacheNode_AuditedUnixSec_Field
+	AuditReputation    OverlayCacheNode_AuditReputation_Field
+	UptimeReputation   OverlayCacheNode_UptimeReputation_Field
+	Vetted             OverlayCacheNode_Vetted_Field
+	Disqualified       OverlayCacheNode_Disqualified_Field
+	FirstSeenUnixSec   OverlayCacheNode_FirstSeenUnixSec_Field
+	LastContactUnixSec OverlayCacheNode_LastContactUnixSec_Field
+}
 
-	var __values []interface{}
-	__values = append(__values, bwagreement_signature.value())
+type OverlayCacheNode_NodeId_Field struct {
+	_set   bool
+	_value []byte
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func OverlayCacheNode_NodeId(v []byte) OverlayCacheNode_NodeId_Field {
+	return OverlayCacheNode_NodeId_Field{_set: true, _value: v}
+}
 
-	bwagreement = &Bwagreement{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_NodeId_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
-	return bwagreement, nil
+	return f._value
+}
+
+func (OverlayCacheNode_NodeId_Field) _Column() string { return "node_id" }
 
+type OverlayCacheNode_NodeType_Field struct {
+	_set   bool
+	_value int
 }
 
-func (obj *sqlite3Impl) Limited_Bwagreement(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Bwagreement, err error) {
+func OverlayCacheNode_NodeType(v int) OverlayCacheNode_NodeType_Field {
+	return OverlayCacheNode_NodeType_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements LIMIT ? OFFSET ?")
+func (f OverlayCacheNode_NodeType_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values)
+func (OverlayCacheNode_NodeType_Field) _Column() string { return "node_type" }
 
-	__values = append(__values, limit, offset)
+type OverlayCacheNode_Address_Field struct {
+	_set   bool
+	_value string
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func OverlayCacheNode_Address(v string) OverlayCacheNode_Address_Field {
+	return OverlayCacheNode_Address_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_Address_Field) value() interface{} {
+	if !f._set {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (OverlayCacheNode_Address_Field) _Column() string { return "address" }
 
+type OverlayCacheNode_Protocol_Field struct {
+	_set   bool
+	_value int
 }
 
-func (obj *sqlite3Impl) All_Bwagreement(ctx context.Context) (
-	rows []*Bwagreement, err error) {
+func OverlayCacheNode_Protocol(v int) OverlayCacheNode_Protocol_Field {
+	return OverlayCacheNode_Protocol_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements")
+func (f OverlayCacheNode_Protocol_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values)
+func (OverlayCacheNode_Protocol_Field) _Column() string { return "protocol" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type OverlayCacheNode_OperatorEmail_Field struct {
+	_set   bool
+	_value string
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func OverlayCacheNode_OperatorEmail(v string) OverlayCacheNode_OperatorEmail_Field {
+	return OverlayCacheNode_OperatorEmail_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_OperatorEmail_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_OperatorEmail_Field) _Column() string { return "operator_email" }
+
+type OverlayCacheNode_OperatorWallet_Field struct {
+	_set   bool
+	_value string
+}
+
+func OverlayCacheNode_OperatorWallet(v string) OverlayCacheNode_OperatorWallet_Field {
+	return OverlayCacheNode_OperatorWallet_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_OperatorWallet_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_OperatorWallet_Field) _Column() string { return "operator_wallet" }
+
+type OverlayCacheNode_Version_Field struct {
+	_set   bool
+	_value string
+}
+
+func OverlayCacheNode_Version(v string) OverlayCacheNode_Version_Field {
+	return OverlayCacheNode_Version_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_Version_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_Version_Field) _Column() string { return "version" }
+
+type OverlayCacheNode_FreeBandwidth_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_FreeBandwidth(v int64) OverlayCacheNode_FreeBandwidth_Field {
+	return OverlayCacheNode_FreeBandwidth_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_FreeBandwidth_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_FreeBandwidth_Field) _Column() string { return "free_bandwidth" }
+
+type OverlayCacheNode_FreeDisk_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_FreeDisk(v int64) OverlayCacheNode_FreeDisk_Field {
+	return OverlayCacheNode_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_FreeDisk_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_FreeDisk_Field) _Column() string { return "free_disk" }
+
+type OverlayCacheNode_Latency90_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_Latency90(v int64) OverlayCacheNode_Latency90_Field {
+	return OverlayCacheNode_Latency90_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_Latency90_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_Latency90_Field) _Column() string { return "latency_90" }
+
+type OverlayCacheNode_AuditSuccessRatio_Field struct {
+	_set   bool
+	_value float64
+}
+
+func OverlayCacheNode_AuditSuccessRatio(v float64) OverlayCacheNode_AuditSuccessRatio_Field {
+	return OverlayCacheNode_AuditSuccessRatio_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditSuccessRatio_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+
+type OverlayCacheNode_AuditUptimeRatio_Field struct {
+	_set   bool
+	_value float64
+}
+
+func OverlayCacheNode_AuditUptimeRatio(v float64) OverlayCacheNode_AuditUptimeRatio_Field {
+	return OverlayCacheNode_AuditUptimeRatio_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditUptimeRatio_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditUptimeRatio_Field) _Column() string { return "audit_uptime_ratio" }
+
+type OverlayCacheNode_AuditCount_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_AuditCount(v int64) OverlayCacheNode_AuditCount_Field {
+	return OverlayCacheNode_AuditCount_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditCount_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditCount_Field) _Column() string { return "audit_count" }
+
+type OverlayCacheNode_AuditSuccessCount_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_AuditSuccessCount(v int64) OverlayCacheNode_AuditSuccessCount_Field {
+	return OverlayCacheNode_AuditSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditSuccessCount_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+
+type OverlayCacheNode_UptimeCount_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_UptimeCount(v int64) OverlayCacheNode_UptimeCount_Field {
+	return OverlayCacheNode_UptimeCount_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_UptimeCount_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_UptimeCount_Field) _Column() string { return "uptime_count" }
+
+type OverlayCacheNode_UptimeSuccessCount_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_UptimeSuccessCount(v int64) OverlayCacheNode_UptimeSuccessCount_Field {
+	return OverlayCacheNode_UptimeSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_UptimeSuccessCount_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+
+type OverlayCacheNode_AuditedUnixSec_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_AuditedUnixSec(v int64) OverlayCacheNode_AuditedUnixSec_Field {
+	return OverlayCacheNode_AuditedUnixSec_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditedUnixSec_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditedUnixSec_Field) _Column() string { return "audited_unix_sec" }
+
+type OverlayCacheNode_AuditReputation_Field struct {
+	_set   bool
+	_value float64
+}
+
+func OverlayCacheNode_AuditReputation(v float64) OverlayCacheNode_AuditReputation_Field {
+	return OverlayCacheNode_AuditReputation_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditReputation_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditReputation_Field) _Column() string { return "audit_reputation" }
+
+type OverlayCacheNode_UptimeReputation_Field struct {
+	_set   bool
+	_value float64
+}
+
+func OverlayCacheNode_UptimeReputation(v float64) OverlayCacheNode_UptimeReputation_Field {
+	return OverlayCacheNode_UptimeReputation_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_UptimeReputation_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_UptimeReputation_Field) _Column() string { return "uptime_reputation" }
+
+type OverlayCacheNode_Vetted_Field struct {
+	_set   bool
+	_value bool
+}
+
+func OverlayCacheNode_Vetted(v bool) OverlayCacheNode_Vetted_Field {
+	return OverlayCacheNode_Vetted_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_Vetted_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_Vetted_Field) _Column() string { return "vetted" }
+
+type OverlayCacheNode_Disqualified_Field struct {
+	_set   bool
+	_value bool
+}
+
+func OverlayCacheNode_Disqualified(v bool) OverlayCacheNode_Disqualified_Field {
+	return OverlayCacheNode_Disqualified_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_Disqualified_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_Disqualified_Field) _Column() string { return "disqualified" }
+
+type OverlayCacheNode_FirstSeenUnixSec_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_FirstSeenUnixSec(v int64) OverlayCacheNode_FirstSeenUnixSec_Field {
+	return OverlayCacheNode_FirstSeenUnixSec_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_FirstSeenUnixSec_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_FirstSeenUnixSec_Field) _Column() string { return "first_seen_unix_sec" }
+
+type OverlayCacheNode_LastContactUnixSec_Field struct {
+	_set   bool
+	_value int64
+}
+
+func OverlayCacheNode_LastContactUnixSec(v int64) OverlayCacheNode_LastContactUnixSec_Field {
+	return OverlayCacheNode_LastContactUnixSec_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_LastContactUnixSec_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_LastContactUnixSec_Field) _Column() string { return "last_contact_unix_sec" }
+
+type BwagreementSerial struct {
+	SerialNumber  string
+	StorageNodeId []byte
+	Total         int64
+}
+
+func (BwagreementSerial) _Table() string { return "bwagreement_serials" }
+
+type BwagreementSerial_Update_Fields struct {
+}
+
+type BwagreementSerial_SerialNumber_Field struct {
+	_set   bool
+	_value string
+}
+
+func BwagreementSerial_SerialNumber(v string) BwagreementSerial_SerialNumber_Field {
+	return BwagreementSerial_SerialNumber_Field{_set: true, _value: v}
+}
+
+func (f BwagreementSerial_SerialNumber_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementSerial_SerialNumber_Field) _Column() string { return "serial_number" }
+
+type BwagreementSerial_StorageNodeId_Field struct {
+	_set   bool
+	_value []byte
+}
+
+func BwagreementSerial_StorageNodeId(v []byte) BwagreementSerial_StorageNodeId_Field {
+	return BwagreementSerial_StorageNodeId_Field{_set: true, _value: v}
+}
+
+func (f BwagreementSerial_StorageNodeId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementSerial_StorageNodeId_Field) _Column() string { return "storage_node_id" }
+
+type BwagreementSerial_Total_Field struct {
+	_set   bool
+	_value int64
+}
+
+func BwagreementSerial_Total(v int64) BwagreementSerial_Total_Field {
+	return BwagreementSerial_Total_Field{_set: true, _value: v}
+}
+
+func (f BwagreementSerial_Total_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementSerial_Total_Field) _Column() string { return "total" }
+
+type ProjectStorage struct {
+	ProjectId []byte
+	Total     int64
+}
+
+func (ProjectStorage) _Table() string { return "project_storages" }
+
+type ProjectStorage_Update_Fields struct {
+	Total ProjectStorage_Total_Field
+}
+
+type ProjectStorage_ProjectId_Field struct {
+	_set   bool
+	_value []byte
+}
+
+func ProjectStorage_ProjectId(v []byte) ProjectStorage_ProjectId_Field {
+	return ProjectStorage_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectStorage_ProjectId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectStorage_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectStorage_Total_Field struct {
+	_set   bool
+	_value int64
+}
+
+func ProjectStorage_Total(v int64) ProjectStorage_Total_Field {
+	return ProjectStorage_Total_Field{_set: true, _value: v}
+}
+
+func (f ProjectStorage_Total_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectStorage_Total_Field) _Column() string { return "total" }
+
+type ProjectEgress struct {
+	ProjectId []byte
+	Month     time.Time
+	Total     int64
+}
+
+func (ProjectEgress) _Table() string { return "project_egresses" }
+
+type ProjectEgress_Update_Fields struct {
+	Total ProjectEgress_Total_Field
+}
+
+type ProjectEgress_ProjectId_Field struct {
+	_set   bool
+	_value []byte
+}
+
+func ProjectEgress_ProjectId(v []byte) ProjectEgress_ProjectId_Field {
+	return ProjectEgress_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectEgress_ProjectId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectEgress_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectEgress_Month_Field struct {
+	_set   bool
+	_value time.Time
+}
+
+func ProjectEgress_Month(v time.Time) ProjectEgress_Month_Field {
+	return ProjectEgress_Month_Field{_set: true, _value: v}
+}
+
+func (f ProjectEgress_Month_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectEgress_Month_Field) _Column() string { return "month" }
+
+type ProjectEgress_Total_Field struct {
+	_set   bool
+	_value int64
+}
+
+func ProjectEgress_Total(v int64) ProjectEgress_Total_Field {
+	return ProjectEgress_Total_Field{_set: true, _value: v}
+}
+
+func (f ProjectEgress_Total_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectEgress_Total_Field) _Column() string { return "total" }
+
+type BwagreementAllocation struct {
+	SerialNumber string
+	Total        int64
+}
+
+func (BwagreementAllocation) _Table() string { return "bwagreement_allocations" }
+
+type BwagreementAllocation_Update_Fields struct {
+	Total BwagreementAllocation_Total_Field
+}
+
+type BwagreementAllocation_SerialNumber_Field struct {
+	_set   bool
+	_value string
+}
+
+func BwagreementAllocation_SerialNumber(v string) BwagreementAllocation_SerialNumber_Field {
+	return BwagreementAllocation_SerialNumber_Field{_set: true, _value: v}
+}
+
+func (f BwagreementAllocation_SerialNumber_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementAllocation_SerialNumber_Field) _Column() string { return "serial_number" }
+
+type BwagreementAllocation_Total_Field struct {
+	_set   bool
+	_value int64
+}
+
+func BwagreementAllocation_Total(v int64) BwagreementAllocation_Total_Field {
+	return BwagreementAllocation_Total_Field{_set: true, _value: v}
+}
+
+func (f BwagreementAllocation_Total_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementAllocation_Total_Field) _Column() string { return "total" }
+
+type AuditCursor struct {
+	Name     string
+	LastPath []byte
+}
+
+func (AuditCursor) _Table() string { return "audit_cursors" }
+
+type AuditCursor_Update_Fields struct {
+	LastPath AuditCursor_LastPath_Field
+}
+
+type AuditCursor_Name_Field struct {
+	_set   bool
+	_value string
+}
+
+func AuditCursor_Name(v string) AuditCursor_Name_Field {
+	return AuditCursor_Name_Field{_set: true, _value: v}
+}
+
+func (f AuditCursor_Name_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditCursor_Name_Field) _Column() string { return "name" }
+
+type AuditCursor_LastPath_Field struct {
+	_set   bool
+	_value []byte
+}
+
+func AuditCursor_LastPath(v []byte) AuditCursor_LastPath_Field {
+	return AuditCursor_LastPath_Field{_set: true, _value: v}
+}
+
+func (f AuditCursor_LastPath_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditCursor_LastPath_Field) _Column() string { return "last_path" }
+
+type NodeWallet struct {
+	NodeId []byte
+	Wallet string
+}
+
+func (NodeWallet) _Table() string { return "node_wallets" }
+
+type NodeWallet_Update_Fields struct {
+	Wallet NodeWallet_Wallet_Field
+}
+
+type NodeWallet_NodeId_Field struct {
+	_set   bool
+	_value []byte
+}
+
+func NodeWallet_NodeId(v []byte) NodeWallet_NodeId_Field {
+	return NodeWallet_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeWallet_NodeId_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeWallet_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeWallet_Wallet_Field struct {
+	_set   bool
+	_value string
+}
+
+func NodeWallet_Wallet(v string) NodeWallet_Wallet_Field {
+	return NodeWallet_Wallet_Field{_set: true, _value: v}
+}
+
+func (f NodeWallet_Wallet_Field) value() interface{} {
+	if !f._set {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeWallet_Wallet_Field) _Column() string { return "wallet" }
+
+func toUTC(t time.Time) time.Time {
+	return t.UTC()
+}
+
+func toDate(t time.Time) time.Time {
+	// keep up the minute portion so that translations between timezones will
+	// continue to reflect properly.
+	return t.Truncate(time.Minute)
+}
+
+//
+// runtime support for building sql statements
+//
+
+type __sqlbundle_SQL interface {
+	Render() string
+
+	private()
+}
+
+type __sqlbundle_Dialect interface {
+	Rebind(sql string) string
+}
+
+type __sqlbundle_RenderOp int
+
+const (
+	__sqlbundle_NoFlatten __sqlbundle_RenderOp = iota
+	__sqlbundle_NoTerminate
+)
+
+func __sqlbundle_Render(dialect __sqlbundle_Dialect, sql __sqlbundle_SQL, ops ...__sqlbundle_RenderOp) string {
+	out := sql.Render()
+
+	flatten := true
+	terminate := true
+	for _, op := range ops {
+		switch op {
+		case __sqlbundle_NoFlatten:
+			flatten = false
+		case __sqlbundle_NoTerminate:
+			terminate = false
+		}
+	}
+
+	if flatten {
+		out = __sqlbundle_flattenSQL(out)
+	}
+	if terminate {
+		out += ";"
+	}
+
+	return dialect.Rebind(out)
+}
+
+var __sqlbundle_reSpace = regexp.MustCompile(`\s+`)
+
+func __sqlbundle_flattenSQL(s string) string {
+	return strings.TrimSpace(__sqlbundle_reSpace.ReplaceAllString(s, " "))
+}
+
+// this type is specially named to match up with the name returned by the
+// dialect impl in the sql package.
+type __sqlbundle_postgres struct{}
+
+func (p __sqlbundle_postgres) Rebind(sql string) string {
+	out := make([]byte, 0, len(sql)+10)
+
+	j := 1
+	for i := 0; i < len(sql); i++ {
+		ch := sql[i]
+		if ch != '?' {
+			out = append(out, ch)
+			continue
+		}
+
+		out = append(out, '$')
+		out = append(out, strconv.Itoa(j)...)
+		j++
+	}
+
+	return string(out)
+}
+
+// this type is specially named to match up with the name returned by the
+// dialect impl in the sql package.
+type __sqlbundle_sqlite3 struct{}
+
+func (s __sqlbundle_sqlite3) Rebind(sql string) string {
+	return sql
+}
+
+type __sqlbundle_Literal string
+
+func (__sqlbundle_Literal) private() {}
+
+func (l __sqlbundle_Literal) Render() string { return string(l) }
+
+type __sqlbundle_Literals struct {
+	Join string
+	SQLs []__sqlbundle_SQL
+}
+
+func (__sqlbundle_Literals) private() {}
+
+func (l __sqlbundle_Literals) Render() string {
+	var out bytes.Buffer
+
+	first := true
+	for _, sql := range l.SQLs {
+		if sql == nil {
+			continue
+		}
+		if !first {
+			out.WriteString(l.Join)
+		}
+		first = false
+		out.WriteString(sql.Render())
+	}
+
+	return out.String()
+}
+
+type __sqlbundle_Condition struct {
+	// set at compile/embed time
+	Name  string
+	Left  string
+	Equal bool
+	Right string
+
+	// set at runtime
+	Null bool
+}
+
+func (*__sqlbundle_Condition) private() {}
+
+func (c *__sqlbundle_Condition) Render() string {
+
+	switch {
+	case c.Equal && c.Null:
+		return c.Left + " is null"
+	case c.Equal && !c.Null:
+		return c.Left + " = " + c.Right
+	case !c.Equal && c.Null:
+		return c.Left + " is not null"
+	case !c.Equal && !c.Null:
+		return c.Left + " != " + c.Right
+	default:
+		panic("unhandled case")
+	}
+}
+
+type __sqlbundle_Hole struct {
+	// set at compiile/embed time
+	Name string
+
+	// set at runtime
+	SQL __sqlbundle_SQL
+}
+
+func (*__sqlbundle_Hole) private() {}
+
+func (h *__sqlbundle_Hole) Render() string { return h.SQL.Render() }
+
+//
+// end runtime support for building sql statements
+//
+
+func (obj *postgresImpl) Create_Bwagreement(ctx context.Context,
+	bwagreement_signature Bwagreement_Signature_Field,
+	bwagreement_data Bwagreement_Data_Field) (
+	bwagreement *Bwagreement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__signature_val := bwagreement_signature.value()
+	__data_val := bwagreement_data.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreements ( signature, data, created_at ) VALUES ( ?, ?, ? ) RETURNING bwagreements.signature, bwagreements.data, bwagreements.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __signature_val, __data_val, __created_at_val)
+
+	bwagreement = &Bwagreement{}
+	err = obj.driver.QueryRow(__stmt, __signature_val, __data_val, __created_at_val).Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement, nil
+
+}
+
+func (obj *postgresImpl) Create_PendingAudit(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field,
+	pending_audit_path PendingAudit_Path_Field,
+	pending_audit_piece_id PendingAudit_PieceId_Field,
+	pending_audit_stripe_index PendingAudit_StripeIndex_Field,
+	pending_audit_share_size PendingAudit_ShareSize_Field,
+	pending_audit_expected_share_hash PendingAudit_ExpectedShareHash_Field,
+	pending_audit_reverify_count PendingAudit_ReverifyCount_Field) (
+	pending_audit *PendingAudit, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := pending_audit_node_id.value()
+	__path_val := pending_audit_path.value()
+	__piece_id_val := pending_audit_piece_id.value()
+	__stripe_index_val := pending_audit_stripe_index.value()
+	__share_size_val := pending_audit_share_size.value()
+	__expected_share_hash_val := pending_audit_expected_share_hash.value()
+	__reverify_count_val := pending_audit_reverify_count.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_audits ( node_id, path, piece_id, stripe_index, share_size, expected_share_hash, reverify_count, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING pending_audits.node_id, pending_audits.path, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __path_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __created_at_val)
+
+	pending_audit = &PendingAudit{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __path_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __created_at_val).Scan(&pending_audit.NodeId, &pending_audit.Path, &pending_audit.PieceId, &pending_audit.StripeIndex, &pending_audit.ShareSize, &pending_audit.ExpectedShareHash, &pending_audit.ReverifyCount, &pending_audit.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_audit, nil
+
+}
+
+func (obj *postgresImpl) Get_Bwagreement_By_Signature(ctx context.Context,
+	bwagreement_signature Bwagreement_Signature_Field) (
+	bwagreement *Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE bwagreements.signature = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_signature.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement = &Bwagreement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement, nil
+
+}
+
+func (obj *postgresImpl) Limited_Bwagreement(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_Bwagreement(ctx context.Context) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_Bwagreement_By_CreatedAt_GreaterOrEqual(ctx context.Context,
+	bwagreement_created_at_greater_or_equal Bwagreement_CreatedAt_Field) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE bwagreements.created_at >= ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_created_at_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_PendingAudit_By_NodeId(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field) (
+	pending_audit *PendingAudit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.path, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.created_at FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_audit_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_audit = &PendingAudit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audit.NodeId, &pending_audit.Path, &pending_audit.PieceId, &pending_audit.StripeIndex, &pending_audit.ShareSize, &pending_audit.ExpectedShareHash, &pending_audit.ReverifyCount, &pending_audit.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_audit, nil
+
+}
+
+func (obj *postgresImpl) Update_PendingAudit_By_NodeId(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field,
+	update PendingAudit_Update_Fields) (
+	pending_audit *PendingAudit, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE pending_audits SET "), __sets, __sqlbundle_Literal(" WHERE pending_audits.node_id = ? RETURNING pending_audits.node_id, pending_audits.path, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.ReverifyCount._set {
+		__values = append(__values, update.ReverifyCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("reverify_count = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, pending_audit_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_audit = &PendingAudit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audit.NodeId, &pending_audit.Path, &pending_audit.PieceId, &pending_audit.StripeIndex, &pending_audit.ShareSize, &pending_audit.ExpectedShareHash, &pending_audit.ReverifyCount, &pending_audit.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_audit, nil
+}
+
+func (obj *postgresImpl) Delete_Bwagreement_By_Signature(ctx context.Context,
+	bwagreement_signature Bwagreement_Signature_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bwagreements WHERE bwagreements.signature = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_signature.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_PendingAudit_By_NodeId(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_audit_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_Injuredsegment(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	injuredsegment_data Injuredsegment_Data_Field,
+	injuredsegment_num_healthy Injuredsegment_NumHealthy_Field,
+	injuredsegment_lease_unix_sec Injuredsegment_LeaseUnixSec_Field) (
+	injuredsegment *Injuredsegment, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__path_val := injuredsegment_path.value()
+	__data_val := injuredsegment_data.value()
+	__num_healthy_val := injuredsegment_num_healthy.value()
+	__lease_unix_sec_val := injuredsegment_lease_unix_sec.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( path, data, num_healthy, lease_unix_sec, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING injuredsegments.path, injuredsegments.data, injuredsegments.num_healthy, injuredsegments.lease_unix_sec, injuredsegments.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __path_val, __data_val, __num_healthy_val, __lease_unix_sec_val, __created_at_val)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, __path_val, __data_val, __num_healthy_val, __lease_unix_sec_val, __created_at_val).Scan(&injuredsegment.Path, &injuredsegment.Data, &injuredsegment.NumHealthy, &injuredsegment.LeaseUnixSec, &injuredsegment.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+
+}
+
+func (obj *postgresImpl) Get_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	injuredsegment *Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.path, injuredsegments.data, injuredsegments.num_healthy, injuredsegments.lease_unix_sec, injuredsegments.created_at FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&injuredsegment.Path, &injuredsegment.Data, &injuredsegment.NumHealthy, &injuredsegment.LeaseUnixSec, &injuredsegment.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+
+}
+
+func (obj *postgresImpl) Update_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	update Injuredsegment_Update_Fields) (
+	injuredsegment *Injuredsegment, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE injuredsegments SET "), __sets, __sqlbundle_Literal(" WHERE injuredsegments.path = ? RETURNING injuredsegments.path, injuredsegments.data, injuredsegments.num_healthy, injuredsegments.lease_unix_sec, injuredsegments.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Data._set {
+		__values = append(__values, update.Data.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("data = ?"))
+	}
+
+	if update.NumHealthy._set {
+		__values = append(__values, update.NumHealthy.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("num_healthy = ?"))
+	}
+
+	if update.LeaseUnixSec._set {
+		__values = append(__values, update.LeaseUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lease_unix_sec = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, injuredsegment_path.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&injuredsegment.Path, &injuredsegment.Data, &injuredsegment.NumHealthy, &injuredsegment.LeaseUnixSec, &injuredsegment.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+}
+
+func (obj *postgresImpl) Delete_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_Repair(ctx context.Context,
+	repair_id Repair_Id_Field,
+	repair_path Repair_Path_Field,
+	repair_healthy_pieces Repair_HealthyPieces_Field,
+	repair_rebuilt_pieces Repair_RebuiltPieces_Field,
+	repair_new_nodes Repair_NewNodes_Field,
+	repair_failure Repair_Failure_Field) (
+	repair *Repair, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := repair_id.value()
+	__path_val := repair_path.value()
+	__healthy_pieces_val := repair_healthy_pieces.value()
+	__rebuilt_pieces_val := repair_rebuilt_pieces.value()
+	__new_nodes_val := repair_new_nodes.value()
+	__failure_val := repair_failure.value()
+	__repaired_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO repairs ( id, path, healthy_pieces, rebuilt_pieces, new_nodes, failure, repaired_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING repairs.id, repairs.path, repairs.healthy_pieces, repairs.rebuilt_pieces, repairs.new_nodes, repairs.failure, repairs.repaired_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __path_val, __healthy_pieces_val, __rebuilt_pieces_val, __new_nodes_val, __failure_val, __repaired_at_val)
+
+	repair = &Repair{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __path_val, __healthy_pieces_val, __rebuilt_pieces_val, __new_nodes_val, __failure_val, __repaired_at_val).Scan(&repair.Id, &repair.Path, &repair.HealthyPieces, &repair.RebuiltPieces, &repair.NewNodes, &repair.Failure, &repair.RepairedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return repair, nil
+
+}
+
+func (obj *postgresImpl) Get_Repair_By_Id(ctx context.Context,
+	repair_id Repair_Id_Field) (
+	repair *Repair, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT repairs.id, repairs.path, repairs.healthy_pieces, repairs.rebuilt_pieces, repairs.new_nodes, repairs.failure, repairs.repaired_at FROM repairs WHERE repairs.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, repair_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	repair = &Repair{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&repair.Id, &repair.Path, &repair.HealthyPieces, &repair.RebuiltPieces, &repair.NewNodes, &repair.Failure, &repair.RepairedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return repair, nil
+
+}
+
+func (obj *postgresImpl) Delete_Repair_By_Id(ctx context.Context,
+	repair_id Repair_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM repairs WHERE repairs.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, repair_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
+	overlay_cache_node_address OverlayCacheNode_Address_Field,
+	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
+	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+	overlay_cache_node_version OverlayCacheNode_Version_Field,
+	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
+	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
+	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
+	overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
+	overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
+	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
+	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
+	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
+	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+	overlay_cache_node_audited_unix_sec OverlayCacheNode_AuditedUnixSec_Field,
+	overlay_cache_node_audit_reputation OverlayCacheNode_AuditReputation_Field,
+	overlay_cache_node_uptime_reputation OverlayCacheNode_UptimeReputation_Field,
+	overlay_cache_node_vetted OverlayCacheNode_Vetted_Field,
+	overlay_cache_node_disqualified OverlayCacheNode_Disqualified_Field,
+	overlay_cache_node_first_seen_unix_sec OverlayCacheNode_FirstSeenUnixSec_Field,
+	overlay_cache_node_last_contact_unix_sec OverlayCacheNode_LastContactUnixSec_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+
+	__node_id_val := overlay_cache_node_node_id.value()
+	__node_type_val := overlay_cache_node_node_type.value()
+	__address_val := overlay_cache_node_address.value()
+	__protocol_val := overlay_cache_node_protocol.value()
+	__operator_email_val := overlay_cache_node_operator_email.value()
+	__operator_wallet_val := overlay_cache_node_operator_wallet.value()
+	__version_val := overlay_cache_node_version.value()
+	__free_bandwidth_val := overlay_cache_node_free_bandwidth.value()
+	__free_disk_val := overlay_cache_node_free_disk.value()
+	__latency_90_val := overlay_cache_node_latency_90.value()
+	__audit_success_ratio_val := overlay_cache_node_audit_success_ratio.value()
+	__audit_uptime_ratio_val := overlay_cache_node_audit_uptime_ratio.value()
+	__audit_count_val := overlay_cache_node_audit_count.value()
+	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
+	__uptime_count_val := overlay_cache_node_uptime_count.value()
+	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+	__audited_unix_sec_val := overlay_cache_node_audited_unix_sec.value()
+	__audit_reputation_val := overlay_cache_node_audit_reputation.value()
+	__uptime_reputation_val := overlay_cache_node_uptime_reputation.value()
+	__vetted_val := overlay_cache_node_vetted.value()
+	__disqualified_val := overlay_cache_node_disqualified.value()
+	__first_seen_unix_sec_val := overlay_cache_node_first_seen_unix_sec.value()
+	__last_contact_unix_sec_val := overlay_cache_node_last_contact_unix_sec.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, version, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count, audited_unix_sec, audit_reputation, uptime_reputation, vetted, disqualified, first_seen_unix_sec, last_contact_unix_sec ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audited_unix_sec, overlay_cache_nodes.audit_reputation, overlay_cache_nodes.uptime_reputation, overlay_cache_nodes.vetted, overlay_cache_nodes.disqualified, overlay_cache_nodes.first_seen_unix_sec, overlay_cache_nodes.last_contact_unix_sec")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __version_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __audited_unix_sec_val, __audit_reputation_val, __uptime_reputation_val, __vetted_val, __disqualified_val, __first_seen_unix_sec_val, __last_contact_unix_sec_val)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __version_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __audited_unix_sec_val, __audit_reputation_val, __uptime_reputation_val, __vetted_val, __disqualified_val, __first_seen_unix_sec_val, __last_contact_unix_sec_val).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditedUnixSec, &overlay_cache_node.AuditReputation, &overlay_cache_node.UptimeReputation, &overlay_cache_node.Vetted, &overlay_cache_node.Disqualified, &overlay_cache_node.FirstSeenUnixSec, &overlay_cache_node.LastContactUnixSec)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+
+}
+
+func (obj *postgresImpl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audited_unix_sec, overlay_cache_nodes.audit_reputation, overlay_cache_nodes.uptime_reputation, overlay_cache_nodes.vetted, overlay_cache_nodes.disqualified, overlay_cache_nodes.first_seen_unix_sec, overlay_cache_nodes.last_contact_unix_sec FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditedUnixSec, &overlay_cache_node.AuditReputation, &overlay_cache_node.UptimeReputation, &overlay_cache_node.Vetted, &overlay_cache_node.Disqualified, &overlay_cache_node.FirstSeenUnixSec, &overlay_cache_node.LastContactUnixSec)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+
+}
+
+func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	update OverlayCacheNode_Update_Fields) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ? RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audited_unix_sec, overlay_cache_nodes.audit_reputation, overlay_cache_nodes.uptime_reputation, overlay_cache_nodes.vetted, overlay_cache_nodes.disqualified, overlay_cache_nodes.first_seen_unix_sec, overlay_cache_nodes.last_contact_unix_sec")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.NodeType._set {
+		__values = append(__values, update.NodeType.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("node_type = ?"))
+	}
+
+	if update.Address._set {
+		__values = append(__values, update.Address.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	}
+
+	if update.Protocol._set {
+		__values = append(__values, update.Protocol.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	}
+
+	if update.OperatorEmail._set {
+		__values = append(__values, update.OperatorEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email = ?"))
+	}
+
+	if update.OperatorWallet._set {
+		__values = append(__values, update.OperatorWallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet = ?"))
+	}
+
+	if update.Version._set {
+		__values = append(__values, update.Version.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("version = ?"))
+	}
+
+	if update.FreeBandwidth._set {
+		__values = append(__values, update.FreeBandwidth.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_bandwidth = ?"))
+	}
+
+	if update.FreeDisk._set {
+		__values = append(__values, update.FreeDisk.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	}
+
+	if update.Latency90._set {
+		__values = append(__values, update.Latency90.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	}
+
+	if update.AuditSuccessRatio._set {
+		__values = append(__values, update.AuditSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
+	}
+
+	if update.AuditUptimeRatio._set {
+		__values = append(__values, update.AuditUptimeRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_uptime_ratio = ?"))
+	}
+
+	if update.AuditCount._set {
+		__values = append(__values, update.AuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_count = ?"))
+	}
+
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.UptimeCount._set {
+		__values = append(__values, update.UptimeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_count = ?"))
+	}
+
+	if update.UptimeSuccessCount._set {
+		__values = append(__values, update.UptimeSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	}
+
+	if update.AuditedUnixSec._set {
+		__values = append(__values, update.AuditedUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audited_unix_sec = ?"))
+	}
+
+	if update.AuditReputation._set {
+		__values = append(__values, update.AuditReputation.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation = ?"))
+	}
+
+	if update.UptimeReputation._set {
+		__values = append(__values, update.UptimeReputation.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation = ?"))
+	}
+
+	if update.Vetted._set {
+		__values = append(__values, update.Vetted.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.FirstSeenUnixSec._set {
+		__values = append(__values, update.FirstSeenUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("first_seen_unix_sec = ?"))
+	}
+
+	if update.LastContactUnixSec._set {
+		__values = append(__values, update.LastContactUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_unix_sec = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, overlay_cache_node_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditedUnixSec, &overlay_cache_node.AuditReputation, &overlay_cache_node.UptimeReputation, &overlay_cache_node.Vetted, &overlay_cache_node.Disqualified, &overlay_cache_node.FirstSeenUnixSec, &overlay_cache_node.LastContactUnixSec)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+}
+
+func (obj *postgresImpl) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_BwagreementSerial(ctx context.Context,
+	bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+	bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field,
+	bwagreement_serial_total BwagreementSerial_Total_Field) (
+	bwagreement_serial *BwagreementSerial, err error) {
+
+	__serial_number_val := bwagreement_serial_serial_number.value()
+	__storage_node_id_val := bwagreement_serial_storage_node_id.value()
+	__total_val := bwagreement_serial_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreement_serials ( serial_number, storage_node_id, total ) VALUES ( ?, ?, ? ) RETURNING bwagreement_serials.serial_number, bwagreement_serials.storage_node_id, bwagreement_serials.total")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __serial_number_val, __storage_node_id_val, __total_val)
+
+	bwagreement_serial = &BwagreementSerial{}
+	err = obj.driver.QueryRow(__stmt, __serial_number_val, __storage_node_id_val, __total_val).Scan(&bwagreement_serial.SerialNumber, &bwagreement_serial.StorageNodeId, &bwagreement_serial.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_serial, nil
+
+}
+
+func (obj *postgresImpl) Get_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx context.Context,
+	bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+	bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field) (
+	bwagreement_serial *BwagreementSerial, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreement_serials.serial_number, bwagreement_serials.storage_node_id, bwagreement_serials.total FROM bwagreement_serials WHERE bwagreement_serials.serial_number = ? AND bwagreement_serials.storage_node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_serial_serial_number.value())
+	__values = append(__values, bwagreement_serial_storage_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement_serial = &BwagreementSerial{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement_serial.SerialNumber, &bwagreement_serial.StorageNodeId, &bwagreement_serial.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_serial, nil
+
+}
+
+func (obj *postgresImpl) Delete_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx context.Context,
+	bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+	bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bwagreement_serials WHERE bwagreement_serials.serial_number = ? AND bwagreement_serials.storage_node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_serial_serial_number.value())
+	__values = append(__values, bwagreement_serial_storage_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_ProjectStorage(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field,
+	project_storage_total ProjectStorage_Total_Field) (
+	project_storage *ProjectStorage, err error) {
+
+	__project_id_val := project_storage_project_id.value()
+	__total_val := project_storage_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_storages ( project_id, total ) VALUES ( ?, ? ) RETURNING project_storages.project_id, project_storages.total")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __total_val)
+
+	project_storage = &ProjectStorage{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __total_val).Scan(&project_storage.ProjectId, &project_storage.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_storage, nil
+
+}
+
+func (obj *postgresImpl) Get_ProjectStorage_By_ProjectId(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field) (
+	project_storage *ProjectStorage, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_storages.project_id, project_storages.total FROM project_storages WHERE project_storages.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_storage_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_storage = &ProjectStorage{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_storage.ProjectId, &project_storage.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_storage, nil
+
+}
+
+func (obj *postgresImpl) Update_ProjectStorage_By_ProjectId(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field,
+	update ProjectStorage_Update_Fields) (
+	project_storage *ProjectStorage, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_storages SET "), __sets, __sqlbundle_Literal(" WHERE project_storages.project_id = ? RETURNING project_storages.project_id, project_storages.total")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Total._set {
+		__values = append(__values, update.Total.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_storage_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_storage = &ProjectStorage{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_storage.ProjectId, &project_storage.Total)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_storage, nil
+}
+
+func (obj *postgresImpl) Delete_ProjectStorage_By_ProjectId(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_storages WHERE project_storages.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_storage_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_ProjectEgress(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field,
+	project_egress_total ProjectEgress_Total_Field) (
+	project_egress *ProjectEgress, err error) {
+
+	__project_id_val := project_egress_project_id.value()
+	__month_val := project_egress_month.value()
+	__total_val := project_egress_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_egresses ( project_id, month, total ) VALUES ( ?, ?, ? ) RETURNING project_egresses.project_id, project_egresses.month, project_egresses.total")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __month_val, __total_val)
+
+	project_egress = &ProjectEgress{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __month_val, __total_val).Scan(&project_egress.ProjectId, &project_egress.Month, &project_egress.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_egress, nil
+
+}
+
+func (obj *postgresImpl) Get_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field) (
+	project_egress *ProjectEgress, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_egresses.project_id, project_egresses.month, project_egresses.total FROM project_egresses WHERE project_egresses.project_id = ? AND project_egresses.month = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_egress_project_id.value())
+	__values = append(__values, project_egress_month.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_egress = &ProjectEgress{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_egress.ProjectId, &project_egress.Month, &project_egress.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_egress, nil
+
+}
+
+func (obj *postgresImpl) Update_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field,
+	update ProjectEgress_Update_Fields) (
+	project_egress *ProjectEgress, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_egresses SET "), __sets, __sqlbundle_Literal(" WHERE project_egresses.project_id = ? AND project_egresses.month = ? RETURNING project_egresses.project_id, project_egresses.month, project_egresses.total")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Total._set {
+		__values = append(__values, update.Total.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_egress_project_id.value())
+	__args = append(__args, project_egress_month.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_egress = &ProjectEgress{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_egress.ProjectId, &project_egress.Month, &project_egress.Total)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_egress, nil
+}
+
+func (obj *postgresImpl) Delete_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_egresses WHERE project_egresses.project_id = ? AND project_egresses.month = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_egress_project_id.value())
+	__values = append(__values, project_egress_month.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_BwagreementAllocation(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field,
+	bwagreement_allocation_total BwagreementAllocation_Total_Field) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+
+	__serial_number_val := bwagreement_allocation_serial_number.value()
+	__total_val := bwagreement_allocation_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreement_allocations ( serial_number, total ) VALUES ( ?, ? ) RETURNING bwagreement_allocations.serial_number, bwagreement_allocations.total")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __serial_number_val, __total_val)
+
+	bwagreement_allocation = &BwagreementAllocation{}
+	err = obj.driver.QueryRow(__stmt, __serial_number_val, __total_val).Scan(&bwagreement_allocation.SerialNumber, &bwagreement_allocation.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_allocation, nil
+
+}
+
+func (obj *postgresImpl) Get_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreement_allocations.serial_number, bwagreement_allocations.total FROM bwagreement_allocations WHERE bwagreement_allocations.serial_number = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_allocation_serial_number.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement_allocation = &BwagreementAllocation{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement_allocation.SerialNumber, &bwagreement_allocation.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_allocation, nil
+
+}
+
+func (obj *postgresImpl) Update_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field,
+	update BwagreementAllocation_Update_Fields) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bwagreement_allocations SET "), __sets, __sqlbundle_Literal(" WHERE bwagreement_allocations.serial_number = ? RETURNING bwagreement_allocations.serial_number, bwagreement_allocations.total")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Total._set {
+		__values = append(__values, update.Total.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bwagreement_allocation_serial_number.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement_allocation = &BwagreementAllocation{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement_allocation.SerialNumber, &bwagreement_allocation.Total)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_allocation, nil
+}
+
+func (obj *postgresImpl) Delete_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bwagreement_allocations WHERE bwagreement_allocations.serial_number = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_allocation_serial_number.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Create_AuditCursor(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field,
+	audit_cursor_last_path AuditCursor_LastPath_Field) (
+	audit_cursor *AuditCursor, err error) {
+
+	__name_val := audit_cursor_name.value()
+	__last_path_val := audit_cursor_last_path.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_cursors ( name, last_path ) VALUES ( ?, ? ) RETURNING audit_cursors.name, audit_cursors.last_path")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __name_val, __last_path_val)
+
+	audit_cursor = &AuditCursor{}
+	err = obj.driver.QueryRow(__stmt, __name_val, __last_path_val).Scan(&audit_cursor.Name, &audit_cursor.LastPath)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_cursor, nil
+
+}
+
+func (obj *postgresImpl) Create_NodeWallet(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field,
+	node_wallet_wallet NodeWallet_Wallet_Field) (
+	node_wallet *NodeWallet, err error) {
+
+	__node_id_val := node_wallet_node_id.value()
+	__wallet_val := node_wallet_wallet.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_wallets ( node_id, wallet ) VALUES ( ?, ? ) RETURNING node_wallets.node_id, node_wallets.wallet")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __wallet_val)
+
+	node_wallet = &NodeWallet{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __wallet_val).Scan(&node_wallet.NodeId, &node_wallet.Wallet)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_wallet, nil
+
+}
+
+func (obj *postgresImpl) Get_AuditCursor_By_Name(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field) (
+	audit_cursor *AuditCursor, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_cursors.name, audit_cursors.last_path FROM audit_cursors WHERE audit_cursors.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_cursor_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	audit_cursor = &AuditCursor{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&audit_cursor.Name, &audit_cursor.LastPath)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_cursor, nil
+
+}
+
+func (obj *postgresImpl) Get_NodeWallet_By_NodeId(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field) (
+	node_wallet *NodeWallet, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_wallets.node_id, node_wallets.wallet FROM node_wallets WHERE node_wallets.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_wallet_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_wallet = &NodeWallet{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_wallet.NodeId, &node_wallet.Wallet)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_wallet, nil
+
+}
+
+func (obj *postgresImpl) Update_AuditCursor_By_Name(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field,
+	update AuditCursor_Update_Fields) (
+	audit_cursor *AuditCursor, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE audit_cursors SET "), __sets, __sqlbundle_Literal(" WHERE audit_cursors.name = ? RETURNING audit_cursors.name, audit_cursors.last_path")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.LastPath._set {
+		__values = append(__values, update.LastPath.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_path = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, audit_cursor_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	audit_cursor = &AuditCursor{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&audit_cursor.Name, &audit_cursor.LastPath)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_cursor, nil
+}
+
+func (obj *postgresImpl) Update_NodeWallet_By_NodeId(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field,
+	update NodeWallet_Update_Fields) (
+	node_wallet *NodeWallet, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_wallets SET "), __sets, __sqlbundle_Literal(" WHERE node_wallets.node_id = ? RETURNING node_wallets.node_id, node_wallets.wallet")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Wallet._set {
+		__values = append(__values, update.Wallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, node_wallet_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_wallet = &NodeWallet{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_wallet.NodeId, &node_wallet.Wallet)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_wallet, nil
+}
+
+func (obj *postgresImpl) Delete_AuditCursor_By_Name(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM audit_cursors WHERE audit_cursors.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_cursor_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_NodeWallet_By_NodeId(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_wallets WHERE node_wallets.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_wallet_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (impl postgresImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(*pq.Error); ok {
+		if e.Code.Class() == "23" {
+			return e.Constraint, true
+		}
+	}
+	return "", false
+}
+
+func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error) {
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM node_wallets;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM audit_cursors;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_allocations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_egresses;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_storages;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_serials;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM overlay_cache_nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM repairs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM injuredsegments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pending_audits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+
+	return count, nil
+
+}
+
+func (obj *sqlite3Impl) Create_Bwagreement(ctx context.Context,
+	bwagreement_signature Bwagreement_Signature_Field,
+	bwagreement_data Bwagreement_Data_Field) (
+	bwagreement *Bwagreement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__signature_val := bwagreement_signature.value()
+	__data_val := bwagreement_data.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreements ( signature, data, created_at ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __signature_val, __data_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __signature_val, __data_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBwagreement(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_PendingAudit(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field,
+	pending_audit_path PendingAudit_Path_Field,
+	pending_audit_piece_id PendingAudit_PieceId_Field,
+	pending_audit_stripe_index PendingAudit_StripeIndex_Field,
+	pending_audit_share_size PendingAudit_ShareSize_Field,
+	pending_audit_expected_share_hash PendingAudit_ExpectedShareHash_Field,
+	pending_audit_reverify_count PendingAudit_ReverifyCount_Field) (
+	pending_audit *PendingAudit, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := pending_audit_node_id.value()
+	__path_val := pending_audit_path.value()
+	__piece_id_val := pending_audit_piece_id.value()
+	__stripe_index_val := pending_audit_stripe_index.value()
+	__share_size_val := pending_audit_share_size.value()
+	__expected_share_hash_val := pending_audit_expected_share_hash.value()
+	__reverify_count_val := pending_audit_reverify_count.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_audits ( node_id, path, piece_id, stripe_index, share_size, expected_share_hash, reverify_count, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __path_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __path_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastPendingAudit(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_Bwagreement_By_Signature(ctx context.Context,
+	bwagreement_signature Bwagreement_Signature_Field) (
+	bwagreement *Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE bwagreements.signature = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_signature.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement = &Bwagreement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Bwagreement(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_Bwagreement(ctx context.Context) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_Bwagreement_By_CreatedAt_GreaterOrEqual(ctx context.Context,
+	bwagreement_created_at_greater_or_equal Bwagreement_CreatedAt_Field) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE bwagreements.created_at >= ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_created_at_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_PendingAudit_By_NodeId(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field) (
+	pending_audit *PendingAudit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.path, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.created_at FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_audit_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_audit = &PendingAudit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audit.NodeId, &pending_audit.Path, &pending_audit.PieceId, &pending_audit.StripeIndex, &pending_audit.ShareSize, &pending_audit.ExpectedShareHash, &pending_audit.ReverifyCount, &pending_audit.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_audit, nil
+
+}
+
+func (obj *sqlite3Impl) Update_PendingAudit_By_NodeId(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field,
+	update PendingAudit_Update_Fields) (
+	pending_audit *PendingAudit, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE pending_audits SET "), __sets, __sqlbundle_Literal(" WHERE pending_audits.node_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.ReverifyCount._set {
+		__values = append(__values, update.ReverifyCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("reverify_count = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, pending_audit_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_audit = &PendingAudit{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.path, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.created_at FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&pending_audit.NodeId, &pending_audit.Path, &pending_audit.PieceId, &pending_audit.StripeIndex, &pending_audit.ShareSize, &pending_audit.ExpectedShareHash, &pending_audit.ReverifyCount, &pending_audit.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_audit, nil
+}
+
+func (obj *sqlite3Impl) Delete_Bwagreement_By_Signature(ctx context.Context,
+	bwagreement_signature Bwagreement_Signature_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bwagreements WHERE bwagreements.signature = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_signature.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_PendingAudit_By_NodeId(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_audit_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastBwagreement(ctx context.Context,
+	pk int64) (
+	bwagreement *Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bwagreement = &Bwagreement{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement, nil
+
+}
+
+func (obj *sqlite3Impl) getLastPendingAudit(ctx context.Context,
+	pk int64) (
+	pending_audit *PendingAudit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.path, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.created_at FROM pending_audits WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	pending_audit = &PendingAudit{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&pending_audit.NodeId, &pending_audit.Path, &pending_audit.PieceId, &pending_audit.StripeIndex, &pending_audit.ShareSize, &pending_audit.ExpectedShareHash, &pending_audit.ReverifyCount, &pending_audit.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_audit, nil
+
+}
+
+func (obj *sqlite3Impl) Create_Injuredsegment(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	injuredsegment_data Injuredsegment_Data_Field,
+	injuredsegment_num_healthy Injuredsegment_NumHealthy_Field,
+	injuredsegment_lease_unix_sec Injuredsegment_LeaseUnixSec_Field) (
+	injuredsegment *Injuredsegment, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__path_val := injuredsegment_path.value()
+	__data_val := injuredsegment_data.value()
+	__num_healthy_val := injuredsegment_num_healthy.value()
+	__lease_unix_sec_val := injuredsegment_lease_unix_sec.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( path, data, num_healthy, lease_unix_sec, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __path_val, __data_val, __num_healthy_val, __lease_unix_sec_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __path_val, __data_val, __num_healthy_val, __lease_unix_sec_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastInjuredsegment(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	injuredsegment *Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.path, injuredsegments.data, injuredsegments.num_healthy, injuredsegments.lease_unix_sec, injuredsegments.created_at FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&injuredsegment.Path, &injuredsegment.Data, &injuredsegment.NumHealthy, &injuredsegment.LeaseUnixSec, &injuredsegment.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+
+}
+
+func (obj *sqlite3Impl) Update_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	update Injuredsegment_Update_Fields) (
+	injuredsegment *Injuredsegment, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE injuredsegments SET "), __sets, __sqlbundle_Literal(" WHERE injuredsegments.path = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Data._set {
+		__values = append(__values, update.Data.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("data = ?"))
+	}
+
+	if update.NumHealthy._set {
+		__values = append(__values, update.NumHealthy.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("num_healthy = ?"))
+	}
+
+	if update.LeaseUnixSec._set {
+		__values = append(__values, update.LeaseUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lease_unix_sec = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, injuredsegment_path.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	injuredsegment = &Injuredsegment{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT injuredsegments.path, injuredsegments.data, injuredsegments.num_healthy, injuredsegments.lease_unix_sec, injuredsegments.created_at FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&injuredsegment.Path, &injuredsegment.Data, &injuredsegment.NumHealthy, &injuredsegment.LeaseUnixSec, &injuredsegment.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+}
+
+func (obj *sqlite3Impl) Delete_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastInjuredsegment(ctx context.Context,
+	pk int64) (
+	injuredsegment *Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.path, injuredsegments.data, injuredsegments.num_healthy, injuredsegments.lease_unix_sec, injuredsegments.created_at FROM injuredsegments WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&injuredsegment.Path, &injuredsegment.Data, &injuredsegment.NumHealthy, &injuredsegment.LeaseUnixSec, &injuredsegment.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+
+}
+
+func (obj *sqlite3Impl) Create_Repair(ctx context.Context,
+	repair_id Repair_Id_Field,
+	repair_path Repair_Path_Field,
+	repair_healthy_pieces Repair_HealthyPieces_Field,
+	repair_rebuilt_pieces Repair_RebuiltPieces_Field,
+	repair_new_nodes Repair_NewNodes_Field,
+	repair_failure Repair_Failure_Field) (
+	repair *Repair, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := repair_id.value()
+	__path_val := repair_path.value()
+	__healthy_pieces_val := repair_healthy_pieces.value()
+	__rebuilt_pieces_val := repair_rebuilt_pieces.value()
+	__new_nodes_val := repair_new_nodes.value()
+	__failure_val := repair_failure.value()
+	__repaired_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO repairs ( id, path, healthy_pieces, rebuilt_pieces, new_nodes, failure, repaired_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __path_val, __healthy_pieces_val, __rebuilt_pieces_val, __new_nodes_val, __failure_val, __repaired_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __path_val, __healthy_pieces_val, __rebuilt_pieces_val, __new_nodes_val, __failure_val, __repaired_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastRepair(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_Repair_By_Id(ctx context.Context,
+	repair_id Repair_Id_Field) (
+	repair *Repair, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT repairs.id, repairs.path, repairs.healthy_pieces, repairs.rebuilt_pieces, repairs.new_nodes, repairs.failure, repairs.repaired_at FROM repairs WHERE repairs.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, repair_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	repair = &Repair{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&repair.Id, &repair.Path, &repair.HealthyPieces, &repair.RebuiltPieces, &repair.NewNodes, &repair.Failure, &repair.RepairedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return repair, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_Repair_By_Id(ctx context.Context,
+	repair_id Repair_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM repairs WHERE repairs.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, repair_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastRepair(ctx context.Context,
+	pk int64) (
+	repair *Repair, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT repairs.id, repairs.path, repairs.healthy_pieces, repairs.rebuilt_pieces, repairs.new_nodes, repairs.failure, repairs.repaired_at FROM repairs WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	repair = &Repair{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&repair.Id, &repair.Path, &repair.HealthyPieces, &repair.RebuiltPieces, &repair.NewNodes, &repair.Failure, &repair.RepairedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return repair, nil
+
+}
+
+func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
+	overlay_cache_node_address OverlayCacheNode_Address_Field,
+	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
+	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+	overlay_cache_node_version OverlayCacheNode_Version_Field,
+	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
+	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
+	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
+	overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
+	overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
+	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
+	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
+	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
+	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+	overlay_cache_node_audited_unix_sec OverlayCacheNode_AuditedUnixSec_Field,
+	overlay_cache_node_audit_reputation OverlayCacheNode_AuditReputation_Field,
+	overlay_cache_node_uptime_reputation OverlayCacheNode_UptimeReputation_Field,
+	overlay_cache_node_vetted OverlayCacheNode_Vetted_Field,
+	overlay_cache_node_disqualified OverlayCacheNode_Disqualified_Field,
+	overlay_cache_node_first_seen_unix_sec OverlayCacheNode_FirstSeenUnixSec_Field,
+	overlay_cache_node_last_contact_unix_sec OverlayCacheNode_LastContactUnixSec_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+
+	__node_id_val := overlay_cache_node_node_id.value()
+	__node_type_val := overlay_cache_node_node_type.value()
+	__address_val := overlay_cache_node_address.value()
+	__protocol_val := overlay_cache_node_protocol.value()
+	__operator_email_val := overlay_cache_node_operator_email.value()
+	__operator_wallet_val := overlay_cache_node_operator_wallet.value()
+	__version_val := overlay_cache_node_version.value()
+	__free_bandwidth_val := overlay_cache_node_free_bandwidth.value()
+	__free_disk_val := overlay_cache_node_free_disk.value()
+	__latency_90_val := overlay_cache_node_latency_90.value()
+	__audit_success_ratio_val := overlay_cache_node_audit_success_ratio.value()
+	__audit_uptime_ratio_val := overlay_cache_node_audit_uptime_ratio.value()
+	__audit_count_val := overlay_cache_node_audit_count.value()
+	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
+	__uptime_count_val := overlay_cache_node_uptime_count.value()
+	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+	__audited_unix_sec_val := overlay_cache_node_audited_unix_sec.value()
+	__audit_reputation_val := overlay_cache_node_audit_reputation.value()
+	__uptime_reputation_val := overlay_cache_node_uptime_reputation.value()
+	__vetted_val := overlay_cache_node_vetted.value()
+	__disqualified_val := overlay_cache_node_disqualified.value()
+	__first_seen_unix_sec_val := overlay_cache_node_first_seen_unix_sec.value()
+	__last_contact_unix_sec_val := overlay_cache_node_last_contact_unix_sec.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, version, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count, audited_unix_sec, audit_reputation, uptime_reputation, vetted, disqualified, first_seen_unix_sec, last_contact_unix_sec ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __version_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __audited_unix_sec_val, __audit_reputation_val, __uptime_reputation_val, __vetted_val, __disqualified_val, __first_seen_unix_sec_val, __last_contact_unix_sec_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __version_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __audited_unix_sec_val, __audit_reputation_val, __uptime_reputation_val, __vetted_val, __disqualified_val, __first_seen_unix_sec_val, __last_contact_unix_sec_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastOverlayCacheNode(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audited_unix_sec, overlay_cache_nodes.audit_reputation, overlay_cache_nodes.uptime_reputation, overlay_cache_nodes.vetted, overlay_cache_nodes.disqualified, overlay_cache_nodes.first_seen_unix_sec, overlay_cache_nodes.last_contact_unix_sec FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditedUnixSec, &overlay_cache_node.AuditReputation, &overlay_cache_node.UptimeReputation, &overlay_cache_node.Vetted, &overlay_cache_node.Disqualified, &overlay_cache_node.FirstSeenUnixSec, &overlay_cache_node.LastContactUnixSec)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+
+}
+
+func (obj *sqlite3Impl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	update OverlayCacheNode_Update_Fields) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.NodeType._set {
+		__values = append(__values, update.NodeType.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("node_type = ?"))
+	}
+
+	if update.Address._set {
+		__values = append(__values, update.Address.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	}
+
+	if update.Protocol._set {
+		__values = append(__values, update.Protocol.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	}
+
+	if update.OperatorEmail._set {
+		__values = append(__values, update.OperatorEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email = ?"))
+	}
+
+	if update.OperatorWallet._set {
+		__values = append(__values, update.OperatorWallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet = ?"))
+	}
+
+	if update.Version._set {
+		__values = append(__values, update.Version.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("version = ?"))
+	}
+
+	if update.FreeBandwidth._set {
+		__values = append(__values, update.FreeBandwidth.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_bandwidth = ?"))
+	}
+
+	if update.FreeDisk._set {
+		__values = append(__values, update.FreeDisk.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	}
+
+	if update.Latency90._set {
+		__values = append(__values, update.Latency90.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	}
+
+	if update.AuditSuccessRatio._set {
+		__values = append(__values, update.AuditSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
+	}
+
+	if update.AuditUptimeRatio._set {
+		__values = append(__values, update.AuditUptimeRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_uptime_ratio = ?"))
+	}
+
+	if update.AuditCount._set {
+		__values = append(__values, update.AuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_count = ?"))
+	}
+
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.UptimeCount._set {
+		__values = append(__values, update.UptimeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_count = ?"))
+	}
+
+	if update.UptimeSuccessCount._set {
+		__values = append(__values, update.UptimeSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	}
+
+	if update.AuditedUnixSec._set {
+		__values = append(__values, update.AuditedUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audited_unix_sec = ?"))
+	}
+
+	if update.AuditReputation._set {
+		__values = append(__values, update.AuditReputation.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation = ?"))
+	}
+
+	if update.UptimeReputation._set {
+		__values = append(__values, update.UptimeReputation.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation = ?"))
+	}
+
+	if update.Vetted._set {
+		__values = append(__values, update.Vetted.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.FirstSeenUnixSec._set {
+		__values = append(__values, update.FirstSeenUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("first_seen_unix_sec = ?"))
+	}
+
+	if update.LastContactUnixSec._set {
+		__values = append(__values, update.LastContactUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_unix_sec = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, overlay_cache_node_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audited_unix_sec, overlay_cache_nodes.audit_reputation, overlay_cache_nodes.uptime_reputation, overlay_cache_nodes.vetted, overlay_cache_nodes.disqualified, overlay_cache_nodes.first_seen_unix_sec, overlay_cache_nodes.last_contact_unix_sec FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditedUnixSec, &overlay_cache_node.AuditReputation, &overlay_cache_node.UptimeReputation, &overlay_cache_node.Vetted, &overlay_cache_node.Disqualified, &overlay_cache_node.FirstSeenUnixSec, &overlay_cache_node.LastContactUnixSec)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+}
+
+func (obj *sqlite3Impl) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastOverlayCacheNode(ctx context.Context,
+	pk int64) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audited_unix_sec, overlay_cache_nodes.audit_reputation, overlay_cache_nodes.uptime_reputation, overlay_cache_nodes.vetted, overlay_cache_nodes.disqualified, overlay_cache_nodes.first_seen_unix_sec, overlay_cache_nodes.last_contact_unix_sec FROM overlay_cache_nodes WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditedUnixSec, &overlay_cache_node.AuditReputation, &overlay_cache_node.UptimeReputation, &overlay_cache_node.Vetted, &overlay_cache_node.Disqualified, &overlay_cache_node.FirstSeenUnixSec, &overlay_cache_node.LastContactUnixSec)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+
+}
+
+func (obj *sqlite3Impl) Create_BwagreementSerial(ctx context.Context,
+	bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+	bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field,
+	bwagreement_serial_total BwagreementSerial_Total_Field) (
+	bwagreement_serial *BwagreementSerial, err error) {
+
+	__serial_number_val := bwagreement_serial_serial_number.value()
+	__storage_node_id_val := bwagreement_serial_storage_node_id.value()
+	__total_val := bwagreement_serial_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreement_serials ( serial_number, storage_node_id, total ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __serial_number_val, __storage_node_id_val, __total_val)
+
+	__res, err := obj.driver.Exec(__stmt, __serial_number_val, __storage_node_id_val, __total_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBwagreementSerial(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx context.Context,
+	bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+	bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field) (
+	bwagreement_serial *BwagreementSerial, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreement_serials.serial_number, bwagreement_serials.storage_node_id, bwagreement_serials.total FROM bwagreement_serials WHERE bwagreement_serials.serial_number = ? AND bwagreement_serials.storage_node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_serial_serial_number.value())
+	__values = append(__values, bwagreement_serial_storage_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement_serial = &BwagreementSerial{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement_serial.SerialNumber, &bwagreement_serial.StorageNodeId, &bwagreement_serial.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_serial, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx context.Context,
+	bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+	bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bwagreement_serials WHERE bwagreement_serials.serial_number = ? AND bwagreement_serials.storage_node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_serial_serial_number.value())
+	__values = append(__values, bwagreement_serial_storage_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastBwagreementSerial(ctx context.Context,
+	pk int64) (
+	bwagreement_serial *BwagreementSerial, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreement_serials.serial_number, bwagreement_serials.storage_node_id, bwagreement_serials.total FROM bwagreement_serials WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bwagreement_serial = &BwagreementSerial{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bwagreement_serial.SerialNumber, &bwagreement_serial.StorageNodeId, &bwagreement_serial.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_serial, nil
+
+}
+
+func (obj *sqlite3Impl) Create_ProjectStorage(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field,
+	project_storage_total ProjectStorage_Total_Field) (
+	project_storage *ProjectStorage, err error) {
+
+	__project_id_val := project_storage_project_id.value()
+	__total_val := project_storage_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_storages ( project_id, total ) VALUES ( ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __total_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __total_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectStorage(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_ProjectStorage_By_ProjectId(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field) (
+	project_storage *ProjectStorage, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_storages.project_id, project_storages.total FROM project_storages WHERE project_storages.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_storage_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_storage = &ProjectStorage{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_storage.ProjectId, &project_storage.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_storage, nil
+
+}
+
+func (obj *sqlite3Impl) Update_ProjectStorage_By_ProjectId(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field,
+	update ProjectStorage_Update_Fields) (
+	project_storage *ProjectStorage, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_storages SET "), __sets, __sqlbundle_Literal(" WHERE project_storages.project_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Total._set {
+		__values = append(__values, update.Total.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_storage_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_storage = &ProjectStorage{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_storages.project_id, project_storages.total FROM project_storages WHERE project_storages.project_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_storage.ProjectId, &project_storage.Total)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_storage, nil
+}
+
+func (obj *sqlite3Impl) Delete_ProjectStorage_By_ProjectId(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_storages WHERE project_storages.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_storage_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastProjectStorage(ctx context.Context,
+	pk int64) (
+	project_storage *ProjectStorage, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_storages.project_id, project_storages.total FROM project_storages WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	project_storage = &ProjectStorage{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_storage.ProjectId, &project_storage.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_storage, nil
+
+}
+
+func (obj *sqlite3Impl) Create_ProjectEgress(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field,
+	project_egress_total ProjectEgress_Total_Field) (
+	project_egress *ProjectEgress, err error) {
+
+	__project_id_val := project_egress_project_id.value()
+	__month_val := project_egress_month.value()
+	__total_val := project_egress_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_egresses ( project_id, month, total ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __month_val, __total_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __month_val, __total_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectEgress(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field) (
+	project_egress *ProjectEgress, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_egresses.project_id, project_egresses.month, project_egresses.total FROM project_egresses WHERE project_egresses.project_id = ? AND project_egresses.month = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_egress_project_id.value())
+	__values = append(__values, project_egress_month.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_egress = &ProjectEgress{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_egress.ProjectId, &project_egress.Month, &project_egress.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_egress, nil
+
+}
+
+func (obj *sqlite3Impl) Update_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field,
+	update ProjectEgress_Update_Fields) (
+	project_egress *ProjectEgress, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_egresses SET "), __sets, __sqlbundle_Literal(" WHERE project_egresses.project_id = ? AND project_egresses.month = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Total._set {
+		__values = append(__values, update.Total.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_egress_project_id.value())
+	__args = append(__args, project_egress_month.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_egress = &ProjectEgress{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_egresses.project_id, project_egresses.month, project_egresses.total FROM project_egresses WHERE project_egresses.project_id = ? AND project_egresses.month = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_egress.ProjectId, &project_egress.Month, &project_egress.Total)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_egress, nil
+}
+
+func (obj *sqlite3Impl) Delete_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_egresses WHERE project_egresses.project_id = ? AND project_egresses.month = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_egress_project_id.value())
+	__values = append(__values, project_egress_month.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastProjectEgress(ctx context.Context,
+	pk int64) (
+	project_egress *ProjectEgress, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_egresses.project_id, project_egresses.month, project_egresses.total FROM project_egresses WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	project_egress = &ProjectEgress{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_egress.ProjectId, &project_egress.Month, &project_egress.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_egress, nil
+
+}
+
+func (obj *sqlite3Impl) Create_BwagreementAllocation(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field,
+	bwagreement_allocation_total BwagreementAllocation_Total_Field) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+
+	__serial_number_val := bwagreement_allocation_serial_number.value()
+	__total_val := bwagreement_allocation_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreement_allocations ( serial_number, total ) VALUES ( ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __serial_number_val, __total_val)
+
+	__res, err := obj.driver.Exec(__stmt, __serial_number_val, __total_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBwagreementAllocation(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreement_allocations.serial_number, bwagreement_allocations.total FROM bwagreement_allocations WHERE bwagreement_allocations.serial_number = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_allocation_serial_number.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement_allocation = &BwagreementAllocation{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement_allocation.SerialNumber, &bwagreement_allocation.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_allocation, nil
+
+}
+
+func (obj *sqlite3Impl) Update_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field,
+	update BwagreementAllocation_Update_Fields) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bwagreement_allocations SET "), __sets, __sqlbundle_Literal(" WHERE bwagreement_allocations.serial_number = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Total._set {
+		__values = append(__values, update.Total.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bwagreement_allocation_serial_number.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement_allocation = &BwagreementAllocation{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT bwagreement_allocations.serial_number, bwagreement_allocations.total FROM bwagreement_allocations WHERE bwagreement_allocations.serial_number = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&bwagreement_allocation.SerialNumber, &bwagreement_allocation.Total)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_allocation, nil
+}
+
+func (obj *sqlite3Impl) Delete_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bwagreement_allocations WHERE bwagreement_allocations.serial_number = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_allocation_serial_number.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastBwagreementAllocation(ctx context.Context,
+	pk int64) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreement_allocations.serial_number, bwagreement_allocations.total FROM bwagreement_allocations WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bwagreement_allocation = &BwagreementAllocation{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bwagreement_allocation.SerialNumber, &bwagreement_allocation.Total)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement_allocation, nil
+
+}
+
+func (obj *sqlite3Impl) Create_AuditCursor(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field,
+	audit_cursor_last_path AuditCursor_LastPath_Field) (
+	audit_cursor *AuditCursor, err error) {
+
+	__name_val := audit_cursor_name.value()
+	__last_path_val := audit_cursor_last_path.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_cursors ( name, last_path ) VALUES ( ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __name_val, __last_path_val)
+
+	__res, err := obj.driver.Exec(__stmt, __name_val, __last_path_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAuditCursor(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_NodeWallet(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field,
+	node_wallet_wallet NodeWallet_Wallet_Field) (
+	node_wallet *NodeWallet, err error) {
+
+	__node_id_val := node_wallet_node_id.value()
+	__wallet_val := node_wallet_wallet.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_wallets ( node_id, wallet ) VALUES ( ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __wallet_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __wallet_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastNodeWallet(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_AuditCursor_By_Name(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field) (
+	audit_cursor *AuditCursor, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_cursors.name, audit_cursors.last_path FROM audit_cursors WHERE audit_cursors.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_cursor_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	audit_cursor = &AuditCursor{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&audit_cursor.Name, &audit_cursor.LastPath)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_cursor, nil
+
+}
+
+func (obj *sqlite3Impl) Get_NodeWallet_By_NodeId(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field) (
+	node_wallet *NodeWallet, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_wallets.node_id, node_wallets.wallet FROM node_wallets WHERE node_wallets.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_wallet_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_wallet = &NodeWallet{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_wallet.NodeId, &node_wallet.Wallet)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_wallet, nil
+
+}
+
+func (obj *sqlite3Impl) Update_AuditCursor_By_Name(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field,
+	update AuditCursor_Update_Fields) (
+	audit_cursor *AuditCursor, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE audit_cursors SET "), __sets, __sqlbundle_Literal(" WHERE audit_cursors.name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.LastPath._set {
+		__values = append(__values, update.LastPath.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_path = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, audit_cursor_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	audit_cursor = &AuditCursor{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT audit_cursors.name, audit_cursors.last_path FROM audit_cursors WHERE audit_cursors.name = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&audit_cursor.Name, &audit_cursor.LastPath)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_cursor, nil
+}
+
+func (obj *sqlite3Impl) Update_NodeWallet_By_NodeId(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field,
+	update NodeWallet_Update_Fields) (
+	node_wallet *NodeWallet, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_wallets SET "), __sets, __sqlbundle_Literal(" WHERE node_wallets.node_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Wallet._set {
+		__values = append(__values, update.Wallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, node_wallet_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_wallet = &NodeWallet{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT node_wallets.node_id, node_wallets.wallet FROM node_wallets WHERE node_wallets.node_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node_wallet.NodeId, &node_wallet.Wallet)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_wallet, nil
+}
+
+func (obj *sqlite3Impl) Delete_AuditCursor_By_Name(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM audit_cursors WHERE audit_cursors.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_cursor_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_NodeWallet_By_NodeId(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_wallets WHERE node_wallets.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_wallet_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) getLastAuditCursor(ctx context.Context,
+	pk int64) (
+	audit_cursor *AuditCursor, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_cursors.name, audit_cursors.last_path FROM audit_cursors WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	audit_cursor = &AuditCursor{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&audit_cursor.Name, &audit_cursor.LastPath)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_cursor, nil
+
+}
+
+func (obj *sqlite3Impl) getLastNodeWallet(ctx context.Context,
+	pk int64) (
+	node_wallet *NodeWallet, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_wallets.node_id, node_wallets.wallet FROM node_wallets WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	node_wallet = &NodeWallet{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node_wallet.NodeId, &node_wallet.Wallet)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_wallet, nil
+
+}
+
+func (impl sqlite3Impl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(sqlite3.Error); ok {
+		if e.Code == sqlite3.ErrConstraint {
+			msg := err.Error()
+			colon := strings.LastIndex(msg, ":")
+			if colon != -1 {
+				return strings.TrimSpace(msg[colon:]), true
+			}
+			return "", true
+		}
+	}
+	return "", false
+}
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
+func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM node_wallets;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM audit_cursors;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_allocations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_egresses;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_storages;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_serials;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM overlay_cache_nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM repairs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM injuredsegments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pending_audits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+
+	return count, nil
+
+}
+
+type Rx struct {
+	db *DB
+	tx *Tx
+}
+
+func (rx *Rx) UnsafeTx(ctx context.Context) (unsafe_tx *sql.Tx, err error) {
+	tx, err := rx.getTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Tx, nil
+}
+
+func (rx *Rx) getTx(ctx context.Context) (tx *Tx, err error) {
+	if rx.tx == nil {
+		if rx.tx, err = rx.db.Open(ctx); err != nil {
+			return nil, err
 		}
-		rows = append(rows, bwagreement)
 	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	return rx.tx, nil
+}
+
+func (rx *Rx) Rebind(s string) string {
+	return rx.db.Rebind(s)
+}
+
+func (rx *Rx) Commit() (err error) {
+	if rx.tx != nil {
+		err = rx.tx.Commit()
+		rx.tx = nil
 	}
-	return rows, nil
+	return err
+}
 
+func (rx *Rx) Rollback() (err error) {
+	if rx.tx != nil {
+		err = rx.tx.Rollback()
+		rx.tx = nil
+	}
+	return err
 }
 
-func (obj *sqlite3Impl) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
-	bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
+func (rx *Rx) All_Bwagreement(ctx context.Context) (
 	rows []*Bwagreement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Bwagreement(ctx)
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE bwagreements.created_at > ?")
+func (rx *Rx) All_Bwagreement_By_CreatedAt_GreaterOrEqual(ctx context.Context,
+	bwagreement_created_at_greater_or_equal Bwagreement_CreatedAt_Field) (
+	rows []*Bwagreement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Bwagreement_By_CreatedAt_GreaterOrEqual(ctx, bwagreement_created_at_greater_or_equal)
+}
 
-	var __values []interface{}
-	__values = append(__values, bwagreement_created_at_greater.value())
+func (rx *Rx) Create_Bwagreement(ctx context.Context,
+	bwagreement_signature Bwagreement_Signature_Field,
+	bwagreement_data Bwagreement_Data_Field) (
+	bwagreement *Bwagreement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_Bwagreement(ctx, bwagreement_signature, bwagreement_data)
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_PendingAudit(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field,
+	pending_audit_path PendingAudit_Path_Field,
+	pending_audit_piece_id PendingAudit_PieceId_Field,
+	pending_audit_stripe_index PendingAudit_StripeIndex_Field,
+	pending_audit_share_size PendingAudit_ShareSize_Field,
+	pending_audit_expected_share_hash PendingAudit_ExpectedShareHash_Field,
+	pending_audit_reverify_count PendingAudit_ReverifyCount_Field) (
+	pending_audit *PendingAudit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	defer __rows.Close()
+	return tx.Create_PendingAudit(ctx, pending_audit_node_id, pending_audit_path, pending_audit_piece_id, pending_audit_stripe_index, pending_audit_share_size, pending_audit_expected_share_hash, pending_audit_reverify_count)
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
+}
+
+func (rx *Rx) Delete_Bwagreement_By_Signature(ctx context.Context,
+	bwagreement_signature Bwagreement_Signature_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	return tx.Delete_Bwagreement_By_Signature(ctx, bwagreement_signature)
+}
+
+func (rx *Rx) Delete_PendingAudit_By_NodeId(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_PendingAudit_By_NodeId(ctx, pending_audit_node_id)
+}
+
+func (rx *Rx) Get_Bwagreement_By_Signature(ctx context.Context,
+	bwagreement_signature Bwagreement_Signature_Field) (
+	bwagreement *Bwagreement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_Bwagreement_By_Signature(ctx, bwagreement_signature)
+}
+
+func (rx *Rx) Get_PendingAudit_By_NodeId(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field) (
+	pending_audit *PendingAudit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_PendingAudit_By_NodeId(ctx, pending_audit_node_id)
+}
+
+func (rx *Rx) Limited_Bwagreement(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Bwagreement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_Bwagreement(ctx, limit, offset)
+}
+
+func (rx *Rx) Update_PendingAudit_By_NodeId(ctx context.Context,
+	pending_audit_node_id PendingAudit_NodeId_Field,
+	update PendingAudit_Update_Fields) (
+	pending_audit *PendingAudit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_PendingAudit_By_NodeId(ctx, pending_audit_node_id, update)
+}
+
+func (rx *Rx) Create_Injuredsegment(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	injuredsegment_data Injuredsegment_Data_Field,
+	injuredsegment_num_healthy Injuredsegment_NumHealthy_Field,
+	injuredsegment_lease_unix_sec Injuredsegment_LeaseUnixSec_Field) (
+	injuredsegment *Injuredsegment, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return rows, nil
+	return tx.Create_Injuredsegment(ctx, injuredsegment_path, injuredsegment_data, injuredsegment_num_healthy, injuredsegment_lease_unix_sec)
+}
 
+func (rx *Rx) Get_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	injuredsegment *Injuredsegment, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_Injuredsegment_By_Path(ctx, injuredsegment_path)
 }
 
-func (obj *sqlite3Impl) Delete_Bwagreement_By_Signature(ctx context.Context,
-	bwagreement_signature Bwagreement_Signature_Field) (
+func (rx *Rx) Update_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	update Injuredsegment_Update_Fields) (
+	injuredsegment *Injuredsegment, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_Injuredsegment_By_Path(ctx, injuredsegment_path, update)
+}
+
+func (rx *Rx) Delete_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
 	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_Injuredsegment_By_Path(ctx, injuredsegment_path)
+}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bwagreements WHERE bwagreements.signature = ?")
+func (rx *Rx) Create_Repair(ctx context.Context,
+	repair_id Repair_Id_Field,
+	repair_path Repair_Path_Field,
+	repair_healthy_pieces Repair_HealthyPieces_Field,
+	repair_rebuilt_pieces Repair_RebuiltPieces_Field,
+	repair_new_nodes Repair_NewNodes_Field,
+	repair_failure Repair_Failure_Field) (
+	repair *Repair, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_Repair(ctx, repair_id, repair_path, repair_healthy_pieces, repair_rebuilt_pieces, repair_new_nodes, repair_failure)
+}
 
-	var __values []interface{}
-	__values = append(__values, bwagreement_signature.value())
+func (rx *Rx) Get_Repair_By_Id(ctx context.Context,
+	repair_id Repair_Id_Field) (
+	repair *Repair, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_Repair_By_Id(ctx, repair_id)
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func (rx *Rx) Delete_Repair_By_Id(ctx context.Context,
+	repair_id Repair_Id_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_Repair_By_Id(ctx, repair_id)
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func (rx *Rx) Create_OverlayCacheNode(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
+	overlay_cache_node_address OverlayCacheNode_Address_Field,
+	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
+	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+	overlay_cache_node_version OverlayCacheNode_Version_Field,
+	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
+	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
+	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
+	overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
+	overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
+	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
+	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
+	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
+	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+	overlay_cache_node_audited_unix_sec OverlayCacheNode_AuditedUnixSec_Field,
+	overlay_cache_node_audit_reputation OverlayCacheNode_AuditReputation_Field,
+	overlay_cache_node_uptime_reputation OverlayCacheNode_UptimeReputation_Field,
+	overlay_cache_node_vetted OverlayCacheNode_Vetted_Field,
+	overlay_cache_node_disqualified OverlayCacheNode_Disqualified_Field,
+	overlay_cache_node_first_seen_unix_sec OverlayCacheNode_FirstSeenUnixSec_Field,
+	overlay_cache_node_last_contact_unix_sec OverlayCacheNode_LastContactUnixSec_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Create_OverlayCacheNode(ctx, overlay_cache_node_node_id, overlay_cache_node_node_type, overlay_cache_node_address, overlay_cache_node_protocol, overlay_cache_node_operator_email, overlay_cache_node_operator_wallet, overlay_cache_node_version, overlay_cache_node_free_bandwidth, overlay_cache_node_free_disk, overlay_cache_node_latency_90, overlay_cache_node_audit_success_ratio, overlay_cache_node_audit_uptime_ratio, overlay_cache_node_audit_count, overlay_cache_node_audit_success_count, overlay_cache_node_uptime_count, overlay_cache_node_uptime_success_count, overlay_cache_node_audited_unix_sec, overlay_cache_node_audit_reputation, overlay_cache_node_uptime_reputation, overlay_cache_node_vetted, overlay_cache_node_disqualified, overlay_cache_node_first_seen_unix_sec, overlay_cache_node_last_contact_unix_sec)
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
+func (rx *Rx) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Get_OverlayCacheNode_By_NodeId(ctx, overlay_cache_node_node_id)
+}
 
-	return __count > 0, nil
+func (rx *Rx) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	update OverlayCacheNode_Update_Fields) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_OverlayCacheNode_By_NodeId(ctx, overlay_cache_node_node_id, update)
+}
 
+func (rx *Rx) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_OverlayCacheNode_By_NodeId(ctx, overlay_cache_node_node_id)
 }
 
-func (obj *sqlite3Impl) getLastBwagreement(ctx context.Context,
-	pk int64) (
-	bwagreement *Bwagreement, err error) {
+func (rx *Rx) Create_BwagreementSerial(ctx context.Context,
+	bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+	bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field,
+	bwagreement_serial_total BwagreementSerial_Total_Field) (
+	bwagreement_serial *BwagreementSerial, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BwagreementSerial(ctx, bwagreement_serial_serial_number, bwagreement_serial_storage_node_id, bwagreement_serial_total)
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.data, bwagreements.created_at FROM bwagreements WHERE _rowid_ = ?")
+func (rx *Rx) Get_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx context.Context,
+	bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+	bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field) (
+	bwagreement_serial *BwagreementSerial, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx, bwagreement_serial_serial_number, bwagreement_serial_storage_node_id)
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+func (rx *Rx) Delete_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx context.Context,
+	bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+	bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx, bwagreement_serial_serial_number, bwagreement_serial_storage_node_id)
+}
 
-	bwagreement = &Bwagreement{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&bwagreement.Signature, &bwagreement.Data, &bwagreement.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_ProjectStorage(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field,
+	project_storage_total ProjectStorage_Total_Field) (
+	project_storage *ProjectStorage, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return bwagreement, nil
+	return tx.Create_ProjectStorage(ctx, project_storage_project_id, project_storage_total)
+}
 
+func (rx *Rx) Get_ProjectStorage_By_ProjectId(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field) (
+	project_storage *ProjectStorage, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ProjectStorage_By_ProjectId(ctx, project_storage_project_id)
 }
 
-func (impl sqlite3Impl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(sqlite3.Error); ok {
-		if e.Code == sqlite3.ErrConstraint {
-			msg := err.Error()
-			colon := strings.LastIndex(msg, ":")
-			if colon != -1 {
-				return strings.TrimSpace(msg[colon:]), true
-			}
-			return "", true
-		}
+func (rx *Rx) Update_ProjectStorage_By_ProjectId(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field,
+	update ProjectStorage_Update_Fields) (
+	project_storage *ProjectStorage, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return "", false
+	return tx.Update_ProjectStorage_By_ProjectId(ctx, project_storage_project_id, update)
 }
 
-func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (rx *Rx) Delete_ProjectStorage_By_ProjectId(ctx context.Context,
+	project_storage_project_id ProjectStorage_ProjectId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Delete_ProjectStorage_By_ProjectId(ctx, project_storage_project_id)
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (rx *Rx) Create_ProjectEgress(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field,
+	project_egress_total ProjectEgress_Total_Field) (
+	project_egress *ProjectEgress, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	count += __count
+	return tx.Create_ProjectEgress(ctx, project_egress_project_id, project_egress_month, project_egress_total)
+}
 
-	return count, nil
+func (rx *Rx) Get_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field) (
+	project_egress *ProjectEgress, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ProjectEgress_By_ProjectId_Month(ctx, project_egress_project_id, project_egress_month)
+}
 
+func (rx *Rx) Update_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field,
+	update ProjectEgress_Update_Fields) (
+	project_egress *ProjectEgress, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectEgress_By_ProjectId_Month(ctx, project_egress_project_id, project_egress_month, update)
 }
 
-type Rx struct {
-	db *DB
-	tx *Tx
+func (rx *Rx) Delete_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+	project_egress_project_id ProjectEgress_ProjectId_Field,
+	project_egress_month ProjectEgress_Month_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_ProjectEgress_By_ProjectId_Month(ctx, project_egress_project_id, project_egress_month)
 }
 
-func (rx *Rx) UnsafeTx(ctx context.Context) (unsafe_tx *sql.Tx, err error) {
-	tx, err := rx.getTx(ctx)
-	if err != nil {
-		return nil, err
+func (rx *Rx) Create_BwagreementAllocation(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field,
+	bwagreement_allocation_total BwagreementAllocation_Total_Field) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return tx.Tx, nil
+	return tx.Create_BwagreementAllocation(ctx, bwagreement_allocation_serial_number, bwagreement_allocation_total)
 }
 
-func (rx *Rx) getTx(ctx context.Context) (tx *Tx, err error) {
-	if rx.tx == nil {
-		if rx.tx, err = rx.db.Open(ctx); err != nil {
-			return nil, err
-		}
+func (rx *Rx) Get_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return rx.tx, nil
+	return tx.Get_BwagreementAllocation_By_SerialNumber(ctx, bwagreement_allocation_serial_number)
 }
 
-func (rx *Rx) Rebind(s string) string {
-	return rx.db.Rebind(s)
+func (rx *Rx) Update_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field,
+	update BwagreementAllocation_Update_Fields) (
+	bwagreement_allocation *BwagreementAllocation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_BwagreementAllocation_By_SerialNumber(ctx, bwagreement_allocation_serial_number, update)
 }
 
-func (rx *Rx) Commit() (err error) {
-	if rx.tx != nil {
-		err = rx.tx.Commit()
-		rx.tx = nil
+func (rx *Rx) Delete_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+	bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return err
+	return tx.Delete_BwagreementAllocation_By_SerialNumber(ctx, bwagreement_allocation_serial_number)
 }
 
-func (rx *Rx) Rollback() (err error) {
-	if rx.tx != nil {
-		err = rx.tx.Rollback()
-		rx.tx = nil
+func (rx *Rx) Create_AuditCursor(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field,
+	audit_cursor_last_path AuditCursor_LastPath_Field) (
+	audit_cursor *AuditCursor, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return err
+	return tx.Create_AuditCursor(ctx, audit_cursor_name, audit_cursor_last_path)
 }
 
-func (rx *Rx) All_Bwagreement(ctx context.Context) (
-	rows []*Bwagreement, err error) {
+func (rx *Rx) Create_NodeWallet(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field,
+	node_wallet_wallet NodeWallet_Wallet_Field) (
+	node_wallet *NodeWallet, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_Bwagreement(ctx)
+	return tx.Create_NodeWallet(ctx, node_wallet_node_id, node_wallet_wallet)
 }
 
-func (rx *Rx) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
-	bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
-	rows []*Bwagreement, err error) {
+func (rx *Rx) Get_AuditCursor_By_Name(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field) (
+	audit_cursor *AuditCursor, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_Bwagreement_By_CreatedAt_Greater(ctx, bwagreement_created_at_greater)
+	return tx.Get_AuditCursor_By_Name(ctx, audit_cursor_name)
 }
 
-func (rx *Rx) Create_Bwagreement(ctx context.Context,
-	bwagreement_signature Bwagreement_Signature_Field,
-	bwagreement_data Bwagreement_Data_Field) (
-	bwagreement *Bwagreement, err error) {
+func (rx *Rx) Get_NodeWallet_By_NodeId(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field) (
+	node_wallet *NodeWallet, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Bwagreement(ctx, bwagreement_signature, bwagreement_data)
+	return tx.Get_NodeWallet_By_NodeId(ctx, node_wallet_node_id)
+}
 
+func (rx *Rx) Update_AuditCursor_By_Name(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field,
+	update AuditCursor_Update_Fields) (
+	audit_cursor *AuditCursor, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_AuditCursor_By_Name(ctx, audit_cursor_name, update)
 }
 
-func (rx *Rx) Delete_Bwagreement_By_Signature(ctx context.Context,
-	bwagreement_signature Bwagreement_Signature_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Update_NodeWallet_By_NodeId(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field,
+	update NodeWallet_Update_Fields) (
+	node_wallet *NodeWallet, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_Bwagreement_By_Signature(ctx, bwagreement_signature)
+	return tx.Update_NodeWallet_By_NodeId(ctx, node_wallet_node_id, update)
 }
 
-func (rx *Rx) Get_Bwagreement_By_Signature(ctx context.Context,
-	bwagreement_signature Bwagreement_Signature_Field) (
-	bwagreement *Bwagreement, err error) {
+func (rx *Rx) Delete_AuditCursor_By_Name(ctx context.Context,
+	audit_cursor_name AuditCursor_Name_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Get_Bwagreement_By_Signature(ctx, bwagreement_signature)
+	return tx.Delete_AuditCursor_By_Name(ctx, audit_cursor_name)
 }
 
-func (rx *Rx) Limited_Bwagreement(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Bwagreement, err error) {
+func (rx *Rx) Delete_NodeWallet_By_NodeId(ctx context.Context,
+	node_wallet_node_id NodeWallet_NodeId_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Limited_Bwagreement(ctx, limit, offset)
+	return tx.Delete_NodeWallet_By_NodeId(ctx, node_wallet_node_id)
 }
 
 type Methods interface {
 	All_Bwagreement(ctx context.Context) (
 		rows []*Bwagreement, err error)
 
-	All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
-		bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
+	All_Bwagreement_By_CreatedAt_GreaterOrEqual(ctx context.Context,
+		bwagreement_created_at_greater_or_equal Bwagreement_CreatedAt_Field) (
 		rows []*Bwagreement, err error)
 
 	Create_Bwagreement(ctx context.Context,
@@ -1182,17 +5898,226 @@ type Methods interface {
 		bwagreement_data Bwagreement_Data_Field) (
 		bwagreement *Bwagreement, err error)
 
+	Create_PendingAudit(ctx context.Context,
+		pending_audit_node_id PendingAudit_NodeId_Field,
+		pending_audit_path PendingAudit_Path_Field,
+		pending_audit_piece_id PendingAudit_PieceId_Field,
+		pending_audit_stripe_index PendingAudit_StripeIndex_Field,
+		pending_audit_share_size PendingAudit_ShareSize_Field,
+		pending_audit_expected_share_hash PendingAudit_ExpectedShareHash_Field,
+		pending_audit_reverify_count PendingAudit_ReverifyCount_Field) (
+		pending_audit *PendingAudit, err error)
+
 	Delete_Bwagreement_By_Signature(ctx context.Context,
 		bwagreement_signature Bwagreement_Signature_Field) (
 		deleted bool, err error)
 
+	Delete_PendingAudit_By_NodeId(ctx context.Context,
+		pending_audit_node_id PendingAudit_NodeId_Field) (
+		deleted bool, err error)
+
 	Get_Bwagreement_By_Signature(ctx context.Context,
 		bwagreement_signature Bwagreement_Signature_Field) (
 		bwagreement *Bwagreement, err error)
 
+	Get_PendingAudit_By_NodeId(ctx context.Context,
+		pending_audit_node_id PendingAudit_NodeId_Field) (
+		pending_audit *PendingAudit, err error)
+
 	Limited_Bwagreement(ctx context.Context,
 		limit int, offset int64) (
 		rows []*Bwagreement, err error)
+
+	Update_PendingAudit_By_NodeId(ctx context.Context,
+		pending_audit_node_id PendingAudit_NodeId_Field,
+		update PendingAudit_Update_Fields) (
+		pending_audit *PendingAudit, err error)
+
+	Create_Injuredsegment(ctx context.Context,
+		injuredsegment_path Injuredsegment_Path_Field,
+		injuredsegment_data Injuredsegment_Data_Field,
+		injuredsegment_num_healthy Injuredsegment_NumHealthy_Field,
+		injuredsegment_lease_unix_sec Injuredsegment_LeaseUnixSec_Field) (
+		injuredsegment *Injuredsegment, err error)
+
+	Get_Injuredsegment_By_Path(ctx context.Context,
+		injuredsegment_path Injuredsegment_Path_Field) (
+		injuredsegment *Injuredsegment, err error)
+
+	Update_Injuredsegment_By_Path(ctx context.Context,
+		injuredsegment_path Injuredsegment_Path_Field,
+		update Injuredsegment_Update_Fields) (
+		injuredsegment *Injuredsegment, err error)
+
+	Delete_Injuredsegment_By_Path(ctx context.Context,
+		injuredsegment_path Injuredsegment_Path_Field) (
+		deleted bool, err error)
+
+	Create_Repair(ctx context.Context,
+		repair_id Repair_Id_Field,
+		repair_path Repair_Path_Field,
+		repair_healthy_pieces Repair_HealthyPieces_Field,
+		repair_rebuilt_pieces Repair_RebuiltPieces_Field,
+		repair_new_nodes Repair_NewNodes_Field,
+		repair_failure Repair_Failure_Field) (
+		repair *Repair, err error)
+
+	Get_Repair_By_Id(ctx context.Context,
+		repair_id Repair_Id_Field) (
+		repair *Repair, err error)
+
+	Delete_Repair_By_Id(ctx context.Context,
+		repair_id Repair_Id_Field) (
+		deleted bool, err error)
+
+	Create_OverlayCacheNode(ctx context.Context,
+		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+		overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
+		overlay_cache_node_address OverlayCacheNode_Address_Field,
+		overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+		overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
+		overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+		overlay_cache_node_version OverlayCacheNode_Version_Field,
+		overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
+		overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
+		overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
+		overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
+		overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
+		overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
+		overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
+		overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
+		overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+		overlay_cache_node_audited_unix_sec OverlayCacheNode_AuditedUnixSec_Field,
+		overlay_cache_node_audit_reputation OverlayCacheNode_AuditReputation_Field,
+		overlay_cache_node_uptime_reputation OverlayCacheNode_UptimeReputation_Field,
+		overlay_cache_node_vetted OverlayCacheNode_Vetted_Field,
+		overlay_cache_node_disqualified OverlayCacheNode_Disqualified_Field,
+		overlay_cache_node_first_seen_unix_sec OverlayCacheNode_FirstSeenUnixSec_Field,
+		overlay_cache_node_last_contact_unix_sec OverlayCacheNode_LastContactUnixSec_Field) (
+		overlay_cache_node *OverlayCacheNode, err error)
+
+	Get_OverlayCacheNode_By_NodeId(ctx context.Context,
+		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+		overlay_cache_node *OverlayCacheNode, err error)
+
+	Update_OverlayCacheNode_By_NodeId(ctx context.Context,
+		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+		update OverlayCacheNode_Update_Fields) (
+		overlay_cache_node *OverlayCacheNode, err error)
+
+	Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
+		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+		deleted bool, err error)
+
+	Create_BwagreementSerial(ctx context.Context,
+		bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+		bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field,
+		bwagreement_serial_total BwagreementSerial_Total_Field) (
+		bwagreement_serial *BwagreementSerial, err error)
+
+	Get_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx context.Context,
+		bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+		bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field) (
+		bwagreement_serial *BwagreementSerial, err error)
+
+	Delete_BwagreementSerial_By_SerialNumber_StorageNodeId(ctx context.Context,
+		bwagreement_serial_serial_number BwagreementSerial_SerialNumber_Field,
+		bwagreement_serial_storage_node_id BwagreementSerial_StorageNodeId_Field) (
+		deleted bool, err error)
+
+	Create_ProjectStorage(ctx context.Context,
+		project_storage_project_id ProjectStorage_ProjectId_Field,
+		project_storage_total ProjectStorage_Total_Field) (
+		project_storage *ProjectStorage, err error)
+
+	Get_ProjectStorage_By_ProjectId(ctx context.Context,
+		project_storage_project_id ProjectStorage_ProjectId_Field) (
+		project_storage *ProjectStorage, err error)
+
+	Update_ProjectStorage_By_ProjectId(ctx context.Context,
+		project_storage_project_id ProjectStorage_ProjectId_Field,
+		update ProjectStorage_Update_Fields) (
+		project_storage *ProjectStorage, err error)
+
+	Delete_ProjectStorage_By_ProjectId(ctx context.Context,
+		project_storage_project_id ProjectStorage_ProjectId_Field) (
+		deleted bool, err error)
+
+	Create_ProjectEgress(ctx context.Context,
+		project_egress_project_id ProjectEgress_ProjectId_Field,
+		project_egress_month ProjectEgress_Month_Field,
+		project_egress_total ProjectEgress_Total_Field) (
+		project_egress *ProjectEgress, err error)
+
+	Get_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+		project_egress_project_id ProjectEgress_ProjectId_Field,
+		project_egress_month ProjectEgress_Month_Field) (
+		project_egress *ProjectEgress, err error)
+
+	Update_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+		project_egress_project_id ProjectEgress_ProjectId_Field,
+		project_egress_month ProjectEgress_Month_Field,
+		update ProjectEgress_Update_Fields) (
+		project_egress *ProjectEgress, err error)
+
+	Delete_ProjectEgress_By_ProjectId_Month(ctx context.Context,
+		project_egress_project_id ProjectEgress_ProjectId_Field,
+		project_egress_month ProjectEgress_Month_Field) (
+		deleted bool, err error)
+
+	Create_BwagreementAllocation(ctx context.Context,
+		bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field,
+		bwagreement_allocation_total BwagreementAllocation_Total_Field) (
+		bwagreement_allocation *BwagreementAllocation, err error)
+
+	Get_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+		bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field) (
+		bwagreement_allocation *BwagreementAllocation, err error)
+
+	Update_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+		bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field,
+		update BwagreementAllocation_Update_Fields) (
+		bwagreement_allocation *BwagreementAllocation, err error)
+
+	Delete_BwagreementAllocation_By_SerialNumber(ctx context.Context,
+		bwagreement_allocation_serial_number BwagreementAllocation_SerialNumber_Field) (
+		deleted bool, err error)
+
+	Create_AuditCursor(ctx context.Context,
+		audit_cursor_name AuditCursor_Name_Field,
+		audit_cursor_last_path AuditCursor_LastPath_Field) (
+		audit_cursor *AuditCursor, err error)
+
+	Get_AuditCursor_By_Name(ctx context.Context,
+		audit_cursor_name AuditCursor_Name_Field) (
+		audit_cursor *AuditCursor, err error)
+
+	Update_AuditCursor_By_Name(ctx context.Context,
+		audit_cursor_name AuditCursor_Name_Field,
+		update AuditCursor_Update_Fields) (
+		audit_cursor *AuditCursor, err error)
+
+	Delete_AuditCursor_By_Name(ctx context.Context,
+		audit_cursor_name AuditCursor_Name_Field) (
+		deleted bool, err error)
+
+	Create_NodeWallet(ctx context.Context,
+		node_wallet_node_id NodeWallet_NodeId_Field,
+		node_wallet_wallet NodeWallet_Wallet_Field) (
+		node_wallet *NodeWallet, err error)
+
+	Get_NodeWallet_By_NodeId(ctx context.Context,
+		node_wallet_node_id NodeWallet_NodeId_Field) (
+		node_wallet *NodeWallet, err error)
+
+	Update_NodeWallet_By_NodeId(ctx context.Context,
+		node_wallet_node_id NodeWallet_NodeId_Field,
+		update NodeWallet_Update_Fields) (
+		node_wallet *NodeWallet, err error)
+
+	Delete_NodeWallet_By_NodeId(ctx context.Context,
+		node_wallet_node_id NodeWallet_NodeId_Field) (
+		deleted bool, err error)
 }
 
 type TxMethods interface {
@@ -6,3 +6,91 @@ CREATE TABLE bwagreements (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( signature )
 );
+CREATE TABLE pending_audits (
+	node_id bytea NOT NULL,
+	path bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	stripe_index bigint NOT NULL,
+	share_size bigint NOT NULL,
+	expected_share_hash bytea NOT NULL,
+	reverify_count bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE injuredsegments (
+	path bytea NOT NULL,
+	data bytea NOT NULL,
+	num_healthy bigint NOT NULL,
+	lease_unix_sec bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( path )
+);
+CREATE TABLE repairs (
+	id bytea NOT NULL,
+	path bytea NOT NULL,
+	healthy_pieces bigint NOT NULL,
+	rebuilt_pieces bigint NOT NULL,
+	new_nodes bytea NOT NULL,
+	failure text NOT NULL,
+	repaired_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE overlay_cache_nodes (
+	node_id bytea NOT NULL,
+	node_type integer NOT NULL,
+	address text NOT NULL,
+	protocol integer NOT NULL,
+	operator_email text NOT NULL,
+	operator_wallet text NOT NULL,
+	version text NOT NULL,
+	free_bandwidth bigint NOT NULL,
+	free_disk bigint NOT NULL,
+	latency_90 bigint NOT NULL,
+	audit_success_ratio double precision NOT NULL,
+	audit_uptime_ratio double precision NOT NULL,
+	audit_count bigint NOT NULL,
+	audit_success_count bigint NOT NULL,
+	uptime_count bigint NOT NULL,
+	uptime_success_count bigint NOT NULL,
+	audited_unix_sec bigint NOT NULL,
+	audit_reputation double precision NOT NULL,
+	uptime_reputation double precision NOT NULL,
+	vetted boolean NOT NULL,
+	disqualified boolean NOT NULL,
+	first_seen_unix_sec bigint NOT NULL,
+	last_contact_unix_sec bigint NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE INDEX overlay_cache_nodes_selection_index ON overlay_cache_nodes ( node_type, free_disk, free_bandwidth, audit_count );
+CREATE TABLE bwagreement_serials (
+	serial_number text NOT NULL,
+	storage_node_id bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( serial_number, storage_node_id )
+);
+CREATE TABLE project_storages (
+	project_id bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_egresses (
+	project_id bytea NOT NULL,
+	month timestamp with time zone NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id, month )
+);
+CREATE TABLE bwagreement_allocations (
+	serial_number text NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
+CREATE TABLE audit_cursors (
+	name text NOT NULL,
+	last_path bytea NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE node_wallets (
+	node_id bytea NOT NULL,
+	wallet text NOT NULL,
+	PRIMARY KEY ( node_id )
+);
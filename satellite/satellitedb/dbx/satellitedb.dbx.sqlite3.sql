@@ -6,3 +6,91 @@ CREATE TABLE bwagreements (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( signature )
 );
+CREATE TABLE pending_audits (
+	node_id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	piece_id BLOB NOT NULL,
+	stripe_index INTEGER NOT NULL,
+	share_size INTEGER NOT NULL,
+	expected_share_hash BLOB NOT NULL,
+	reverify_count INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE injuredsegments (
+	path BLOB NOT NULL,
+	data BLOB NOT NULL,
+	num_healthy INTEGER NOT NULL,
+	lease_unix_sec INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( path )
+);
+CREATE TABLE repairs (
+	id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	healthy_pieces INTEGER NOT NULL,
+	rebuilt_pieces INTEGER NOT NULL,
+	new_nodes BLOB NOT NULL,
+	failure TEXT NOT NULL,
+	repaired_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE overlay_cache_nodes (
+	node_id BLOB NOT NULL,
+	node_type INTEGER NOT NULL,
+	address TEXT NOT NULL,
+	protocol INTEGER NOT NULL,
+	operator_email TEXT NOT NULL,
+	operator_wallet TEXT NOT NULL,
+	version TEXT NOT NULL,
+	free_bandwidth INTEGER NOT NULL,
+	free_disk INTEGER NOT NULL,
+	latency_90 INTEGER NOT NULL,
+	audit_success_ratio REAL NOT NULL,
+	audit_uptime_ratio REAL NOT NULL,
+	audit_count INTEGER NOT NULL,
+	audit_success_count INTEGER NOT NULL,
+	uptime_count INTEGER NOT NULL,
+	uptime_success_count INTEGER NOT NULL,
+	audited_unix_sec INTEGER NOT NULL,
+	audit_reputation REAL NOT NULL,
+	uptime_reputation REAL NOT NULL,
+	vetted INTEGER NOT NULL,
+	disqualified INTEGER NOT NULL,
+	first_seen_unix_sec INTEGER NOT NULL,
+	last_contact_unix_sec INTEGER NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE INDEX overlay_cache_nodes_selection_index ON overlay_cache_nodes ( node_type, free_disk, free_bandwidth, audit_count );
+CREATE TABLE bwagreement_serials (
+	serial_number TEXT NOT NULL,
+	storage_node_id BLOB NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( serial_number, storage_node_id )
+);
+CREATE TABLE project_storages (
+	project_id BLOB NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_egresses (
+	project_id BLOB NOT NULL,
+	month TIMESTAMP NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( project_id, month )
+);
+CREATE TABLE bwagreement_allocations (
+	serial_number TEXT NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
+CREATE TABLE audit_cursors (
+	name TEXT NOT NULL,
+	last_path BLOB NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE node_wallets (
+	node_id BLOB NOT NULL,
+	wallet TEXT NOT NULL,
+	PRIMARY KEY ( node_id )
+);
@@ -0,0 +1,245 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"czarcoin.org/czarcoin/internal/migrate"
+)
+
+// tables of the master database
+const (
+	postgresBwagreements = `CREATE TABLE bwagreements (
+	signature bytea NOT NULL,
+	data bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( signature )
+);`
+	postgresPendingAudits = `CREATE TABLE pending_audits (
+	node_id bytea NOT NULL,
+	path bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	stripe_index bigint NOT NULL,
+	share_size bigint NOT NULL,
+	expected_share_hash bytea NOT NULL,
+	reverify_count bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);`
+	postgresInjuredSegments = `CREATE TABLE injuredsegments (
+	path bytea NOT NULL,
+	data bytea NOT NULL,
+	num_healthy bigint NOT NULL,
+	lease_unix_sec bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( path )
+);`
+	postgresRepairs = `CREATE TABLE repairs (
+	id bytea NOT NULL,
+	path bytea NOT NULL,
+	healthy_pieces bigint NOT NULL,
+	rebuilt_pieces bigint NOT NULL,
+	new_nodes bytea NOT NULL,
+	failure text NOT NULL,
+	repaired_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);`
+	postgresOverlayCacheNodes = `CREATE TABLE overlay_cache_nodes (
+	node_id bytea NOT NULL,
+	node_type integer NOT NULL,
+	address text NOT NULL,
+	protocol integer NOT NULL,
+	operator_email text NOT NULL,
+	operator_wallet text NOT NULL,
+	version text NOT NULL,
+	free_bandwidth bigint NOT NULL,
+	free_disk bigint NOT NULL,
+	latency_90 bigint NOT NULL,
+	audit_success_ratio double precision NOT NULL,
+	audit_uptime_ratio double precision NOT NULL,
+	audit_count bigint NOT NULL,
+	audit_success_count bigint NOT NULL,
+	uptime_count bigint NOT NULL,
+	uptime_success_count bigint NOT NULL,
+	audited_unix_sec bigint NOT NULL,
+	audit_reputation double precision NOT NULL,
+	uptime_reputation double precision NOT NULL,
+	vetted boolean NOT NULL,
+	disqualified boolean NOT NULL,
+	first_seen_unix_sec bigint NOT NULL,
+	last_contact_unix_sec bigint NOT NULL,
+	PRIMARY KEY ( node_id )
+);`
+	postgresOverlayCacheNodesIndex = `CREATE INDEX overlay_cache_nodes_selection_index ON overlay_cache_nodes ( node_type, free_disk, free_bandwidth, audit_count );`
+	postgresBwagreementSerials     = `CREATE TABLE bwagreement_serials (
+	serial_number text NOT NULL,
+	storage_node_id bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( serial_number, storage_node_id )
+);`
+	postgresBwagreementAllocations = `CREATE TABLE bwagreement_allocations (
+	serial_number text NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( serial_number )
+);`
+	postgresProjectStorages = `CREATE TABLE project_storages (
+	project_id bytea NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id )
+);`
+	postgresProjectEgresses = `CREATE TABLE project_egresses (
+	project_id bytea NOT NULL,
+	month timestamp with time zone NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( project_id, month )
+);`
+	postgresAuditCursors = `CREATE TABLE audit_cursors (
+	name text NOT NULL,
+	last_path bytea NOT NULL,
+	PRIMARY KEY ( name )
+);`
+	postgresNodeWallets = `CREATE TABLE node_wallets (
+	node_id bytea NOT NULL,
+	wallet text NOT NULL,
+	PRIMARY KEY ( node_id )
+);`
+
+	sqliteBwagreements = `CREATE TABLE bwagreements (
+	signature BLOB NOT NULL,
+	data BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( signature )
+);`
+	sqlitePendingAudits = `CREATE TABLE pending_audits (
+	node_id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	piece_id BLOB NOT NULL,
+	stripe_index INTEGER NOT NULL,
+	share_size INTEGER NOT NULL,
+	expected_share_hash BLOB NOT NULL,
+	reverify_count INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);`
+	sqliteInjuredSegments = `CREATE TABLE injuredsegments (
+	path BLOB NOT NULL,
+	data BLOB NOT NULL,
+	num_healthy INTEGER NOT NULL,
+	lease_unix_sec INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( path )
+);`
+	sqliteRepairs = `CREATE TABLE repairs (
+	id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	healthy_pieces INTEGER NOT NULL,
+	rebuilt_pieces INTEGER NOT NULL,
+	new_nodes BLOB NOT NULL,
+	failure TEXT NOT NULL,
+	repaired_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);`
+	sqliteOverlayCacheNodes = `CREATE TABLE overlay_cache_nodes (
+	node_id BLOB NOT NULL,
+	node_type INTEGER NOT NULL,
+	address TEXT NOT NULL,
+	protocol INTEGER NOT NULL,
+	operator_email TEXT NOT NULL,
+	operator_wallet TEXT NOT NULL,
+	version TEXT NOT NULL,
+	free_bandwidth INTEGER NOT NULL,
+	free_disk INTEGER NOT NULL,
+	latency_90 INTEGER NOT NULL,
+	audit_success_ratio REAL NOT NULL,
+	audit_uptime_ratio REAL NOT NULL,
+	audit_count INTEGER NOT NULL,
+	audit_success_count INTEGER NOT NULL,
+	uptime_count INTEGER NOT NULL,
+	uptime_success_count INTEGER NOT NULL,
+	audited_unix_sec INTEGER NOT NULL,
+	audit_reputation REAL NOT NULL,
+	uptime_reputation REAL NOT NULL,
+	vetted INTEGER NOT NULL,
+	disqualified INTEGER NOT NULL,
+	first_seen_unix_sec INTEGER NOT NULL,
+	last_contact_unix_sec INTEGER NOT NULL,
+	PRIMARY KEY ( node_id )
+);`
+	sqliteOverlayCacheNodesIndex = `CREATE INDEX overlay_cache_nodes_selection_index ON overlay_cache_nodes ( node_type, free_disk, free_bandwidth, audit_count );`
+	sqliteBwagreementSerials     = `CREATE TABLE bwagreement_serials (
+	serial_number TEXT NOT NULL,
+	storage_node_id BLOB NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( serial_number, storage_node_id )
+);`
+	sqliteBwagreementAllocations = `CREATE TABLE bwagreement_allocations (
+	serial_number TEXT NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( serial_number )
+);`
+	sqliteProjectStorages = `CREATE TABLE project_storages (
+	project_id BLOB NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( project_id )
+);`
+	sqliteProjectEgresses = `CREATE TABLE project_egresses (
+	project_id BLOB NOT NULL,
+	month TIMESTAMP NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( project_id, month )
+);`
+	sqliteAuditCursors = `CREATE TABLE audit_cursors (
+	name TEXT NOT NULL,
+	last_path BLOB NOT NULL,
+	PRIMARY KEY ( name )
+);`
+	sqliteNodeWallets = `CREATE TABLE node_wallets (
+	node_id BLOB NOT NULL,
+	wallet TEXT NOT NULL,
+	PRIMARY KEY ( node_id )
+);`
+)
+
+// postgresBaseline is the master database schema created before the audit,
+// repair, overlay cache, bandwidth agreement, project usage and wallet tables
+const postgresBaseline = postgresBwagreements
+
+// sqliteBaseline is the master database schema created before the audit,
+// repair, overlay cache, bandwidth agreement, project usage and wallet tables
+const sqliteBaseline = sqliteBwagreements
+
+// migrations upgrade master databases of the baseline schema
+var migrations = []migrate.Migration{
+	{
+		From: postgresBaseline,
+		Steps: []string{
+			postgresPendingAudits,
+			postgresInjuredSegments,
+			postgresRepairs,
+			postgresOverlayCacheNodes,
+			postgresOverlayCacheNodesIndex,
+			postgresBwagreementSerials,
+			postgresProjectStorages,
+			postgresProjectEgresses,
+			postgresBwagreementAllocations,
+			postgresAuditCursors,
+			postgresNodeWallets,
+		},
+	},
+	{
+		From: sqliteBaseline,
+		Steps: []string{
+			sqlitePendingAudits,
+			sqliteInjuredSegments,
+			sqliteRepairs,
+			sqliteOverlayCacheNodes,
+			sqliteOverlayCacheNodesIndex,
+			sqliteBwagreementSerials,
+			sqliteProjectStorages,
+			sqliteProjectEgresses,
+			sqliteBwagreementAllocations,
+			sqliteAuditCursors,
+			sqliteNodeWallets,
+		},
+	},
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/audit"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+)
+
+func TestMigrations(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	fresh, err := NewDB("sqlite3://file:migrationfresh?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(fresh.Close)
+	if err := fresh.CreateTables(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := NewDB("sqlite3://file:migrationbaseline?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(db.Close)
+
+	// a master database created with the baseline schema
+	for _, query := range []string{`CREATE TABLE table_schemas (id text, schemaText text);`, sqliteBaseline} {
+		if _, err := db.db.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = db.db.Exec(`INSERT INTO table_schemas(id, schemaText) VALUES (?, ?);`, "database", sqliteBaseline)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.CreateTables(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Containment().Get(ctx, testczarcoin.NodeIDFromString("node"))
+	assert.True(t, audit.ErrContainedNotFound.Has(err))
+
+	_, err = db.RepairQueueDB().Peek(ctx, 1)
+	assert.NoError(t, err)
+
+	_, err = db.RepairHistory().List(ctx, "", 1, 0)
+	assert.NoError(t, err)
+
+	_, err = db.OverlayCacheDB().Count(ctx)
+	assert.NoError(t, err)
+
+	_, err = db.ProjectUsage().GetStorage(ctx, uuid.UUID{})
+	assert.NoError(t, err)
+
+	_, err = db.AuditCursor().LastPath(ctx)
+	assert.NoError(t, err)
+
+	_, err = db.NodeWallets().GetWallets(ctx, czarcoin.NodeIDList{testczarcoin.NodeIDFromString("node")})
+	assert.NoError(t, err)
+
+	// the migrated database has the structure of a new one
+	assert.Equal(t, sqliteStructure(t, fresh), sqliteStructure(t, db))
+}
+
+// sqliteStructure describes the columns and indexes of each table of the
+// database, independent of the order the columns were added in
+func sqliteStructure(t *testing.T, db *DB) map[string][]string {
+	rows, err := db.db.Query(`SELECT type, tbl_name, COALESCE(sql, '') FROM sqlite_master WHERE tbl_name != 'table_schemas'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	structure := make(map[string][]string)
+	for rows.Next() {
+		var kind, table, sql string
+		if err := rows.Scan(&kind, &table, &sql); err != nil {
+			t.Fatal(err)
+		}
+		if kind == "index" {
+			structure[table] = append(structure[table], "index "+sql)
+		} else {
+			structure[table] = append(structure[table], kind)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for table := range structure {
+		columns, err := db.db.Query(`PRAGMA table_info(` + table + `)`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for columns.Next() {
+			var cid, notNull, pk int
+			var name, kind string
+			var defaultValue interface{}
+			if err := columns.Scan(&cid, &name, &kind, &notNull, &defaultValue, &pk); err != nil {
+				t.Fatal(err)
+			}
+			structure[table] = append(structure[table], fmt.Sprint("column ", name, " ", kind, " notnull=", notNull, " pk=", pk))
+		}
+		if err := columns.Close(); err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(structure[table])
+	}
+	return structure
+}
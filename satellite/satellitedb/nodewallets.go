@@ -0,0 +1,71 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"strings"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/utils"
+	dbx "czarcoin.org/czarcoin/satellite/satellitedb/dbx"
+)
+
+type nodeWallets struct {
+	db *dbx.DB
+}
+
+// SetWallet saves the wallet of the node
+func (wallets *nodeWallets) SetWallet(ctx context.Context, nodeID czarcoin.NodeID, wallet string) error {
+	_, err := wallets.db.ExecContext(ctx, wallets.db.Rebind(
+		`INSERT INTO node_wallets (node_id, wallet) VALUES (?, ?)
+		ON CONFLICT (node_id) DO UPDATE SET wallet = excluded.wallet`),
+		nodeID.Bytes(), wallet)
+	return Error.Wrap(err)
+}
+
+// GetWallets returns the wallets of the nodes, nodes without one are left out
+func (wallets *nodeWallets) GetWallets(ctx context.Context, nodeIDs czarcoin.NodeIDList) (map[czarcoin.NodeID]string, error) {
+	found := make(map[czarcoin.NodeID]string, len(nodeIDs))
+	for start := 0; start < len(nodeIDs); start += overlayCacheBatch {
+		end := start + overlayCacheBatch
+		if end > len(nodeIDs) {
+			end = len(nodeIDs)
+		}
+		if err := wallets.getBatch(ctx, nodeIDs[start:end], found); err != nil {
+			return nil, err
+		}
+	}
+	return found, nil
+}
+
+// getBatch adds the wallets of nodeIDs to found
+func (wallets *nodeWallets) getBatch(ctx context.Context, nodeIDs czarcoin.NodeIDList, found map[czarcoin.NodeID]string) (err error) {
+	args := make([]interface{}, len(nodeIDs))
+	for i, id := range nodeIDs {
+		args[i] = id.Bytes()
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(nodeIDs)), ", ")
+
+	rows, err := wallets.db.QueryContext(ctx, wallets.db.Rebind(
+		`SELECT node_id, wallet FROM node_wallets WHERE node_id IN (`+placeholders+`)`), args...)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = utils.CombineErrors(err, rows.Close()) }()
+
+	for rows.Next() {
+		var id []byte
+		var wallet string
+		if err := rows.Scan(&id, &wallet); err != nil {
+			return Error.Wrap(err)
+		}
+		nodeID, err := czarcoin.NodeIDFromBytes(id)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		found[nodeID] = wallet
+	}
+	return Error.Wrap(rows.Err())
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+)
+
+func TestNodeWallets(t *testing.T) {
+	testDrivers(t, func(ctx *testcontext.Context, t *testing.T, db *DB) {
+		if !assert.NoError(t, db.CreateTables()) {
+			return
+		}
+		wallets := db.NodeWallets()
+
+		node1 := testczarcoin.NodeIDFromString("node1")
+		node2 := testczarcoin.NodeIDFromString("node2")
+		missing := testczarcoin.NodeIDFromString("missing")
+
+		assert.NoError(t, wallets.SetWallet(ctx, node1, "0x1"))
+		assert.NoError(t, wallets.SetWallet(ctx, node2, "0x2"))
+		// check-ins with a new wallet replace the previous one
+		assert.NoError(t, wallets.SetWallet(ctx, node1, "0x3"))
+
+		found, err := wallets.GetWallets(ctx, czarcoin.NodeIDList{node1, node2, missing})
+		assert.NoError(t, err)
+		assert.Equal(t, map[czarcoin.NodeID]string{node1: "0x3", node2: "0x2"}, found)
+
+		found, err = wallets.GetWallets(ctx, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, found)
+	})
+}
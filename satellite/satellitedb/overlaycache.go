@@ -0,0 +1,296 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/utils"
+	dbx "czarcoin.org/czarcoin/satellite/satellitedb/dbx"
+	"czarcoin.org/czarcoin/storage"
+)
+
+// overlayCacheColumns are the columns of overlay_cache_nodes in the order scanned by scanOverlayCacheNodes
+const overlayCacheColumns = `node_id, node_type, address, protocol, operator_email, operator_wallet, version,
+	free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count,
+	audit_success_count, uptime_count, uptime_success_count, audited_unix_sec,
+	audit_reputation, uptime_reputation, vetted, disqualified,
+	first_seen_unix_sec, last_contact_unix_sec`
+
+// overlayCacheBatch is the number of nodes GetAll selects at once, it stays
+// below the 999 variables sqlite allows in a statement
+const overlayCacheBatch = 500
+
+type overlayCacheDB struct {
+	db *dbx.DB
+}
+
+// Get looks up the node by nodeID
+func (cache *overlayCacheDB) Get(ctx context.Context, nodeID czarcoin.NodeID) (*pb.Node, error) {
+	node, err := cache.db.Get_OverlayCacheNode_By_NodeId(ctx, dbx.OverlayCacheNode_NodeId(nodeID.Bytes()))
+	if err != nil {
+		if dbxErr, ok := errs.Unwrap(err).(*dbx.Error); ok && dbxErr.Code == dbx.ErrorCode_NoRows {
+			return nil, storage.ErrKeyNotFound.New("node %s", nodeID)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return convertOverlayCacheNode(node)
+}
+
+// GetAll looks up the nodes by nodeIDs, nodes which aren't found are nil
+func (cache *overlayCacheDB) GetAll(ctx context.Context, nodeIDs czarcoin.NodeIDList) ([]*pb.Node, error) {
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+
+	byID := make(map[czarcoin.NodeID]*pb.Node, len(nodeIDs))
+	for start := 0; start < len(nodeIDs); start += overlayCacheBatch {
+		end := start + overlayCacheBatch
+		if end > len(nodeIDs) {
+			end = len(nodeIDs)
+		}
+		found, err := cache.getBatch(ctx, nodeIDs[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range found {
+			byID[node.Id] = node
+		}
+	}
+
+	nodes := make([]*pb.Node, len(nodeIDs))
+	for i, id := range nodeIDs {
+		nodes[i] = byID[id]
+	}
+	return nodes, nil
+}
+
+// getBatch selects the cached nodes out of nodeIDs
+func (cache *overlayCacheDB) getBatch(ctx context.Context, nodeIDs czarcoin.NodeIDList) ([]*pb.Node, error) {
+	args := make([]interface{}, len(nodeIDs))
+	for i, id := range nodeIDs {
+		args[i] = id.Bytes()
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(nodeIDs)), ", ")
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(
+		`SELECT `+overlayCacheColumns+` FROM overlay_cache_nodes
+		WHERE node_id IN (`+placeholders+`)`), args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanOverlayCacheNodes(rows)
+}
+
+// List lists up to limit nodes in node ID order, starting with start, a
+// limit of 0 lists all nodes
+func (cache *overlayCacheDB) List(ctx context.Context, start czarcoin.NodeID, limit int) ([]*pb.Node, error) {
+	var rows *sql.Rows
+	var err error
+	if limit <= 0 {
+		rows, err = cache.db.QueryContext(ctx, cache.db.Rebind(
+			`SELECT `+overlayCacheColumns+` FROM overlay_cache_nodes
+			WHERE node_id >= ? ORDER BY node_id`), start.Bytes())
+	} else {
+		rows, err = cache.db.QueryContext(ctx, cache.db.Rebind(
+			`SELECT `+overlayCacheColumns+` FROM overlay_cache_nodes
+			WHERE node_id >= ? ORDER BY node_id LIMIT ?`), start.Bytes(), limit)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanOverlayCacheNodes(rows)
+}
+
+// FindStorageNodes returns the storage nodes which meet the criteria, up to
+// the limit of the criteria picked at random
+func (cache *overlayCacheDB) FindStorageNodes(ctx context.Context, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
+	query := `SELECT ` + overlayCacheColumns + ` FROM overlay_cache_nodes
+		WHERE node_type = ? AND free_disk >= ? AND free_bandwidth >= ? AND audit_count >= ?
+		AND audit_reputation >= ? AND uptime_count >= ? AND uptime_reputation >= ? AND disqualified = ?`
+	args := []interface{}{int(pb.NodeType_STORAGE), criteria.FreeDisk, criteria.FreeBandwidth, criteria.AuditCount,
+		criteria.AuditReputation, criteria.UptimeCount, criteria.UptimeReputation, false}
+
+	if criteria.Vetted {
+		query += ` AND vetted = ?`
+		args = append(args, true)
+	}
+	if criteria.Unvetted {
+		query += ` AND vetted = ?`
+		args = append(args, false)
+	}
+	if len(criteria.Excluded) > 0 {
+		query += ` AND node_id NOT IN (` + strings.TrimSuffix(strings.Repeat("?, ", len(criteria.Excluded)), ", ") + `)`
+		for _, id := range criteria.Excluded {
+			args = append(args, id.Bytes())
+		}
+	}
+	if criteria.Limit > 0 {
+		query += ` ORDER BY random() LIMIT ?`
+		args = append(args, criteria.Limit)
+	}
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(query), args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanOverlayCacheNodes(rows)
+}
+
+// Count returns the number of cached nodes
+func (cache *overlayCacheDB) Count(ctx context.Context) (count int, err error) {
+	err = cache.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM overlay_cache_nodes`).Scan(&count)
+	return count, Error.Wrap(err)
+}
+
+// Update creates or replaces the node
+func (cache *overlayCacheDB) Update(ctx context.Context, node *pb.Node) error {
+	address := node.GetAddress()
+	restrictions := node.GetRestrictions()
+	reputation := node.GetReputation()
+	metadata := node.GetMetadata()
+
+	updated, err := cache.db.Update_OverlayCacheNode_By_NodeId(ctx,
+		dbx.OverlayCacheNode_NodeId(node.Id.Bytes()),
+		dbx.OverlayCacheNode_Update_Fields{
+			NodeType:           dbx.OverlayCacheNode_NodeType(int(node.Type)),
+			Address:            dbx.OverlayCacheNode_Address(address.GetAddress()),
+			Protocol:           dbx.OverlayCacheNode_Protocol(int(address.GetTransport())),
+			OperatorEmail:      dbx.OverlayCacheNode_OperatorEmail(metadata.GetEmail()),
+			OperatorWallet:     dbx.OverlayCacheNode_OperatorWallet(metadata.GetWallet()),
+			Version:            dbx.OverlayCacheNode_Version(metadata.GetVersion()),
+			FreeBandwidth:      dbx.OverlayCacheNode_FreeBandwidth(restrictions.GetFreeBandwidth()),
+			FreeDisk:           dbx.OverlayCacheNode_FreeDisk(restrictions.GetFreeDisk()),
+			Latency90:          dbx.OverlayCacheNode_Latency90(reputation.GetLatency_90()),
+			AuditSuccessRatio:  dbx.OverlayCacheNode_AuditSuccessRatio(reputation.GetAuditSuccessRatio()),
+			AuditUptimeRatio:   dbx.OverlayCacheNode_AuditUptimeRatio(reputation.GetUptimeRatio()),
+			AuditCount:         dbx.OverlayCacheNode_AuditCount(reputation.GetAuditCount()),
+			AuditSuccessCount:  dbx.OverlayCacheNode_AuditSuccessCount(reputation.GetAuditSuccessCount()),
+			UptimeCount:        dbx.OverlayCacheNode_UptimeCount(reputation.GetUptimeCount()),
+			UptimeSuccessCount: dbx.OverlayCacheNode_UptimeSuccessCount(reputation.GetUptimeSuccessCount()),
+			AuditedUnixSec:     dbx.OverlayCacheNode_AuditedUnixSec(reputation.GetAuditedUnixSec()),
+			AuditReputation:    dbx.OverlayCacheNode_AuditReputation(reputation.GetAuditReputation()),
+			UptimeReputation:   dbx.OverlayCacheNode_UptimeReputation(reputation.GetUptimeReputation()),
+			Vetted:             dbx.OverlayCacheNode_Vetted(reputation.GetVetted()),
+			Disqualified:       dbx.OverlayCacheNode_Disqualified(reputation.GetDisqualified()),
+			FirstSeenUnixSec:   dbx.OverlayCacheNode_FirstSeenUnixSec(node.FirstSeenUnixSec),
+			LastContactUnixSec: dbx.OverlayCacheNode_LastContactUnixSec(node.LastContactUnixSec),
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated != nil {
+		return nil
+	}
+
+	_, err = cache.db.Create_OverlayCacheNode(ctx,
+		dbx.OverlayCacheNode_NodeId(node.Id.Bytes()),
+		dbx.OverlayCacheNode_NodeType(int(node.Type)),
+		dbx.OverlayCacheNode_Address(address.GetAddress()),
+		dbx.OverlayCacheNode_Protocol(int(address.GetTransport())),
+		dbx.OverlayCacheNode_OperatorEmail(metadata.GetEmail()),
+		dbx.OverlayCacheNode_OperatorWallet(metadata.GetWallet()),
+		dbx.OverlayCacheNode_Version(metadata.GetVersion()),
+		dbx.OverlayCacheNode_FreeBandwidth(restrictions.GetFreeBandwidth()),
+		dbx.OverlayCacheNode_FreeDisk(restrictions.GetFreeDisk()),
+		dbx.OverlayCacheNode_Latency90(reputation.GetLatency_90()),
+		dbx.OverlayCacheNode_AuditSuccessRatio(reputation.GetAuditSuccessRatio()),
+		dbx.OverlayCacheNode_AuditUptimeRatio(reputation.GetUptimeRatio()),
+		dbx.OverlayCacheNode_AuditCount(reputation.GetAuditCount()),
+		dbx.OverlayCacheNode_AuditSuccessCount(reputation.GetAuditSuccessCount()),
+		dbx.OverlayCacheNode_UptimeCount(reputation.GetUptimeCount()),
+		dbx.OverlayCacheNode_UptimeSuccessCount(reputation.GetUptimeSuccessCount()),
+		dbx.OverlayCacheNode_AuditedUnixSec(reputation.GetAuditedUnixSec()),
+		dbx.OverlayCacheNode_AuditReputation(reputation.GetAuditReputation()),
+		dbx.OverlayCacheNode_UptimeReputation(reputation.GetUptimeReputation()),
+		dbx.OverlayCacheNode_Vetted(reputation.GetVetted()),
+		dbx.OverlayCacheNode_Disqualified(reputation.GetDisqualified()),
+		dbx.OverlayCacheNode_FirstSeenUnixSec(node.FirstSeenUnixSec),
+		dbx.OverlayCacheNode_LastContactUnixSec(node.LastContactUnixSec),
+	)
+	return Error.Wrap(err)
+}
+
+// Delete deletes the node
+func (cache *overlayCacheDB) Delete(ctx context.Context, nodeID czarcoin.NodeID) error {
+	_, err := cache.db.Delete_OverlayCacheNode_By_NodeId(ctx, dbx.OverlayCacheNode_NodeId(nodeID.Bytes()))
+	return Error.Wrap(err)
+}
+
+// scanOverlayCacheNodes reads and closes rows selecting overlayCacheColumns
+func scanOverlayCacheNodes(rows *sql.Rows) (nodes []*pb.Node, err error) {
+	defer func() { err = utils.CombineErrors(err, rows.Close()) }()
+
+	for rows.Next() {
+		n := &dbx.OverlayCacheNode{}
+		err := rows.Scan(&n.NodeId, &n.NodeType, &n.Address, &n.Protocol, &n.OperatorEmail, &n.OperatorWallet, &n.Version,
+			&n.FreeBandwidth, &n.FreeDisk, &n.Latency90, &n.AuditSuccessRatio, &n.AuditUptimeRatio, &n.AuditCount,
+			&n.AuditSuccessCount, &n.UptimeCount, &n.UptimeSuccessCount, &n.AuditedUnixSec,
+			&n.AuditReputation, &n.UptimeReputation, &n.Vetted, &n.Disqualified,
+			&n.FirstSeenUnixSec, &n.LastContactUnixSec)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		node, err := convertOverlayCacheNode(n)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
+// convertOverlayCacheNode converts a database row into a node
+func convertOverlayCacheNode(n *dbx.OverlayCacheNode) (*pb.Node, error) {
+	id, err := czarcoin.NodeIDFromBytes(n.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	node := &pb.Node{
+		Id:   id,
+		Type: pb.NodeType(n.NodeType),
+		Address: &pb.NodeAddress{
+			Address:   n.Address,
+			Transport: pb.NodeTransport(n.Protocol),
+		},
+		Restrictions: &pb.NodeRestrictions{
+			FreeBandwidth: n.FreeBandwidth,
+			FreeDisk:      n.FreeDisk,
+		},
+		Reputation: &pb.NodeStats{
+			NodeId:             id,
+			Latency_90:         n.Latency90,
+			AuditSuccessRatio:  n.AuditSuccessRatio,
+			UptimeRatio:        n.AuditUptimeRatio,
+			AuditCount:         n.AuditCount,
+			AuditSuccessCount:  n.AuditSuccessCount,
+			UptimeCount:        n.UptimeCount,
+			UptimeSuccessCount: n.UptimeSuccessCount,
+			AuditedUnixSec:     n.AuditedUnixSec,
+			AuditReputation:    n.AuditReputation,
+			UptimeReputation:   n.UptimeReputation,
+			Vetted:             n.Vetted,
+			Disqualified:       n.Disqualified,
+		},
+		FirstSeenUnixSec:   n.FirstSeenUnixSec,
+		LastContactUnixSec: n.LastContactUnixSec,
+	}
+	if n.OperatorEmail != "" || n.OperatorWallet != "" || n.Version != "" {
+		node.Metadata = &pb.NodeMetadata{
+			Email:   n.OperatorEmail,
+			Wallet:  n.OperatorWallet,
+			Version: n.Version,
+		}
+	}
+	return node, nil
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/overlay"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/storage"
+	"czarcoin.org/czarcoin/storage/teststore"
+)
+
+func TestOverlayCache(t *testing.T) {
+	testDrivers(t, func(ctx *testcontext.Context, t *testing.T, db *DB) {
+		if !assert.NoError(t, db.CreateTables()) {
+			return
+		}
+		cache := db.OverlayCacheDB()
+
+		newNode := func(name string, freeDisk, auditCount int64) *pb.Node {
+			id := testczarcoin.NodeIDFromString(name)
+			return &pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: name + ":7777"},
+				Restrictions: &pb.NodeRestrictions{FreeDisk: freeDisk, FreeBandwidth: 100},
+				Reputation:   &pb.NodeStats{NodeId: id, AuditCount: auditCount, AuditSuccessRatio: 1, AuditReputation: 1, UptimeReputation: 1, Vetted: auditCount >= 100},
+				Metadata:     &pb.NodeMetadata{Email: name + "@example.com", Version: "v0.1.0"},
+
+				FirstSeenUnixSec: 10,
+			}
+		}
+
+		small := newNode("small", 10, 100)
+		large := newNode("large", 1000, 100)
+		fresh := newNode("fresh", 1000, 0)
+		for _, node := range []*pb.Node{small, large, fresh} {
+			assert.NoError(t, cache.Update(ctx, node))
+		}
+
+		_, err := cache.Get(ctx, testczarcoin.NodeIDFromString("missing"))
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+		got, err := cache.Get(ctx, large.Id)
+		if assert.NoError(t, err) {
+			assertEqualNodes(t, large, got)
+		}
+
+		// updating replaces the entry
+		large.LastContactUnixSec = 20
+		assert.NoError(t, cache.Update(ctx, large))
+		got, err = cache.Get(ctx, large.Id)
+		if assert.NoError(t, err) {
+			assert.EqualValues(t, 20, got.LastContactUnixSec)
+		}
+
+		nodes, err := cache.GetAll(ctx, czarcoin.NodeIDList{fresh.Id, testczarcoin.NodeIDFromString("missing"), small.Id})
+		if assert.NoError(t, err) && assert.Len(t, nodes, 3) {
+			assert.Equal(t, fresh.Id, nodes[0].Id)
+			assert.Nil(t, nodes[1])
+			assert.Equal(t, small.Id, nodes[2].Id)
+		}
+
+		// more nodes than a single statement can select
+		many := make(czarcoin.NodeIDList, 1200)
+		for i := range many {
+			many[i] = testczarcoin.NodeIDFromString(fmt.Sprint("missing", i))
+		}
+		many[len(many)-1] = large.Id
+		nodes, err = cache.GetAll(ctx, many)
+		if assert.NoError(t, err) && assert.Len(t, nodes, len(many)) {
+			assert.Nil(t, nodes[0])
+			assert.Equal(t, large.Id, nodes[len(many)-1].Id)
+		}
+
+		count, err := cache.Count(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+
+		nodes, err = cache.List(ctx, czarcoin.NodeID{}, 2)
+		assert.NoError(t, err)
+		assert.Len(t, nodes, 2)
+
+		nodes, err = cache.FindStorageNodes(ctx, &overlay.NodeCriteria{FreeDisk: 100})
+		assert.NoError(t, err)
+		assert.Len(t, nodes, 2)
+
+		nodes, err = cache.FindStorageNodes(ctx, &overlay.NodeCriteria{FreeDisk: 100, AuditCount: 50})
+		if assert.NoError(t, err) && assert.Len(t, nodes, 1) {
+			assert.Equal(t, large.Id, nodes[0].Id)
+		}
+
+		nodes, err = cache.FindStorageNodes(ctx, &overlay.NodeCriteria{Excluded: czarcoin.NodeIDList{small.Id}})
+		assert.NoError(t, err)
+		assert.Len(t, nodes, 2)
+
+		nodes, err = cache.FindStorageNodes(ctx, &overlay.NodeCriteria{Excluded: czarcoin.NodeIDList{small.Id, large.Id}})
+		if assert.NoError(t, err) && assert.Len(t, nodes, 1) {
+			assert.Equal(t, fresh.Id, nodes[0].Id)
+		}
+
+		nodes, err = cache.FindStorageNodes(ctx, &overlay.NodeCriteria{Vetted: true})
+		assert.NoError(t, err)
+		assert.Len(t, nodes, 2)
+
+		nodes, err = cache.FindStorageNodes(ctx, &overlay.NodeCriteria{Unvetted: true})
+		if assert.NoError(t, err) && assert.Len(t, nodes, 1) {
+			assert.Equal(t, fresh.Id, nodes[0].Id)
+		}
+
+		nodes, err = cache.FindStorageNodes(ctx, &overlay.NodeCriteria{Limit: 2})
+		assert.NoError(t, err)
+		assert.Len(t, nodes, 2)
+
+		// disqualified nodes are never selected
+		fresh.Reputation.Disqualified = true
+		assert.NoError(t, cache.Update(ctx, fresh))
+		nodes, err = cache.FindStorageNodes(ctx, &overlay.NodeCriteria{FreeDisk: 100})
+		if assert.NoError(t, err) && assert.Len(t, nodes, 1) {
+			assert.Equal(t, large.Id, nodes[0].Id)
+		}
+
+		assert.NoError(t, cache.Delete(ctx, small.Id))
+		_, err = cache.Get(ctx, small.Id)
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+		{ // import a key value overlay cache
+			imported := newNode("imported", 500, 5)
+
+			kv := teststore.New()
+			for _, node := range []*pb.Node{imported, small} {
+				data, err := proto.Marshal(node)
+				assert.NoError(t, err)
+				assert.NoError(t, kv.Put(node.Id.Bytes(), data))
+			}
+
+			count, err := overlay.Import(ctx, kv, cache)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, count)
+
+			got, err := cache.Get(ctx, imported.Id)
+			if assert.NoError(t, err) {
+				assertEqualNodes(t, imported, got)
+			}
+		}
+	})
+}
+
+func assertEqualNodes(t *testing.T, expected, actual *pb.Node) {
+	expectedData, err := proto.Marshal(expected)
+	assert.NoError(t, err)
+	actualData, err := proto.Marshal(actual)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedData, actualData)
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	dbx "czarcoin.org/czarcoin/satellite/satellitedb/dbx"
+)
+
+type projectUsage struct {
+	db *dbx.DB
+}
+
+// AddStorage adds delta bytes to the data the project stores
+func (usage *projectUsage) AddStorage(ctx context.Context, projectID uuid.UUID, delta int64) error {
+	_, err := usage.db.ExecContext(ctx, usage.db.Rebind(
+		`INSERT INTO project_storages (project_id, total) VALUES (?, ?)
+		ON CONFLICT (project_id) DO UPDATE SET total = project_storages.total + excluded.total`),
+		projectID[:], delta)
+	return Error.Wrap(err)
+}
+
+// GetStorage returns the number of bytes the project stores
+func (usage *projectUsage) GetStorage(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	storage, err := usage.db.Get_ProjectStorage_By_ProjectId(ctx, dbx.ProjectStorage_ProjectId(projectID[:]))
+	if err != nil {
+		if dbxErr, ok := errs.Unwrap(err).(*dbx.Error); ok && dbxErr.Code == dbx.ErrorCode_NoRows {
+			return 0, nil
+		}
+		return 0, Error.Wrap(err)
+	}
+	return storage.Total, nil
+}
+
+// AddEgress adds bytes to the egress of the project within the month
+func (usage *projectUsage) AddEgress(ctx context.Context, projectID uuid.UUID, month time.Time, bytes int64) error {
+	_, err := usage.db.ExecContext(ctx, usage.db.Rebind(
+		`INSERT INTO project_egresses (project_id, month, total) VALUES (?, ?, ?)
+		ON CONFLICT (project_id, month) DO UPDATE SET total = project_egresses.total + excluded.total`),
+		projectID[:], month.UTC(), bytes)
+	return Error.Wrap(err)
+}
+
+// GetEgress returns the egress of the project within the month
+func (usage *projectUsage) GetEgress(ctx context.Context, projectID uuid.UUID, month time.Time) (int64, error) {
+	egress, err := usage.db.Get_ProjectEgress_By_ProjectId_Month(ctx,
+		dbx.ProjectEgress_ProjectId(projectID[:]), dbx.ProjectEgress_Month(month.UTC()))
+	if err != nil {
+		if dbxErr, ok := errs.Unwrap(err).(*dbx.Error); ok && dbxErr.Code == dbx.ErrorCode_NoRows {
+			return 0, nil
+		}
+		return 0, Error.Wrap(err)
+	}
+	return egress.Total, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/pkg/accounting/projectusage"
+)
+
+func TestProjectUsage(t *testing.T) {
+	testDrivers(t, func(ctx *testcontext.Context, t *testing.T, db *DB) {
+		if !assert.NoError(t, db.CreateTables()) {
+			return
+		}
+		usage := db.ProjectUsage()
+
+		projectID, err := uuid.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		stored, err := usage.GetStorage(ctx, *projectID)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 0, stored)
+
+		assert.NoError(t, usage.AddStorage(ctx, *projectID, 100))
+		assert.NoError(t, usage.AddStorage(ctx, *projectID, -30))
+		stored, err = usage.GetStorage(ctx, *projectID)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 70, stored)
+
+		month := projectusage.Month(time.Now())
+		next := month.AddDate(0, 1, 0)
+		assert.NoError(t, usage.AddEgress(ctx, *projectID, month, 10))
+		assert.NoError(t, usage.AddEgress(ctx, *projectID, month, 15))
+		assert.NoError(t, usage.AddEgress(ctx, *projectID, next, 5))
+
+		egress, err := usage.GetEgress(ctx, *projectID, month)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 25, egress)
+
+		egress, err = usage.GetEgress(ctx, *projectID, next)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 5, egress)
+	})
+}
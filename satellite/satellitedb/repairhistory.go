@@ -0,0 +1,100 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/datarepair/repairer"
+	"czarcoin.org/czarcoin/pkg/utils"
+	dbx "czarcoin.org/czarcoin/satellite/satellitedb/dbx"
+)
+
+type repairHistory struct {
+	db *dbx.DB
+}
+
+// Add records the outcome of a repair
+func (history *repairHistory) Add(ctx context.Context, record *repairer.Record) error {
+	id, err := uuid.New()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	newNodes := []byte{}
+	for _, nodeID := range record.NewNodes {
+		newNodes = append(newNodes, nodeID.Bytes()...)
+	}
+
+	_, err = history.db.Create_Repair(ctx,
+		dbx.Repair_Id(id[:]),
+		dbx.Repair_Path([]byte(record.Path)),
+		dbx.Repair_HealthyPieces(record.HealthyPieces),
+		dbx.Repair_RebuiltPieces(record.RebuiltPieces),
+		dbx.Repair_NewNodes(newNodes),
+		dbx.Repair_Failure(record.Failure),
+	)
+	return Error.Wrap(err)
+}
+
+// List returns up to limit records of path, or of all paths if path is
+// empty, the newest first
+func (history *repairHistory) List(ctx context.Context, path czarcoin.Path, limit, offset int) (records []*repairer.Record, err error) {
+	var rows *sql.Rows
+	if path == "" {
+		rows, err = history.db.QueryContext(ctx, history.db.Rebind(
+			`SELECT path, healthy_pieces, rebuilt_pieces, new_nodes, failure, repaired_at FROM repairs
+			ORDER BY repaired_at DESC LIMIT ? OFFSET ?`), limit, offset)
+	} else {
+		rows, err = history.db.QueryContext(ctx, history.db.Rebind(
+			`SELECT path, healthy_pieces, rebuilt_pieces, new_nodes, failure, repaired_at FROM repairs
+			WHERE path = ? ORDER BY repaired_at DESC LIMIT ? OFFSET ?`), []byte(path), limit, offset)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = utils.CombineErrors(err, rows.Close()) }()
+
+	for rows.Next() {
+		repair := &dbx.Repair{}
+		err := rows.Scan(&repair.Path, &repair.HealthyPieces, &repair.RebuiltPieces, &repair.NewNodes, &repair.Failure, &repair.RepairedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		record, err := convertRepair(repair)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, Error.Wrap(rows.Err())
+}
+
+func convertRepair(repair *dbx.Repair) (*repairer.Record, error) {
+	var newNodes czarcoin.NodeIDList
+	size := len(czarcoin.NodeID{})
+	for data := repair.NewNodes; len(data) > 0; data = data[size:] {
+		if len(data) < size {
+			return nil, Error.New("invalid new nodes of repair of %q", repair.Path)
+		}
+		nodeID, err := czarcoin.NodeIDFromBytes(data[:size])
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		newNodes = append(newNodes, nodeID)
+	}
+
+	return &repairer.Record{
+		Path:          czarcoin.Path(repair.Path),
+		HealthyPieces: repair.HealthyPieces,
+		RebuiltPieces: repair.RebuiltPieces,
+		NewNodes:      newNodes,
+		Failure:       repair.Failure,
+		RepairedAt:    repair.RepairedAt,
+	}, nil
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/internal/testczarcoin"
+	"czarcoin.org/czarcoin/pkg/czarcoin"
+	"czarcoin.org/czarcoin/pkg/datarepair/repairer"
+)
+
+func TestRepairHistory(t *testing.T) {
+	testDrivers(t, func(ctx *testcontext.Context, t *testing.T, db *DB) {
+		if !assert.NoError(t, db.CreateTables()) {
+			return
+		}
+		history := db.RepairHistory()
+
+		records := []*repairer.Record{
+			{
+				Path:          "a",
+				HealthyPieces: 5,
+				RebuiltPieces: 2,
+				NewNodes: czarcoin.NodeIDList{
+					testczarcoin.NodeIDFromString("new1"),
+					testczarcoin.NodeIDFromString("new2"),
+				},
+			},
+			{Path: "b", HealthyPieces: 4, Failure: "not enough nodes"},
+			{Path: "a", HealthyPieces: 7, RebuiltPieces: 0},
+		}
+		for _, record := range records {
+			assert.NoError(t, history.Add(ctx, record))
+		}
+
+		all, err := history.List(ctx, "", 10, 0)
+		assert.NoError(t, err)
+		assert.Len(t, all, 3)
+
+		listed, err := history.List(ctx, "a", 10, 0)
+		assert.NoError(t, err)
+		if assert.Len(t, listed, 2) {
+			assert.False(t, listed[0].RepairedAt.Before(listed[1].RepairedAt))
+		}
+
+		listed, err = history.List(ctx, "b", 10, 0)
+		assert.NoError(t, err)
+		if assert.Len(t, listed, 1) {
+			assert.Equal(t, "not enough nodes", listed[0].Failure)
+			assert.EqualValues(t, 4, listed[0].HealthyPieces)
+			assert.Empty(t, listed[0].NewNodes)
+		}
+
+		for _, record := range all {
+			if record.RebuiltPieces == 2 {
+				assert.Equal(t, records[0].NewNodes, record.NewNodes)
+			}
+		}
+
+		listed, err = history.List(ctx, "", 10, 2)
+		assert.NoError(t, err)
+		assert.Len(t, listed, 1)
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/pkg/utils"
+	dbx "czarcoin.org/czarcoin/satellite/satellitedb/dbx"
+	"czarcoin.org/czarcoin/storage"
+)
+
+type repairQueueDB struct {
+	db *dbx.DB
+}
+
+// Enqueue adds an injured segment or updates the entry of its path, the
+// lease of a queued segment is kept
+func (queue *repairQueueDB) Enqueue(ctx context.Context, seg *pb.InjuredSegment) error {
+	data, err := proto.Marshal(seg)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// a single upsert, so concurrent checkers finding the same segment don't
+	// race between an update and a create
+	_, err = queue.db.ExecContext(ctx, queue.db.Rebind(
+		`INSERT INTO injuredsegments (path, data, num_healthy, lease_unix_sec, created_at) VALUES (?, ?, ?, 0, ?)
+		ON CONFLICT (path) DO UPDATE SET data = excluded.data, num_healthy = excluded.num_healthy`),
+		[]byte(seg.GetPath()), data, int64(seg.GetNumHealthy()), time.Now().UTC())
+	return Error.Wrap(err)
+}
+
+// Claim leases the injured segment with the fewest healthy pieces among the
+// ones which aren't leased or whose lease expired
+func (queue *repairQueueDB) Claim(ctx context.Context, lease time.Duration) (*pb.InjuredSegment, error) {
+	for {
+		now := time.Now().Unix()
+
+		var path, data []byte
+		err := queue.db.QueryRowContext(ctx, queue.db.Rebind(
+			`SELECT path, data FROM injuredsegments WHERE lease_unix_sec <= ?
+			ORDER BY num_healthy ASC, created_at ASC LIMIT 1`), now).Scan(&path, &data)
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrEmptyQueue
+		}
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		res, err := queue.db.ExecContext(ctx, queue.db.Rebind(
+			`UPDATE injuredsegments SET lease_unix_sec = ? WHERE path = ? AND lease_unix_sec <= ?`),
+			now+int64(lease/time.Second), path, now)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		claimed, err := res.RowsAffected()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if claimed == 0 {
+			// another worker claimed the segment first
+			continue
+		}
+
+		seg := &pb.InjuredSegment{}
+		if err := proto.Unmarshal(data, seg); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return seg, nil
+	}
+}
+
+// Delete removes the injured segment of path
+func (queue *repairQueueDB) Delete(ctx context.Context, path string) error {
+	_, err := queue.db.Delete_Injuredsegment_By_Path(ctx, dbx.Injuredsegment_Path([]byte(path)))
+	return Error.Wrap(err)
+}
+
+// Peek returns up to limit injured segments, the ones with the fewest healthy
+// pieces first
+func (queue *repairQueueDB) Peek(ctx context.Context, limit int) (segs []pb.InjuredSegment, err error) {
+	rows, err := queue.db.QueryContext(ctx, queue.db.Rebind(
+		`SELECT data FROM injuredsegments ORDER BY num_healthy ASC, created_at ASC LIMIT ?`), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = utils.CombineErrors(err, rows.Close()) }()
+
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		seg := pb.InjuredSegment{}
+		if err := proto.Unmarshal(data, &seg); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		segs = append(segs, seg)
+	}
+	return segs, Error.Wrap(rows.Err())
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"czarcoin.org/czarcoin/internal/testcontext"
+	"czarcoin.org/czarcoin/pkg/pb"
+	"czarcoin.org/czarcoin/storage"
+)
+
+func TestRepairQueue(t *testing.T) {
+	testDrivers(t, func(ctx *testcontext.Context, t *testing.T, db *DB) {
+		if !assert.NoError(t, db.CreateTables()) {
+			return
+		}
+		queue := db.RepairQueueDB()
+
+		_, err := queue.Claim(ctx, time.Hour)
+		assert.Equal(t, storage.ErrEmptyQueue, err)
+
+		for _, seg := range []*pb.InjuredSegment{
+			{Path: "a", LostPieces: []int32{1}, NumHealthy: 8},
+			{Path: "b", LostPieces: []int32{1, 2, 3}, NumHealthy: 6},
+			{Path: "c", LostPieces: []int32{1, 2}, NumHealthy: 7},
+			// the checker finds the first segment again with more lost pieces
+			{Path: "a", LostPieces: []int32{1, 2, 3, 4}, NumHealthy: 5},
+		} {
+			assert.NoError(t, queue.Enqueue(ctx, seg))
+		}
+
+		peeked, err := queue.Peek(ctx, 10)
+		assert.NoError(t, err)
+		var paths []string
+		for _, seg := range peeked {
+			paths = append(paths, seg.Path)
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, paths)
+
+		// claimed segments are leased instead of removed
+		first, err := queue.Claim(ctx, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, "a", first.Path)
+		assert.Equal(t, []int32{1, 2, 3, 4}, first.LostPieces)
+
+		second, err := queue.Claim(ctx, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, "b", second.Path)
+
+		// an expired lease makes the segment available again
+		expired, err := queue.Claim(ctx, -time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, "c", expired.Path)
+
+		again, err := queue.Claim(ctx, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, "c", again.Path)
+
+		_, err = queue.Claim(ctx, time.Hour)
+		assert.Equal(t, storage.ErrEmptyQueue, err)
+
+		for _, path := range []string{"a", "b", "c"} {
+			assert.NoError(t, queue.Delete(ctx, path))
+		}
+		peeked, err = queue.Peek(ctx, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, peeked)
+	})
+}
+
+func TestRepairQueueConcurrentEnqueue(t *testing.T) {
+	testDrivers(t, func(ctx *testcontext.Context, t *testing.T, db *DB) {
+		if !assert.NoError(t, db.CreateTables()) {
+			return
+		}
+		queue := db.RepairQueueDB()
+
+		// checkers finding the same segment at once all succeed and leave a single entry
+		const n = 10
+		errs := make(chan error, n)
+		for i := 0; i < n; i++ {
+			seg := &pb.InjuredSegment{Path: "a", NumHealthy: int32(i)}
+			go func() { errs <- queue.Enqueue(ctx, seg) }()
+		}
+		for i := 0; i < n; i++ {
+			assert.NoError(t, <-errs)
+		}
+
+		peeked, err := queue.Peek(ctx, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, peeked, 1) {
+			assert.Equal(t, "a", peeked[0].Path)
+		}
+	})
+}
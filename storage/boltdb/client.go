@@ -120,6 +120,47 @@ func (client *Client) Put(key storage.Key, value storage.Value) error {
 	})
 }
 
+// Apply applies all changes of the batch in a single transaction
+func (client *Client) Apply(batch storage.Batch) error {
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		for _, op := range batch {
+			var err error
+			if op.Delete {
+				err = bucket.Delete(op.Key)
+			} else {
+				err = bucket.Put(op.Key, op.Value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CompareAndSwap replaces the value of key with newValue only if it is still oldValue,
+// checking and changing the value in a single transaction
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		current := bucket.Get(key)
+		if (oldValue == nil) != (current == nil) || !bytes.Equal(current, oldValue) {
+			return storage.ErrValueChanged.New(key.String())
+		}
+		if newValue == nil {
+			return bucket.Delete(key)
+		}
+		return bucket.Put(key, newValue)
+	})
+}
+
 // Get looks up the provided key from boltdb returning either an error or the result.
 func (client *Client) Get(key storage.Key) (storage.Value, error) {
 	if key.IsZero() {
@@ -19,6 +19,9 @@ var ErrKeyNotFound = errs.Class("key not found")
 // ErrEmptyKey is returned when an empty key is used in Put
 var ErrEmptyKey = errs.Class("empty key")
 
+// ErrValueChanged is returned by CompareAndSwap when the value isn't the expected one anymore
+var ErrValueChanged = errs.Class("value changed")
+
 // ErrEmptyQueue is returned when attempting to Dequeue from an empty queue
 var ErrEmptyQueue = errors.New("empty queue")
 
@@ -66,10 +69,46 @@ type KeyValueStore interface {
 	ReverseList(Key, int) (Keys, error)
 	// Iterate iterates over items based on opts
 	Iterate(opts IterateOptions, fn func(Iterator) error) error
+	// Apply applies all changes of the batch in order, either all of them or none
+	Apply(Batch) error
+	// CompareAndSwap replaces the value of key with newValue only if it is still oldValue,
+	// a nil oldValue expects the key to be missing and a nil newValue deletes it
+	CompareAndSwap(key Key, oldValue, newValue Value) error
 	// Close closes the store
 	Close() error
 }
 
+// Batch is a list of changes to a KeyValueStore which are applied atomically
+type Batch []BatchOp
+
+// BatchOp is a single change in a Batch, it deletes Key when Delete is set and
+// puts Value otherwise
+type BatchOp struct {
+	Key    Key
+	Value  Value
+	Delete bool
+}
+
+// Put adds a put of value at key to the batch
+func (batch *Batch) Put(key Key, value Value) {
+	*batch = append(*batch, BatchOp{Key: key, Value: value})
+}
+
+// Delete adds a delete of key to the batch, deleting a missing key is not an error
+func (batch *Batch) Delete(key Key) {
+	*batch = append(*batch, BatchOp{Key: key, Delete: true})
+}
+
+// Validate checks that none of the changes uses an empty key
+func (batch Batch) Validate() error {
+	for _, op := range batch {
+		if op.Key.IsZero() {
+			return ErrEmptyKey.New("")
+		}
+	}
+	return nil
+}
+
 //Queue is an interface describing queue stores like redis
 type Queue interface {
 	//Enqueue add a FIFO element
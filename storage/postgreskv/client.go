@@ -111,6 +111,100 @@ func (client *Client) DeletePath(bucket, key storage.Key) error {
 	return nil
 }
 
+// Apply applies all changes of the batch in a single transaction
+func (client *Client) Apply(batch storage.Batch) error {
+	return client.ApplyPath(storage.Key(defaultBucket), batch)
+}
+
+// ApplyPath applies all changes of the batch (in the given bucket) in a single transaction
+func (client *Client) ApplyPath(bucket storage.Key, batch storage.Batch) (err error) {
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := client.pgConn.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = utils.CombineErrors(err, tx.Rollback())
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	for _, op := range batch {
+		if op.Delete {
+			_, err = tx.Exec("DELETE FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA", []byte(bucket), []byte(op.Key))
+		} else {
+			_, err = tx.Exec(`
+				INSERT INTO pathdata (bucket, fullpath, metadata)
+					VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
+					ON CONFLICT (bucket, fullpath) DO UPDATE SET metadata = EXCLUDED.metadata
+			`, []byte(bucket), []byte(op.Key), []byte(op.Value))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CompareAndSwap replaces the value of key with newValue only if it is still oldValue.
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	return client.CompareAndSwapPath(storage.Key(defaultBucket), key, oldValue, newValue)
+}
+
+// CompareAndSwapPath replaces the value of key (in the given bucket) with newValue only if it is still
+// oldValue, using a single conditional statement.
+func (client *Client) CompareAndSwapPath(bucket, key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	var result sql.Result
+	var err error
+	switch {
+	case oldValue == nil && newValue == nil:
+		_, err = client.GetPath(bucket, key)
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return storage.ErrValueChanged.New(key.String())
+	case oldValue == nil:
+		result, err = client.pgConn.Exec(`
+			INSERT INTO pathdata (bucket, fullpath, metadata)
+				VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
+				ON CONFLICT (bucket, fullpath) DO NOTHING
+		`, []byte(bucket), []byte(key), []byte(newValue))
+	case newValue == nil:
+		result, err = client.pgConn.Exec(`
+			DELETE FROM pathdata
+				WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $3::BYTEA
+		`, []byte(bucket), []byte(key), []byte(oldValue))
+	default:
+		result, err = client.pgConn.Exec(`
+			UPDATE pathdata SET metadata = $3::BYTEA
+				WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $4::BYTEA
+		`, []byte(bucket), []byte(key), []byte(newValue), []byte(oldValue))
+	}
+	if err != nil {
+		return err
+	}
+	numRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numRows == 0 {
+		return storage.ErrValueChanged.New(key.String())
+	}
+	return nil
+}
+
 // List returns either a list of known keys, in order, or an error.
 func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	return storage.ListKeys(client, first, limit)
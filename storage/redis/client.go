@@ -4,6 +4,7 @@
 package redis
 
 import (
+	"bytes"
 	"sort"
 	"strconv"
 	"time"
@@ -97,6 +98,65 @@ func (client *Client) Put(key storage.Key, value storage.Value) error {
 	return nil
 }
 
+// Apply applies all changes of the batch in a single MULTI/EXEC transaction
+func (client *Client) Apply(batch storage.Batch) error {
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	_, err := client.db.TxPipelined(func(pipe redis.Pipeliner) error {
+		for _, op := range batch {
+			if op.Delete {
+				pipe.Del(op.Key.String())
+			} else {
+				pipe.Set(op.Key.String(), []byte(op.Value), client.TTL)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.New("apply error: %v", err)
+	}
+	return nil
+}
+
+// CompareAndSwap replaces the value of key with newValue only if it is still oldValue,
+// watching the key so the MULTI/EXEC transaction fails when it changes in between
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	err := client.db.Watch(func(tx *redis.Tx) error {
+		current, err := tx.Get(key.String()).Bytes()
+		if err == redis.Nil {
+			current = nil
+		} else if err != nil {
+			return Error.New("get error: %v", err)
+		}
+		if (oldValue == nil) != (current == nil) || !bytes.Equal(current, oldValue) {
+			return storage.ErrValueChanged.New(key.String())
+		}
+
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			if newValue == nil {
+				pipe.Del(key.String())
+			} else {
+				pipe.Set(key.String(), []byte(newValue), client.TTL)
+			}
+			return nil
+		})
+		return err
+	}, key.String())
+	if err == redis.TxFailedErr {
+		return storage.ErrValueChanged.New(key.String())
+	}
+	if err != nil && !storage.ErrValueChanged.Has(err) && !Error.Has(err) {
+		return Error.New("compare and swap error: %v", err)
+	}
+	return err
+}
+
 // List returns either a list of keys for which boltdb has values or an error.
 func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	return storage.ListKeys(client, first, limit)
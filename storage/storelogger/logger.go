@@ -51,6 +51,18 @@ func (store *Logger) Delete(key storage.Key) error {
 	return store.store.Delete(key)
 }
 
+// Apply applies all changes of the batch atomically
+func (store *Logger) Apply(batch storage.Batch) error {
+	store.log.Debug("Apply", zap.Int("changes", len(batch)))
+	return store.store.Apply(batch)
+}
+
+// CompareAndSwap replaces the value of key with newValue only if it is still oldValue
+func (store *Logger) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	store.log.Debug("CompareAndSwap", zap.String("key", string(key)), zap.Binary("old value", []byte(oldValue)), zap.Binary("new value", []byte(newValue)))
+	return store.store.CompareAndSwap(key, oldValue, newValue)
+}
+
 // List lists all keys starting from first and upto limit items
 func (store *Logger) List(first storage.Key, limit int) (storage.Keys, error) {
 	keys, err := store.store.List(first, limit)
@@ -30,6 +30,8 @@ type Client struct {
 		Delete      int
 		Close       int
 		Iterate     int
+		Apply       int
+		CAS         int
 	}
 
 	version int
@@ -166,6 +168,81 @@ func (store *Client) Delete(key storage.Key) error {
 	return nil
 }
 
+// Apply applies all changes of the batch in order, either all of them or none
+func (store *Client) Apply(batch storage.Batch) error {
+	defer store.locked()()
+
+	store.version++
+	store.CallCount.Apply++
+	if store.forcedError() {
+		return errInternal
+	}
+
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	for _, op := range batch {
+		keyIndex, found := store.indexOf(op.Key)
+		switch {
+		case op.Delete && found:
+			copy(store.Items[keyIndex:], store.Items[keyIndex+1:])
+			store.Items = store.Items[:len(store.Items)-1]
+		case op.Delete:
+		case found:
+			store.Items[keyIndex].Value = storage.CloneValue(op.Value)
+		default:
+			store.Items = append(store.Items, storage.ListItem{})
+			copy(store.Items[keyIndex+1:], store.Items[keyIndex:])
+			store.Items[keyIndex] = storage.ListItem{
+				Key:   storage.CloneKey(op.Key),
+				Value: storage.CloneValue(op.Value),
+			}
+		}
+	}
+	return nil
+}
+
+// CompareAndSwap replaces the value of key with newValue only if it is still oldValue
+func (store *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	defer store.locked()()
+
+	store.version++
+	store.CallCount.CAS++
+	if store.forcedError() {
+		return errInternal
+	}
+
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	keyIndex, found := store.indexOf(key)
+	switch {
+	case oldValue == nil && found:
+		return storage.ErrValueChanged.New(key.String())
+	case oldValue != nil && (!found || !bytes.Equal(store.Items[keyIndex].Value, oldValue)):
+		return storage.ErrValueChanged.New(key.String())
+	}
+
+	switch {
+	case newValue == nil && found:
+		copy(store.Items[keyIndex:], store.Items[keyIndex+1:])
+		store.Items = store.Items[:len(store.Items)-1]
+	case newValue == nil:
+	case found:
+		store.Items[keyIndex].Value = storage.CloneValue(newValue)
+	default:
+		store.Items = append(store.Items, storage.ListItem{})
+		copy(store.Items[keyIndex+1:], store.Items[keyIndex:])
+		store.Items[keyIndex] = storage.ListItem{
+			Key:   storage.CloneKey(key),
+			Value: storage.CloneValue(newValue),
+		}
+	}
+	return nil
+}
+
 // List lists all keys starting from start and upto limit items
 func (store *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	store.mu.Lock()
@@ -19,6 +19,8 @@ func RunTests(t *testing.T, store storage.KeyValueStore) {
 	t.Run("Iterate", func(t *testing.T) { testIterate(t, store) })
 	t.Run("IterateAll", func(t *testing.T) { testIterateAll(t, store) })
 	t.Run("Prefix", func(t *testing.T) { testPrefix(t, store) })
+	t.Run("Batch", func(t *testing.T) { testBatch(t, store) })
+	t.Run("CompareAndSwap", func(t *testing.T) { testCompareAndSwap(t, store) })
 
 	t.Run("List", func(t *testing.T) { testList(t, store) })
 	t.Run("ListV2", func(t *testing.T) { testListV2(t, store) })
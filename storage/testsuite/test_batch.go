@@ -0,0 +1,64 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"czarcoin.org/czarcoin/storage"
+)
+
+func testBatch(t *testing.T, store storage.KeyValueStore) {
+	items := storage.Items{
+		newItem("batch/a", "a", false),
+		newItem("batch/b", "b", false),
+		newItem("batch/c", "c", false),
+	}
+	defer cleanupItems(store, items)
+
+	if err := store.Put(items[0].Key, items[0].Value); err != nil {
+		t.Fatalf("failed to put %q: %v", items[0].Key, err)
+	}
+
+	t.Run("Apply", func(t *testing.T) {
+		var batch storage.Batch
+		batch.Delete(items[0].Key)
+		batch.Put(items[1].Key, storage.Value("old"))
+		batch.Put(items[2].Key, items[2].Value)
+		batch.Put(items[1].Key, items[1].Value)
+		batch.Delete(storage.Key("batch/missing"))
+
+		if err := store.Apply(batch); err != nil {
+			t.Fatalf("failed to apply batch: %v", err)
+		}
+
+		if _, err := store.Get(items[0].Key); !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("expected %q to be deleted, got %v", items[0].Key, err)
+		}
+		for _, item := range items[1:] {
+			value, err := store.Get(item.Key)
+			if err != nil {
+				t.Fatalf("failed to get %q: %v", item.Key, err)
+			}
+			if !bytes.Equal(value, item.Value) {
+				t.Fatalf("invalid value for %q = %v: got %v", item.Key, item.Value, value)
+			}
+		}
+	})
+
+	t.Run("Apply Empty Key", func(t *testing.T) {
+		var batch storage.Batch
+		batch.Put(items[0].Key, items[0].Value)
+		batch.Put(nil, storage.Value("x"))
+
+		if err := store.Apply(batch); err == nil {
+			t.Fatal("applying a batch with an empty key should fail")
+		}
+		// nothing of the failed batch is applied
+		if _, err := store.Get(items[0].Key); !storage.ErrKeyNotFound.Has(err) {
+			t.Fatalf("expected %q to be missing, got %v", items[0].Key, err)
+		}
+	})
+}
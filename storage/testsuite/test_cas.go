@@ -0,0 +1,71 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"czarcoin.org/czarcoin/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	key := storage.Key("cas/a")
+	defer func() { _ = store.Delete(key) }()
+
+	checkValue := func(expected storage.Value) {
+		t.Helper()
+		value, err := store.Get(key)
+		if expected == nil {
+			if !storage.ErrKeyNotFound.Has(err) {
+				t.Fatalf("expected %q to be missing, got %v", key, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("failed to get %q: %v", key, err)
+		}
+		if !bytes.Equal(value, expected) {
+			t.Fatalf("invalid value for %q = %v: got %v", key, expected, value)
+		}
+	}
+
+	// creating only works while the key is missing
+	if err := store.CompareAndSwap(key, nil, storage.Value("a")); err != nil {
+		t.Fatalf("failed to create %q: %v", key, err)
+	}
+	if err := store.CompareAndSwap(key, nil, storage.Value("b")); !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("expected creating existing %q to fail, got %v", key, err)
+	}
+	checkValue(storage.Value("a"))
+
+	// swapping only works with the current value
+	if err := store.CompareAndSwap(key, storage.Value("x"), storage.Value("b")); !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("expected swapping with a stale value to fail, got %v", err)
+	}
+	checkValue(storage.Value("a"))
+	if err := store.CompareAndSwap(key, storage.Value("a"), storage.Value("b")); err != nil {
+		t.Fatalf("failed to swap %q: %v", key, err)
+	}
+	checkValue(storage.Value("b"))
+
+	// deleting only works with the current value
+	if err := store.CompareAndSwap(key, storage.Value("a"), nil); !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("expected deleting with a stale value to fail, got %v", err)
+	}
+	if err := store.CompareAndSwap(key, storage.Value("b"), nil); err != nil {
+		t.Fatalf("failed to delete %q: %v", key, err)
+	}
+	checkValue(nil)
+
+	// a missing key doesn't match any value
+	if err := store.CompareAndSwap(key, storage.Value("b"), storage.Value("c")); !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("expected swapping missing %q to fail, got %v", key, err)
+	}
+	checkValue(nil)
+
+	if err := store.CompareAndSwap(nil, nil, storage.Value("a")); !storage.ErrEmptyKey.Has(err) {
+		t.Fatalf("expected an empty key to fail, got %v", err)
+	}
+}